@@ -51,6 +51,9 @@ const (
 	// CPULimitAnnotation enforces a strict CPU limit on a workspace by virtue of ws-daemon
 	CPULimitAnnotation = "gitpod/cpuLimit"
 
+	// LastBackupAnnotation is set by ws-daemon on a workspace pod and contains the time of the last successful backup while the workspace was running
+	LastBackupAnnotation = "gitpod/lastBackup"
+
 	// RequiredNodeServicesAnnotation lists all Gitpod services required on the node
 	RequiredNodeServicesAnnotation = "gitpod.io/requiredNodeServices"
 )
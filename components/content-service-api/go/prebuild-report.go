@@ -0,0 +1,43 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package api
+
+const (
+	// PrebuildReportFile is the location of the prebuild report in a workspace, relative to the workspace root
+	PrebuildReportFile = ".gitpod/prebuild-report.json"
+
+	// ContentTypePrebuildReport is the content type for a JSON serialized PrebuildReport
+	ContentTypePrebuildReport = "application/vnd.gitpod.prebuild-report.v1+json"
+)
+
+// PrebuildReport describes how a prebuild went. Supervisor writes it into the workspace once the prebuild
+// tasks are done, ws-daemon adds the snapshot and uploads it next to the snapshot.
+type PrebuildReport struct {
+	// Snapshot is the snapshot the prebuild produced
+	Snapshot string `json:"snapshot,omitempty"`
+
+	Tasks []PrebuildTaskReport `json:"tasks"`
+}
+
+// PrebuildTaskReport describes how a single prebuild task went
+type PrebuildTaskReport struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Failed   bool   `json:"failed,omitempty"`
+	Failure  string `json:"failure,omitempty"`
+	ExitCode int32  `json:"exitCode"`
+
+	Phases []PrebuildTaskPhase `json:"phases"`
+
+	// LogFile is the name of the file in the workspace which holds the task's output. It lives next to the prebuild report.
+	LogFile string `json:"logFile,omitempty"`
+}
+
+// PrebuildTaskPhase describes how a single phase of a prebuild task went
+type PrebuildTaskPhase struct {
+	Name       string `json:"name"`
+	ExitCode   int32  `json:"exitCode"`
+	DurationMS int64  `json:"durationMs"`
+}
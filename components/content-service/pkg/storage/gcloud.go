@@ -17,6 +17,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
@@ -337,8 +338,15 @@ func (rs *DirectGCPStorage) Upload(ctx context.Context, source string, name stri
 	 * so we'll have 32 chunks max. See https://cloud.google.com/storage/docs/composite-objects
 	 * for more details.
 	 */
+	var progress func(n int64)
+	if options.Progress != nil {
+		var uploaded int64
+		progress = func(n int64) {
+			options.Progress(atomic.AddInt64(&uploaded, n), totalSize)
+		}
+	}
 	var chunks []string
-	if chunks, err = rs.uploadChunks(opentracing.ContextWithSpan(ctx, uploadSpan), sfn, totalSize, rs.GCPConfig.ParallelUpload); err != nil {
+	if chunks, err = rs.uploadChunks(opentracing.ContextWithSpan(ctx, uploadSpan), sfn, totalSize, rs.GCPConfig.ParallelUpload, progress); err != nil {
 		tracing.FinishSpan(uploadSpan, &err)
 		return
 	}
@@ -455,7 +463,7 @@ func (rs *DirectGCPStorage) ensureBackupSlotAvailable() error {
 	return nil
 }
 
-func (rs *DirectGCPStorage) uploadChunks(ctx context.Context, f io.ReaderAt, totalSize int64, desiredChunkCount int) (chnks []string, err error) {
+func (rs *DirectGCPStorage) uploadChunks(ctx context.Context, f io.ReaderAt, totalSize int64, desiredChunkCount int, progress func(n int64)) (chnks []string, err error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "uploadChunks")
 	defer tracing.FinishSpan(span, &err)
 
@@ -493,7 +501,10 @@ func (rs *DirectGCPStorage) uploadChunks(ctx context.Context, f io.ReaderAt, tot
 		if off+n > totalSize {
 			n = totalSize - off
 		}
-		r := io.NewSectionReader(f, off, n)
+		var r io.Reader = io.NewSectionReader(f, off, n)
+		if progress != nil {
+			r = &progressReader{R: r, Report: progress}
+		}
 		chunkName := fmt.Sprintf("%s/%d-upload", pfx, i)
 		chunks[i] = chunkName
 
@@ -563,6 +574,20 @@ func (rs *DirectGCPStorage) uploadChunk(ctx context.Context, name string, r io.R
 	log.WithField("name", name).WithField("duration", time.Since(start)).Debug("Upload complete")
 }
 
+// progressReader reports the number of bytes read from the underlying reader
+type progressReader struct {
+	R      io.Reader
+	Report func(n int64)
+}
+
+func (p *progressReader) Read(b []byte) (n int, err error) {
+	n, err = p.R.Read(b)
+	if n > 0 {
+		p.Report(int64(n))
+	}
+	return
+}
+
 func (rs *DirectGCPStorage) deleteChunks(ctx context.Context, chunks []string) (err error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "deleteChunks")
 	defer tracing.FinishSpan(span, &err)
@@ -657,7 +682,7 @@ func (rs *DirectGCPStorage) workspacePrefix() string {
 }
 
 func (rs *DirectGCPStorage) objectName(name string) string {
-	return fmt.Sprintf("%s/%s", rs.workspacePrefix(), name)
+	return WorkspaceObject(rs.WorkspaceName, name)
 }
 
 func (rs *DirectGCPStorage) trailPrefix() string {
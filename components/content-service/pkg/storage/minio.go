@@ -9,7 +9,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
@@ -221,6 +223,15 @@ func (rs *DirectMinIOStorage) Upload(ctx context.Context, source string, name st
 		return
 	}
 
+	var progress io.Reader
+	if options.Progress != nil {
+		stat, err := os.Stat(source)
+		if err != nil {
+			return "", "", xerrors.Errorf("cannot stat upload source: %w", err)
+		}
+		progress = &progressSink{Total: stat.Size(), Report: options.Progress}
+	}
+
 	// upload the thing
 	bucket = rs.bucketName()
 	obj = rs.objectName(name)
@@ -228,6 +239,7 @@ func (rs *DirectMinIOStorage) Upload(ctx context.Context, source string, name st
 		NumThreads:   rs.MinIOConfig.ParallelUpload,
 		UserMetadata: options.Annotations,
 		ContentType:  options.ContentType,
+		Progress:     progress,
 	})
 	if err != nil {
 		return
@@ -236,6 +248,19 @@ func (rs *DirectMinIOStorage) Upload(ctx context.Context, source string, name st
 	return
 }
 
+// progressSink is read by the MinIO client for every chunk of bytes it uploaded
+type progressSink struct {
+	Total  int64
+	Report func(uploaded, total int64)
+
+	uploaded int64
+}
+
+func (p *progressSink) Read(b []byte) (n int, err error) {
+	p.Report(atomic.AddInt64(&p.uploaded, int64(len(b))), p.Total)
+	return len(b), nil
+}
+
 func minioBucketName(ownerID string) string {
 	return fmt.Sprintf("gitpod-user-%s", ownerID)
 }
@@ -255,7 +280,7 @@ func (rs *DirectMinIOStorage) bucketName() string {
 }
 
 func (rs *DirectMinIOStorage) objectName(name string) string {
-	return fmt.Sprintf("workspaces/%s/%s", rs.WorkspaceName, name)
+	return WorkspaceObject(rs.WorkspaceName, name)
 }
 
 func newPresignedMinIOAccess(cfg MinIOConfig) (*presignedMinIOStorage, error) {
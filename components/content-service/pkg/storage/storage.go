@@ -24,8 +24,24 @@ const (
 
 	// FmtFullWorkspaceBackup is the format for names of full workspace backups
 	FmtFullWorkspaceBackup = "wsfull-%d.tar"
+
+	// PrebuildReport is the name of the report a prebuild uploads next to its snapshot
+	PrebuildReport = "prebuild-report.json"
+
+	// fmtPrebuildLog is the format for names of the task logs a prebuild uploads next to its snapshot
+	fmtPrebuildLog = "prebuild-log-%s.txt"
 )
 
+// PrebuildLog returns the name of the log a prebuild uploads for one of its tasks
+func PrebuildLog(taskID string) string {
+	return fmt.Sprintf(fmtPrebuildLog, taskID)
+}
+
+// WorkspaceObject returns the object name of a workspace's storage object, e.g. a snapshot or prebuild report
+func WorkspaceObject(workspaceID, name string) string {
+	return fmt.Sprintf("workspaces/%s/%s", workspaceID, name)
+}
+
 var (
 	// ErrNotFound is returned when an object is not found
 	ErrNotFound = fmt.Errorf("not found")
@@ -106,6 +122,9 @@ type UploadOptions struct {
 	Annotations map[string]string
 
 	ContentType string
+
+	// Progress is called while uploading with the number of bytes uploaded so far and the total size of the upload
+	Progress func(uploaded, total int64)
 }
 
 // UploadOption configures a particular aspect of remote storage upload
@@ -145,6 +164,14 @@ func WithContentType(ct string) UploadOption {
 	}
 }
 
+// WithProgress reports the upload progress to fn. fn can be called concurrently and must not block.
+func WithProgress(fn func(uploaded, total int64)) UploadOption {
+	return func(opts *UploadOptions) error {
+		opts.Progress = fn
+		return nil
+	}
+}
+
 // GetUploadOptions turns functional opts into a struct
 func GetUploadOptions(opts []UploadOption) (*UploadOptions, error) {
 	res := &UploadOptions{}
@@ -0,0 +1,133 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+)
+
+// configApplyTimeout is the time we give supervisor to apply the config, including restarting changed tasks
+const configApplyTimeout = 1 * time.Minute
+
+var configApplyOpts struct {
+	DryRun  bool
+	Restart bool
+	Yes     bool
+}
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Works with the configuration of this workspace",
+}
+
+var configApplyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Applies the tasks and ports configured in .gitpod.yml to this workspace",
+	Long: `Applies the tasks and ports configured in .gitpod.yml to this workspace.
+Added tasks are started. Tasks whose configuration changed keep running unless you choose to restart them.
+Removed tasks keep running until you close their terminal. Changes to the visibility and onOpen action
+of ports take effect once applied.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), configApplyTimeout)
+		defer cancel()
+
+		conn, err := dialSupervisor(ctx)
+		if err != nil {
+			log.Fatalf("cannot connect to supervisor: %s", err)
+		}
+		defer conn.Close()
+		client := api.NewControlServiceClient(conn)
+
+		plan, err := client.ReloadConfig(ctx, &api.ReloadConfigRequest{DryRun: true, RestartChangedTasks: configApplyOpts.Restart})
+		if err != nil {
+			log.Fatalf("cannot compute config changes: %s", err)
+		}
+		if len(plan.Tasks) == 0 && len(plan.Ports) == 0 {
+			fmt.Println("The workspace is up to date with .gitpod.yml")
+			return
+		}
+		printConfigChanges(plan)
+		if configApplyOpts.DryRun {
+			return
+		}
+
+		restart := configApplyOpts.Restart
+		if !restart && !configApplyOpts.Yes && hasChangedTasks(plan) {
+			prompt := promptui.Prompt{
+				IsConfirm: true,
+				Label:     "Restart the changed tasks",
+			}
+			_, err := prompt.Run()
+			restart = err == nil
+		}
+		if !configApplyOpts.Yes {
+			prompt := promptui.Prompt{
+				IsConfirm: true,
+				Label:     "Apply these changes",
+			}
+			if _, err := prompt.Run(); err != nil {
+				fmt.Println("Not applying the changes.")
+				os.Exit(1)
+			}
+		}
+
+		res, err := client.ReloadConfig(ctx, &api.ReloadConfigRequest{RestartChangedTasks: restart})
+		if err != nil {
+			log.Fatalf("cannot apply config changes: %s", err)
+		}
+		if !res.Applied {
+			fmt.Println("The workspace is up to date with .gitpod.yml")
+			return
+		}
+		fmt.Println("Applied the changes.")
+	},
+}
+
+func hasChangedTasks(plan *api.ReloadConfigResponse) bool {
+	for _, t := range plan.Tasks {
+		if t.Kind == api.ConfigChangeKind_config_changed {
+			return true
+		}
+	}
+	return false
+}
+
+func printConfigChanges(plan *api.ReloadConfigResponse) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	if len(plan.Tasks) > 0 {
+		fmt.Fprintln(w, "TASK\tCHANGE\tACTION")
+		for _, t := range plan.Tasks {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", t.Name, strings.TrimPrefix(t.Kind.String(), "config_"), strings.TrimPrefix(t.Action.String(), "task_"))
+		}
+		fmt.Fprintln(w)
+	}
+	if len(plan.Ports) > 0 {
+		fmt.Fprintln(w, "PORT\tCHANGE\tVISIBILITY\tON OPEN")
+		for _, p := range plan.Ports {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", p.Port, strings.TrimPrefix(p.Kind.String(), "config_"), p.Visibility, p.OnOpen)
+		}
+		fmt.Fprintln(w)
+	}
+	w.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configApplyCmd)
+	configApplyCmd.Flags().BoolVar(&configApplyOpts.DryRun, "dry-run", false, "only show the changes without applying them")
+	configApplyCmd.Flags().BoolVar(&configApplyOpts.Restart, "restart", false, "restart tasks whose configuration changed")
+	configApplyCmd.Flags().BoolVarP(&configApplyOpts.Yes, "yes", "y", false, "apply the changes without asking")
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/spf13/cobra"
+)
+
+var notifyOpts struct {
+	Level string
+}
+
+var askOpts struct {
+	Level   string
+	Options []string
+	Timeout time.Duration
+}
+
+var notifyCmd = &cobra.Command{
+	Use:   "notify <message>",
+	Short: "Shows a notification in the IDE",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		level, err := parseNotificationLevel(notifyOpts.Level)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_, err = notify(ctx, &api.NotifyRequest{
+			Level:   level,
+			Message: strings.Join(args, " "),
+		})
+		if err != nil {
+			log.Fatalf("cannot show notification: %s", err)
+		}
+	},
+}
+
+var askCmd = &cobra.Command{
+	Use:   "ask <question>",
+	Short: "Asks the user a question in the IDE and prints their answer",
+	Long: `Asks the user a question in the IDE and prints their answer.
+Exits with code 1 if the user dismissed the question, e.g.
+
+	if [ "$(gp ask -o Yes -o No 'Deploy to staging?')" = "Yes" ]; then ./deploy.sh; fi`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		level, err := parseNotificationLevel(askOpts.Level)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(askOpts.Options) == 0 {
+			log.Fatal("at least one option is required")
+		}
+
+		ctx := context.Background()
+		if askOpts.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, askOpts.Timeout)
+			defer cancel()
+		}
+		resp, err := notify(ctx, &api.NotifyRequest{
+			Level:   level,
+			Message: strings.Join(args, " "),
+			Actions: askOpts.Options,
+		})
+		if err != nil {
+			log.Fatalf("cannot ask the user: %s", err)
+		}
+		if resp.Action == "" {
+			os.Exit(1)
+		}
+		fmt.Println(resp.Action)
+	},
+}
+
+func notify(ctx context.Context, req *api.NotifyRequest) (*api.NotifyResponse, error) {
+	conn, err := dialSupervisor(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to supervisor: %w", err)
+	}
+	defer conn.Close()
+
+	return api.NewNotificationServiceClient(conn).Notify(ctx, req)
+}
+
+func parseNotificationLevel(level string) (api.NotificationLevel, error) {
+	l, ok := api.NotificationLevel_value["notification_"+level]
+	if !ok {
+		return 0, fmt.Errorf("unknown level %q - must be one of info, warning or error", level)
+	}
+	return api.NotificationLevel(l), nil
+}
+
+func init() {
+	rootCmd.AddCommand(notifyCmd)
+	notifyCmd.Flags().StringVarP(&notifyOpts.Level, "level", "l", "info", "level of the notification: info, warning or error")
+
+	rootCmd.AddCommand(askCmd)
+	askCmd.Flags().StringVarP(&askOpts.Level, "level", "l", "info", "level of the question: info, warning or error")
+	askCmd.Flags().StringArrayVarP(&askOpts.Options, "option", "o", []string{"Yes", "No"}, "answer the user can choose - can be given multiple times")
+	askCmd.Flags().DurationVar(&askOpts.Timeout, "timeout", 0, "time to wait for an answer - waits forever if zero")
+}
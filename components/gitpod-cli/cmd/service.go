@@ -0,0 +1,104 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/spf13/cobra"
+)
+
+// serviceControlTimeout is the time we give supervisor to start, stop or restart a service
+const serviceControlTimeout = 30 * time.Second
+
+var serviceCmd = &cobra.Command{
+	Use:   "service",
+	Short: "Lists and controls the background services of this workspace",
+}
+
+var serviceListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists the background services of this workspace",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		conn, err := dialSupervisor(ctx)
+		if err != nil {
+			log.Fatalf("cannot connect to supervisor: %s", err)
+		}
+		defer conn.Close()
+
+		resp, err := api.NewStatusServiceClient(conn).ServicesStatus(ctx, &api.ServicesStatusRequest{})
+		if err != nil {
+			log.Fatalf("cannot get service status: %s", err)
+		}
+		status, err := resp.Recv()
+		if err != nil {
+			log.Fatalf("cannot get service status: %s", err)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "NAME\tSTATE\tPID\tRESTARTS\tEXIT CODE\tLOG")
+		for _, s := range status.Services {
+			state := strings.TrimPrefix(s.State.String(), "service_")
+			pid := "-"
+			if s.Pid != 0 {
+				pid = fmt.Sprint(s.Pid)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%s\n", s.Name, state, pid, s.Restarts, s.ExitCode, s.LogFile)
+		}
+		w.Flush()
+	},
+}
+
+// serviceControlCmd produces a command which controls a single service
+func serviceControlCmd(use, short string, control func(ctx context.Context, client api.ControlServiceClient, name string) error) *cobra.Command {
+	return &cobra.Command{
+		Use:   use + " <name>",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), serviceControlTimeout)
+			defer cancel()
+
+			conn, err := dialSupervisor(ctx)
+			if err != nil {
+				log.Fatalf("cannot connect to supervisor: %s", err)
+			}
+			defer conn.Close()
+
+			err = control(ctx, api.NewControlServiceClient(conn), args[0])
+			if err != nil {
+				log.Fatalf("cannot %s service %s: %s", use, args[0], err)
+			}
+		},
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(serviceCmd)
+	serviceCmd.AddCommand(serviceListCmd)
+	serviceCmd.AddCommand(serviceControlCmd("start", "Starts a stopped or failed background service", func(ctx context.Context, client api.ControlServiceClient, name string) error {
+		_, err := client.StartService(ctx, &api.StartServiceRequest{Name: name})
+		return err
+	}))
+	serviceCmd.AddCommand(serviceControlCmd("stop", "Stops a background service", func(ctx context.Context, client api.ControlServiceClient, name string) error {
+		_, err := client.StopService(ctx, &api.StopServiceRequest{Name: name})
+		return err
+	}))
+	serviceCmd.AddCommand(serviceControlCmd("restart", "Restarts a background service", func(ctx context.Context, client api.ControlServiceClient, name string) error {
+		_, err := client.RestartService(ctx, &api.RestartServiceRequest{Name: name})
+		return err
+	}))
+}
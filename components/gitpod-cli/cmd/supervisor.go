@@ -0,0 +1,28 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// defaultSupervisorAddr is the address of the supervisor API if SUPERVISOR_ADDR isn't set
+const defaultSupervisorAddr = "localhost:22999"
+
+// dialSupervisor connects to the supervisor API of the workspace
+func dialSupervisor(ctx context.Context) (*grpc.ClientConn, error) {
+	addr := os.Getenv("SUPERVISOR_ADDR")
+	if addr == "" {
+		addr = defaultSupervisorAddr
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	return grpc.DialContext(ctx, addr, grpc.WithInsecure(), grpc.WithBlock())
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/spf13/cobra"
+)
+
+var topOpts struct {
+	Watch bool
+	Top   uint32
+}
+
+var topCmd = &cobra.Command{
+	Use:   "top",
+	Short: "Shows the resource usage of this workspace and its top processes",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		conn, err := dialSupervisor(ctx)
+		if err != nil {
+			log.Fatalf("cannot connect to supervisor: %s", err)
+		}
+		defer conn.Close()
+
+		resp, err := api.NewStatusServiceClient(conn).ResourceStatus(ctx, &api.ResourceStatusRequest{
+			Observe: topOpts.Watch,
+			Top:     topOpts.Top,
+		})
+		if err != nil {
+			log.Fatalf("cannot get resource usage: %s", err)
+		}
+		for {
+			usage, err := resp.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				log.Fatalf("cannot get resource usage: %s", err)
+			}
+			if topOpts.Watch {
+				// clear the screen and move the cursor home
+				fmt.Print("\033[H\033[2J")
+			}
+			printResourceUsage(usage)
+		}
+	},
+}
+
+func printResourceUsage(usage *api.ResourceStatusResponse) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "RESOURCE\tUSED\tLIMIT\t")
+	cpuLimit := "-"
+	if usage.Cpu.Limit > 0 {
+		cpuLimit = fmt.Sprintf("%.2f cores", usage.Cpu.Limit)
+	}
+	fmt.Fprintf(w, "CPU\t%.2f cores\t%s\t%.0f%% throttled\n", usage.Cpu.Used, cpuLimit, usage.Cpu.Throttled*100)
+	memLimit := "-"
+	if usage.Memory.Limit > 0 {
+		memLimit = formatBytes(usage.Memory.Limit)
+	}
+	fmt.Fprintf(w, "Memory\t%s\t%s\t\n", formatBytes(usage.Memory.Used), memLimit)
+	fmt.Fprintf(w, "Disk\t%s\t%s\t%s\n", formatBytes(usage.Disk.Used), formatBytes(usage.Disk.Total), usage.Disk.Location)
+	w.Flush()
+
+	fmt.Println()
+	printProcessUsage("TOP CPU", usage.TopCpu)
+	fmt.Println()
+	printProcessUsage("TOP MEMORY", usage.TopMemory)
+}
+
+func printProcessUsage(title string, procs []*api.ProcessUsage) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "%s\tPID\tCPU\tMEMORY\n", title)
+	for _, p := range procs {
+		fmt.Fprintf(w, "%s\t%d\t%.0f%%\t%s\n", p.Command, p.Pid, p.Cpu*100, formatBytes(p.Memory))
+	}
+	w.Flush()
+}
+
+// formatBytes formats a number of bytes using binary prefixes, e.g. 1.5 GiB
+func formatBytes(b uint64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+	div, exp := uint64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
+
+func init() {
+	rootCmd.AddCommand(topCmd)
+	topCmd.Flags().BoolVarP(&topOpts.Watch, "watch", "w", false, "keep updating the resource usage until interrupted")
+	topCmd.Flags().Uint32VarP(&topOpts.Top, "processes", "n", 5, "number of top processes to show")
+}
@@ -0,0 +1,348 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/golang/protobuf/proto"
+	"github.com/gorilla/websocket"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// tunnelDialTimeout is the time we give a tunnel target to accept a connection
+	tunnelDialTimeout = 10 * time.Second
+	// tunnelBufferSize is the size of the buffer we read forwarded connections with
+	tunnelBufferSize = 32 << 10
+	// tunnelQueueSize is the number of data frames we queue per forwarded connection
+	tunnelQueueSize = 16
+)
+
+var tunnelOwnerToken string
+
+var tunnelCmd = &cobra.Command{
+	Use:   "tunnel",
+	Short: "Makes services on your machine available inside a workspace",
+}
+
+var tunnelOpenCmd = &cobra.Command{
+	Use:   "open <workspace-url> <port> [target]",
+	Short: "Forwards connections to localhost:<port> in the workspace to target on this machine",
+	Long: `Forwards connections to localhost:<port> in the workspace to target on this machine.
+Run this command on your own machine, not in the workspace. Target defaults to localhost:<port>.
+The tunnel stays open until this command is interrupted or the tunnel is closed in the workspace.`,
+	Args: cobra.RangeArgs(2, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		port, err := strconv.ParseInt(args[1], 10, 32)
+		if err != nil {
+			log.Fatalf("port cannot be parsed as int: %s", err)
+		}
+		if err := checkPortRange(port); err != nil {
+			log.Fatalf("port: %s", err)
+		}
+		target := fmt.Sprintf("localhost:%d", port)
+		if len(args) > 2 {
+			target = args[2]
+		}
+		if tunnelOwnerToken == "" {
+			tunnelOwnerToken = os.Getenv("GITPOD_OWNER_TOKEN")
+		}
+		if tunnelOwnerToken == "" {
+			log.Fatal("no owner token present (use --token or set $GITPOD_OWNER_TOKEN)")
+		}
+
+		u, err := url.Parse(args[0])
+		if err != nil {
+			log.Fatalf("invalid workspace URL: %s", err)
+		}
+		switch u.Scheme {
+		case "http":
+			u.Scheme = "ws"
+		default:
+			u.Scheme = "wss"
+		}
+		u.Path = "/_supervisor/tunnel"
+		u.RawQuery = url.Values{
+			"port":   []string{fmt.Sprint(port)},
+			"target": []string{target},
+		}.Encode()
+
+		conn, resp, err := websocket.DefaultDialer.Dial(u.String(), http.Header{
+			"Authorization": []string{"Bearer " + tunnelOwnerToken},
+		})
+		if err != nil {
+			if resp != nil {
+				log.Fatalf("cannot open tunnel: %s", resp.Status)
+			}
+			log.Fatalf("cannot open tunnel: %s", err)
+		}
+		defer conn.Close()
+
+		fmt.Printf("Tunneling connections: workspace localhost:%d -> %s\n", port, target)
+		c := &tunnelClient{
+			Target: target,
+			conn:   conn,
+			conns:  make(map[uint32]*tunnelClientConn),
+		}
+		err = c.Run()
+		if err != nil {
+			log.Fatalf("tunnel failed: %s", err)
+		}
+		fmt.Println("Tunnel closed")
+	},
+}
+
+var tunnelListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists the tunnels into this workspace",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		conn, err := dialSupervisor(ctx)
+		if err != nil {
+			log.Fatalf("cannot connect to supervisor: %s", err)
+		}
+		defer conn.Close()
+
+		resp, err := api.NewStatusServiceClient(conn).PortsStatus(ctx, &api.PortsStatusRequest{})
+		if err != nil {
+			log.Fatalf("cannot get ports status: %s", err)
+		}
+		status, err := resp.Recv()
+		if err != nil {
+			log.Fatalf("cannot get ports status: %s", err)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "PORT\tTARGET\tCLIENT")
+		for _, p := range status.Ports {
+			if p.Tunneled == nil {
+				continue
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\n", p.LocalPort, p.Tunneled.Target, p.Tunneled.Client)
+		}
+		w.Flush()
+	},
+}
+
+var tunnelCloseCmd = &cobra.Command{
+	Use:   "close <port>",
+	Short: "Closes the tunnel bound to a port in this workspace",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		port, err := strconv.ParseInt(args[0], 10, 32)
+		if err != nil {
+			log.Fatalf("port cannot be parsed as int: %s", err)
+		}
+		if err := checkPortRange(port); err != nil {
+			log.Fatalf("port: %s", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		conn, err := dialSupervisor(ctx)
+		if err != nil {
+			log.Fatalf("cannot connect to supervisor: %s", err)
+		}
+		defer conn.Close()
+
+		_, err = api.NewControlServiceClient(conn).CloseTunnel(ctx, &api.CloseTunnelRequest{Port: uint32(port)})
+		if err != nil {
+			log.Fatalf("cannot close tunnel: %s", err)
+		}
+	},
+}
+
+// tunnelClient is the client end of a reverse tunnel. For every connection supervisor accepted in the workspace
+// it connects to the target and forwards the data.
+type tunnelClient struct {
+	Target string
+
+	// wmu serialises writes to the WebSocket
+	wmu  sync.Mutex
+	conn *websocket.Conn
+
+	mu    sync.Mutex
+	conns map[uint32]*tunnelClientConn
+}
+
+type tunnelClientConn struct {
+	// queue holds data received from the workspace. It's closed once the workspace side closed the connection.
+	queue chan []byte
+	// done is closed when the connection to the target ended
+	done chan struct{}
+}
+
+// Run forwards connections until the tunnel is closed
+func (c *tunnelClient) Run() error {
+	defer func() {
+		c.mu.Lock()
+		for id, tc := range c.conns {
+			delete(c.conns, id)
+			close(tc.done)
+		}
+		c.mu.Unlock()
+	}()
+
+	for {
+		tpe, msg, err := c.conn.ReadMessage()
+		if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if tpe != websocket.BinaryMessage {
+			continue
+		}
+
+		var frame api.TunnelFrame
+		err = proto.Unmarshal(msg, &frame)
+		if err != nil {
+			return fmt.Errorf("invalid tunnel frame: %w", err)
+		}
+		switch pl := frame.Payload.(type) {
+		case *api.TunnelFrame_Open:
+			tc := &tunnelClientConn{
+				queue: make(chan []byte, tunnelQueueSize),
+				done:  make(chan struct{}),
+			}
+			c.mu.Lock()
+			c.conns[frame.ConnId] = tc
+			c.mu.Unlock()
+			go c.forward(frame.ConnId, tc)
+		case *api.TunnelFrame_Data:
+			c.mu.Lock()
+			tc := c.conns[frame.ConnId]
+			c.mu.Unlock()
+			if tc == nil {
+				continue
+			}
+			select {
+			case tc.queue <- pl.Data:
+			case <-tc.done:
+			}
+		case *api.TunnelFrame_Close:
+			c.mu.Lock()
+			tc := c.conns[frame.ConnId]
+			delete(c.conns, frame.ConnId)
+			c.mu.Unlock()
+			if tc == nil {
+				continue
+			}
+			close(tc.queue)
+		}
+	}
+}
+
+// forward connects to the target and copies data between it and the workspace
+func (c *tunnelClient) forward(id uint32, tc *tunnelClientConn) {
+	conn, err := net.DialTimeout("tcp", c.Target, tunnelDialTimeout)
+	if err != nil {
+		log.Printf("cannot connect to %s: %s", c.Target, err)
+		c.closeConn(id, err)
+		return
+	}
+	defer conn.Close()
+
+	go func() {
+		for {
+			select {
+			case data, ok := <-tc.queue:
+				if !ok {
+					conn.Close()
+					return
+				}
+				_, err := conn.Write(data)
+				if err != nil {
+					c.closeConn(id, err)
+					conn.Close()
+					return
+				}
+			case <-tc.done:
+				conn.Close()
+				return
+			}
+		}
+	}()
+
+	buf := make([]byte, tunnelBufferSize)
+	for {
+		n, err := conn.Read(buf)
+		if n > 0 {
+			serr := c.send(&api.TunnelFrame{
+				ConnId:  id,
+				Payload: &api.TunnelFrame_Data{Data: buf[:n]},
+			})
+			if serr != nil {
+				c.closeConn(id, nil)
+				return
+			}
+		}
+		if err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			c.closeConn(id, err)
+			return
+		}
+	}
+}
+
+// closeConn forgets a connection and tells supervisor about it
+func (c *tunnelClient) closeConn(id uint32, reason error) {
+	c.mu.Lock()
+	tc, exists := c.conns[id]
+	delete(c.conns, id)
+	c.mu.Unlock()
+	if !exists {
+		return
+	}
+	close(tc.done)
+
+	var msg string
+	if reason != nil {
+		msg = reason.Error()
+	}
+	_ = c.send(&api.TunnelFrame{
+		ConnId:  id,
+		Payload: &api.TunnelFrame_Close{Close: &api.TunnelClose{Error: msg}},
+	})
+}
+
+func (c *tunnelClient) send(frame *api.TunnelFrame) error {
+	msg, err := proto.Marshal(frame)
+	if err != nil {
+		return err
+	}
+
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	return c.conn.WriteMessage(websocket.BinaryMessage, msg)
+}
+
+func init() {
+	rootCmd.AddCommand(tunnelCmd)
+	tunnelCmd.AddCommand(tunnelOpenCmd)
+	tunnelCmd.AddCommand(tunnelListCmd)
+	tunnelCmd.AddCommand(tunnelCloseCmd)
+	tunnelOpenCmd.Flags().StringVarP(&tunnelOwnerToken, "token", "t", "", "owner token of the workspace (defaults to $GITPOD_OWNER_TOKEN)")
+}
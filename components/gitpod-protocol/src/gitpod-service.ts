@@ -108,6 +108,9 @@ export interface GitpodServer extends JsonRpcServer<GitpodClient>, AdminServer,
     generateNewGitpodToken(options: GitpodServer.GenerateNewGitpodTokenOptions): Promise<string>;
     deleteGitpodToken(tokenHash: string): Promise<void>;
 
+    // SSH
+    getSSHPublicKeys(): Promise<string[]>;
+
     // misc
     sendFeedback(feedback: string): Promise<string | undefined>;
     registerGithubApp(installationId: string): Promise<void>;
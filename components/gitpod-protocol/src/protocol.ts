@@ -89,6 +89,8 @@ export interface AdditionalUserData {
     emailNotificationSettings?: EmailNotificationSettings;
     featurePreview?: boolean;
     ideSettings?: IDESettings;
+    // public keys (in authorized_keys format) which may be used to SSH into workspaces
+    sshPublicKeys?: string[];
 }
 
 export interface EmailNotificationSettings {
@@ -512,6 +514,7 @@ export interface WorkspaceConfig {
     image?: ImageConfig;
     ports?: PortConfig[];
     tasks?: TaskConfig[];
+    services?: ServiceConfig[];
     checkoutLocation?: string;
     workspaceLocation?: string;
     gitConfig?: { [config: string]: string };
@@ -666,6 +669,10 @@ export interface TaskConfig {
     env?: { [env: string]: string };
     openIn?: 'bottom' | 'main' | 'left' | 'right';
     openMode?: 'split-top' | 'split-left' | 'split-right' | 'split-bottom' | 'tab-before' | 'tab-after';
+    dependsOn?: string[];
+    waitFor?: { port?: number, file?: string };
+    restart?: { policy?: 'never' | 'on-failure' | 'always', maxAttempts?: number };
+    readiness?: { http?: { port: number, path?: string }, tcp?: { port: number } };
 }
 
 export namespace TaskConfig {
@@ -675,6 +682,13 @@ export namespace TaskConfig {
     }
 }
 
+export interface ServiceConfig {
+    name: string;
+    command: string;
+    env?: { [env: string]: string };
+    restart?: { policy?: 'never' | 'on-failure' | 'always', maxAttempts?: number };
+}
+
 export namespace WorkspaceImageBuild {
     export type Phase = 'BaseImage' | 'GitpodLayer' | 'Error' | 'Done';
     export interface StateInfo {
@@ -141,6 +141,9 @@ export type AdmissionLevel = 'owner_only' | 'everyone';
 // PortVisibility describes how a port can be accessed
 export type PortVisibility = 'public' | 'private';
 
+// PortProtocol describes the application protocol a port is served with
+export type PortProtocol = 'http' | 'h2c' | 'tls' | 'grpc' | 'tcp';
+
 // WorkspaceInstancePort describes a port exposed on a workspace instance
 export interface WorkspaceInstancePort {
     // The outward-facing port number
@@ -154,6 +157,9 @@ export interface WorkspaceInstancePort {
 
     // Public, outward-facing URL where the port can be accessed on.
     url?: string;
+
+    // The application protocol the port is served with. If not present the port serves HTTP.
+    protocol?: PortProtocol;
 }
 
 // WorkspaceInstanceRepoStatus describes the status of th Git working copy of a workspace
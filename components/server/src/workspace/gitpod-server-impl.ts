@@ -13,7 +13,7 @@ import {
     CreateWorkspaceMode, PrebuiltWorkspace, Token, UserEnvVarValue, UserEnvVar, ResolvePluginsParams,
     ResolvedPlugins, PreparePluginUploadParams, WorkspaceImageBuild, StartWorkspaceResult,
     StartPrebuildContext, WorkspaceTimeoutDuration,
-    SetWorkspaceTimeoutResult, GetWorkspaceTimeoutResult, Configuration, PortVisibility, InstallPluginsParams, UninstallPluginParams, PermissionName, GitpodTokenType, GitpodToken, AuthProviderEntry, WorkspaceInstancePort
+    SetWorkspaceTimeoutResult, GetWorkspaceTimeoutResult, Configuration, PortVisibility, PortProtocol, InstallPluginsParams, UninstallPluginParams, PermissionName, GitpodTokenType, GitpodToken, AuthProviderEntry, WorkspaceInstancePort
 } from '@gitpod/gitpod-protocol';
 import { LicenseValidationResult, GetLicenseInfoResult, LicenseFeature } from '@gitpod/gitpod-protocol/lib/license-protocol';
 import { ErrorCodes } from '@gitpod/gitpod-protocol/lib/messaging/error';
@@ -42,7 +42,7 @@ import { TracedWorkspaceDB, DBWithTracing } from '@gitpod/gitpod-db/lib/traced-d
 import * as uuidv4 from 'uuid/v4';
 import { WorkspaceStarter } from './workspace-starter';
 import { WorkspaceManagerClientProvider } from '@gitpod/ws-manager/lib/client-provider';
-import { StopWorkspaceRequest, StopWorkspacePolicy, DescribeWorkspaceRequest, ControlPortRequest, PortSpec, MarkActiveRequest, PortVisibility as ProtoPortVisibility } from '@gitpod/ws-manager/lib/core_pb';
+import { StopWorkspaceRequest, StopWorkspacePolicy, DescribeWorkspaceRequest, ControlPortRequest, PortSpec, MarkActiveRequest, PortVisibility as ProtoPortVisibility, PortProtocol as ProtoPortProtocol } from '@gitpod/ws-manager/lib/core_pb';
 import { TheiaPluginService } from '../theia-plugin/theia-plugin-service';
 import { ImageBuilderClientProvider, LogsRequest } from '@gitpod/image-builder/lib';
 import { URL } from 'url';
@@ -998,6 +998,7 @@ export class GitpodServerImpl<Client extends GitpodClient, Server extends Gitpod
                 spec.setTarget(port.port);
             }
             spec.setVisibility(this.portVisibilityToProto(port.visibility))
+            spec.setProtocol(this.portProtocolToProto(port.protocol));
             req.setSpec(spec);
             req.setExpose(true);
 
@@ -1031,6 +1032,22 @@ export class GitpodServerImpl<Client extends GitpodClient, Server extends Gitpod
         }
     }
 
+    protected portProtocolToProto(protocol: PortProtocol | undefined): ProtoPortProtocol {
+        switch (protocol) {
+            default:    // ports serve HTTP unless we know better
+            case 'http':
+                return ProtoPortProtocol.PORT_PROTOCOL_HTTP;
+            case 'h2c':
+                return ProtoPortProtocol.PORT_PROTOCOL_H2C;
+            case 'tls':
+                return ProtoPortProtocol.PORT_PROTOCOL_TLS;
+            case 'grpc':
+                return ProtoPortProtocol.PORT_PROTOCOL_GRPC;
+            case 'tcp':
+                return ProtoPortProtocol.PORT_PROTOCOL_TCP;
+        }
+    }
+
     public async closePort(workspaceId: string, port: number) {
         const user = this.checkAndBlockUser("closePort");
         const span = opentracing.globalTracer().startSpan("closePort");
@@ -1332,6 +1349,12 @@ export class GitpodServerImpl<Client extends GitpodClient, Server extends Gitpod
         return this.userDB.deleteGitpodToken(tokenHash);
     }
 
+    public async getSSHPublicKeys(): Promise<string[]> {
+        // Note: this operation is per-user only, hence needs no resource guard
+        const user = this.checkUser("getSSHPublicKeys");
+        return (user.additionalData && user.additionalData.sshPublicKeys) || [];
+    }
+
     public async hasPermission(permission: PermissionName): Promise<boolean> {
         const user = this.checkUser("hasPermission");
         return this.authorizationService.hasPermission(user, permission);
@@ -507,6 +507,13 @@ export class WorkspaceStarter {
             ev.setValue(JSON.stringify(workspace.config.tasks));
             envvars.push(ev);
         }
+        if (!!workspace.config.services) {
+            // Like tasks, background services are interpreted by supervisor only.
+            const ev = new EnvironmentVariable();
+            ev.setName("GITPOD_SERVICES");
+            ev.setValue(JSON.stringify(workspace.config.services));
+            envvars.push(ev);
+        }
         const addExtensionsToEnvvarPromise = this.theiaService.resolvePlugins(user.id, { config: workspace.config }).then(
             resolvedExtensions => {
                 if (resolvedExtensions) {
@@ -638,6 +645,7 @@ export class WorkspaceStarter {
             "function:storeLayout",
             "function:stopWorkspace",
             "function:getToken",
+            "function:getSSHPublicKeys",
 
             "resource:"+ScopedResourceGuard.marshalResourceScope({kind: "workspace", subjectID: workspace.id, operations: ["get", "update"]}),
             "resource:"+ScopedResourceGuard.marshalResourceScope({kind: "workspaceInstance", subjectID: instance.id, operations: ["get", "update", "delete"]}),
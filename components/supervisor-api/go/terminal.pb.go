@@ -314,6 +314,9 @@ type ListenTerminalRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Alias string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	// replay, if set, streams the recording of the terminal instead of its live output.
+	// The terminal need not exist anymore for its recording to be replayed.
+	Replay *ReplayTerminalOptions `protobuf:"bytes,2,opt,name=replay,proto3" json:"replay,omitempty"`
 }
 
 func (x *ListenTerminalRequest) Reset() {
@@ -355,6 +358,70 @@ func (x *ListenTerminalRequest) GetAlias() string {
 	return ""
 }
 
+func (x *ListenTerminalRequest) GetReplay() *ReplayTerminalOptions {
+	if x != nil {
+		return x.Replay
+	}
+	return nil
+}
+
+type ReplayTerminalOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// offset_ms is the time into the recording (in milliseconds) from which to start the replay
+	OffsetMs uint64 `protobuf:"varint,1,opt,name=offset_ms,json=offsetMs,proto3" json:"offset_ms,omitempty"`
+	// realtime replays the recording with its original timing rather than as fast as possible
+	Realtime bool `protobuf:"varint,2,opt,name=realtime,proto3" json:"realtime,omitempty"`
+}
+
+func (x *ReplayTerminalOptions) Reset() {
+	*x = ReplayTerminalOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_terminal_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayTerminalOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayTerminalOptions) ProtoMessage() {}
+
+func (x *ReplayTerminalOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_terminal_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayTerminalOptions.ProtoReflect.Descriptor instead.
+func (*ReplayTerminalOptions) Descriptor() ([]byte, []int) {
+	return file_terminal_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReplayTerminalOptions) GetOffsetMs() uint64 {
+	if x != nil {
+		return x.OffsetMs
+	}
+	return 0
+}
+
+func (x *ReplayTerminalOptions) GetRealtime() bool {
+	if x != nil {
+		return x.Realtime
+	}
+	return false
+}
+
 type ListenTerminalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -369,7 +436,7 @@ type ListenTerminalResponse struct {
 func (x *ListenTerminalResponse) Reset() {
 	*x = ListenTerminalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_terminal_proto_msgTypes[7]
+		mi := &file_terminal_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -382,7 +449,7 @@ func (x *ListenTerminalResponse) String() string {
 func (*ListenTerminalResponse) ProtoMessage() {}
 
 func (x *ListenTerminalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_terminal_proto_msgTypes[7]
+	mi := &file_terminal_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -395,7 +462,7 @@ func (x *ListenTerminalResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListenTerminalResponse.ProtoReflect.Descriptor instead.
 func (*ListenTerminalResponse) Descriptor() ([]byte, []int) {
-	return file_terminal_proto_rawDescGZIP(), []int{7}
+	return file_terminal_proto_rawDescGZIP(), []int{8}
 }
 
 func (m *ListenTerminalResponse) GetOutput() isListenTerminalResponse_Output {
@@ -447,7 +514,7 @@ type WriteTerminalRequest struct {
 func (x *WriteTerminalRequest) Reset() {
 	*x = WriteTerminalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_terminal_proto_msgTypes[8]
+		mi := &file_terminal_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -460,7 +527,7 @@ func (x *WriteTerminalRequest) String() string {
 func (*WriteTerminalRequest) ProtoMessage() {}
 
 func (x *WriteTerminalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_terminal_proto_msgTypes[8]
+	mi := &file_terminal_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,7 +540,7 @@ func (x *WriteTerminalRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WriteTerminalRequest.ProtoReflect.Descriptor instead.
 func (*WriteTerminalRequest) Descriptor() ([]byte, []int) {
-	return file_terminal_proto_rawDescGZIP(), []int{8}
+	return file_terminal_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *WriteTerminalRequest) GetAlias() string {
@@ -490,31 +557,534 @@ func (x *WriteTerminalRequest) GetStdin() []byte {
 	return nil
 }
 
-type WriteTerminalResponse struct {
+type WriteTerminalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BytesWritten uint32 `protobuf:"varint,1,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+}
+
+func (x *WriteTerminalResponse) Reset() {
+	*x = WriteTerminalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_terminal_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteTerminalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteTerminalResponse) ProtoMessage() {}
+
+func (x *WriteTerminalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_terminal_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteTerminalResponse.ProtoReflect.Descriptor instead.
+func (*WriteTerminalResponse) Descriptor() ([]byte, []int) {
+	return file_terminal_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WriteTerminalResponse) GetBytesWritten() uint32 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+type SetTerminalSizeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alias string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	// token is the starter_token that Open() returned.
+	// Without token it's possible that the request is ignored.
+	// If you want to force your size, indendently of all other listener,
+	// use force.
+	//
+	// Types that are assignable to Priority:
+	//	*SetTerminalSizeRequest_Token
+	//	*SetTerminalSizeRequest_Force
+	Priority isSetTerminalSizeRequest_Priority `protobuf_oneof:"priority"`
+	Rows     uint32                            `protobuf:"varint,4,opt,name=rows,proto3" json:"rows,omitempty"`
+	Cols     uint32                            `protobuf:"varint,5,opt,name=cols,proto3" json:"cols,omitempty"`
+	WidthPx  uint32                            `protobuf:"varint,6,opt,name=widthPx,proto3" json:"widthPx,omitempty"`
+	HeightPx uint32                            `protobuf:"varint,7,opt,name=heightPx,proto3" json:"heightPx,omitempty"`
+}
+
+func (x *SetTerminalSizeRequest) Reset() {
+	*x = SetTerminalSizeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_terminal_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTerminalSizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTerminalSizeRequest) ProtoMessage() {}
+
+func (x *SetTerminalSizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_terminal_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTerminalSizeRequest.ProtoReflect.Descriptor instead.
+func (*SetTerminalSizeRequest) Descriptor() ([]byte, []int) {
+	return file_terminal_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetTerminalSizeRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (m *SetTerminalSizeRequest) GetPriority() isSetTerminalSizeRequest_Priority {
+	if m != nil {
+		return m.Priority
+	}
+	return nil
+}
+
+func (x *SetTerminalSizeRequest) GetToken() string {
+	if x, ok := x.GetPriority().(*SetTerminalSizeRequest_Token); ok {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetTerminalSizeRequest) GetForce() bool {
+	if x, ok := x.GetPriority().(*SetTerminalSizeRequest_Force); ok {
+		return x.Force
+	}
+	return false
+}
+
+func (x *SetTerminalSizeRequest) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *SetTerminalSizeRequest) GetCols() uint32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+func (x *SetTerminalSizeRequest) GetWidthPx() uint32 {
+	if x != nil {
+		return x.WidthPx
+	}
+	return 0
+}
+
+func (x *SetTerminalSizeRequest) GetHeightPx() uint32 {
+	if x != nil {
+		return x.HeightPx
+	}
+	return 0
+}
+
+type isSetTerminalSizeRequest_Priority interface {
+	isSetTerminalSizeRequest_Priority()
+}
+
+type SetTerminalSizeRequest_Token struct {
+	Token string `protobuf:"bytes,2,opt,name=token,proto3,oneof"`
+}
+
+type SetTerminalSizeRequest_Force struct {
+	Force bool `protobuf:"varint,3,opt,name=force,proto3,oneof"`
+}
+
+func (*SetTerminalSizeRequest_Token) isSetTerminalSizeRequest_Priority() {}
+
+func (*SetTerminalSizeRequest_Force) isSetTerminalSizeRequest_Priority() {}
+
+type SetTerminalSizeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetTerminalSizeResponse) Reset() {
+	*x = SetTerminalSizeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_terminal_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTerminalSizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTerminalSizeResponse) ProtoMessage() {}
+
+func (x *SetTerminalSizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_terminal_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTerminalSizeResponse.ProtoReflect.Descriptor instead.
+func (*SetTerminalSizeResponse) Descriptor() ([]byte, []int) {
+	return file_terminal_proto_rawDescGZIP(), []int{12}
+}
+
+type AttachTerminalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Frame:
+	//	*AttachTerminalRequest_Start
+	//	*AttachTerminalRequest_Stdin
+	//	*AttachTerminalRequest_Resize
+	//	*AttachTerminalRequest_Ack
+	Frame isAttachTerminalRequest_Frame `protobuf_oneof:"frame"`
+}
+
+func (x *AttachTerminalRequest) Reset() {
+	*x = AttachTerminalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_terminal_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachTerminalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachTerminalRequest) ProtoMessage() {}
+
+func (x *AttachTerminalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_terminal_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachTerminalRequest.ProtoReflect.Descriptor instead.
+func (*AttachTerminalRequest) Descriptor() ([]byte, []int) {
+	return file_terminal_proto_rawDescGZIP(), []int{13}
+}
+
+func (m *AttachTerminalRequest) GetFrame() isAttachTerminalRequest_Frame {
+	if m != nil {
+		return m.Frame
+	}
+	return nil
+}
+
+func (x *AttachTerminalRequest) GetStart() *AttachTerminalStart {
+	if x, ok := x.GetFrame().(*AttachTerminalRequest_Start); ok {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *AttachTerminalRequest) GetStdin() *TerminalInput {
+	if x, ok := x.GetFrame().(*AttachTerminalRequest_Stdin); ok {
+		return x.Stdin
+	}
+	return nil
+}
+
+func (x *AttachTerminalRequest) GetResize() *TerminalSize {
+	if x, ok := x.GetFrame().(*AttachTerminalRequest_Resize); ok {
+		return x.Resize
+	}
+	return nil
+}
+
+func (x *AttachTerminalRequest) GetAck() uint64 {
+	if x, ok := x.GetFrame().(*AttachTerminalRequest_Ack); ok {
+		return x.Ack
+	}
+	return 0
+}
+
+type isAttachTerminalRequest_Frame interface {
+	isAttachTerminalRequest_Frame()
+}
+
+type AttachTerminalRequest_Start struct {
+	// start must be the first frame sent on the stream
+	Start *AttachTerminalStart `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
+}
+
+type AttachTerminalRequest_Stdin struct {
+	Stdin *TerminalInput `protobuf:"bytes,2,opt,name=stdin,proto3,oneof"`
+}
+
+type AttachTerminalRequest_Resize struct {
+	Resize *TerminalSize `protobuf:"bytes,3,opt,name=resize,proto3,oneof"`
+}
+
+type AttachTerminalRequest_Ack struct {
+	// ack acknowledges all output up to (excluding) this offset.
+	// Clients must acknowledge output to receive more than the flow control window.
+	Ack uint64 `protobuf:"varint,4,opt,name=ack,proto3,oneof"`
+}
+
+func (*AttachTerminalRequest_Start) isAttachTerminalRequest_Frame() {}
+
+func (*AttachTerminalRequest_Stdin) isAttachTerminalRequest_Frame() {}
+
+func (*AttachTerminalRequest_Resize) isAttachTerminalRequest_Frame() {}
+
+func (*AttachTerminalRequest_Ack) isAttachTerminalRequest_Frame() {}
+
+type AttachTerminalStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alias string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	// offset is the output offset from which to stream. Clients resuming a previous
+	// attachment use their last acknowledged offset. Output that is no longer
+	// retained by the terminal is skipped, i.e. the first output frame starts at
+	// a later offset.
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// window is the number of bytes the server sends before it waits for an ack.
+	// Use 0 for the default window.
+	Window uint32 `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
+	// priority determines if resize frames are honoured, see SetTerminalSizeRequest.
+	// Resize frames without priority are ignored.
+	//
+	// Types that are assignable to Priority:
+	//	*AttachTerminalStart_Token
+	//	*AttachTerminalStart_Force
+	Priority isAttachTerminalStart_Priority `protobuf_oneof:"priority"`
+	// session identifies the client across resumed attachments. The server drops stdin
+	// frames whose seq it has written to the terminal for that session before.
+	Session string `protobuf:"bytes,6,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (x *AttachTerminalStart) Reset() {
+	*x = AttachTerminalStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_terminal_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachTerminalStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachTerminalStart) ProtoMessage() {}
+
+func (x *AttachTerminalStart) ProtoReflect() protoreflect.Message {
+	mi := &file_terminal_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachTerminalStart.ProtoReflect.Descriptor instead.
+func (*AttachTerminalStart) Descriptor() ([]byte, []int) {
+	return file_terminal_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AttachTerminalStart) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *AttachTerminalStart) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *AttachTerminalStart) GetWindow() uint32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (m *AttachTerminalStart) GetPriority() isAttachTerminalStart_Priority {
+	if m != nil {
+		return m.Priority
+	}
+	return nil
+}
+
+func (x *AttachTerminalStart) GetToken() string {
+	if x, ok := x.GetPriority().(*AttachTerminalStart_Token); ok {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AttachTerminalStart) GetForce() bool {
+	if x, ok := x.GetPriority().(*AttachTerminalStart_Force); ok {
+		return x.Force
+	}
+	return false
+}
+
+func (x *AttachTerminalStart) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+type isAttachTerminalStart_Priority interface {
+	isAttachTerminalStart_Priority()
+}
+
+type AttachTerminalStart_Token struct {
+	Token string `protobuf:"bytes,4,opt,name=token,proto3,oneof"`
+}
+
+type AttachTerminalStart_Force struct {
+	Force bool `protobuf:"varint,5,opt,name=force,proto3,oneof"`
+}
+
+func (*AttachTerminalStart_Token) isAttachTerminalStart_Priority() {}
+
+func (*AttachTerminalStart_Force) isAttachTerminalStart_Priority() {}
+
+type TerminalInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// seq is the sequence number of this input frame chosen by the client. It must increase within a session.
+	Seq  uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *TerminalInput) Reset() {
+	*x = TerminalInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_terminal_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminalInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalInput) ProtoMessage() {}
+
+func (x *TerminalInput) ProtoReflect() protoreflect.Message {
+	mi := &file_terminal_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalInput.ProtoReflect.Descriptor instead.
+func (*TerminalInput) Descriptor() ([]byte, []int) {
+	return file_terminal_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TerminalInput) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *TerminalInput) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type TerminalSize struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BytesWritten uint32 `protobuf:"varint,1,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	Rows     uint32 `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	Cols     uint32 `protobuf:"varint,2,opt,name=cols,proto3" json:"cols,omitempty"`
+	WidthPx  uint32 `protobuf:"varint,3,opt,name=widthPx,proto3" json:"widthPx,omitempty"`
+	HeightPx uint32 `protobuf:"varint,4,opt,name=heightPx,proto3" json:"heightPx,omitempty"`
 }
 
-func (x *WriteTerminalResponse) Reset() {
-	*x = WriteTerminalResponse{}
+func (x *TerminalSize) Reset() {
+	*x = TerminalSize{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_terminal_proto_msgTypes[9]
+		mi := &file_terminal_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WriteTerminalResponse) String() string {
+func (x *TerminalSize) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteTerminalResponse) ProtoMessage() {}
+func (*TerminalSize) ProtoMessage() {}
 
-func (x *WriteTerminalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_terminal_proto_msgTypes[9]
+func (x *TerminalSize) ProtoReflect() protoreflect.Message {
+	mi := &file_terminal_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,56 +1095,67 @@ func (x *WriteTerminalResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteTerminalResponse.ProtoReflect.Descriptor instead.
-func (*WriteTerminalResponse) Descriptor() ([]byte, []int) {
-	return file_terminal_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use TerminalSize.ProtoReflect.Descriptor instead.
+func (*TerminalSize) Descriptor() ([]byte, []int) {
+	return file_terminal_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *WriteTerminalResponse) GetBytesWritten() uint32 {
+func (x *TerminalSize) GetRows() uint32 {
 	if x != nil {
-		return x.BytesWritten
+		return x.Rows
 	}
 	return 0
 }
 
-type SetTerminalSizeRequest struct {
+func (x *TerminalSize) GetCols() uint32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+func (x *TerminalSize) GetWidthPx() uint32 {
+	if x != nil {
+		return x.WidthPx
+	}
+	return 0
+}
+
+func (x *TerminalSize) GetHeightPx() uint32 {
+	if x != nil {
+		return x.HeightPx
+	}
+	return 0
+}
+
+type AttachTerminalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Alias string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
-	// token is the starter_token that Open() returned.
-	// Without token it's possible that the request is ignored.
-	// If you want to force your size, indendently of all other listener,
-	// use force.
-	//
-	// Types that are assignable to Priority:
-	//	*SetTerminalSizeRequest_Token
-	//	*SetTerminalSizeRequest_Force
-	Priority isSetTerminalSizeRequest_Priority `protobuf_oneof:"priority"`
-	Rows     uint32                            `protobuf:"varint,4,opt,name=rows,proto3" json:"rows,omitempty"`
-	Cols     uint32                            `protobuf:"varint,5,opt,name=cols,proto3" json:"cols,omitempty"`
-	WidthPx  uint32                            `protobuf:"varint,6,opt,name=widthPx,proto3" json:"widthPx,omitempty"`
-	HeightPx uint32                            `protobuf:"varint,7,opt,name=heightPx,proto3" json:"heightPx,omitempty"`
+	// Types that are assignable to Frame:
+	//	*AttachTerminalResponse_Output
+	//	*AttachTerminalResponse_StdinAck
+	Frame isAttachTerminalResponse_Frame `protobuf_oneof:"frame"`
 }
 
-func (x *SetTerminalSizeRequest) Reset() {
-	*x = SetTerminalSizeRequest{}
+func (x *AttachTerminalResponse) Reset() {
+	*x = AttachTerminalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_terminal_proto_msgTypes[10]
+		mi := &file_terminal_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetTerminalSizeRequest) String() string {
+func (x *AttachTerminalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetTerminalSizeRequest) ProtoMessage() {}
+func (*AttachTerminalResponse) ProtoMessage() {}
 
-func (x *SetTerminalSizeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_terminal_proto_msgTypes[10]
+func (x *AttachTerminalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_terminal_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -585,106 +1166,76 @@ func (x *SetTerminalSizeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetTerminalSizeRequest.ProtoReflect.Descriptor instead.
-func (*SetTerminalSizeRequest) Descriptor() ([]byte, []int) {
-	return file_terminal_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *SetTerminalSizeRequest) GetAlias() string {
-	if x != nil {
-		return x.Alias
-	}
-	return ""
+// Deprecated: Use AttachTerminalResponse.ProtoReflect.Descriptor instead.
+func (*AttachTerminalResponse) Descriptor() ([]byte, []int) {
+	return file_terminal_proto_rawDescGZIP(), []int{17}
 }
 
-func (m *SetTerminalSizeRequest) GetPriority() isSetTerminalSizeRequest_Priority {
+func (m *AttachTerminalResponse) GetFrame() isAttachTerminalResponse_Frame {
 	if m != nil {
-		return m.Priority
+		return m.Frame
 	}
 	return nil
 }
 
-func (x *SetTerminalSizeRequest) GetToken() string {
-	if x, ok := x.GetPriority().(*SetTerminalSizeRequest_Token); ok {
-		return x.Token
-	}
-	return ""
-}
-
-func (x *SetTerminalSizeRequest) GetForce() bool {
-	if x, ok := x.GetPriority().(*SetTerminalSizeRequest_Force); ok {
-		return x.Force
-	}
-	return false
-}
-
-func (x *SetTerminalSizeRequest) GetRows() uint32 {
-	if x != nil {
-		return x.Rows
-	}
-	return 0
-}
-
-func (x *SetTerminalSizeRequest) GetCols() uint32 {
-	if x != nil {
-		return x.Cols
-	}
-	return 0
-}
-
-func (x *SetTerminalSizeRequest) GetWidthPx() uint32 {
-	if x != nil {
-		return x.WidthPx
+func (x *AttachTerminalResponse) GetOutput() *TerminalOutput {
+	if x, ok := x.GetFrame().(*AttachTerminalResponse_Output); ok {
+		return x.Output
 	}
-	return 0
+	return nil
 }
 
-func (x *SetTerminalSizeRequest) GetHeightPx() uint32 {
-	if x != nil {
-		return x.HeightPx
+func (x *AttachTerminalResponse) GetStdinAck() uint64 {
+	if x, ok := x.GetFrame().(*AttachTerminalResponse_StdinAck); ok {
+		return x.StdinAck
 	}
 	return 0
 }
 
-type isSetTerminalSizeRequest_Priority interface {
-	isSetTerminalSizeRequest_Priority()
+type isAttachTerminalResponse_Frame interface {
+	isAttachTerminalResponse_Frame()
 }
 
-type SetTerminalSizeRequest_Token struct {
-	Token string `protobuf:"bytes,2,opt,name=token,proto3,oneof"`
+type AttachTerminalResponse_Output struct {
+	Output *TerminalOutput `protobuf:"bytes,1,opt,name=output,proto3,oneof"`
 }
 
-type SetTerminalSizeRequest_Force struct {
-	Force bool `protobuf:"varint,3,opt,name=force,proto3,oneof"`
+type AttachTerminalResponse_StdinAck struct {
+	// stdin_ack is the sequence number of the last input frame written to the terminal
+	StdinAck uint64 `protobuf:"varint,2,opt,name=stdin_ack,json=stdinAck,proto3,oneof"`
 }
 
-func (*SetTerminalSizeRequest_Token) isSetTerminalSizeRequest_Priority() {}
+func (*AttachTerminalResponse_Output) isAttachTerminalResponse_Frame() {}
 
-func (*SetTerminalSizeRequest_Force) isSetTerminalSizeRequest_Priority() {}
+func (*AttachTerminalResponse_StdinAck) isAttachTerminalResponse_Frame() {}
 
-type SetTerminalSizeResponse struct {
+type TerminalOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// offset is the position of the first byte of data in the terminal's output
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data   []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *SetTerminalSizeResponse) Reset() {
-	*x = SetTerminalSizeResponse{}
+func (x *TerminalOutput) Reset() {
+	*x = TerminalOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_terminal_proto_msgTypes[11]
+		mi := &file_terminal_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetTerminalSizeResponse) String() string {
+func (x *TerminalOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetTerminalSizeResponse) ProtoMessage() {}
+func (*TerminalOutput) ProtoMessage() {}
 
-func (x *SetTerminalSizeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_terminal_proto_msgTypes[11]
+func (x *TerminalOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_terminal_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -695,9 +1246,23 @@ func (x *SetTerminalSizeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetTerminalSizeResponse.ProtoReflect.Descriptor instead.
-func (*SetTerminalSizeResponse) Descriptor() ([]byte, []int) {
-	return file_terminal_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use TerminalOutput.ProtoReflect.Descriptor instead.
+func (*TerminalOutput) Descriptor() ([]byte, []int) {
+	return file_terminal_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TerminalOutput) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *TerminalOutput) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
 }
 
 type ListTerminalsResponse_Terminal struct {
@@ -713,7 +1278,7 @@ type ListTerminalsResponse_Terminal struct {
 func (x *ListTerminalsResponse_Terminal) Reset() {
 	*x = ListTerminalsResponse_Terminal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_terminal_proto_msgTypes[13]
+		mi := &file_terminal_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -726,7 +1291,7 @@ func (x *ListTerminalsResponse_Terminal) String() string {
 func (*ListTerminalsResponse_Terminal) ProtoMessage() {}
 
 func (x *ListTerminalsResponse_Terminal) ProtoReflect() protoreflect.Message {
-	mi := &file_terminal_proto_msgTypes[13]
+	mi := &file_terminal_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,79 +1365,140 @@ var file_terminal_proto_rawDesc = []byte{
 	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x18,
 	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
 	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x2d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x68,
 	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x56, 0x0a,
-	0x16, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75,
-	0x74, 0x12, 0x18, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x42, 0x0a, 0x14, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x65,
-	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c,
-	0x69, 0x61, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x22, 0x3c, 0x0a, 0x15, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74,
-	0x74, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x22, 0xc8, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x54,
-	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x16, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48,
-	0x00, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x6f, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x77, 0x69, 0x64, 0x74, 0x68, 0x50, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x07, 0x77, 0x69, 0x64, 0x74, 0x68, 0x50, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x50, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x50, 0x78, 0x42, 0x0a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
-	0x6c, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf8, 0x04,
-	0x0a, 0x0f, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x4b, 0x0a, 0x04, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x1f, 0x2e, 0x73, 0x75, 0x70, 0x65,
-	0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x69,
-	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x75, 0x70,
-	0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x54, 0x65, 0x72, 0x6d,
-	0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70,
-	0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x20, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76,
-	0x69, 0x73, 0x6f, 0x72, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e,
-	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x75, 0x70, 0x65,
-	0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x65, 0x72, 0x6d,
-	0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
-	0x61, 0x6c, 0x2f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x2f, 0x7b, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x7d,
-	0x12, 0x66, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72,
-	0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e,
-	0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x75, 0x70,
-	0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x72, 0x6d,
-	0x69, 0x6e, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x72, 0x6d, 0x69,
-	0x6e, 0x61, 0x6c, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x76, 0x0a, 0x06, 0x4c, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x12, 0x21, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x39, 0x0a,
+	0x06, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x06, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x22, 0x50, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4d, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x56, 0x0a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x18,
+	0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00,
+	0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x22, 0x42, 0x0a, 0x14, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x22, 0x3c, 0x0a, 0x15, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72, 0x69,
+	0x74, 0x74, 0x65, 0x6e, 0x22, 0xc8, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x54, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a,
+	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x05,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x6c,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x77, 0x69, 0x64, 0x74, 0x68, 0x50, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x50, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x50, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x50, 0x78, 0x42, 0x0a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22,
+	0x19, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69,
+	0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x15, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72,
+	0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x31, 0x0a,
+	0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e,
+	0x12, 0x32, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x54, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x03, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x48, 0x00, 0x52, 0x03, 0x61, 0x63, 0x6b, 0x42, 0x07, 0x0a, 0x05, 0x66, 0x72, 0x61, 0x6d,
+	0x65, 0x22, 0xb1, 0x01, 0x0a, 0x13, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x54, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x16, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x35, 0x0a, 0x0d, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6c, 0x0a, 0x0c,
+	0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x63, 0x6f, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x69, 0x64, 0x74, 0x68, 0x50, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x77, 0x69, 0x64, 0x74, 0x68, 0x50, 0x78, 0x12, 0x1a,
+	0x0a, 0x08, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x78, 0x22, 0x76, 0x0a, 0x16, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f,
+	0x72, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x09, 0x73, 0x74,
+	0x64, 0x69, 0x6e, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52,
+	0x08, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x41, 0x63, 0x6b, 0x42, 0x07, 0x0a, 0x05, 0x66, 0x72, 0x61,
+	0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x0e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x32, 0xcf, 0x05, 0x0a, 0x0f, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x04, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x1f, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x54, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x54,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x70, 0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x20, 0x2e, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x54,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x2f, 0x7b, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x7d, 0x12, 0x66, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x73, 0x75,
+	0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x76, 0x0a, 0x06, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x12, 0x21, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73,
 	0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2f,
-	0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x2f, 0x7b, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x7d, 0x30, 0x01,
-	0x12, 0x70, 0x0a, 0x05, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x73, 0x75, 0x70, 0x65,
-	0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x65, 0x72, 0x6d,
-	0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x75,
-	0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x65,
-	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x72, 0x6d,
-	0x69, 0x6e, 0x61, 0x6c, 0x2f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x2f, 0x7b, 0x61, 0x6c, 0x69, 0x61,
-	0x73, 0x7d, 0x12, 0x54, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x2e,
-	0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x65,
-	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x23, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x53,
-	0x65, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x3b, 0x61, 0x70,
-	0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72,
+	0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x54, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x2f, 0x7b, 0x61, 0x6c, 0x69, 0x61, 0x73,
+	0x7d, 0x30, 0x01, 0x12, 0x70, 0x0a, 0x05, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x74,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x2f, 0x7b, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x7d, 0x12, 0x54, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x22, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x53, 0x65,
+	0x74, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f,
+	0x72, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x06, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x12, 0x21, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73,
+	0x6f, 0x72, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72,
+	0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x54, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01,
+	0x30, 0x01, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -887,7 +1513,7 @@ func file_terminal_proto_rawDescGZIP() []byte {
 	return file_terminal_proto_rawDescData
 }
 
-var file_terminal_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_terminal_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_terminal_proto_goTypes = []interface{}{
 	(*OpenTerminalRequest)(nil),            // 0: supervisor.OpenTerminalRequest
 	(*OpenTerminalResponse)(nil),           // 1: supervisor.OpenTerminalResponse
@@ -896,34 +1522,48 @@ var file_terminal_proto_goTypes = []interface{}{
 	(*ListTerminalsRequest)(nil),           // 4: supervisor.ListTerminalsRequest
 	(*ListTerminalsResponse)(nil),          // 5: supervisor.ListTerminalsResponse
 	(*ListenTerminalRequest)(nil),          // 6: supervisor.ListenTerminalRequest
-	(*ListenTerminalResponse)(nil),         // 7: supervisor.ListenTerminalResponse
-	(*WriteTerminalRequest)(nil),           // 8: supervisor.WriteTerminalRequest
-	(*WriteTerminalResponse)(nil),          // 9: supervisor.WriteTerminalResponse
-	(*SetTerminalSizeRequest)(nil),         // 10: supervisor.SetTerminalSizeRequest
-	(*SetTerminalSizeResponse)(nil),        // 11: supervisor.SetTerminalSizeResponse
-	nil,                                    // 12: supervisor.OpenTerminalRequest.EnvEntry
-	(*ListTerminalsResponse_Terminal)(nil), // 13: supervisor.ListTerminalsResponse.Terminal
+	(*ReplayTerminalOptions)(nil),          // 7: supervisor.ReplayTerminalOptions
+	(*ListenTerminalResponse)(nil),         // 8: supervisor.ListenTerminalResponse
+	(*WriteTerminalRequest)(nil),           // 9: supervisor.WriteTerminalRequest
+	(*WriteTerminalResponse)(nil),          // 10: supervisor.WriteTerminalResponse
+	(*SetTerminalSizeRequest)(nil),         // 11: supervisor.SetTerminalSizeRequest
+	(*SetTerminalSizeResponse)(nil),        // 12: supervisor.SetTerminalSizeResponse
+	(*AttachTerminalRequest)(nil),          // 13: supervisor.AttachTerminalRequest
+	(*AttachTerminalStart)(nil),            // 14: supervisor.AttachTerminalStart
+	(*TerminalInput)(nil),                  // 15: supervisor.TerminalInput
+	(*TerminalSize)(nil),                   // 16: supervisor.TerminalSize
+	(*AttachTerminalResponse)(nil),         // 17: supervisor.AttachTerminalResponse
+	(*TerminalOutput)(nil),                 // 18: supervisor.TerminalOutput
+	nil,                                    // 19: supervisor.OpenTerminalRequest.EnvEntry
+	(*ListTerminalsResponse_Terminal)(nil), // 20: supervisor.ListTerminalsResponse.Terminal
 }
 var file_terminal_proto_depIdxs = []int32{
-	12, // 0: supervisor.OpenTerminalRequest.env:type_name -> supervisor.OpenTerminalRequest.EnvEntry
-	13, // 1: supervisor.ListTerminalsResponse.terminals:type_name -> supervisor.ListTerminalsResponse.Terminal
-	0,  // 2: supervisor.TerminalService.Open:input_type -> supervisor.OpenTerminalRequest
-	2,  // 3: supervisor.TerminalService.Close:input_type -> supervisor.CloseTerminalRequest
-	4,  // 4: supervisor.TerminalService.List:input_type -> supervisor.ListTerminalsRequest
-	6,  // 5: supervisor.TerminalService.Listen:input_type -> supervisor.ListenTerminalRequest
-	8,  // 6: supervisor.TerminalService.Write:input_type -> supervisor.WriteTerminalRequest
-	10, // 7: supervisor.TerminalService.SetSize:input_type -> supervisor.SetTerminalSizeRequest
-	1,  // 8: supervisor.TerminalService.Open:output_type -> supervisor.OpenTerminalResponse
-	3,  // 9: supervisor.TerminalService.Close:output_type -> supervisor.CloseTerminalResponse
-	5,  // 10: supervisor.TerminalService.List:output_type -> supervisor.ListTerminalsResponse
-	7,  // 11: supervisor.TerminalService.Listen:output_type -> supervisor.ListenTerminalResponse
-	9,  // 12: supervisor.TerminalService.Write:output_type -> supervisor.WriteTerminalResponse
-	11, // 13: supervisor.TerminalService.SetSize:output_type -> supervisor.SetTerminalSizeResponse
-	8,  // [8:14] is the sub-list for method output_type
-	2,  // [2:8] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	19, // 0: supervisor.OpenTerminalRequest.env:type_name -> supervisor.OpenTerminalRequest.EnvEntry
+	20, // 1: supervisor.ListTerminalsResponse.terminals:type_name -> supervisor.ListTerminalsResponse.Terminal
+	7,  // 2: supervisor.ListenTerminalRequest.replay:type_name -> supervisor.ReplayTerminalOptions
+	14, // 3: supervisor.AttachTerminalRequest.start:type_name -> supervisor.AttachTerminalStart
+	15, // 4: supervisor.AttachTerminalRequest.stdin:type_name -> supervisor.TerminalInput
+	16, // 5: supervisor.AttachTerminalRequest.resize:type_name -> supervisor.TerminalSize
+	18, // 6: supervisor.AttachTerminalResponse.output:type_name -> supervisor.TerminalOutput
+	0,  // 7: supervisor.TerminalService.Open:input_type -> supervisor.OpenTerminalRequest
+	2,  // 8: supervisor.TerminalService.Close:input_type -> supervisor.CloseTerminalRequest
+	4,  // 9: supervisor.TerminalService.List:input_type -> supervisor.ListTerminalsRequest
+	6,  // 10: supervisor.TerminalService.Listen:input_type -> supervisor.ListenTerminalRequest
+	9,  // 11: supervisor.TerminalService.Write:input_type -> supervisor.WriteTerminalRequest
+	11, // 12: supervisor.TerminalService.SetSize:input_type -> supervisor.SetTerminalSizeRequest
+	13, // 13: supervisor.TerminalService.Attach:input_type -> supervisor.AttachTerminalRequest
+	1,  // 14: supervisor.TerminalService.Open:output_type -> supervisor.OpenTerminalResponse
+	3,  // 15: supervisor.TerminalService.Close:output_type -> supervisor.CloseTerminalResponse
+	5,  // 16: supervisor.TerminalService.List:output_type -> supervisor.ListTerminalsResponse
+	8,  // 17: supervisor.TerminalService.Listen:output_type -> supervisor.ListenTerminalResponse
+	10, // 18: supervisor.TerminalService.Write:output_type -> supervisor.WriteTerminalResponse
+	12, // 19: supervisor.TerminalService.SetSize:output_type -> supervisor.SetTerminalSizeResponse
+	17, // 20: supervisor.TerminalService.Attach:output_type -> supervisor.AttachTerminalResponse
+	14, // [14:21] is the sub-list for method output_type
+	7,  // [7:14] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_terminal_proto_init() }
@@ -1017,7 +1657,7 @@ func file_terminal_proto_init() {
 			}
 		}
 		file_terminal_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListenTerminalResponse); i {
+			switch v := v.(*ReplayTerminalOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1029,7 +1669,7 @@ func file_terminal_proto_init() {
 			}
 		}
 		file_terminal_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteTerminalRequest); i {
+			switch v := v.(*ListenTerminalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1041,7 +1681,7 @@ func file_terminal_proto_init() {
 			}
 		}
 		file_terminal_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteTerminalResponse); i {
+			switch v := v.(*WriteTerminalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1053,7 +1693,7 @@ func file_terminal_proto_init() {
 			}
 		}
 		file_terminal_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetTerminalSizeRequest); i {
+			switch v := v.(*WriteTerminalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1065,6 +1705,18 @@ func file_terminal_proto_init() {
 			}
 		}
 		file_terminal_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTerminalSizeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_terminal_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetTerminalSizeResponse); i {
 			case 0:
 				return &v.state
@@ -1077,6 +1729,78 @@ func file_terminal_proto_init() {
 			}
 		}
 		file_terminal_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachTerminalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_terminal_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachTerminalStart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_terminal_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_terminal_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalSize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_terminal_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachTerminalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_terminal_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_terminal_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListTerminalsResponse_Terminal); i {
 			case 0:
 				return &v.state
@@ -1089,21 +1813,35 @@ func file_terminal_proto_init() {
 			}
 		}
 	}
-	file_terminal_proto_msgTypes[7].OneofWrappers = []interface{}{
+	file_terminal_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*ListenTerminalResponse_Stdout)(nil),
 		(*ListenTerminalResponse_Stderr)(nil),
 	}
-	file_terminal_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_terminal_proto_msgTypes[11].OneofWrappers = []interface{}{
 		(*SetTerminalSizeRequest_Token)(nil),
 		(*SetTerminalSizeRequest_Force)(nil),
 	}
+	file_terminal_proto_msgTypes[13].OneofWrappers = []interface{}{
+		(*AttachTerminalRequest_Start)(nil),
+		(*AttachTerminalRequest_Stdin)(nil),
+		(*AttachTerminalRequest_Resize)(nil),
+		(*AttachTerminalRequest_Ack)(nil),
+	}
+	file_terminal_proto_msgTypes[14].OneofWrappers = []interface{}{
+		(*AttachTerminalStart_Token)(nil),
+		(*AttachTerminalStart_Force)(nil),
+	}
+	file_terminal_proto_msgTypes[17].OneofWrappers = []interface{}{
+		(*AttachTerminalResponse_Output)(nil),
+		(*AttachTerminalResponse_StdinAck)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_terminal_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1142,6 +1880,9 @@ type TerminalServiceClient interface {
 	Write(ctx context.Context, in *WriteTerminalRequest, opts ...grpc.CallOption) (*WriteTerminalResponse, error)
 	// SetSize sets the terminal's size
 	SetSize(ctx context.Context, in *SetTerminalSizeRequest, opts ...grpc.CallOption) (*SetTerminalSizeResponse, error)
+	// Attach attaches to a terminal, carrying its input, size changes and output over a single stream.
+	// The first message a client sends must be an AttachTerminalStart.
+	Attach(ctx context.Context, opts ...grpc.CallOption) (TerminalService_AttachClient, error)
 }
 
 type terminalServiceClient struct {
@@ -1229,6 +1970,37 @@ func (c *terminalServiceClient) SetSize(ctx context.Context, in *SetTerminalSize
 	return out, nil
 }
 
+func (c *terminalServiceClient) Attach(ctx context.Context, opts ...grpc.CallOption) (TerminalService_AttachClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TerminalService_serviceDesc.Streams[1], "/supervisor.TerminalService/Attach", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &terminalServiceAttachClient{stream}
+	return x, nil
+}
+
+type TerminalService_AttachClient interface {
+	Send(*AttachTerminalRequest) error
+	Recv() (*AttachTerminalResponse, error)
+	grpc.ClientStream
+}
+
+type terminalServiceAttachClient struct {
+	grpc.ClientStream
+}
+
+func (x *terminalServiceAttachClient) Send(m *AttachTerminalRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *terminalServiceAttachClient) Recv() (*AttachTerminalResponse, error) {
+	m := new(AttachTerminalResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // TerminalServiceServer is the server API for TerminalService service.
 type TerminalServiceServer interface {
 	// Open opens a new terminal running the login shell
@@ -1244,6 +2016,9 @@ type TerminalServiceServer interface {
 	Write(context.Context, *WriteTerminalRequest) (*WriteTerminalResponse, error)
 	// SetSize sets the terminal's size
 	SetSize(context.Context, *SetTerminalSizeRequest) (*SetTerminalSizeResponse, error)
+	// Attach attaches to a terminal, carrying its input, size changes and output over a single stream.
+	// The first message a client sends must be an AttachTerminalStart.
+	Attach(TerminalService_AttachServer) error
 }
 
 // UnimplementedTerminalServiceServer can be embedded to have forward compatible implementations.
@@ -1268,6 +2043,9 @@ func (*UnimplementedTerminalServiceServer) Write(context.Context, *WriteTerminal
 func (*UnimplementedTerminalServiceServer) SetSize(context.Context, *SetTerminalSizeRequest) (*SetTerminalSizeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSize not implemented")
 }
+func (*UnimplementedTerminalServiceServer) Attach(TerminalService_AttachServer) error {
+	return status.Errorf(codes.Unimplemented, "method Attach not implemented")
+}
 
 func RegisterTerminalServiceServer(s *grpc.Server, srv TerminalServiceServer) {
 	s.RegisterService(&_TerminalService_serviceDesc, srv)
@@ -1384,6 +2162,32 @@ func _TerminalService_SetSize_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TerminalService_Attach_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TerminalServiceServer).Attach(&terminalServiceAttachServer{stream})
+}
+
+type TerminalService_AttachServer interface {
+	Send(*AttachTerminalResponse) error
+	Recv() (*AttachTerminalRequest, error)
+	grpc.ServerStream
+}
+
+type terminalServiceAttachServer struct {
+	grpc.ServerStream
+}
+
+func (x *terminalServiceAttachServer) Send(m *AttachTerminalResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *terminalServiceAttachServer) Recv() (*AttachTerminalRequest, error) {
+	m := new(AttachTerminalRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _TerminalService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "supervisor.TerminalService",
 	HandlerType: (*TerminalServiceServer)(nil),
@@ -1415,6 +2219,12 @@ var _TerminalService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _TerminalService_Listen_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Attach",
+			Handler:       _TerminalService_Attach_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "terminal.proto",
 }
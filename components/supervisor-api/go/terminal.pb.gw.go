@@ -105,6 +105,10 @@ func local_request_TerminalService_List_0(ctx context.Context, marshaler runtime
 
 }
 
+var (
+	filter_TerminalService_Listen_0 = &utilities.DoubleArray{Encoding: map[string]int{"alias": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+)
+
 func request_TerminalService_Listen_0(ctx context.Context, marshaler runtime.Marshaler, client TerminalServiceClient, req *http.Request, pathParams map[string]string) (TerminalService_ListenClient, runtime.ServerMetadata, error) {
 	var protoReq ListenTerminalRequest
 	var metadata runtime.ServerMetadata
@@ -126,6 +130,13 @@ func request_TerminalService_Listen_0(ctx context.Context, marshaler runtime.Mar
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "alias", err)
 	}
 
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_TerminalService_Listen_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
 	stream, err := client.Listen(ctx, &protoReq)
 	if err != nil {
 		return nil, metadata, err
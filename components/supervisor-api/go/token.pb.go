@@ -90,6 +90,66 @@ func (TokenReuse) EnumDescriptor() ([]byte, []int) {
 	return file_token_proto_rawDescGZIP(), []int{0}
 }
 
+type TokenRequestOutcome int32
+
+const (
+	// GRANTED_CACHED means the token was served from supervisor's cache
+	TokenRequestOutcome_GRANTED_CACHED TokenRequestOutcome = 0
+	// GRANTED_PROVIDER means the token was obtained from a token provider
+	TokenRequestOutcome_GRANTED_PROVIDER TokenRequestOutcome = 1
+	// DENIED means the caller isn't allowed to request this token by an access policy
+	TokenRequestOutcome_DENIED TokenRequestOutcome = 2
+	// NOT_FOUND means no token was cached and no provider could supply one
+	TokenRequestOutcome_NOT_FOUND TokenRequestOutcome = 3
+	// REFRESHED means supervisor renewed a cached token from a provider before it expired
+	TokenRequestOutcome_REFRESHED TokenRequestOutcome = 4
+)
+
+// Enum value maps for TokenRequestOutcome.
+var (
+	TokenRequestOutcome_name = map[int32]string{
+		0: "GRANTED_CACHED",
+		1: "GRANTED_PROVIDER",
+		2: "DENIED",
+		3: "NOT_FOUND",
+		4: "REFRESHED",
+	}
+	TokenRequestOutcome_value = map[string]int32{
+		"GRANTED_CACHED":   0,
+		"GRANTED_PROVIDER": 1,
+		"DENIED":           2,
+		"NOT_FOUND":        3,
+		"REFRESHED":        4,
+	}
+)
+
+func (x TokenRequestOutcome) Enum() *TokenRequestOutcome {
+	p := new(TokenRequestOutcome)
+	*p = x
+	return p
+}
+
+func (x TokenRequestOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TokenRequestOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_token_proto_enumTypes[1].Descriptor()
+}
+
+func (TokenRequestOutcome) Type() protoreflect.EnumType {
+	return &file_token_proto_enumTypes[1]
+}
+
+func (x TokenRequestOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TokenRequestOutcome.Descriptor instead.
+func (TokenRequestOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_token_proto_rawDescGZIP(), []int{1}
+}
+
 type GetTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -167,7 +227,8 @@ type GetTokenResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	User  string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	//* The username of the account associated with the token.
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
 }
 
 func (x *GetTokenResponse) Reset() {
@@ -594,6 +655,262 @@ func (x *ProvideTokenResponse) GetRequest() *GetTokenRequest {
 	return nil
 }
 
+type ListTokenRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// kind limits the list to requests for tokens of this kind. If empty, requests of all kinds are listed.
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+}
+
+func (x *ListTokenRequestsRequest) Reset() {
+	*x = ListTokenRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_token_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTokenRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTokenRequestsRequest) ProtoMessage() {}
+
+func (x *ListTokenRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_token_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTokenRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListTokenRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_token_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListTokenRequestsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type ListTokenRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// requests are ordered from oldest to newest
+	Requests []*TokenRequestRecord `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *ListTokenRequestsResponse) Reset() {
+	*x = ListTokenRequestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_token_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTokenRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTokenRequestsResponse) ProtoMessage() {}
+
+func (x *ListTokenRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_token_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTokenRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListTokenRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_token_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListTokenRequestsResponse) GetRequests() []*TokenRequestRecord {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type TokenRequestRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time  *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Kind  string               `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Host  string               `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	Scope []string             `protobuf:"bytes,4,rep,name=scope,proto3" json:"scope,omitempty"`
+	// caller is the process which requested the token. It is not set for requests made by supervisor itself.
+	Caller  *TokenCaller        `protobuf:"bytes,5,opt,name=caller,proto3" json:"caller,omitempty"`
+	Outcome TokenRequestOutcome `protobuf:"varint,6,opt,name=outcome,proto3,enum=supervisor.TokenRequestOutcome" json:"outcome,omitempty"`
+	// reason explains why a request was denied or could not be answered
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *TokenRequestRecord) Reset() {
+	*x = TokenRequestRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_token_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenRequestRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenRequestRecord) ProtoMessage() {}
+
+func (x *TokenRequestRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_token_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenRequestRecord.ProtoReflect.Descriptor instead.
+func (*TokenRequestRecord) Descriptor() ([]byte, []int) {
+	return file_token_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TokenRequestRecord) GetTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *TokenRequestRecord) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *TokenRequestRecord) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *TokenRequestRecord) GetScope() []string {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *TokenRequestRecord) GetCaller() *TokenCaller {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
+func (x *TokenRequestRecord) GetOutcome() TokenRequestOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return TokenRequestOutcome_GRANTED_CACHED
+}
+
+func (x *TokenRequestRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type TokenCaller struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid        int64  `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Uid        uint32 `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Executable string `protobuf:"bytes,3,opt,name=executable,proto3" json:"executable,omitempty"`
+}
+
+func (x *TokenCaller) Reset() {
+	*x = TokenCaller{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_token_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenCaller) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenCaller) ProtoMessage() {}
+
+func (x *TokenCaller) ProtoReflect() protoreflect.Message {
+	mi := &file_token_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenCaller.ProtoReflect.Descriptor instead.
+func (*TokenCaller) Descriptor() ([]byte, []int) {
+	return file_token_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TokenCaller) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *TokenCaller) GetUid() uint32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *TokenCaller) GetExecutable() string {
+	if x != nil {
+		return x.Executable
+	}
+	return ""
+}
+
 type ProvideTokenRequest_RegisterProvider struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -605,7 +922,7 @@ type ProvideTokenRequest_RegisterProvider struct {
 func (x *ProvideTokenRequest_RegisterProvider) Reset() {
 	*x = ProvideTokenRequest_RegisterProvider{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_token_proto_msgTypes[8]
+		mi := &file_token_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -618,7 +935,7 @@ func (x *ProvideTokenRequest_RegisterProvider) String() string {
 func (*ProvideTokenRequest_RegisterProvider) ProtoMessage() {}
 
 func (x *ProvideTokenRequest_RegisterProvider) ProtoReflect() protoreflect.Message {
-	mi := &file_token_proto_msgTypes[8]
+	mi := &file_token_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -700,12 +1017,49 @@ var file_token_proto_rawDesc = []byte{
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76,
 	0x69, 0x73, 0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2a, 0x49, 0x0a,
-	0x0a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x75, 0x73, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x52,
-	0x45, 0x55, 0x53, 0x45, 0x5f, 0x4e, 0x45, 0x56, 0x45, 0x52, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
-	0x52, 0x45, 0x55, 0x53, 0x45, 0x5f, 0x45, 0x58, 0x41, 0x43, 0x54, 0x4c, 0x59, 0x10, 0x01, 0x12,
-	0x17, 0x0a, 0x13, 0x52, 0x45, 0x55, 0x53, 0x45, 0x5f, 0x57, 0x48, 0x45, 0x4e, 0x5f, 0x50, 0x4f,
-	0x53, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x32, 0xdb, 0x03, 0x0a, 0x0c, 0x54, 0x6f, 0x6b,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2e, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x57, 0x0a,
+	0x19, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x86, 0x02, 0x0a, 0x12, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x2e, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x63,
+	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x75,
+	0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x07,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
+	0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x51, 0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x10,
+	0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70, 0x69, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x75,
+	0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x2a, 0x49, 0x0a, 0x0a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x75, 0x73, 0x65,
+	0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x55, 0x53, 0x45, 0x5f, 0x4e, 0x45, 0x56, 0x45, 0x52, 0x10,
+	0x00, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x55, 0x53, 0x45, 0x5f, 0x45, 0x58, 0x41, 0x43, 0x54,
+	0x4c, 0x59, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x55, 0x53, 0x45, 0x5f, 0x57, 0x48,
+	0x45, 0x4e, 0x5f, 0x50, 0x4f, 0x53, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x2a, 0x69, 0x0a,
+	0x13, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x45, 0x44, 0x5f,
+	0x43, 0x41, 0x43, 0x48, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x52, 0x41, 0x4e,
+	0x54, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x46,
+	0x52, 0x45, 0x53, 0x48, 0x45, 0x44, 0x10, 0x04, 0x32, 0xbf, 0x04, 0x0a, 0x0c, 0x54, 0x6f, 0x6b,
 	0x65, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6e, 0x0a, 0x08, 0x47, 0x65, 0x74,
 	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73,
 	0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
@@ -735,8 +1089,14 @@ var file_token_proto_rawDesc = []byte{
 	0x64, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
 	0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x76,
 	0x69, 0x64, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x3b, 0x61, 0x70, 0x69, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x62, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x75,
+	0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x3b,
+	0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -751,40 +1111,51 @@ func file_token_proto_rawDescGZIP() []byte {
 	return file_token_proto_rawDescData
 }
 
-var file_token_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_token_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_token_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_token_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_token_proto_goTypes = []interface{}{
 	(TokenReuse)(0),                              // 0: supervisor.TokenReuse
-	(*GetTokenRequest)(nil),                      // 1: supervisor.GetTokenRequest
-	(*GetTokenResponse)(nil),                     // 2: supervisor.GetTokenResponse
-	(*SetTokenRequest)(nil),                      // 3: supervisor.SetTokenRequest
-	(*SetTokenResponse)(nil),                     // 4: supervisor.SetTokenResponse
-	(*ClearTokenRequest)(nil),                    // 5: supervisor.ClearTokenRequest
-	(*ClearTokenResponse)(nil),                   // 6: supervisor.ClearTokenResponse
-	(*ProvideTokenRequest)(nil),                  // 7: supervisor.ProvideTokenRequest
-	(*ProvideTokenResponse)(nil),                 // 8: supervisor.ProvideTokenResponse
-	(*ProvideTokenRequest_RegisterProvider)(nil), // 9: supervisor.ProvideTokenRequest.RegisterProvider
-	(*timestamp.Timestamp)(nil),                  // 10: google.protobuf.Timestamp
+	(TokenRequestOutcome)(0),                     // 1: supervisor.TokenRequestOutcome
+	(*GetTokenRequest)(nil),                      // 2: supervisor.GetTokenRequest
+	(*GetTokenResponse)(nil),                     // 3: supervisor.GetTokenResponse
+	(*SetTokenRequest)(nil),                      // 4: supervisor.SetTokenRequest
+	(*SetTokenResponse)(nil),                     // 5: supervisor.SetTokenResponse
+	(*ClearTokenRequest)(nil),                    // 6: supervisor.ClearTokenRequest
+	(*ClearTokenResponse)(nil),                   // 7: supervisor.ClearTokenResponse
+	(*ProvideTokenRequest)(nil),                  // 8: supervisor.ProvideTokenRequest
+	(*ProvideTokenResponse)(nil),                 // 9: supervisor.ProvideTokenResponse
+	(*ListTokenRequestsRequest)(nil),             // 10: supervisor.ListTokenRequestsRequest
+	(*ListTokenRequestsResponse)(nil),            // 11: supervisor.ListTokenRequestsResponse
+	(*TokenRequestRecord)(nil),                   // 12: supervisor.TokenRequestRecord
+	(*TokenCaller)(nil),                          // 13: supervisor.TokenCaller
+	(*ProvideTokenRequest_RegisterProvider)(nil), // 14: supervisor.ProvideTokenRequest.RegisterProvider
+	(*timestamp.Timestamp)(nil),                  // 15: google.protobuf.Timestamp
 }
 var file_token_proto_depIdxs = []int32{
-	10, // 0: supervisor.SetTokenRequest.expiry_date:type_name -> google.protobuf.Timestamp
+	15, // 0: supervisor.SetTokenRequest.expiry_date:type_name -> google.protobuf.Timestamp
 	0,  // 1: supervisor.SetTokenRequest.reuse:type_name -> supervisor.TokenReuse
-	9,  // 2: supervisor.ProvideTokenRequest.registration:type_name -> supervisor.ProvideTokenRequest.RegisterProvider
-	3,  // 3: supervisor.ProvideTokenRequest.answer:type_name -> supervisor.SetTokenRequest
-	1,  // 4: supervisor.ProvideTokenResponse.request:type_name -> supervisor.GetTokenRequest
-	1,  // 5: supervisor.TokenService.GetToken:input_type -> supervisor.GetTokenRequest
-	3,  // 6: supervisor.TokenService.SetToken:input_type -> supervisor.SetTokenRequest
-	5,  // 7: supervisor.TokenService.ClearToken:input_type -> supervisor.ClearTokenRequest
-	7,  // 8: supervisor.TokenService.ProvideToken:input_type -> supervisor.ProvideTokenRequest
-	2,  // 9: supervisor.TokenService.GetToken:output_type -> supervisor.GetTokenResponse
-	4,  // 10: supervisor.TokenService.SetToken:output_type -> supervisor.SetTokenResponse
-	6,  // 11: supervisor.TokenService.ClearToken:output_type -> supervisor.ClearTokenResponse
-	8,  // 12: supervisor.TokenService.ProvideToken:output_type -> supervisor.ProvideTokenResponse
-	9,  // [9:13] is the sub-list for method output_type
-	5,  // [5:9] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	14, // 2: supervisor.ProvideTokenRequest.registration:type_name -> supervisor.ProvideTokenRequest.RegisterProvider
+	4,  // 3: supervisor.ProvideTokenRequest.answer:type_name -> supervisor.SetTokenRequest
+	2,  // 4: supervisor.ProvideTokenResponse.request:type_name -> supervisor.GetTokenRequest
+	12, // 5: supervisor.ListTokenRequestsResponse.requests:type_name -> supervisor.TokenRequestRecord
+	15, // 6: supervisor.TokenRequestRecord.time:type_name -> google.protobuf.Timestamp
+	13, // 7: supervisor.TokenRequestRecord.caller:type_name -> supervisor.TokenCaller
+	1,  // 8: supervisor.TokenRequestRecord.outcome:type_name -> supervisor.TokenRequestOutcome
+	2,  // 9: supervisor.TokenService.GetToken:input_type -> supervisor.GetTokenRequest
+	4,  // 10: supervisor.TokenService.SetToken:input_type -> supervisor.SetTokenRequest
+	6,  // 11: supervisor.TokenService.ClearToken:input_type -> supervisor.ClearTokenRequest
+	8,  // 12: supervisor.TokenService.ProvideToken:input_type -> supervisor.ProvideTokenRequest
+	10, // 13: supervisor.TokenService.ListTokenRequests:input_type -> supervisor.ListTokenRequestsRequest
+	3,  // 14: supervisor.TokenService.GetToken:output_type -> supervisor.GetTokenResponse
+	5,  // 15: supervisor.TokenService.SetToken:output_type -> supervisor.SetTokenResponse
+	7,  // 16: supervisor.TokenService.ClearToken:output_type -> supervisor.ClearTokenResponse
+	9,  // 17: supervisor.TokenService.ProvideToken:output_type -> supervisor.ProvideTokenResponse
+	11, // 18: supervisor.TokenService.ListTokenRequests:output_type -> supervisor.ListTokenRequestsResponse
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_token_proto_init() }
@@ -890,6 +1261,54 @@ func file_token_proto_init() {
 			}
 		}
 		file_token_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTokenRequestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_token_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTokenRequestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_token_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenRequestRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_token_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenCaller); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_token_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProvideTokenRequest_RegisterProvider); i {
 			case 0:
 				return &v.state
@@ -915,8 +1334,8 @@ func file_token_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_token_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -947,6 +1366,8 @@ type TokenServiceClient interface {
 	SetToken(ctx context.Context, in *SetTokenRequest, opts ...grpc.CallOption) (*SetTokenResponse, error)
 	ClearToken(ctx context.Context, in *ClearTokenRequest, opts ...grpc.CallOption) (*ClearTokenResponse, error)
 	ProvideToken(ctx context.Context, opts ...grpc.CallOption) (TokenService_ProvideTokenClient, error)
+	// ListTokenRequests lists the most recent token requests, including those which were denied
+	ListTokenRequests(ctx context.Context, in *ListTokenRequestsRequest, opts ...grpc.CallOption) (*ListTokenRequestsResponse, error)
 }
 
 type tokenServiceClient struct {
@@ -1015,12 +1436,23 @@ func (x *tokenServiceProvideTokenClient) Recv() (*ProvideTokenResponse, error) {
 	return m, nil
 }
 
+func (c *tokenServiceClient) ListTokenRequests(ctx context.Context, in *ListTokenRequestsRequest, opts ...grpc.CallOption) (*ListTokenRequestsResponse, error) {
+	out := new(ListTokenRequestsResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.TokenService/ListTokenRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TokenServiceServer is the server API for TokenService service.
 type TokenServiceServer interface {
 	GetToken(context.Context, *GetTokenRequest) (*GetTokenResponse, error)
 	SetToken(context.Context, *SetTokenRequest) (*SetTokenResponse, error)
 	ClearToken(context.Context, *ClearTokenRequest) (*ClearTokenResponse, error)
 	ProvideToken(TokenService_ProvideTokenServer) error
+	// ListTokenRequests lists the most recent token requests, including those which were denied
+	ListTokenRequests(context.Context, *ListTokenRequestsRequest) (*ListTokenRequestsResponse, error)
 }
 
 // UnimplementedTokenServiceServer can be embedded to have forward compatible implementations.
@@ -1039,6 +1471,9 @@ func (*UnimplementedTokenServiceServer) ClearToken(context.Context, *ClearTokenR
 func (*UnimplementedTokenServiceServer) ProvideToken(TokenService_ProvideTokenServer) error {
 	return status.Errorf(codes.Unimplemented, "method ProvideToken not implemented")
 }
+func (*UnimplementedTokenServiceServer) ListTokenRequests(context.Context, *ListTokenRequestsRequest) (*ListTokenRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTokenRequests not implemented")
+}
 
 func RegisterTokenServiceServer(s *grpc.Server, srv TokenServiceServer) {
 	s.RegisterService(&_TokenService_serviceDesc, srv)
@@ -1124,6 +1559,24 @@ func (x *tokenServiceProvideTokenServer) Recv() (*ProvideTokenRequest, error) {
 	return m, nil
 }
 
+func _TokenService_ListTokenRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTokenRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).ListTokenRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.TokenService/ListTokenRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).ListTokenRequests(ctx, req.(*ListTokenRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TokenService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "supervisor.TokenService",
 	HandlerType: (*TokenServiceServer)(nil),
@@ -1140,6 +1593,10 @@ var _TokenService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClearToken",
 			Handler:    _TokenService_ClearToken_Handler,
 		},
+		{
+			MethodName: "ListTokenRequests",
+			Handler:    _TokenService_ListTokenRequests_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -16,8 +16,11 @@ import (
 	"github.com/creack/pty"
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 	"golang.org/x/crypto/ssh/terminal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var terminalAttachOpts struct {
@@ -75,25 +78,21 @@ type attachToTerminalOpts struct {
 	Token       string
 }
 
+const (
+	// attachMaxRetries is the number of times in a row we try to re-attach to a terminal
+	attachMaxRetries = 5
+	// attachRetryDelay is the time we wait before re-attaching to a terminal
+	attachRetryDelay = 1 * time.Second
+)
+
 func attachToTerminal(ctx context.Context, client api.TerminalServiceClient, alias string, opts attachToTerminalOpts) {
-	// Copy to stdout/stderr
-	listen, err := client.Listen(ctx, &api.ListenTerminalRequest{
-		Alias: alias,
-	})
+	session, err := uuid.NewRandom()
 	if err != nil {
-		log.WithError(err).Fatal("cannot attach to terminal")
+		log.WithError(err).Fatal("cannot create attach session")
 	}
-	errchan := make(chan error, 5)
-	go func() {
-		for {
-			resp, err := listen.Recv()
-			if err != nil {
-				errchan <- err
-			}
-			os.Stderr.Write(resp.GetStderr())
-			os.Stdout.Write(resp.GetStdout())
-		}
-	}()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
 	// Set stdin in raw mode.
 	oldState, err := terminal.MakeRaw(int(os.Stdin.Fd()))
@@ -102,7 +101,16 @@ func attachToTerminal(ctx context.Context, client api.TerminalServiceClient, ali
 	}
 	defer func() { _ = terminal.Restore(int(os.Stdin.Fd()), oldState) }() // Best effort.
 
+	att := &terminalAttachment{
+		Client:  client,
+		Alias:   alias,
+		Opts:    opts,
+		Session: session.String(),
+	}
 	if opts.Interactive {
+		att.Stdin = make(chan []byte)
+		att.Resize = make(chan *api.TerminalSize, 1)
+
 		// Handle pty size.
 		ch := make(chan os.Signal, 1)
 		signal.Notify(ch, syscall.SIGWINCH)
@@ -114,61 +122,203 @@ func attachToTerminal(ctx context.Context, client api.TerminalServiceClient, ali
 					continue
 				}
 
-				req := &api.SetTerminalSizeRequest{
-					Alias:    alias,
+				// only the latest size matters
+				select {
+				case <-att.Resize:
+				default:
+				}
+				att.Resize <- &api.TerminalSize{
 					Cols:     uint32(size.Cols),
 					Rows:     uint32(size.Rows),
 					WidthPx:  uint32(size.X),
 					HeightPx: uint32(size.Y),
 				}
-
-				var expectResize bool
-				if opts.ForceResize {
-					req.Priority = &api.SetTerminalSizeRequest_Force{Force: true}
-					expectResize = true
-				} else if opts.Token != "" {
-					req.Priority = &api.SetTerminalSizeRequest_Token{Token: opts.Token}
-					expectResize = true
-				}
-
-				_, err = client.SetSize(ctx, req)
-				if err != nil && expectResize {
-					log.WithError(err).Error("cannot set terminal size")
-					continue
-				}
 			}
 		}()
 		ch <- syscall.SIGWINCH // Initial resize.
 
-		// Copy stdin to the pty and the pty to stdout.
 		go func() {
-			buf := make([]byte, 32*1024)
 			for {
+				buf := make([]byte, 32*1024)
 				n, err := os.Stdin.Read(buf)
 				if n > 0 {
-					_, serr := client.Write(ctx, &api.WriteTerminalRequest{Alias: alias, Stdin: buf[:n]})
-					if serr != nil {
-						errchan <- err
+					select {
+					case att.Stdin <- buf[:n]:
+					case <-ctx.Done():
 						return
 					}
 				}
 				if err != nil {
-					errchan <- err
 					return
 				}
 			}
 		}()
 	}
 
-	// wait indefinitely
 	stopch := make(chan os.Signal, 1)
-	signal.Notify(stopch, syscall.SIGTERM|syscall.SIGINT)
-	select {
-	case err := <-errchan:
-		if err != io.EOF {
-			log.WithError(err).Error("error")
+	signal.Notify(stopch, syscall.SIGTERM, syscall.SIGINT)
+	go func() {
+		select {
+		case <-stopch:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	err = att.Run(ctx)
+	if err != nil && ctx.Err() == nil {
+		log.WithError(err).Error("error")
+	}
+}
+
+// terminalAttachment attaches to a terminal and re-attaches if the connection breaks,
+// resuming the output from where we left off and re-sending unacknowledged input.
+type terminalAttachment struct {
+	Client api.TerminalServiceClient
+	Alias  string
+	Opts   attachToTerminalOpts
+	// Session lets the server recognise input we re-send after resuming
+	Session string
+
+	Stdin  chan []byte
+	Resize chan *api.TerminalSize
+
+	offset  uint64
+	seq     uint64
+	pending []*api.TerminalInput
+	size    *api.TerminalSize
+}
+
+// Run attaches to the terminal until it closes or ctx is canceled
+func (att *terminalAttachment) Run(ctx context.Context) error {
+	var retries int
+	for {
+		progress, err := att.attach(ctx)
+		if err == nil || err == io.EOF {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+		switch status.Code(err) {
+		case codes.NotFound, codes.InvalidArgument, codes.Unimplemented, codes.Canceled:
+			return err
+		}
+
+		if progress {
+			retries = 0
+		}
+		retries++
+		if retries > attachMaxRetries {
+			return err
+		}
+
+		select {
+		case <-time.After(attachRetryDelay):
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// attach runs a single attachment. It returns true if we made progress during that attachment.
+func (att *terminalAttachment) attach(ctx context.Context) (progress bool, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := att.Client.Attach(ctx)
+	if err != nil {
+		return false, err
+	}
+	start := &api.AttachTerminalStart{
+		Alias:   att.Alias,
+		Offset:  att.offset,
+		Session: att.Session,
+	}
+	if att.Opts.ForceResize {
+		start.Priority = &api.AttachTerminalStart_Force{Force: true}
+	} else if att.Opts.Token != "" {
+		start.Priority = &api.AttachTerminalStart_Token{Token: att.Opts.Token}
+	}
+	err = stream.Send(&api.AttachTerminalRequest{Frame: &api.AttachTerminalRequest_Start{Start: start}})
+	if err != nil {
+		return false, err
+	}
+	for _, in := range att.pending {
+		err = stream.Send(&api.AttachTerminalRequest{Frame: &api.AttachTerminalRequest_Stdin{Stdin: in}})
+		if err != nil {
+			return false, err
+		}
+	}
+	if att.size != nil {
+		err = stream.Send(&api.AttachTerminalRequest{Frame: &api.AttachTerminalRequest_Resize{Resize: att.size}})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	// gRPC streams must not be sent to concurrently, hence the receiving side hands everything
+	// that requires a response over to us.
+	var (
+		recvErr = make(chan error, 1)
+		acks    = make(chan *api.AttachTerminalResponse, 16)
+	)
+	go func() {
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case acks <- resp:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return progress, ctx.Err()
+		case err := <-recvErr:
+			return progress, err
+		case resp := <-acks:
+			progress = true
+			switch frame := resp.Frame.(type) {
+			case *api.AttachTerminalResponse_Output:
+				data := frame.Output.Data
+				if frame.Output.Offset < att.offset {
+					// we've seen (some of) this output before
+					skip := att.offset - frame.Output.Offset
+					if skip >= uint64(len(data)) {
+						continue
+					}
+					data = data[skip:]
+				}
+				os.Stdout.Write(data)
+				att.offset = frame.Output.Offset + uint64(len(frame.Output.Data))
+				err = stream.Send(&api.AttachTerminalRequest{Frame: &api.AttachTerminalRequest_Ack{Ack: att.offset}})
+			case *api.AttachTerminalResponse_StdinAck:
+				var i int
+				for i < len(att.pending) && att.pending[i].Seq <= frame.StdinAck {
+					i++
+				}
+				att.pending = att.pending[i:]
+			}
+		case data := <-att.Stdin:
+			att.seq++
+			in := &api.TerminalInput{Seq: att.seq, Data: data}
+			att.pending = append(att.pending, in)
+			err = stream.Send(&api.AttachTerminalRequest{Frame: &api.AttachTerminalRequest_Stdin{Stdin: in}})
+		case size := <-att.Resize:
+			att.size = size
+			err = stream.Send(&api.AttachTerminalRequest{Frame: &api.AttachTerminalRequest_Resize{Resize: size}})
+		}
+		if err != nil {
+			return progress, err
 		}
-	case <-stopch:
 	}
 }
 
@@ -0,0 +1,59 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/spf13/cobra"
+)
+
+var terminalReplayOpts struct {
+	Offset  time.Duration
+	Instant bool
+}
+
+var terminalReplayCmd = &cobra.Command{
+	Use:   "replay <alias>",
+	Short: "plays back the recording of a terminal",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := api.NewTerminalServiceClient(dialSupervisor())
+
+		replay, err := client.Listen(context.Background(), &api.ListenTerminalRequest{
+			Alias: args[0],
+			Replay: &api.ReplayTerminalOptions{
+				OffsetMs: uint64(terminalReplayOpts.Offset / time.Millisecond),
+				Realtime: !terminalReplayOpts.Instant,
+			},
+		})
+		if err != nil {
+			log.WithError(err).Fatal("cannot replay terminal")
+		}
+
+		for {
+			resp, err := replay.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				log.WithError(err).Fatal("cannot replay terminal")
+			}
+			os.Stdout.Write(resp.GetStdout())
+		}
+	},
+}
+
+func init() {
+	terminalCmd.AddCommand(terminalReplayCmd)
+
+	terminalReplayCmd.Flags().DurationVar(&terminalReplayOpts.Offset, "offset", 0, "time into the recording from which to start the replay")
+	terminalReplayCmd.Flags().BoolVar(&terminalReplayOpts.Instant, "instant", false, "replay the recording as fast as possible instead of using its original timing")
+}
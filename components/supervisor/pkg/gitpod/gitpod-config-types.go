@@ -58,6 +58,16 @@ type GitpodConfig struct {
 	WorkspaceLocation string `yaml:"workspaceLocation,omitempty"`
 }
 
+// Http Considers the command ready once an HTTP GET request to localhost succeeds.
+type Http struct {
+
+	// The path to request.
+	Path string `yaml:"path,omitempty"`
+
+	// The port to send the request to.
+	Port float64 `yaml:"port"`
+}
+
 // Image_object The Docker image to run your workspace in.
 type Image_object struct {
 
@@ -109,6 +119,26 @@ type Prebuilds_object struct {
 	PullRequestsFromForks bool `yaml:"pullRequestsFromForks,omitempty"`
 }
 
+// Readiness Checks if the main `command` is ready to serve requests.
+type Readiness struct {
+
+	// Considers the command ready once an HTTP GET request to localhost succeeds.
+	Http *Http `yaml:"http,omitempty"`
+
+	// Considers the command ready once a port on localhost accepts connections.
+	Tcp *Tcp `yaml:"tcp,omitempty"`
+}
+
+// Restart Restarts the main `command` once it exits. Restarts are delayed with an exponential backoff.
+type Restart struct {
+
+	// The maximum number of restarts. Defaults to no limit.
+	MaxAttempts float64 `yaml:"maxAttempts,omitempty"`
+
+	// When to restart the command. 'never' (default) does not restart it. 'on-failure' restarts it if it exits with a non-zero exit code. 'always' restarts it whenever it exits.
+	Policy string `yaml:"policy,omitempty"`
+}
+
 // TasksItems
 type TasksItems struct {
 
@@ -118,6 +148,9 @@ type TasksItems struct {
 	// The main shell command to run after `before` and `init`. This command is executed last on every start and doesn't have to terminate.
 	Command string `yaml:"command,omitempty"`
 
+	// Names of tasks whose `before`, `init` and `prebuild` commands must succeed before this task starts. If one of them fails, this task will not be started.
+	DependsOn []string `yaml:"dependsOn,omitempty"`
+
 	// Environment variables to set.
 	Env *Env `yaml:"env,omitempty"`
 
@@ -135,6 +168,22 @@ type TasksItems struct {
 
 	// A shell command to run after `before`. This command is executed only on during workspace prebuilds. This command is expected to terminate. If it fails, the workspace build fails.
 	Prebuild string `yaml:"prebuild,omitempty"`
+
+	// Checks if the main `command` is ready to serve requests.
+	Readiness *Readiness `yaml:"readiness,omitempty"`
+
+	// Restarts the main `command` once it exits. Restarts are delayed with an exponential backoff.
+	Restart *Restart `yaml:"restart,omitempty"`
+
+	// Delays the start of this task until a port is served or a file exists.
+	WaitFor *WaitFor `yaml:"waitFor,omitempty"`
+}
+
+// Tcp Considers the command ready once a port on localhost accepts connections.
+type Tcp struct {
+
+	// The port to connect to.
+	Port float64 `yaml:"port"`
 }
 
 // Vscode Configure VS Code integration
@@ -144,6 +193,16 @@ type Vscode struct {
 	Extensions []string `yaml:"extensions,omitempty"`
 }
 
+// WaitFor Delays the start of this task until a port is served or a file exists.
+type WaitFor struct {
+
+	// The file that must exist. Relative paths are resolved against the repository root.
+	File string `yaml:"file,omitempty"`
+
+	// The port that must be served on localhost.
+	Port float64 `yaml:"port,omitempty"`
+}
+
 func (strct *Github) MarshalJSON() ([]byte, error) {
 	buf := bytes.NewBuffer(make([]byte, 0))
 	buf.WriteString("{")
@@ -359,6 +418,69 @@ func (strct *GitpodConfig) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+func (strct *Http) MarshalJSON() ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0))
+	buf.WriteString("{")
+	comma := false
+	// Marshal the "path" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"path\": ")
+	if tmp, err := json.Marshal(strct.Path); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// "Port" field is required
+	// only required object types supported for marshal checking (for now)
+	// Marshal the "port" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"port\": ")
+	if tmp, err := json.Marshal(strct.Port); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+
+	buf.WriteString("}")
+	rv := buf.Bytes()
+	return rv, nil
+}
+
+func (strct *Http) UnmarshalJSON(b []byte) error {
+	portReceived := false
+	var jsonMap map[string]json.RawMessage
+	if err := json.Unmarshal(b, &jsonMap); err != nil {
+		return err
+	}
+	// parse all the defined properties
+	for k, v := range jsonMap {
+		switch k {
+		case "path":
+			if err := json.Unmarshal([]byte(v), &strct.Path); err != nil {
+				return err
+			}
+		case "port":
+			if err := json.Unmarshal([]byte(v), &strct.Port); err != nil {
+				return err
+			}
+			portReceived = true
+		default:
+			return fmt.Errorf("additional property not allowed: \"" + k + "\"")
+		}
+	}
+	// check if port (a required property) was received
+	if !portReceived {
+		return errors.New("\"port\" is required but was not present")
+	}
+	return nil
+}
+
 func (strct *Image_object) MarshalJSON() ([]byte, error) {
 	buf := bytes.NewBuffer(make([]byte, 0))
 	buf.WriteString("{")
@@ -530,6 +652,116 @@ func (strct *PortsItems) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+func (strct *Readiness) MarshalJSON() ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0))
+	buf.WriteString("{")
+	comma := false
+	// Marshal the "http" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"http\": ")
+	if tmp, err := json.Marshal(strct.Http); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "tcp" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"tcp\": ")
+	if tmp, err := json.Marshal(strct.Tcp); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+
+	buf.WriteString("}")
+	rv := buf.Bytes()
+	return rv, nil
+}
+
+func (strct *Readiness) UnmarshalJSON(b []byte) error {
+	var jsonMap map[string]json.RawMessage
+	if err := json.Unmarshal(b, &jsonMap); err != nil {
+		return err
+	}
+	// parse all the defined properties
+	for k, v := range jsonMap {
+		switch k {
+		case "http":
+			if err := json.Unmarshal([]byte(v), &strct.Http); err != nil {
+				return err
+			}
+		case "tcp":
+			if err := json.Unmarshal([]byte(v), &strct.Tcp); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("additional property not allowed: \"" + k + "\"")
+		}
+	}
+	return nil
+}
+
+func (strct *Restart) MarshalJSON() ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0))
+	buf.WriteString("{")
+	comma := false
+	// Marshal the "maxAttempts" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"maxAttempts\": ")
+	if tmp, err := json.Marshal(strct.MaxAttempts); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "policy" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"policy\": ")
+	if tmp, err := json.Marshal(strct.Policy); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+
+	buf.WriteString("}")
+	rv := buf.Bytes()
+	return rv, nil
+}
+
+func (strct *Restart) UnmarshalJSON(b []byte) error {
+	var jsonMap map[string]json.RawMessage
+	if err := json.Unmarshal(b, &jsonMap); err != nil {
+		return err
+	}
+	// parse all the defined properties
+	for k, v := range jsonMap {
+		switch k {
+		case "maxAttempts":
+			if err := json.Unmarshal([]byte(v), &strct.MaxAttempts); err != nil {
+				return err
+			}
+		case "policy":
+			if err := json.Unmarshal([]byte(v), &strct.Policy); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("additional property not allowed: \"" + k + "\"")
+		}
+	}
+	return nil
+}
+
 func (strct *TasksItems) MarshalJSON() ([]byte, error) {
 	buf := bytes.NewBuffer(make([]byte, 0))
 	buf.WriteString("{")
@@ -556,6 +788,17 @@ func (strct *TasksItems) MarshalJSON() ([]byte, error) {
 		buf.Write(tmp)
 	}
 	comma = true
+	// Marshal the "dependsOn" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"dependsOn\": ")
+	if tmp, err := json.Marshal(strct.DependsOn); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
 	// Marshal the "env" field
 	if comma {
 		buf.WriteString(",")
@@ -622,6 +865,39 @@ func (strct *TasksItems) MarshalJSON() ([]byte, error) {
 		buf.Write(tmp)
 	}
 	comma = true
+	// Marshal the "readiness" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"readiness\": ")
+	if tmp, err := json.Marshal(strct.Readiness); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "restart" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"restart\": ")
+	if tmp, err := json.Marshal(strct.Restart); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "waitFor" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"waitFor\": ")
+	if tmp, err := json.Marshal(strct.WaitFor); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
 
 	buf.WriteString("}")
 	rv := buf.Bytes()
@@ -644,6 +920,10 @@ func (strct *TasksItems) UnmarshalJSON(b []byte) error {
 			if err := json.Unmarshal([]byte(v), &strct.Command); err != nil {
 				return err
 			}
+		case "dependsOn":
+			if err := json.Unmarshal([]byte(v), &strct.DependsOn); err != nil {
+				return err
+			}
 		case "env":
 			if err := json.Unmarshal([]byte(v), &strct.Env); err != nil {
 				return err
@@ -668,10 +948,70 @@ func (strct *TasksItems) UnmarshalJSON(b []byte) error {
 			if err := json.Unmarshal([]byte(v), &strct.Prebuild); err != nil {
 				return err
 			}
+		case "readiness":
+			if err := json.Unmarshal([]byte(v), &strct.Readiness); err != nil {
+				return err
+			}
+		case "restart":
+			if err := json.Unmarshal([]byte(v), &strct.Restart); err != nil {
+				return err
+			}
+		case "waitFor":
+			if err := json.Unmarshal([]byte(v), &strct.WaitFor); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("additional property not allowed: \"" + k + "\"")
+		}
+	}
+	return nil
+}
+
+func (strct *Tcp) MarshalJSON() ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0))
+	buf.WriteString("{")
+	comma := false
+	// "Port" field is required
+	// only required object types supported for marshal checking (for now)
+	// Marshal the "port" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"port\": ")
+	if tmp, err := json.Marshal(strct.Port); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+
+	buf.WriteString("}")
+	rv := buf.Bytes()
+	return rv, nil
+}
+
+func (strct *Tcp) UnmarshalJSON(b []byte) error {
+	portReceived := false
+	var jsonMap map[string]json.RawMessage
+	if err := json.Unmarshal(b, &jsonMap); err != nil {
+		return err
+	}
+	// parse all the defined properties
+	for k, v := range jsonMap {
+		switch k {
+		case "port":
+			if err := json.Unmarshal([]byte(v), &strct.Port); err != nil {
+				return err
+			}
+			portReceived = true
 		default:
 			return fmt.Errorf("additional property not allowed: \"" + k + "\"")
 		}
 	}
+	// check if port (a required property) was received
+	if !portReceived {
+		return errors.New("\"port\" is required but was not present")
+	}
 	return nil
 }
 
@@ -714,3 +1054,58 @@ func (strct *Vscode) UnmarshalJSON(b []byte) error {
 	}
 	return nil
 }
+
+func (strct *WaitFor) MarshalJSON() ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0))
+	buf.WriteString("{")
+	comma := false
+	// Marshal the "file" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"file\": ")
+	if tmp, err := json.Marshal(strct.File); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "port" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"port\": ")
+	if tmp, err := json.Marshal(strct.Port); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+
+	buf.WriteString("}")
+	rv := buf.Bytes()
+	return rv, nil
+}
+
+func (strct *WaitFor) UnmarshalJSON(b []byte) error {
+	var jsonMap map[string]json.RawMessage
+	if err := json.Unmarshal(b, &jsonMap); err != nil {
+		return err
+	}
+	// parse all the defined properties
+	for k, v := range jsonMap {
+		switch k {
+		case "file":
+			if err := json.Unmarshal([]byte(v), &strct.File); err != nil {
+				return err
+			}
+		case "port":
+			if err := json.Unmarshal([]byte(v), &strct.Port); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("additional property not allowed: \"" + k + "\"")
+		}
+	}
+	return nil
+}
@@ -67,6 +67,7 @@ type APIInterface interface {
 	GetGitpodTokens(ctx context.Context) (res []*APIToken, err error)
 	GenerateNewGitpodToken(ctx context.Context, options *GenerateNewGitpodTokenOptions) (res string, err error)
 	DeleteGitpodToken(ctx context.Context, tokenHash string) (err error)
+	GetSSHPublicKeys(ctx context.Context) (res []string, err error)
 	SendFeedback(ctx context.Context, feedback string) (res string, err error)
 	RegisterGithubApp(ctx context.Context, installationID string) (err error)
 	TakeSnapshot(ctx context.Context, options *TakeSnapshotOptions) (res string, err error)
@@ -175,6 +176,8 @@ const (
 	FunctionGenerateNewGitpodToken FunctionName = "generateNewGitpodToken"
 	// FunctionDeleteGitpodToken is the name of the deleteGitpodToken function
 	FunctionDeleteGitpodToken FunctionName = "deleteGitpodToken"
+	// FunctionGetSSHPublicKeys is the name of the getSSHPublicKeys function
+	FunctionGetSSHPublicKeys FunctionName = "getSSHPublicKeys"
 	// FunctionSendFeedback is the name of the sendFeedback function
 	FunctionSendFeedback FunctionName = "sendFeedback"
 	// FunctionRegisterGithubApp is the name of the registerGithubApp function
@@ -980,6 +983,20 @@ func (gp *APIoverJSONRPC) DeleteGitpodToken(ctx context.Context, tokenHash strin
 	return
 }
 
+// GetSSHPublicKeys calls getSSHPublicKeys on the server
+func (gp *APIoverJSONRPC) GetSSHPublicKeys(ctx context.Context) (res []string, err error) {
+	var _params []interface{}
+
+	var result []string
+	err = gp.C.Call(ctx, "getSSHPublicKeys", _params, &result)
+	if err != nil {
+		return
+	}
+	res = result
+
+	return
+}
+
 // SendFeedback calls sendFeedback on the server
 func (gp *APIoverJSONRPC) SendFeedback(ctx context.Context, feedback string) (res string, err error) {
 	var _params []interface{}
@@ -1489,6 +1506,7 @@ type WorkspaceInstancePort struct {
 	TargetPort float64 `json:"targetPort,omitempty"`
 	URL        string  `json:"url,omitempty"`
 	Visibility string  `json:"visibility,omitempty"`
+	Protocol   string  `json:"protocol,omitempty"`
 }
 
 // GithubAppConfig is the GithubAppConfig message type
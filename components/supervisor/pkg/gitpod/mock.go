@@ -695,6 +695,21 @@ func (mr *MockAPIInterfaceMockRecorder) DeleteGitpodToken(ctx, tokenHash interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGitpodToken", reflect.TypeOf((*MockAPIInterface)(nil).DeleteGitpodToken), ctx, tokenHash)
 }
 
+// GetSSHPublicKeys mocks base method
+func (m *MockAPIInterface) GetSSHPublicKeys(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSSHPublicKeys", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSSHPublicKeys indicates an expected call of GetSSHPublicKeys
+func (mr *MockAPIInterfaceMockRecorder) GetSSHPublicKeys(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSSHPublicKeys", reflect.TypeOf((*MockAPIInterface)(nil).GetSSHPublicKeys), ctx)
+}
+
 // SendFeedback mocks base method
 func (m *MockAPIInterface) SendFeedback(ctx context.Context, feedback string) (string, error) {
 	m.ctrl.T.Helper()
@@ -7,6 +7,7 @@ package ports
 import (
 	"context"
 
+	"github.com/gitpod-io/gitpod/supervisor/api"
 	"github.com/gitpod-io/gitpod/supervisor/pkg/gitpod"
 )
 
@@ -16,6 +17,7 @@ type ExposedPort struct {
 	GlobalPort uint32
 	URL        string
 	Public     bool
+	Protocol   api.PortProtocol
 }
 
 // ExposedPortsInterface provides access to port exposure
@@ -28,7 +30,8 @@ type ExposedPortsInterface interface {
 	Observe(ctx context.Context) (<-chan []ExposedPort, <-chan error)
 
 	// Expose exposes a port to the internet. Upon successful execution any Observer will be updated.
-	Expose(ctx context.Context, local, global uint32, public bool) error
+	// The protocol tells the proxy how to talk to the port, protocol_unknown is treated like protocol_http.
+	Expose(ctx context.Context, local, global uint32, public bool, protocol api.PortProtocol) error
 }
 
 // NoopExposedPorts implements ExposedPortsInterface but does nothing
@@ -40,7 +43,7 @@ func (*NoopExposedPorts) Observe(ctx context.Context) (<-chan []ExposedPort, <-c
 }
 
 // Expose exposes a port to the internet. Upon successful execution any Observer will be updated.
-func (*NoopExposedPorts) Expose(ctx context.Context, local, global uint32, public bool) error {
+func (*NoopExposedPorts) Expose(ctx context.Context, local, global uint32, public bool, protocol api.PortProtocol) error {
 	return nil
 }
 
@@ -85,6 +88,7 @@ func (g *GitpodExposedPorts) Observe(ctx context.Context) (<-chan []ExposedPort,
 						GlobalPort: uint32(globalport),
 						Public:     p.Visibility == "public",
 						URL:        p.URL,
+						Protocol:   portProtocolFromGitpod(p.Protocol),
 					}
 				}
 
@@ -99,7 +103,7 @@ func (g *GitpodExposedPorts) Observe(ctx context.Context) (<-chan []ExposedPort,
 }
 
 // Expose exposes a port to the internet. Upon successful execution any Observer will be updated.
-func (g *GitpodExposedPorts) Expose(ctx context.Context, local, global uint32, public bool) error {
+func (g *GitpodExposedPorts) Expose(ctx context.Context, local, global uint32, public bool, protocol api.PortProtocol) error {
 	var v string
 	if public {
 		v = "public"
@@ -110,6 +114,7 @@ func (g *GitpodExposedPorts) Expose(ctx context.Context, local, global uint32, p
 		Port:       float64(local),
 		TargetPort: float64(global),
 		Visibility: v,
+		Protocol:   portProtocolToGitpod(protocol),
 	})
 	if err != nil {
 		return err
@@ -117,3 +122,35 @@ func (g *GitpodExposedPorts) Expose(ctx context.Context, local, global uint32, p
 
 	return nil
 }
+
+// portProtocolToGitpod maps a port protocol to its representation in the Gitpod API. HTTP is the default and omitted.
+func portProtocolToGitpod(protocol api.PortProtocol) string {
+	switch protocol {
+	case api.PortProtocol_protocol_h2c:
+		return "h2c"
+	case api.PortProtocol_protocol_tls:
+		return "tls"
+	case api.PortProtocol_protocol_grpc:
+		return "grpc"
+	case api.PortProtocol_protocol_tcp:
+		return "tcp"
+	default:
+		return ""
+	}
+}
+
+// portProtocolFromGitpod is the inverse of portProtocolToGitpod
+func portProtocolFromGitpod(protocol string) api.PortProtocol {
+	switch protocol {
+	case "h2c":
+		return api.PortProtocol_protocol_h2c
+	case "tls":
+		return api.PortProtocol_protocol_tls
+	case "grpc":
+		return api.PortProtocol_protocol_grpc
+	case "tcp":
+		return api.PortProtocol_protocol_tcp
+	default:
+		return api.PortProtocol_protocol_http
+	}
+}
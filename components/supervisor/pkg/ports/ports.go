@@ -45,6 +45,11 @@ func NewManager(exposed ExposedPortsInterface, served ServedPortsObserver, confi
 
 		internal: internal,
 		proxies:  make(map[uint32]*localhostProxy),
+		tunnels:  make(map[uint32]*ReverseTunnel),
+
+		protocols:        make(map[uint32]api.PortProtocol),
+		detecting:        make(map[uint32]struct{}),
+		protocolDetector: DetectPortProtocol,
 
 		state:         state,
 		subscriptions: make(map[*Subscription]struct{}),
@@ -66,6 +71,12 @@ type Manager struct {
 	internal     map[uint32]struct{}
 	proxies      map[uint32]*localhostProxy
 	proxyStarter func(LocalhostPort uint32, GlobalPort uint32) (proxy io.Closer, err error)
+	tunnels      map[uint32]*ReverseTunnel
+
+	// protocols holds the detected protocol of served ports, detecting the ports whose detection is in progress
+	protocols        map[uint32]api.PortProtocol
+	detecting        map[uint32]struct{}
+	protocolDetector func(port uint32) api.PortProtocol
 
 	configs *Configs
 	exposed []ExposedPort
@@ -84,6 +95,8 @@ type managedPort struct {
 	Visibility api.PortVisibility
 	URL        string
 	OnExposed  api.OnPortExposedAction
+	Protocol   api.PortProtocol
+	Tunnel     *tunnelInfo
 
 	LocalhostPort uint32
 	GlobalPort    uint32
@@ -192,6 +205,7 @@ func (pm *Manager) updateState(exposed []ExposedPort, served []ServedPort, confi
 	if served != nil && !reflect.DeepEqual(pm.served, served) {
 		pm.served = served
 		pm.updateProxies()
+		pm.updateProtocols()
 	}
 	if configured != nil {
 		pm.configs = configured
@@ -241,7 +255,7 @@ func (pm *Manager) nextState() map[uint32]*managedPort {
 			Exposed:       true,
 			Visibility:    Visibility,
 			URL:           exposed.URL,
-			OnExposed:     getOnExposedAction(config, port),
+			OnExposed:     getOnExposedAction(config, port, pm.protocols[port]),
 		}
 	}
 
@@ -262,13 +276,13 @@ func (pm *Manager) nextState() map[uint32]*managedPort {
 			if mp.Exposed {
 				return
 			}
-			mp.OnExposed = getOnExposedAction(config, port)
+			mp.OnExposed = getOnExposedAction(config, port, pm.protocols[port])
 			mp.Visibility = api.PortVisibility_public
 			if config.Visibility == "private" {
 				mp.Visibility = api.PortVisibility_private
 			}
 			public := mp.Visibility == api.PortVisibility_public
-			err := pm.E.Expose(ctx, mp.LocalhostPort, mp.GlobalPort, public)
+			err := pm.E.Expose(ctx, mp.LocalhostPort, mp.GlobalPort, public, pm.protocols[port])
 			if err != nil {
 				log.WithError(err).WithField("port", *mp).Warn("cannot auto-expose port")
 				return
@@ -282,7 +296,7 @@ func (pm *Manager) nextState() map[uint32]*managedPort {
 	// and need configured to decide about default visiblity properly
 	for _, served := range pm.served {
 		port := served.Port
-		if pm.boundInternally(port) {
+		if pm.boundInternally(port) || pm.tunneled(port) {
 			continue
 		}
 
@@ -294,6 +308,7 @@ func (pm *Manager) nextState() map[uint32]*managedPort {
 
 		mp.LocalhostPort = port
 		mp.Served = true
+		mp.Protocol = pm.protocols[port]
 
 		exposedGlobalPort := mp.GlobalPort
 		if served.BoundToLocalhost {
@@ -308,7 +323,7 @@ func (pm *Manager) nextState() map[uint32]*managedPort {
 			mp.GlobalPort = port
 		}
 
-		if mp.GlobalPort == 0 || (mp.Exposed && mp.GlobalPort == exposedGlobalPort) {
+		if mp.GlobalPort == 0 || (mp.Exposed && mp.GlobalPort == exposedGlobalPort && !pm.protocolChanged(port)) {
 			continue
 		}
 
@@ -321,13 +336,25 @@ func (pm *Manager) nextState() map[uint32]*managedPort {
 			public = exists && config.Visibility != "private"
 		}
 
-		err := pm.E.Expose(ctx, mp.LocalhostPort, mp.GlobalPort, public)
+		err := pm.E.Expose(ctx, mp.LocalhostPort, mp.GlobalPort, public, mp.Protocol)
 		if err != nil {
 			log.WithError(err).WithField("port", *mp).Warn("cannot auto-expose port")
 			continue
 		}
 		log.WithField("port", *mp).Warn("auto-expose port")
 	}
+
+	// 4. tunneled ports are served by supervisor on behalf of a client and are never exposed
+	for port, tunnel := range pm.tunnels {
+		mp, exists := state[port]
+		if !exists {
+			mp = &managedPort{}
+			state[port] = mp
+		}
+		mp.LocalhostPort = port
+		mp.Served = true
+		mp.Tunnel = tunnel.info()
+	}
 	return state
 }
 
@@ -361,7 +388,7 @@ func (pm *Manager) updateProxies() {
 	for _, served := range pm.served {
 		localPort := served.Port
 		_, exists := pm.proxies[localPort]
-		if exists || !served.BoundToLocalhost {
+		if exists || !served.BoundToLocalhost || pm.tunneled(localPort) {
 			continue
 		}
 
@@ -397,8 +424,87 @@ func (pm *Manager) updateProxies() {
 	}
 }
 
-func getOnExposedAction(config *gitpod.PortConfig, port uint32) api.OnPortExposedAction {
+// updateProtocols starts the protocol detection for newly served ports and forgets the protocol of ports which aren't served anymore
+func (pm *Manager) updateProtocols() {
+	served := make(map[uint32]struct{}, len(pm.served))
+	for _, p := range pm.served {
+		served[p.Port] = struct{}{}
+	}
+	for port := range pm.protocols {
+		if _, ok := served[port]; !ok {
+			delete(pm.protocols, port)
+		}
+	}
+	for port := range pm.detecting {
+		if _, ok := served[port]; !ok {
+			delete(pm.detecting, port)
+		}
+	}
+
+	for port := range served {
+		if pm.boundInternally(port) || pm.tunneled(port) {
+			continue
+		}
+		if _, detected := pm.protocols[port]; detected {
+			continue
+		}
+		if _, detecting := pm.detecting[port]; detecting {
+			continue
+		}
+
+		pm.detecting[port] = struct{}{}
+		go pm.detectProtocol(port)
+	}
+}
+
+func (pm *Manager) detectProtocol(port uint32) {
+	protocol := pm.protocolDetector(port)
+
+	pm.mu.Lock()
+	_, detecting := pm.detecting[port]
+	if detecting {
+		delete(pm.detecting, port)
+		pm.protocols[port] = protocol
+	}
+	closed := pm.closed
+	pm.mu.Unlock()
+	if !detecting || closed {
+		// the port was closed in the meantime
+		return
+	}
+	if protocol == api.PortProtocol_protocol_unknown {
+		// nothing has changed
+		return
+	}
+
+	log.WithField("port", port).WithField("protocol", protocol.String()).Debug("detected port protocol")
+	pm.updateState(nil, nil, nil)
+}
+
+// protocolChanged returns true if the port was exposed with a different protocol than the one we detected
+func (pm *Manager) protocolChanged(port uint32) bool {
+	protocol, detected := pm.protocols[port]
+	if !detected || protocol == api.PortProtocol_protocol_unknown {
+		return false
+	}
+	for _, exposed := range pm.exposed {
+		if exposed.LocalPort != port {
+			continue
+		}
+		if protocol == api.PortProtocol_protocol_http {
+			return exposed.Protocol != api.PortProtocol_protocol_http && exposed.Protocol != api.PortProtocol_protocol_unknown
+		}
+		return exposed.Protocol != protocol
+	}
+	return false
+}
+
+func getOnExposedAction(config *gitpod.PortConfig, port uint32, protocol api.PortProtocol) api.OnPortExposedAction {
 	if config == nil {
+		if protocol == api.PortProtocol_protocol_tcp || protocol == api.PortProtocol_protocol_grpc {
+			// there's nothing a browser could show for those
+			return api.OnPortExposedAction_ignore
+		}
 		// anything above 32767 seems odd (e.g. used by language servers)
 		unusualRange := !(0 < port && port < 32767)
 		wellKnown := port <= 10000
@@ -424,6 +530,11 @@ func (pm *Manager) boundInternally(port uint32) bool {
 	return exists
 }
 
+func (pm *Manager) tunneled(port uint32) bool {
+	_, exists := pm.tunnels[port]
+	return exists
+}
+
 // Expose exposes a port
 func (pm *Manager) Expose(ctx context.Context, port uint32, targetPort uint32) error {
 	unlock := true
@@ -434,6 +545,7 @@ func (pm *Manager) Expose(ctx context.Context, port uint32, targetPort uint32) e
 		}
 	}()
 
+	protocol := pm.protocols[port]
 	mp, ok := pm.state[port]
 	if ok {
 		if mp.Exposed {
@@ -442,6 +554,9 @@ func (pm *Manager) Expose(ctx context.Context, port uint32, targetPort uint32) e
 		if pm.boundInternally(port) {
 			return xerrors.New("internal service cannot be exposed")
 		}
+		if mp.Tunnel != nil {
+			return xerrors.New("tunneled port cannot be exposed")
+		}
 	}
 
 	config, kind, exists := pm.configs.Get(port)
@@ -462,7 +577,7 @@ func (pm *Manager) Expose(ctx context.Context, port uint32, targetPort uint32) e
 		global = port
 	}
 	public := exists && config.Visibility != "private"
-	err := pm.E.Expose(ctx, port, global, public)
+	err := pm.E.Expose(ctx, port, global, public, protocol)
 	if err != nil {
 		log.WithError(err).WithField("port", port).WithField("targetPort", targetPort).Error("cannot expose port")
 		return err
@@ -526,6 +641,7 @@ func (pm *Manager) getPortStatus(port uint32) *api.PortsStatus {
 		GlobalPort: mp.GlobalPort,
 		LocalPort:  mp.LocalhostPort,
 		Served:     mp.Served,
+		Protocol:   mp.Protocol,
 	}
 	if mp.Exposed {
 		ps.Exposed = &api.ExposedPortInfo{
@@ -534,6 +650,12 @@ func (pm *Manager) getPortStatus(port uint32) *api.PortsStatus {
 			OnExposed:  mp.OnExposed,
 		}
 	}
+	if mp.Tunnel != nil {
+		ps.Tunneled = &api.TunneledPortInfo{
+			Target: mp.Tunnel.Target,
+			Client: mp.Tunnel.Client,
+		}
+	}
 	return ps
 }
 
@@ -259,6 +259,9 @@ func TestPortsUpdateState(t *testing.T) {
 			pm.proxyStarter = func(localPort uint32, globalPort uint32) (io.Closer, error) {
 				return ioutil.NopCloser(nil), nil
 			}
+			pm.protocolDetector = func(port uint32) api.PortProtocol {
+				return api.PortProtocol_protocol_unknown
+			}
 
 			var wg sync.WaitGroup
 			wg.Add(3)
@@ -352,7 +355,7 @@ func (tep *testExposedPorts) Observe(ctx context.Context) (<-chan []ExposedPort,
 	return tep.Changes, tep.Error
 }
 
-func (tep *testExposedPorts) Expose(ctx context.Context, local, global uint32, public bool) error {
+func (tep *testExposedPorts) Expose(ctx context.Context, local, global uint32, public bool, protocol api.PortProtocol) error {
 	tep.mu.Lock()
 	defer tep.mu.Unlock()
 
@@ -360,6 +363,7 @@ func (tep *testExposedPorts) Expose(ctx context.Context, local, global uint32, p
 		GlobalPort: global,
 		LocalPort:  local,
 		Public:     public,
+		Protocol:   protocol,
 	})
 	return nil
 }
@@ -395,6 +399,9 @@ func TestPortsConcurrentSubscribe(t *testing.T) {
 	pm.proxyStarter = func(localPort uint32, globalPort uint32) (io.Closer, error) {
 		return ioutil.NopCloser(nil), nil
 	}
+	pm.protocolDetector = func(port uint32) api.PortProtocol {
+		return api.PortProtocol_protocol_unknown
+	}
 
 	var wg sync.WaitGroup
 	wg.Add(2)
@@ -453,3 +460,84 @@ func TestPortsConcurrentSubscribe(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestPortsProtocolDetection(t *testing.T) {
+	var (
+		exposed = &testExposedPorts{
+			Changes: make(chan []ExposedPort),
+			Error:   make(chan error, 1),
+		}
+		served = &testServedPorts{
+			Changes: make(chan []ServedPort),
+			Error:   make(chan error, 1),
+		}
+		config = &testConfigService{
+			Changes: make(chan *Configs),
+			Error:   make(chan error, 1),
+		}
+		pm = NewManager(exposed, served, config)
+	)
+	protocols := map[uint32]api.PortProtocol{
+		3000: api.PortProtocol_protocol_http,
+		5432: api.PortProtocol_protocol_tcp,
+	}
+	pm.protocolDetector = func(port uint32) api.PortProtocol {
+		return protocols[port]
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		pm.Run()
+	}()
+	defer func() {
+		close(config.Changes)
+		wg.Wait()
+	}()
+
+	awaitStatus := func(expectation []*api.PortsStatus) {
+		t.Helper()
+		var (
+			sortPortStatus   = cmpopts.SortSlices(func(x, y *api.PortsStatus) bool { return x.LocalPort < y.LocalPort })
+			ignoreUnexported = cmpopts.IgnoreUnexported(api.PortsStatus{}, api.ExposedPortInfo{})
+			diff             string
+		)
+		for i := 0; i < 100; i++ {
+			diff = cmp.Diff(expectation, pm.Status(), sortPortStatus, ignoreUnexported)
+			if diff == "" {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Errorf("unexpected status (-want +got):\n%s", diff)
+	}
+
+	served.Changes <- []ServedPort{{3000, false}, {5432, false}}
+	awaitStatus([]*api.PortsStatus{
+		{LocalPort: 3000, GlobalPort: 3000, Served: true, Protocol: api.PortProtocol_protocol_http},
+		{LocalPort: 5432, GlobalPort: 5432, Served: true, Protocol: api.PortProtocol_protocol_tcp},
+	})
+
+	// 5432 was exposed before its protocol was known
+	exposed.Changes <- []ExposedPort{
+		{LocalPort: 3000, GlobalPort: 3000, Protocol: api.PortProtocol_protocol_http},
+		{LocalPort: 5432, GlobalPort: 5432, Protocol: api.PortProtocol_protocol_http},
+	}
+	awaitStatus([]*api.PortsStatus{
+		{LocalPort: 3000, GlobalPort: 3000, Served: true, Protocol: api.PortProtocol_protocol_http, Exposed: &api.ExposedPortInfo{OnExposed: api.OnPortExposedAction_notify_private}},
+		{LocalPort: 5432, GlobalPort: 5432, Served: true, Protocol: api.PortProtocol_protocol_tcp, Exposed: &api.ExposedPortInfo{OnExposed: api.OnPortExposedAction_ignore}},
+	})
+
+	exposed.mu.Lock()
+	var tcpExposures int
+	for _, e := range exposed.Exposures {
+		if e.LocalPort == 5432 && e.Protocol == api.PortProtocol_protocol_tcp {
+			tcpExposures++
+		}
+	}
+	exposed.mu.Unlock()
+	if tcpExposures == 0 {
+		t.Errorf("expected port 5432 to be exposed with its detected protocol")
+	}
+}
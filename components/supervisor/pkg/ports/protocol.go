@@ -0,0 +1,186 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package ports
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"golang.org/x/net/http2"
+)
+
+const (
+	// protocolProbeTimeout is the time we give a port to answer a single protocol probe
+	protocolProbeTimeout = 2 * time.Second
+
+	// protocolGreetingTimeout is the time we wait for a server to talk first, e.g. SSH or SMTP servers do that
+	protocolGreetingTimeout = 200 * time.Millisecond
+)
+
+// DetectPortProtocol probes the service listening on a local port to find out which protocol it serves.
+// If nothing accepts connections on that port, protocol_unknown is returned. Services which don't speak
+// any of the protocols we know are reported as protocol_tcp.
+func DetectPortProtocol(port uint32) api.PortProtocol {
+	addr := fmt.Sprintf("localhost:%d", port)
+
+	greets, err := probeGreeting(addr)
+	if err != nil {
+		return api.PortProtocol_protocol_unknown
+	}
+	if greets {
+		// none of the protocols we can proxy lets the server talk first
+		return api.PortProtocol_protocol_tcp
+	}
+	if probeTLS(addr) {
+		return api.PortProtocol_protocol_tls
+	}
+	if probeH2C(addr) {
+		if probeGRPC(addr) {
+			return api.PortProtocol_protocol_grpc
+		}
+		return api.PortProtocol_protocol_h2c
+	}
+	if probeHTTP(addr) {
+		return api.PortProtocol_protocol_http
+	}
+	return api.PortProtocol_protocol_tcp
+}
+
+// probeGreeting returns true if the server sends data without the client saying anything. HTTP/2 servers
+// may send their settings right away (e.g. gRPC servers do), which doesn't count as greeting.
+func probeGreeting(addr string) (greets bool, err error) {
+	conn, err := net.DialTimeout("tcp", addr, protocolProbeTimeout)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	err = conn.SetReadDeadline(time.Now().Add(protocolGreetingTimeout))
+	if err != nil {
+		return false, err
+	}
+	greeting := make([]byte, 9)
+	n, err := io.ReadFull(conn, greeting)
+	if n == len(greeting) {
+		return !isHTTP2SettingsFrameHeader(greeting), nil
+	}
+	if n > 0 {
+		return true, nil
+	}
+	if nerr, ok := err.(net.Error); ok && nerr.Timeout() {
+		return false, nil
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		// the server closed the connection without saying anything
+		return false, nil
+	}
+	return false, err
+}
+
+// isHTTP2SettingsFrameHeader returns true if the 9 bytes are the header of an HTTP/2 SETTINGS frame on the connection stream
+func isHTTP2SettingsFrameHeader(hdr []byte) bool {
+	frameType := http2.FrameType(hdr[3])
+	streamID := uint32(hdr[5]&0x7f)<<24 | uint32(hdr[6])<<16 | uint32(hdr[7])<<8 | uint32(hdr[8])
+	return frameType == http2.FrameSettings && streamID == 0
+}
+
+// probeTLS returns true if the server completes a TLS handshake
+func probeTLS(addr string) bool {
+	conn, err := net.DialTimeout("tcp", addr, protocolProbeTimeout)
+	if err != nil {
+		return false
+	}
+	defer conn.Close()
+
+	// we only want to know if the server speaks TLS - workspace services mostly use self-signed certificates anyways
+	tlsConn := tls.Client(conn, &tls.Config{InsecureSkipVerify: true})
+	_ = tlsConn.SetDeadline(time.Now().Add(protocolProbeTimeout))
+	return tlsConn.Handshake() == nil
+}
+
+// probeH2C returns true if the server answers the HTTP/2 connection preface without TLS
+func probeH2C(addr string) bool {
+	conn, err := net.DialTimeout("tcp", addr, protocolProbeTimeout)
+	if err != nil {
+		return false
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(protocolProbeTimeout))
+
+	_, err = conn.Write([]byte(http2.ClientPreface))
+	if err != nil {
+		return false
+	}
+	framer := http2.NewFramer(conn, conn)
+	err = framer.WriteSettings()
+	if err != nil {
+		return false
+	}
+	frame, err := framer.ReadFrame()
+	if err != nil {
+		return false
+	}
+	return frame.Header().Type == http2.FrameSettings
+}
+
+// probeGRPC returns true if an HTTP/2 server answers a gRPC call. Any gRPC server answers with a gRPC response,
+// even if it does not implement the service we call.
+func probeGRPC(addr string) bool {
+	transport := &http2.Transport{
+		AllowHTTP: true,
+		DialTLS: func(network, addr string, cfg *tls.Config) (net.Conn, error) {
+			return net.DialTimeout(network, addr, protocolProbeTimeout)
+		},
+	}
+	defer transport.CloseIdleConnections()
+
+	// an empty, uncompressed gRPC message
+	body := bytes.NewReader([]byte{0, 0, 0, 0, 0})
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("http://%s/grpc.health.v1.Health/Check", addr), body)
+	if err != nil {
+		return false
+	}
+	req.Header.Set("Content-Type", "application/grpc")
+	req.Header.Set("TE", "trailers")
+
+	client := &http.Client{Transport: transport, Timeout: protocolProbeTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+
+	return strings.HasPrefix(resp.Header.Get("Content-Type"), "application/grpc")
+}
+
+// probeHTTP returns true if the server answers an HTTP/1.1 request
+func probeHTTP(addr string) bool {
+	conn, err := net.DialTimeout("tcp", addr, protocolProbeTimeout)
+	if err != nil {
+		return false
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(protocolProbeTimeout))
+
+	_, err = fmt.Fprintf(conn, "GET / HTTP/1.1\r\nHost: %s\r\nUser-Agent: gitpod-supervisor\r\nConnection: close\r\n\r\n", addr)
+	if err != nil {
+		return false
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(conn), nil)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	return true
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package ports
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestDetectPortProtocol(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello world"))
+	})
+
+	tests := []struct {
+		Desc     string
+		Listen   func(t *testing.T) (port uint32, close func())
+		Expected api.PortProtocol
+	}{
+		{
+			Desc: "http",
+			Listen: func(t *testing.T) (uint32, func()) {
+				srv := httptest.NewServer(handler)
+				return listenerPort(srv.Listener), srv.Close
+			},
+			Expected: api.PortProtocol_protocol_http,
+		},
+		{
+			Desc: "tls",
+			Listen: func(t *testing.T) (uint32, func()) {
+				srv := httptest.NewTLSServer(handler)
+				return listenerPort(srv.Listener), srv.Close
+			},
+			Expected: api.PortProtocol_protocol_tls,
+		},
+		{
+			Desc: "h2c",
+			Listen: func(t *testing.T) (uint32, func()) {
+				srv := httptest.NewServer(h2c.NewHandler(handler, &http2.Server{}))
+				return listenerPort(srv.Listener), srv.Close
+			},
+			Expected: api.PortProtocol_protocol_h2c,
+		},
+		{
+			Desc: "grpc",
+			Listen: func(t *testing.T) (uint32, func()) {
+				lis := listen(t)
+				srv := grpc.NewServer()
+				healthpb.RegisterHealthServer(srv, health.NewServer())
+				go srv.Serve(lis)
+				return listenerPort(lis), srv.Stop
+			},
+			Expected: api.PortProtocol_protocol_grpc,
+		},
+		{
+			Desc: "server talks first",
+			Listen: func(t *testing.T) (uint32, func()) {
+				return serveTCP(t, func(conn net.Conn) {
+					conn.Write([]byte("SSH-2.0-OpenSSH_8.2\r\n"))
+					conn.Read(make([]byte, 1024))
+				}), func() {}
+			},
+			Expected: api.PortProtocol_protocol_tcp,
+		},
+		{
+			Desc: "server rejects what it does not understand",
+			Listen: func(t *testing.T) (uint32, func()) {
+				return serveTCP(t, func(conn net.Conn) {
+					conn.Read(make([]byte, 1024))
+				}), func() {}
+			},
+			Expected: api.PortProtocol_protocol_tcp,
+		},
+		{
+			Desc: "nothing listening",
+			Listen: func(t *testing.T) (uint32, func()) {
+				lis := listen(t)
+				port := listenerPort(lis)
+				lis.Close()
+				return port, func() {}
+			},
+			Expected: api.PortProtocol_protocol_unknown,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			port, close := test.Listen(t)
+			defer close()
+
+			act := DetectPortProtocol(port)
+			if act != test.Expected {
+				t.Errorf("expected %s, got %s", test.Expected, act)
+			}
+		})
+	}
+}
+
+func listen(t *testing.T) net.Listener {
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return lis
+}
+
+func listenerPort(lis net.Listener) uint32 {
+	return uint32(lis.Addr().(*net.TCPAddr).Port)
+}
+
+// serveTCP serves connections using the handler, which closes the connection once it returns
+func serveTCP(t *testing.T, handler func(conn net.Conn)) uint32 {
+	lis := listen(t)
+	t.Cleanup(func() { lis.Close() })
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				handler(conn)
+			}()
+		}
+	}()
+	return listenerPort(lis)
+}
@@ -40,7 +40,11 @@ const (
 	fnNetTCP6 = "/proc/net/tcp6"
 )
 
-// PollingServedPortsObserver regularly polls "/proc" to observe port changes
+// PollingServedPortsObserver regularly polls "/proc" to observe port changes.
+//
+// We poll because there is no event to wait for instead: the kernel does not announce new listening sockets.
+// netlink sock_diag only multicasts the destruction of sockets, and only to processes with CAP_NET_ADMIN,
+// which workspaces don't have.
 type PollingServedPortsObserver struct {
 	RefreshInterval time.Duration
 
@@ -0,0 +1,342 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package ports
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/golang/protobuf/proto"
+	"github.com/gorilla/websocket"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// tunnelBufferSize is the size of the buffer we read forwarded connections with
+	tunnelBufferSize = 32 << 10
+	// tunnelQueueSize is the number of data frames we queue per forwarded connection. All connections share
+	// the WebSocket, hence we cannot wait for a connection that does not keep up. Once its queue is full, we close it.
+	tunnelQueueSize = 64
+)
+
+var (
+	// ErrTunnelNotFound is returned when there's no reverse tunnel bound to a port
+	ErrTunnelNotFound = errors.New("tunnel not found")
+	// ErrPortInUse is returned when a reverse tunnel is requested for a port that's already in use
+	ErrPortInUse = errors.New("port is already in use")
+
+	// errTunneledConnTooSlow is the reason we close tunneled connections which do not keep up with the client
+	errTunneledConnTooSlow = errors.New("connection does not read fast enough")
+)
+
+// tunnelInfo describes a reverse tunnel in the port state
+type tunnelInfo struct {
+	Target string
+	Client string
+}
+
+// ReverseTunnel makes a service on a client's machine available inside the workspace. It listens on a
+// localhost port in the workspace and forwards every connection made to that port to the client,
+// multiplexed over a single WebSocket.
+type ReverseTunnel struct {
+	Port   uint32
+	Target string
+	Client string
+
+	pm  *Manager
+	lis net.Listener
+
+	// wmu serialises writes to the WebSocket - gorilla/websocket supports only one concurrent writer
+	wmu    sync.Mutex
+	conn   *websocket.Conn
+	closed bool
+
+	mu     sync.Mutex
+	conns  map[uint32]*tunneledConn
+	nextID uint32
+
+	closeOnce sync.Once
+}
+
+type tunneledConn struct {
+	net.Conn
+
+	// queue holds data received from the client. It's closed once the client closed the connection.
+	queue chan []byte
+	// done is closed when the connection ended on the workspace side
+	done chan struct{}
+}
+
+// OpenTunnel binds a localhost port in the workspace for a reverse tunnel. target is the address on the
+// client's machine connections are forwarded to, client identifies the client which opened the tunnel.
+// The tunnel starts forwarding connections once Serve is called.
+func (pm *Manager) OpenTunnel(port uint32, target, client string) (*ReverseTunnel, error) {
+	pm.mu.Lock()
+	if pm.closed {
+		pm.mu.Unlock()
+		return nil, ErrClosed
+	}
+	if pm.boundInternally(port) {
+		pm.mu.Unlock()
+		return nil, xerrors.Errorf("port %d is used by an internal service: %w", port, ErrPortInUse)
+	}
+	if _, exists := pm.tunnels[port]; exists {
+		pm.mu.Unlock()
+		return nil, xerrors.Errorf("port %d is already tunneled: %w", port, ErrPortInUse)
+	}
+
+	lis, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		pm.mu.Unlock()
+		return nil, xerrors.Errorf("cannot listen on port %d (%v): %w", port, err, ErrPortInUse)
+	}
+	t := &ReverseTunnel{
+		Port:   port,
+		Target: target,
+		Client: client,
+		pm:     pm,
+		lis:    lis,
+		conns:  make(map[uint32]*tunneledConn),
+	}
+	pm.tunnels[port] = t
+	pm.mu.Unlock()
+
+	log.WithField("port", port).WithField("target", target).WithField("client", client).Info("reverse tunnel opened")
+	pm.updateState(nil, nil, nil)
+	return t, nil
+}
+
+// CloseTunnel closes the reverse tunnel bound to a port and all connections forwarded through it
+func (pm *Manager) CloseTunnel(port uint32) error {
+	pm.mu.RLock()
+	t, exists := pm.tunnels[port]
+	pm.mu.RUnlock()
+	if !exists {
+		return ErrTunnelNotFound
+	}
+
+	return t.Close()
+}
+
+// Serve forwards connections over conn until either the client goes away or the tunnel is closed.
+// Once Serve returns, the tunnel is closed.
+func (t *ReverseTunnel) Serve(conn *websocket.Conn) {
+	t.wmu.Lock()
+	if t.closed {
+		t.wmu.Unlock()
+		conn.Close()
+		return
+	}
+	t.conn = conn
+	t.wmu.Unlock()
+	defer t.Close()
+
+	go t.accept()
+	for {
+		tpe, msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		if tpe != websocket.BinaryMessage {
+			continue
+		}
+
+		var frame api.TunnelFrame
+		err = proto.Unmarshal(msg, &frame)
+		if err != nil {
+			log.WithError(err).WithField("port", t.Port).Warn("received invalid tunnel frame - closing tunnel")
+			return
+		}
+		switch pl := frame.Payload.(type) {
+		case *api.TunnelFrame_Data:
+			t.mu.Lock()
+			c := t.conns[frame.ConnId]
+			t.mu.Unlock()
+			if c == nil {
+				// the connection ended in the meantime
+				continue
+			}
+			select {
+			case c.queue <- pl.Data:
+			case <-c.done:
+			default:
+				// waiting for this connection would stall all other connections of the tunnel
+				log.WithField("port", t.Port).WithField("conn", frame.ConnId).Debug("tunneled connection is too slow - closing it")
+				t.closeConn(frame.ConnId, errTunneledConnTooSlow)
+			}
+		case *api.TunnelFrame_Close:
+			t.mu.Lock()
+			c := t.conns[frame.ConnId]
+			delete(t.conns, frame.ConnId)
+			t.mu.Unlock()
+			if c == nil {
+				continue
+			}
+			if pl.Close.Error != "" {
+				log.WithField("port", t.Port).WithField("error", pl.Close.Error).Debug("client closed tunneled connection")
+			}
+			// the connection is closed once everything the client sent is written
+			close(c.queue)
+		}
+	}
+}
+
+// Close closes the tunnel and frees its port
+func (t *ReverseTunnel) Close() error {
+	var err error
+	t.closeOnce.Do(func() {
+		err = t.lis.Close()
+
+		t.wmu.Lock()
+		t.closed = true
+		if t.conn != nil {
+			_ = t.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+			t.conn.Close()
+		}
+		t.wmu.Unlock()
+
+		t.mu.Lock()
+		for id, c := range t.conns {
+			delete(t.conns, id)
+			close(c.done)
+			c.Close()
+		}
+		t.mu.Unlock()
+
+		t.pm.mu.Lock()
+		if t.pm.tunnels[t.Port] == t {
+			delete(t.pm.tunnels, t.Port)
+		}
+		t.pm.mu.Unlock()
+
+		log.WithField("port", t.Port).Info("reverse tunnel closed")
+		t.pm.updateState(nil, nil, nil)
+	})
+	return err
+}
+
+func (t *ReverseTunnel) accept() {
+	// once we stop accepting connections, the tunnel is of no use anymore
+	defer t.Close()
+
+	for {
+		conn, err := t.lis.Accept()
+		if err != nil {
+			return
+		}
+
+		c := &tunneledConn{
+			Conn:  conn,
+			queue: make(chan []byte, tunnelQueueSize),
+			done:  make(chan struct{}),
+		}
+		t.mu.Lock()
+		t.nextID++
+		id := t.nextID
+		t.conns[id] = c
+		t.mu.Unlock()
+
+		err = t.send(&api.TunnelFrame{
+			ConnId:  id,
+			Payload: &api.TunnelFrame_Open{Open: &api.TunnelOpen{RemoteAddr: conn.RemoteAddr().String()}},
+		})
+		if err != nil {
+			c.Close()
+			return
+		}
+		go t.forward(id, c)
+	}
+}
+
+// forward copies data between a connection made in the workspace and the client
+func (t *ReverseTunnel) forward(id uint32, c *tunneledConn) {
+	go func() {
+		for {
+			select {
+			case data, ok := <-c.queue:
+				if !ok {
+					c.Close()
+					return
+				}
+				_, err := c.Write(data)
+				if err != nil {
+					t.closeConn(id, err)
+					return
+				}
+			case <-c.done:
+				return
+			}
+		}
+	}()
+
+	buf := make([]byte, tunnelBufferSize)
+	for {
+		n, err := c.Read(buf)
+		if n > 0 {
+			serr := t.send(&api.TunnelFrame{
+				ConnId:  id,
+				Payload: &api.TunnelFrame_Data{Data: buf[:n]},
+			})
+			if serr != nil {
+				t.closeConn(id, nil)
+				return
+			}
+		}
+		if err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			t.closeConn(id, err)
+			return
+		}
+	}
+}
+
+// closeConn closes a connection on the workspace side and tells the client about it
+func (t *ReverseTunnel) closeConn(id uint32, reason error) {
+	t.mu.Lock()
+	c, exists := t.conns[id]
+	delete(t.conns, id)
+	t.mu.Unlock()
+	if !exists {
+		// the connection was closed by the client or the tunnel already
+		return
+	}
+	close(c.done)
+	c.Close()
+
+	var msg string
+	if reason != nil {
+		msg = reason.Error()
+	}
+	_ = t.send(&api.TunnelFrame{
+		ConnId:  id,
+		Payload: &api.TunnelFrame_Close{Close: &api.TunnelClose{Error: msg}},
+	})
+}
+
+func (t *ReverseTunnel) send(frame *api.TunnelFrame) error {
+	msg, err := proto.Marshal(frame)
+	if err != nil {
+		return err
+	}
+
+	t.wmu.Lock()
+	defer t.wmu.Unlock()
+	return t.conn.WriteMessage(websocket.BinaryMessage, msg)
+}
+
+func (t *ReverseTunnel) info() *tunnelInfo {
+	return &tunnelInfo{
+		Target: t.Target,
+		Client: t.Client,
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package ports
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+)
+
+func TestReverseTunnel(t *testing.T) {
+	log.Log.Logger.SetLevel(logrus.ErrorLevel)
+
+	var (
+		exposed = &testExposedPorts{
+			Changes: make(chan []ExposedPort),
+			Error:   make(chan error, 1),
+		}
+		served = &testServedPorts{
+			Changes: make(chan []ServedPort),
+			Error:   make(chan error, 1),
+		}
+		config = &testConfigService{
+			Changes: make(chan *Configs),
+			Error:   make(chan error, 1),
+		}
+		pm = NewManager(exposed, served, config)
+	)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		pm.Run()
+	}()
+	defer func() {
+		close(config.Changes)
+		wg.Wait()
+	}()
+
+	port := freePort(t)
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		tunnel, err := pm.OpenTunnel(port, "localhost:5432", "test-client")
+		if err != nil {
+			resp.WriteHeader(http.StatusConflict)
+			return
+		}
+		conn, err := upgrader.Upgrade(resp, req, nil)
+		if err != nil {
+			tunnel.Close()
+			return
+		}
+		tunnel.Serve(conn)
+	}))
+	defer srv.Close()
+
+	client, _, err := websocket.DefaultDialer.Dial(strings.Replace(srv.URL, "http://", "ws://", 1), nil)
+	if err != nil {
+		t.Fatalf("cannot open tunnel: %v", err)
+	}
+	defer client.Close()
+
+	diff := cmp.Diff([]*api.PortsStatus{
+		{LocalPort: port, Served: true, Tunneled: &api.TunneledPortInfo{Target: "localhost:5432", Client: "test-client"}},
+	}, pm.Status(), cmpopts.IgnoreUnexported(api.PortsStatus{}, api.TunneledPortInfo{}))
+	if diff != "" {
+		t.Errorf("unexpected status (-want +got):\n%s", diff)
+	}
+	err = pm.Expose(context.Background(), port, 0)
+	if err == nil {
+		t.Errorf("expected tunneled port to be unexposable")
+	}
+
+	// a process in the workspace connects to the tunnel
+	conn, err := net.Dial("tcp", fmt.Sprintf("localhost:%d", port))
+	if err != nil {
+		t.Fatalf("cannot connect to tunnel port: %v", err)
+	}
+	defer conn.Close()
+
+	frame := readFrame(t, client)
+	if frame.GetOpen() == nil {
+		t.Fatalf("expected open frame, got %v", frame)
+	}
+	connID := frame.ConnId
+
+	_, err = conn.Write([]byte("ping"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	frame = readFrame(t, client)
+	if frame.ConnId != connID || string(frame.GetData()) != "ping" {
+		t.Errorf("expected ping data frame, got %v", frame)
+	}
+
+	writeFrame(t, client, &api.TunnelFrame{ConnId: connID, Payload: &api.TunnelFrame_Data{Data: []byte("pong")}})
+	writeFrame(t, client, &api.TunnelFrame{ConnId: connID, Payload: &api.TunnelFrame_Close{Close: &api.TunnelClose{}}})
+
+	// all data the client sent before closing the connection must arrive
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	resp, err := ioutil.ReadAll(conn)
+	if err != nil {
+		t.Fatalf("cannot read from tunneled connection: %v", err)
+	}
+	if string(resp) != "pong" {
+		t.Errorf("unexpected response: %q", string(resp))
+	}
+
+	err = pm.CloseTunnel(port)
+	if err != nil {
+		t.Fatalf("cannot close tunnel: %v", err)
+	}
+	_ = client.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for {
+		_, _, err = client.ReadMessage()
+		if err != nil {
+			break
+		}
+	}
+	if !websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+		t.Errorf("expected tunnel to be closed normally, got %v", err)
+	}
+	if status := pm.Status(); len(status) != 0 {
+		t.Errorf("expected no ports after the tunnel was closed, got %v", status)
+	}
+	if err = pm.CloseTunnel(port); err != ErrTunnelNotFound {
+		t.Errorf("expected ErrTunnelNotFound, got %v", err)
+	}
+}
+
+func TestReverseTunnelSlowConnection(t *testing.T) {
+	log.Log.Logger.SetLevel(logrus.ErrorLevel)
+
+	pm := NewManager(&testExposedPorts{}, &testServedPorts{}, &testConfigService{})
+	port := freePort(t)
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		tunnel, err := pm.OpenTunnel(port, "localhost:5432", "test-client")
+		if err != nil {
+			resp.WriteHeader(http.StatusConflict)
+			return
+		}
+		conn, err := upgrader.Upgrade(resp, req, nil)
+		if err != nil {
+			tunnel.Close()
+			return
+		}
+		tunnel.Serve(conn)
+	}))
+	defer srv.Close()
+
+	client, _, err := websocket.DefaultDialer.Dial(strings.Replace(srv.URL, "http://", "ws://", 1), nil)
+	if err != nil {
+		t.Fatalf("cannot open tunnel: %v", err)
+	}
+	defer client.Close()
+	defer pm.CloseTunnel(port)
+
+	connect := func() (net.Conn, uint32) {
+		conn, err := net.Dial("tcp", fmt.Sprintf("localhost:%d", port))
+		if err != nil {
+			t.Fatalf("cannot connect to tunnel port: %v", err)
+		}
+		frame := readFrame(t, client)
+		if frame.GetOpen() == nil {
+			t.Fatalf("expected open frame, got %v", frame)
+		}
+		return conn, frame.ConnId
+	}
+	slow, slowID := connect()
+	defer slow.Close()
+	fast, fastID := connect()
+	defer fast.Close()
+
+	// the slow connection never reads - the client's data ends up in the socket buffers and the queue.
+	// Should the tunnel wait for the slow connection, it would stop reading from the WebSocket.
+	_ = client.SetWriteDeadline(time.Now().Add(10 * time.Second))
+	chunk := make([]byte, 256<<10)
+	for i := 0; i < 2*tunnelQueueSize; i++ {
+		writeFrame(t, client, &api.TunnelFrame{ConnId: slowID, Payload: &api.TunnelFrame_Data{Data: chunk}})
+	}
+	writeFrame(t, client, &api.TunnelFrame{ConnId: fastID, Payload: &api.TunnelFrame_Data{Data: []byte("pong")}})
+
+	_ = fast.SetReadDeadline(time.Now().Add(5 * time.Second))
+	resp := make([]byte, 4)
+	_, err = io.ReadFull(fast, resp)
+	if err != nil {
+		t.Fatalf("fast connection was stalled by the slow one: %v", err)
+	}
+	if string(resp) != "pong" {
+		t.Errorf("unexpected response: %q", string(resp))
+	}
+
+	frame := readFrame(t, client)
+	if frame.ConnId != slowID || frame.GetClose() == nil || frame.GetClose().Error == "" {
+		t.Errorf("expected the slow connection to be closed with an error, got %v", frame)
+	}
+}
+
+func freePort(t *testing.T) uint32 {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return uint32(l.Addr().(*net.TCPAddr).Port)
+}
+
+func readFrame(t *testing.T, conn *websocket.Conn) *api.TunnelFrame {
+	t.Helper()
+
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, msg, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatalf("cannot read tunnel frame: %v", err)
+	}
+	var frame api.TunnelFrame
+	err = proto.Unmarshal(msg, &frame)
+	if err != nil {
+		t.Fatalf("cannot unmarshal tunnel frame: %v", err)
+	}
+	return &frame
+}
+
+func writeFrame(t *testing.T, conn *websocket.Conn, frame *api.TunnelFrame) {
+	t.Helper()
+
+	msg, err := proto.Marshal(frame)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = conn.WriteMessage(websocket.BinaryMessage, msg)
+	if err != nil {
+		t.Fatalf("cannot write tunnel frame: %v", err)
+	}
+}
@@ -0,0 +1,440 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package resources
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// clockTicks is the number of clock ticks per second the kernel reports process times in (USER_HZ)
+	clockTicks = 100
+	// maxCommandLen is the length of a command line we report
+	maxCommandLen = 128
+	// unlimitedMemory is the limit above which we consider memory unlimited. cgroup v1 reports
+	// a page-aligned maximum int64 if there's no limit.
+	unlimitedMemory = 1 << 62
+)
+
+// Sampler samples the resource usage of the workspace container from its cgroup and procfs
+type Sampler struct {
+	// CgroupRoot is where the cgroup file system of the container is mounted, usually /sys/fs/cgroup
+	CgroupRoot string
+	// ProcRoot is where procfs is mounted, usually /proc
+	ProcRoot string
+	// DiskLocation is the directory whose disk usage we report, usually /workspace
+	DiskLocation string
+	// DiskUsageInterval is the minimum time between two walks of DiskLocation. Walking large
+	// workspaces is expensive, hence we report the last result in between.
+	DiskUsageInterval time.Duration
+
+	mu          sync.Mutex
+	diskUsed    uint64
+	diskSampled time.Time
+}
+
+// Sample is the resource usage at a point in time. CPU times are cumulative.
+type Sample struct {
+	Time time.Time
+
+	CPUTime          time.Duration
+	CPULimit         float64
+	Periods          uint64
+	ThrottledPeriods uint64
+
+	MemoryUsed  uint64
+	MemoryLimit uint64
+
+	// DiskUsed is what the files below the disk location occupy. The file system backing the
+	// workspace is usually shared with other workspaces on the node, hence DiskTotal is the usage
+	// plus what's still available on that file system rather than the file system's size.
+	DiskUsed  uint64
+	DiskTotal uint64
+
+	Processes map[int]Process
+}
+
+// Process is the resource usage of a single process
+type Process struct {
+	PID     int
+	Command string
+	CPUTime time.Duration
+	RSS     uint64
+}
+
+// Sample reads the current resource usage. It fails if any of the cgroup files it relies on cannot be read.
+// Limits which are not set (e.g. no CPU quota) are reported as zero.
+func (s *Sampler) Sample() (*Sample, error) {
+	res := &Sample{Time: time.Now()}
+
+	var err error
+	if _, serr := os.Stat(filepath.Join(s.CgroupRoot, "cgroup.controllers")); serr == nil {
+		err = s.sampleCgroupV2(res)
+	} else {
+		err = s.sampleCgroupV1(res)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if s.DiskLocation != "" {
+		var stat syscall.Statfs_t
+		err = syscall.Statfs(s.DiskLocation, &stat)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot stat %s: %w", s.DiskLocation, err)
+		}
+		res.DiskUsed, err = s.sampleDiskUsage(res.Time)
+		if err != nil {
+			return nil, err
+		}
+		res.DiskTotal = res.DiskUsed + stat.Bavail*uint64(stat.Bsize)
+	}
+
+	res.Processes, err = s.sampleProcesses()
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *Sampler) sampleCgroupV1(res *Sample) error {
+	usage, err := readUint(filepath.Join(s.CgroupRoot, "cpuacct", "cpuacct.usage"))
+	if err != nil {
+		return err
+	}
+	res.CPUTime = time.Duration(usage)
+
+	quota, err := readInt(filepath.Join(s.CgroupRoot, "cpu", "cpu.cfs_quota_us"))
+	if err != nil {
+		return err
+	}
+	period, err := readInt(filepath.Join(s.CgroupRoot, "cpu", "cpu.cfs_period_us"))
+	if err != nil {
+		return err
+	}
+	if quota > 0 && period > 0 {
+		res.CPULimit = float64(quota) / float64(period)
+	}
+
+	stat, err := readKeyValues(filepath.Join(s.CgroupRoot, "cpu", "cpu.stat"))
+	if err != nil {
+		return err
+	}
+	res.Periods, res.ThrottledPeriods = stat["nr_periods"], stat["nr_throttled"]
+
+	used, err := readUint(filepath.Join(s.CgroupRoot, "memory", "memory.usage_in_bytes"))
+	if err != nil {
+		return err
+	}
+	limit, err := readUint(filepath.Join(s.CgroupRoot, "memory", "memory.limit_in_bytes"))
+	if err != nil {
+		return err
+	}
+	memstat, err := readKeyValues(filepath.Join(s.CgroupRoot, "memory", "memory.stat"))
+	if err != nil {
+		return err
+	}
+	res.MemoryUsed = withoutReclaimable(used, memstat["total_inactive_file"])
+	if limit < unlimitedMemory {
+		res.MemoryLimit = limit
+	}
+	return nil
+}
+
+func (s *Sampler) sampleCgroupV2(res *Sample) error {
+	stat, err := readKeyValues(filepath.Join(s.CgroupRoot, "cpu.stat"))
+	if err != nil {
+		return err
+	}
+	res.CPUTime = time.Duration(stat["usage_usec"]) * time.Microsecond
+	res.Periods, res.ThrottledPeriods = stat["nr_periods"], stat["nr_throttled"]
+
+	// cpu.max is "$MAX $PERIOD" where $MAX may be "max"
+	max, err := ioutil.ReadFile(filepath.Join(s.CgroupRoot, "cpu.max"))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if segs := strings.Fields(string(max)); len(segs) == 2 && segs[0] != "max" {
+		quota, _ := strconv.ParseInt(segs[0], 10, 64)
+		period, _ := strconv.ParseInt(segs[1], 10, 64)
+		if quota > 0 && period > 0 {
+			res.CPULimit = float64(quota) / float64(period)
+		}
+	}
+
+	used, err := readUint(filepath.Join(s.CgroupRoot, "memory.current"))
+	if err != nil {
+		return err
+	}
+	memstat, err := readKeyValues(filepath.Join(s.CgroupRoot, "memory.stat"))
+	if err != nil {
+		return err
+	}
+	res.MemoryUsed = withoutReclaimable(used, memstat["inactive_file"])
+
+	limit, err := ioutil.ReadFile(filepath.Join(s.CgroupRoot, "memory.max"))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if l := strings.TrimSpace(string(limit)); l != "" && l != "max" {
+		res.MemoryLimit, _ = strconv.ParseUint(l, 10, 64)
+	}
+	return nil
+}
+
+// withoutReclaimable subtracts the inactive page cache from the memory usage, just like the kubelet does
+// when it computes the working set of a container
+func withoutReclaimable(used, inactiveFile uint64) uint64 {
+	if inactiveFile > used {
+		return 0
+	}
+	return used - inactiveFile
+}
+
+// sampleDiskUsage reports the space the files below the disk location occupy, much like du does.
+// Hard links are counted once and we do not descend into other file systems.
+func (s *Sampler) sampleDiskUsage(now time.Time) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.diskSampled.IsZero() && now.Sub(s.diskSampled) < s.DiskUsageInterval {
+		return s.diskUsed, nil
+	}
+
+	root, err := os.Lstat(s.DiskLocation)
+	if err != nil {
+		return 0, xerrors.Errorf("cannot stat %s: %w", s.DiskLocation, err)
+	}
+	dev := root.Sys().(*syscall.Stat_t).Dev
+
+	var (
+		used  uint64
+		inode = make(map[uint64]struct{})
+	)
+	err = filepath.Walk(s.DiskLocation, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// files might disappear while we walk, and we don't want one unreadable directory
+			// to fail the whole sample
+			return nil
+		}
+		stat, ok := info.Sys().(*syscall.Stat_t)
+		if !ok {
+			return nil
+		}
+		if stat.Dev != dev {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if stat.Nlink > 1 {
+			if _, seen := inode[stat.Ino]; seen {
+				return nil
+			}
+			inode[stat.Ino] = struct{}{}
+		}
+		// st_blocks is always in 512 byte units, see stat(2)
+		used += uint64(stat.Blocks) * 512
+		return nil
+	})
+	if err != nil {
+		return 0, xerrors.Errorf("cannot walk %s: %w", s.DiskLocation, err)
+	}
+
+	s.diskUsed, s.diskSampled = used, now
+	return used, nil
+}
+
+func (s *Sampler) sampleProcesses() (map[int]Process, error) {
+	entries, err := ioutil.ReadDir(s.ProcRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := uint64(os.Getpagesize())
+	res := make(map[int]Process)
+	for _, e := range entries {
+		pid, err := strconv.Atoi(e.Name())
+		if err != nil {
+			continue
+		}
+		stat, err := ioutil.ReadFile(filepath.Join(s.ProcRoot, e.Name(), "stat"))
+		if err != nil {
+			// the process might have gone away in the meantime
+			continue
+		}
+		p, err := parseProcStat(stat, pageSize)
+		if err != nil {
+			continue
+		}
+		p.PID = pid
+		if cmdline, err := ioutil.ReadFile(filepath.Join(s.ProcRoot, e.Name(), "cmdline")); err == nil && len(cmdline) > 0 {
+			p.Command = formatCmdline(cmdline)
+		}
+		res[pid] = p
+	}
+	return res, nil
+}
+
+// parseProcStat parses /proc/<pid>/stat. See proc(5) for its format.
+func parseProcStat(stat []byte, pageSize uint64) (p Process, err error) {
+	// the command is in parentheses and may contain spaces and parentheses itself
+	start, end := bytes.IndexByte(stat, '('), bytes.LastIndexByte(stat, ')')
+	if start < 0 || end < start {
+		return p, xerrors.Errorf("invalid stat: %q", stat)
+	}
+	p.Command = string(stat[start+1 : end])
+
+	// fields starts with field 3 (state)
+	fields := strings.Fields(string(stat[end+1:]))
+	if len(fields) < 22 {
+		return p, xerrors.Errorf("invalid stat: %q", stat)
+	}
+	utime, err := strconv.ParseUint(fields[11], 10, 64)
+	if err != nil {
+		return p, err
+	}
+	stime, err := strconv.ParseUint(fields[12], 10, 64)
+	if err != nil {
+		return p, err
+	}
+	rss, err := strconv.ParseInt(fields[21], 10, 64)
+	if err != nil {
+		return p, err
+	}
+	p.CPUTime = time.Duration(utime+stime) * time.Second / clockTicks
+	if rss > 0 {
+		p.RSS = uint64(rss) * pageSize
+	}
+	return p, nil
+}
+
+func formatCmdline(cmdline []byte) string {
+	res := strings.TrimSpace(strings.ReplaceAll(string(bytes.TrimRight(cmdline, "\x00")), "\x00", " "))
+	if len(res) > maxCommandLen {
+		res = res[:maxCommandLen]
+	}
+	return res
+}
+
+// Usage computes the resource usage between two samples and reports the top processes per resource
+func Usage(prev, cur *Sample, top int) *api.ResourceStatusResponse {
+	dt := cur.Time.Sub(prev.Time)
+	res := &api.ResourceStatusResponse{
+		Cpu: &api.CPUUsage{
+			Limit: cur.CPULimit,
+		},
+		Memory: &api.MemoryUsage{
+			Used:  cur.MemoryUsed,
+			Limit: cur.MemoryLimit,
+		},
+		Disk: &api.DiskUsage{
+			Used:  cur.DiskUsed,
+			Total: cur.DiskTotal,
+		},
+	}
+	if dt > 0 && cur.CPUTime >= prev.CPUTime {
+		res.Cpu.Used = float64(cur.CPUTime-prev.CPUTime) / float64(dt)
+	}
+	if cur.Periods > prev.Periods && cur.ThrottledPeriods >= prev.ThrottledPeriods {
+		res.Cpu.Throttled = float64(cur.ThrottledPeriods-prev.ThrottledPeriods) / float64(cur.Periods-prev.Periods)
+	}
+
+	procs := make([]*api.ProcessUsage, 0, len(cur.Processes))
+	for pid, p := range cur.Processes {
+		// processes which started since the last sample used all their CPU time since then
+		cpuTime := p.CPUTime
+		if pp, ok := prev.Processes[pid]; ok && pp.CPUTime <= p.CPUTime {
+			cpuTime -= pp.CPUTime
+		}
+		var cpu float64
+		if dt > 0 {
+			cpu = float64(cpuTime) / float64(dt)
+		}
+		procs = append(procs, &api.ProcessUsage{
+			Pid:     int64(pid),
+			Command: p.Command,
+			Cpu:     cpu,
+			Memory:  p.RSS,
+		})
+	}
+
+	sort.Slice(procs, func(i, j int) bool {
+		if procs[i].Cpu == procs[j].Cpu {
+			return procs[i].Pid < procs[j].Pid
+		}
+		return procs[i].Cpu > procs[j].Cpu
+	})
+	res.TopCpu = append([]*api.ProcessUsage(nil), procs[:min(top, len(procs))]...)
+
+	sort.Slice(procs, func(i, j int) bool {
+		if procs[i].Memory == procs[j].Memory {
+			return procs[i].Pid < procs[j].Pid
+		}
+		return procs[i].Memory > procs[j].Memory
+	})
+	res.TopMemory = append([]*api.ProcessUsage(nil), procs[:min(top, len(procs))]...)
+
+	return res
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func readUint(fn string) (uint64, error) {
+	content, err := ioutil.ReadFile(fn)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+}
+
+func readInt(fn string) (int64, error) {
+	content, err := ioutil.ReadFile(fn)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(content)), 10, 64)
+}
+
+// readKeyValues reads files of "<key> <value>" lines, e.g. cpu.stat or memory.stat
+func readKeyValues(fn string) (map[string]uint64, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	res := make(map[string]uint64)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		segs := strings.Fields(scanner.Text())
+		if len(segs) != 2 {
+			continue
+		}
+		v, err := strconv.ParseUint(segs[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		res[segs[0]] = v
+	}
+	return res, scanner.Err()
+}
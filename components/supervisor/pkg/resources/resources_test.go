@@ -0,0 +1,235 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package resources
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestSample(t *testing.T) {
+	pageSize := uint64(os.Getpagesize())
+	procs := map[string]string{
+		"1/stat":    "1 (supervisor) S 0 1 1 0 -1 4194560 1000 0 0 0 150 50 0 0 20 0 12 0 100 700000000 2048 18446744073709551615",
+		"1/cmdline": "/.supervisor/supervisor\x00run\x00",
+		"42/stat":   "42 (my (odd) cmd) R 1 42 42 0 -1 4194560 1000 0 0 0 10 0 0 0 20 0 1 0 200 10000000 100 18446744073709551615",
+		"self/stat": "not a process",
+	}
+
+	tests := []struct {
+		Name        string
+		Cgroup      map[string]string
+		Expectation *Sample
+	}{
+		{
+			Name: "cgroup v1",
+			Cgroup: map[string]string{
+				"cpuacct/cpuacct.usage":        "5000000000\n",
+				"cpu/cpu.cfs_quota_us":         "400000\n",
+				"cpu/cpu.cfs_period_us":        "100000\n",
+				"cpu/cpu.stat":                 "nr_periods 100\nnr_throttled 10\nthrottled_time 12345\n",
+				"memory/memory.usage_in_bytes": "2000\n",
+				"memory/memory.limit_in_bytes": "9223372036854771712\n",
+				"memory/memory.stat":           "cache 1000\ntotal_inactive_file 500\n",
+			},
+			Expectation: &Sample{
+				CPUTime:          5 * time.Second,
+				CPULimit:         4,
+				Periods:          100,
+				ThrottledPeriods: 10,
+				MemoryUsed:       1500,
+			},
+		},
+		{
+			Name: "cgroup v2",
+			Cgroup: map[string]string{
+				"cgroup.controllers": "cpu memory\n",
+				"cpu.stat":           "usage_usec 2500000\nnr_periods 20\nnr_throttled 5\n",
+				"cpu.max":            "200000 100000\n",
+				"memory.current":     "4096\n",
+				"memory.max":         "8192\n",
+				"memory.stat":        "inactive_file 96\n",
+			},
+			Expectation: &Sample{
+				CPUTime:          2500 * time.Millisecond,
+				CPULimit:         2,
+				Periods:          20,
+				ThrottledPeriods: 5,
+				MemoryUsed:       4000,
+				MemoryLimit:      8192,
+			},
+		},
+		{
+			Name: "cgroup v2 unlimited",
+			Cgroup: map[string]string{
+				"cgroup.controllers": "cpu memory\n",
+				"cpu.stat":           "usage_usec 1000\n",
+				"cpu.max":            "max 100000\n",
+				"memory.current":     "4096\n",
+				"memory.max":         "max\n",
+				"memory.stat":        "",
+			},
+			Expectation: &Sample{
+				CPUTime:    time.Millisecond,
+				MemoryUsed: 4096,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			cgroupRoot := writeTree(t, test.Cgroup)
+			defer os.RemoveAll(cgroupRoot)
+			procRoot := writeTree(t, procs)
+			defer os.RemoveAll(procRoot)
+
+			sampler := &Sampler{CgroupRoot: cgroupRoot, ProcRoot: procRoot}
+			act, err := sampler.Sample()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			exp := *test.Expectation
+			exp.Processes = map[int]Process{
+				1:  {PID: 1, Command: "/.supervisor/supervisor run", CPUTime: 2 * time.Second, RSS: 2048 * pageSize},
+				42: {PID: 42, Command: "my (odd) cmd", CPUTime: 100 * time.Millisecond, RSS: 100 * pageSize},
+			}
+			if diff := cmp.Diff(&exp, act, cmp.FilterPath(func(p cmp.Path) bool { return p.String() == "Time" }, cmp.Ignore())); diff != "" {
+				t.Errorf("unexpected Sample() (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSampleDiskUsage(t *testing.T) {
+	loc := writeTree(t, map[string]string{
+		"src/main.go": "package main",
+		"README.md":   "# Hello",
+	})
+	defer os.RemoveAll(loc)
+
+	var (
+		sampler = &Sampler{DiskLocation: loc, DiskUsageInterval: time.Minute}
+		now     = time.Now()
+	)
+	before, err := sampler.sampleDiskUsage(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if before == 0 {
+		t.Fatal("expected disk usage")
+	}
+
+	err = os.Link(filepath.Join(loc, "README.md"), filepath.Join(loc, "src", "README.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(loc, "large"), make([]byte, 1<<20), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cached, err := sampler.sampleDiskUsage(now.Add(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cached != before {
+		t.Errorf("expected cached disk usage %d within the interval, got %d", before, cached)
+	}
+
+	after, err := sampler.sampleDiskUsage(now.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after < before+1<<20 {
+		t.Errorf("expected disk usage to grow by at least 1MiB from %d, got %d", before, after)
+	}
+
+	err = os.Remove(filepath.Join(loc, "large"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	linked, err := sampler.sampleDiskUsage(now.Add(2 * time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if linked != before {
+		t.Errorf("expected hard links to be counted once: expected %d, got %d", before, linked)
+	}
+}
+
+func TestUsage(t *testing.T) {
+	now := time.Now()
+	prev := &Sample{
+		Time:             now,
+		CPUTime:          1 * time.Second,
+		Periods:          10,
+		ThrottledPeriods: 2,
+		Processes: map[int]Process{
+			1: {PID: 1, Command: "supervisor", CPUTime: 1 * time.Second, RSS: 100},
+			2: {PID: 2, Command: "node", CPUTime: 0, RSS: 500},
+		},
+	}
+	cur := &Sample{
+		Time:             now.Add(2 * time.Second),
+		CPUTime:          4 * time.Second,
+		CPULimit:         4,
+		Periods:          30,
+		ThrottledPeriods: 7,
+		MemoryUsed:       600,
+		MemoryLimit:      1000,
+		DiskUsed:         10,
+		DiskTotal:        100,
+		Processes: map[int]Process{
+			1: {PID: 1, Command: "supervisor", CPUTime: 1500 * time.Millisecond, RSS: 100},
+			2: {PID: 2, Command: "node", CPUTime: 2 * time.Second, RSS: 500},
+			3: {PID: 3, Command: "go build", CPUTime: 500 * time.Millisecond, RSS: 200},
+		},
+	}
+
+	act := Usage(prev, cur, 2)
+	exp := &api.ResourceStatusResponse{
+		Cpu:    &api.CPUUsage{Used: 1.5, Limit: 4, Throttled: 0.25},
+		Memory: &api.MemoryUsage{Used: 600, Limit: 1000},
+		Disk:   &api.DiskUsage{Used: 10, Total: 100},
+		TopCpu: []*api.ProcessUsage{
+			{Pid: 2, Command: "node", Cpu: 1, Memory: 500},
+			{Pid: 1, Command: "supervisor", Cpu: 0.25, Memory: 100},
+		},
+		TopMemory: []*api.ProcessUsage{
+			{Pid: 2, Command: "node", Cpu: 1, Memory: 500},
+			{Pid: 3, Command: "go build", Cpu: 0.25, Memory: 200},
+		},
+	}
+	if diff := cmp.Diff(exp, act, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected Usage() (-want +got):\n%s", diff)
+	}
+}
+
+func writeTree(t *testing.T, files map[string]string) string {
+	root, err := ioutil.TempDir("", "resources")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for fn, content := range files {
+		fn = filepath.Join(root, fn)
+		err = os.MkdirAll(filepath.Dir(fn), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(fn, []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
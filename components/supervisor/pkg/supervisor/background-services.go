@@ -0,0 +1,576 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+)
+
+const (
+	// serviceStopGracePeriod is the time services get between SIGTERM and SIGKILL
+	serviceStopGracePeriod = 10 * time.Second
+
+	// serviceLogMaxSize is the size in bytes after which a service's log file is rotated
+	serviceLogMaxSize = 10 << 20
+	// serviceLogMaxFiles is the number of rotated log files we keep for each service
+	serviceLogMaxFiles = 3
+)
+
+var (
+	// validServiceName matches service names which are safe to use as file names
+	validServiceName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+	// ErrServiceNotFound is returned when a service does not exist
+	ErrServiceNotFound = errors.New("service not found")
+	// ErrServicesNotStarted is returned when services are controlled before supervisor has started them
+	ErrServicesNotStarted = errors.New("services have not been started yet")
+)
+
+type servicesSubscription struct {
+	updates chan []*api.ServiceStatus
+	Close   func() error
+}
+
+func (sub *servicesSubscription) Updates() <-chan []*api.ServiceStatus {
+	return sub.updates
+}
+
+// servicesManager runs the background services of a workspace, e.g. databases or language servers.
+// Unlike tasks, services don't run in a terminal and have their own lifecycle.
+type servicesManager struct {
+	config        *Config
+	env           []string
+	workdir       string
+	logLocation   string
+	contentState  ContentState
+	services      []*service
+	subscriptions map[*servicesSubscription]struct{}
+	mu            sync.RWMutex
+	ready         chan struct{}
+
+	// ctx is the context services run in. It's nil until the services were started.
+	ctx context.Context
+}
+
+func newServicesManager(config *Config, contentState ContentState) *servicesManager {
+	workdir := config.RepoRoot
+	if workdir == "" {
+		workdir = config.WorkspaceRoot
+	}
+	return &servicesManager{
+		config:        config,
+		env:           buildIDEEnv(config),
+		workdir:       workdir,
+		logLocation:   "/workspace/.gitpod/services",
+		contentState:  contentState,
+		subscriptions: make(map[*servicesSubscription]struct{}),
+		ready:         make(chan struct{}),
+	}
+}
+
+type service struct {
+	api.ServiceStatus
+	config ServiceConfig
+	log    *rotatingLog
+
+	// stop is closed to ask the service's run loop to stop the service
+	stop chan struct{}
+	// done is closed once the service's run loop has ended. It's nil if the service never ran.
+	done chan struct{}
+}
+
+// isRunning returns true if the service's run loop is active.
+// Callers are expected to hold mu.
+func (s *service) isRunning() bool {
+	if s.done == nil {
+		return false
+	}
+	select {
+	case <-s.done:
+		return false
+	default:
+		return true
+	}
+}
+
+func (sm *servicesManager) Subscribe() *servicesSubscription {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	if len(sm.subscriptions) > maxSubscriptions {
+		return nil
+	}
+
+	sub := &servicesSubscription{updates: make(chan []*api.ServiceStatus, 5)}
+	sub.Close = func() error {
+		sm.mu.Lock()
+		defer sm.mu.Unlock()
+
+		// We can safely close the channel here even though we're not the
+		// producer writing to it, because we're holding mu.
+		close(sub.updates)
+		delete(sm.subscriptions, sub)
+
+		return nil
+	}
+	sm.subscriptions[sub] = struct{}{}
+
+	// makes sure that no updates can happen between clients receiving an initial status and subscribing
+	sub.updates <- sm.getStatus()
+	return sub
+}
+
+func (sm *servicesManager) Status() []*api.ServiceStatus {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	return sm.getStatus()
+}
+
+// getStatus produces an API compatible service status list.
+// Callers are expected to hold mu.
+func (sm *servicesManager) getStatus() []*api.ServiceStatus {
+	status := make([]*api.ServiceStatus, 0, len(sm.services))
+	for _, s := range sm.services {
+		status = append(status, proto.Clone(&s.ServiceStatus).(*api.ServiceStatus))
+	}
+	return status
+}
+
+func (sm *servicesManager) updateState(doUpdate func() (changed bool)) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	changed := doUpdate()
+	if !changed {
+		return
+	}
+
+	updates := sm.getStatus()
+	for sub := range sm.subscriptions {
+		select {
+		case sub.updates <- updates:
+		case <-time.After(5 * time.Second):
+			log.Error("services subscription droped out")
+			sub.Close()
+		}
+	}
+}
+
+func (sm *servicesManager) init() {
+	defer close(sm.ready)
+
+	cfgs, err := sm.config.getGitpodServices()
+	if err != nil {
+		log.WithError(err).Error()
+		return
+	}
+
+	names := make(map[string]struct{}, len(cfgs))
+	for _, cfg := range cfgs {
+		if !validServiceName.MatchString(cfg.Name) {
+			log.WithField("service", cfg.Name).Error("invalid service name - ignoring service")
+			continue
+		}
+		if _, exists := names[cfg.Name]; exists {
+			log.WithField("service", cfg.Name).Error("duplicate service name - ignoring service")
+			continue
+		}
+		if cfg.Command == "" {
+			log.WithField("service", cfg.Name).Error("service has no command - ignoring service")
+			continue
+		}
+		names[cfg.Name] = struct{}{}
+
+		logFile := filepath.Join(sm.logLocation, cfg.Name+".log")
+		sm.services = append(sm.services, &service{
+			ServiceStatus: api.ServiceStatus{
+				Name:    cfg.Name,
+				State:   api.ServiceState_service_stopped,
+				LogFile: logFile,
+			},
+			config: cfg,
+			log: &rotatingLog{
+				Path:     logFile,
+				MaxSize:  serviceLogMaxSize,
+				MaxFiles: serviceLogMaxFiles,
+			},
+		})
+	}
+}
+
+// Run starts all services once the workspace content is available and stops them when ctx is canceled
+func (sm *servicesManager) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	sm.init()
+
+	select {
+	case <-sm.contentState.ContentReady():
+	case <-ctx.Done():
+		return
+	}
+
+	if len(sm.services) > 0 {
+		err := os.MkdirAll(sm.logLocation, 0755)
+		if err != nil {
+			log.WithError(err).Warn("cannot create service log location")
+		}
+	}
+
+	sm.mu.Lock()
+	sm.ctx = ctx
+	for _, s := range sm.services {
+		sm.startService(ctx, s)
+	}
+	sm.mu.Unlock()
+
+	<-ctx.Done()
+
+	// the run loops stop their service because ctx is done - we just have to wait for them
+	sm.mu.RLock()
+	var done []chan struct{}
+	for _, s := range sm.services {
+		if s.done != nil {
+			done = append(done, s.done)
+		}
+	}
+	sm.mu.RUnlock()
+	for _, d := range done {
+		<-d
+	}
+}
+
+// Start starts a stopped or failed service
+func (sm *servicesManager) Start(name string) error {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	if sm.ctx == nil {
+		return ErrServicesNotStarted
+	}
+	s := sm.get(name)
+	if s == nil {
+		return ErrServiceNotFound
+	}
+	if s.isRunning() {
+		return nil
+	}
+	sm.startService(sm.ctx, s)
+	return nil
+}
+
+// Stop stops a service and waits until its process has exited
+func (sm *servicesManager) Stop(ctx context.Context, name string) error {
+	sm.mu.Lock()
+	if sm.ctx == nil {
+		sm.mu.Unlock()
+		return ErrServicesNotStarted
+	}
+	s := sm.get(name)
+	if s == nil {
+		sm.mu.Unlock()
+		return ErrServiceNotFound
+	}
+	if s.stop != nil {
+		close(s.stop)
+		s.stop = nil
+	}
+	done := s.done
+	sm.mu.Unlock()
+
+	if done == nil {
+		return nil
+	}
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Restart stops a service (if it's running) and starts it again
+func (sm *servicesManager) Restart(ctx context.Context, name string) error {
+	err := sm.Stop(ctx, name)
+	if err != nil {
+		return err
+	}
+	return sm.Start(name)
+}
+
+// get returns the service with the given name or nil if there's none.
+// Callers are expected to hold mu.
+func (sm *servicesManager) get(name string) *service {
+	for _, s := range sm.services {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// startService starts the run loop of a service.
+// Callers are expected to hold mu.
+func (sm *servicesManager) startService(ctx context.Context, s *service) {
+	stop, done := make(chan struct{}), make(chan struct{})
+	s.stop, s.done = stop, done
+	s.Restarts = 0
+	go sm.runService(ctx, s, stop, done)
+}
+
+func (sm *servicesManager) runService(ctx context.Context, s *service, stop, done chan struct{}) {
+	defer close(done)
+	defer s.log.Close()
+
+	// Service processes get SIGKILL once the OS thread which started them exits (see serviceCommand).
+	// Every (re)start of this service happens in this loop, hence we pin it to one thread for as long as
+	// the service may run. Otherwise the Go scheduler could retire the thread and kill a healthy service.
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	logger := log.WithField("service", s.Name)
+	setState := func(state api.ServiceState, update func()) {
+		sm.updateState(func() bool {
+			s.State = state
+			if update != nil {
+				update()
+			}
+			return true
+		})
+	}
+
+	var backoff restartBackoff
+	for {
+		cmd := sm.serviceCommand(s)
+		err := cmd.Start()
+		if err != nil {
+			logger.WithError(err).Error("cannot start service")
+			fmt.Fprintf(s.log, "cannot start service: %v\n", err)
+			setState(api.ServiceState_service_failed, func() { s.Pid = 0 })
+			return
+		}
+		started := time.Now()
+		logger.WithField("pid", cmd.Process.Pid).Info("service started")
+		setState(api.ServiceState_service_running, func() {
+			s.Pid = int64(cmd.Process.Pid)
+			s.Started, _ = ptypes.TimestampProto(started)
+		})
+
+		exited := make(chan error, 1)
+		go func() { exited <- cmd.Wait() }()
+
+		var stopping bool
+		select {
+		case err = <-exited:
+		case <-stop:
+			stopping = true
+		case <-ctx.Done():
+			stopping = true
+		}
+		if stopping {
+			setState(api.ServiceState_service_stopping, nil)
+			err = terminateProcessGroup(cmd, exited, serviceStopGracePeriod)
+			logger.Info("service stopped")
+			setState(api.ServiceState_service_stopped, func() {
+				s.Pid = 0
+				s.ExitCode = exitCode(cmd, err)
+			})
+			return
+		}
+
+		code := exitCode(cmd, err)
+		logger.WithField("exitCode", code).Warn("service exited")
+		if !shouldRestartService(s.config.Restart, code, s.Restarts) {
+			state := api.ServiceState_service_stopped
+			if code != 0 {
+				state = api.ServiceState_service_failed
+			}
+			setState(state, func() {
+				s.Pid = 0
+				s.ExitCode = code
+			})
+			return
+		}
+
+		delay := backoff.Next(time.Since(started))
+		logger.WithField("delay", delay.String()).Info("restarting service")
+		setState(api.ServiceState_service_backoff, func() {
+			s.Pid = 0
+			s.ExitCode = code
+			s.Restarts++
+		})
+		select {
+		case <-time.After(delay):
+		case <-stop:
+			setState(api.ServiceState_service_stopped, nil)
+			return
+		case <-ctx.Done():
+			setState(api.ServiceState_service_stopped, nil)
+			return
+		}
+	}
+}
+
+func (sm *servicesManager) serviceCommand(s *service) *exec.Cmd {
+	cmd := exec.Command("/bin/sh", "-c", s.config.Command)
+	cmd.Dir = sm.workdir
+	cmd.Env = append([]string(nil), sm.env...)
+	for k, v := range s.config.Env {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+	cmd.Stdout = s.log
+	cmd.Stderr = s.log
+
+	// Services run in their own process group, s.t. we can stop them and their children
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setpgid:   true,
+		Pdeathsig: syscall.SIGKILL,
+	}
+	return cmd
+}
+
+// shouldRestartService determines if a service which exited with the given code is restarted.
+// Unlike tasks, services are restarted on failure unless configured otherwise.
+func shouldRestartService(restart *TaskRestart, exitCode int32, restarts int32) bool {
+	policy := TaskRestartOnFailure
+	if restart != nil && restart.Policy != "" {
+		policy = restart.Policy
+	}
+	if restart != nil && restart.MaxAttempts > 0 && int(restarts) >= restart.MaxAttempts {
+		return false
+	}
+
+	switch policy {
+	case TaskRestartAlways:
+		return true
+	case TaskRestartOnFailure:
+		return exitCode != 0
+	default:
+		return false
+	}
+}
+
+// terminateProcessGroup sends SIGTERM to the process group of cmd and SIGKILL if it doesn't exit within the grace period
+func terminateProcessGroup(cmd *exec.Cmd, exited <-chan error, gracePeriod time.Duration) error {
+	pgid := -cmd.Process.Pid
+	_ = syscall.Kill(pgid, syscall.SIGTERM)
+	select {
+	case err := <-exited:
+		return err
+	case <-time.After(gracePeriod):
+	}
+
+	log.WithField("pid", cmd.Process.Pid).Warn("service did not stop in time - sending SIGKILL")
+	_ = syscall.Kill(pgid, syscall.SIGKILL)
+	return <-exited
+}
+
+// exitCode returns the exit code of a command which has finished
+func exitCode(cmd *exec.Cmd, err error) int32 {
+	if cmd.ProcessState != nil {
+		return int32(cmd.ProcessState.ExitCode())
+	}
+	if err != nil {
+		return -1
+	}
+	return 0
+}
+
+// rotatingLog is a log file which is rotated once it would exceed MaxSize. We keep MaxFiles
+// rotated files, named <Path>.1 (the most recent one) to <Path>.<MaxFiles>.
+type rotatingLog struct {
+	Path     string
+	MaxSize  int64
+	MaxFiles int
+
+	mu   sync.Mutex
+	f    *os.File
+	size int64
+}
+
+func (l *rotatingLog) Write(p []byte) (n int, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.f == nil {
+		err = l.open(os.O_APPEND)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if l.MaxSize > 0 && l.size > 0 && l.size+int64(len(p)) > l.MaxSize {
+		err = l.rotate()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n, err = l.f.Write(p)
+	l.size += int64(n)
+	return n, err
+}
+
+// Close closes the log file. Writing to the log afterwards reopens it.
+func (l *rotatingLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.f == nil {
+		return nil
+	}
+	err := l.f.Close()
+	l.f = nil
+	return err
+}
+
+// open opens the log file. Callers are expected to hold mu.
+func (l *rotatingLog) open(flag int) error {
+	f, err := os.OpenFile(l.Path, os.O_CREATE|os.O_WRONLY|flag, 0644)
+	if err != nil {
+		return err
+	}
+	stat, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	l.f, l.size = f, stat.Size()
+	return nil
+}
+
+// rotate moves the current log file out of the way and opens a new one. Callers are expected to hold mu.
+func (l *rotatingLog) rotate() error {
+	l.f.Close()
+	l.f = nil
+
+	for i := l.MaxFiles - 1; i > 0; i-- {
+		err := os.Rename(fmt.Sprintf("%s.%d", l.Path, i), fmt.Sprintf("%s.%d", l.Path, i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if l.MaxFiles > 0 {
+		err := os.Rename(l.Path, l.Path+".1")
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return l.open(os.O_TRUNC)
+}
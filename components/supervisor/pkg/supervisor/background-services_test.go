@@ -0,0 +1,205 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/google/go-cmp/cmp"
+	"github.com/sirupsen/logrus"
+)
+
+func TestServicesManager(t *testing.T) {
+	log.Log.Logger.SetLevel(logrus.FatalLevel)
+
+	tmpdir, err := ioutil.TempDir("", "supervisor-services")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	services, err := json.Marshal([]ServiceConfig{
+		{Name: "done", Command: "echo $GREETING", Env: map[string]string{"GREETING": "hello"}},
+		{Name: "fail", Command: "exit 3", Restart: &TaskRestart{MaxAttempts: 1}},
+		{Name: "daemon", Command: "sleep 60"},
+		{Name: "../invalid", Command: "true"},
+		{Name: "daemon", Command: "true"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cstate := NewInMemoryContentState("")
+	sm := newServicesManager(&Config{WorkspaceConfig: WorkspaceConfig{
+		WorkspaceRoot:  tmpdir,
+		GitpodServices: string(services),
+	}}, cstate)
+	sm.logLocation = tmpdir
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go sm.Run(ctx, &wg)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	<-sm.ready
+	if err := sm.Start("daemon"); err != ErrServicesNotStarted {
+		t.Errorf("expected services to not have started before the content is ready, got %v", err)
+	}
+	cstate.MarkContentReady(csapi.WorkspaceInitFromOther)
+
+	awaitState := func(name string, state api.ServiceState) *api.ServiceStatus {
+		t.Helper()
+		deadline := time.Now().Add(10 * time.Second)
+		for time.Now().Before(deadline) {
+			for _, s := range sm.Status() {
+				// services start out as stopped, hence we only consider services which have been started
+				if s.Name == name && s.State == state && s.Started != nil {
+					return s
+				}
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+		t.Fatalf("service %s did not reach state %s", name, state)
+		return nil
+	}
+
+	var names []string
+	for _, s := range sm.Status() {
+		names = append(names, s.Name)
+	}
+	if diff := cmp.Diff([]string{"done", "fail", "daemon"}, names); diff != "" {
+		t.Errorf("unexpected services (-want +got):\n%s", diff)
+	}
+
+	t.Run("exits successfully", func(t *testing.T) {
+		s := awaitState("done", api.ServiceState_service_stopped)
+		if s.ExitCode != 0 || s.Restarts != 0 {
+			t.Errorf("unexpected status: %v", s)
+		}
+		fc, err := ioutil.ReadFile(filepath.Join(tmpdir, "done.log"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.TrimSpace(string(fc)) != "hello" {
+			t.Errorf("unexpected log: %q", string(fc))
+		}
+	})
+
+	t.Run("fails after restart", func(t *testing.T) {
+		s := awaitState("fail", api.ServiceState_service_failed)
+		if s.ExitCode != 3 || s.Restarts != 1 {
+			t.Errorf("unexpected status: %v", s)
+		}
+	})
+
+	t.Run("stop and start", func(t *testing.T) {
+		s := awaitState("daemon", api.ServiceState_service_running)
+		if s.Pid == 0 {
+			t.Errorf("running service has no PID: %v", s)
+		}
+
+		err := sm.Stop(context.Background(), "daemon")
+		if err != nil {
+			t.Fatal(err)
+		}
+		awaitState("daemon", api.ServiceState_service_stopped)
+
+		err = sm.Start("daemon")
+		if err != nil {
+			t.Fatal(err)
+		}
+		awaitState("daemon", api.ServiceState_service_running)
+
+		err = sm.Restart(context.Background(), "daemon")
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := awaitState("daemon", api.ServiceState_service_running)
+		if n.Pid == s.Pid {
+			t.Errorf("service was not restarted")
+		}
+	})
+
+	t.Run("unknown service", func(t *testing.T) {
+		err := sm.Start("foobar")
+		if err != ErrServiceNotFound {
+			t.Errorf("expected ErrServiceNotFound, got %v", err)
+		}
+	})
+}
+
+func TestRotatingLog(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "supervisor-services")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	fn := filepath.Join(tmpdir, "service.log")
+	l := &rotatingLog{Path: fn, MaxSize: 10, MaxFiles: 2}
+	for _, line := range []string{"aaaaaaaa\n", "bbbbbbbb\n", "cccccccc\n", "dddddddd\n"} {
+		_, err := l.Write([]byte(line))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	l.Close()
+
+	for file, expectation := range map[string]string{
+		fn:        "dddddddd\n",
+		fn + ".1": "cccccccc\n",
+		fn + ".2": "bbbbbbbb\n",
+	} {
+		fc, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(fc) != expectation {
+			t.Errorf("%s: expected %q, got %q", filepath.Base(file), expectation, string(fc))
+		}
+	}
+	if _, err := os.Stat(fn + ".3"); !os.IsNotExist(err) {
+		t.Errorf("expected no more than two rotated files")
+	}
+}
+
+func TestShouldRestartService(t *testing.T) {
+	tests := []struct {
+		Desc     string
+		Restart  *TaskRestart
+		ExitCode int32
+		Restarts int32
+		Expected bool
+	}{
+		{Desc: "default restarts on failure", ExitCode: 1, Expected: true},
+		{Desc: "default does not restart on success", ExitCode: 0, Expected: false},
+		{Desc: "always", Restart: &TaskRestart{Policy: TaskRestartAlways}, ExitCode: 0, Expected: true},
+		{Desc: "never", Restart: &TaskRestart{Policy: TaskRestartNever}, ExitCode: 1, Expected: false},
+		{Desc: "max attempts", Restart: &TaskRestart{MaxAttempts: 2}, ExitCode: 1, Restarts: 2, Expected: false},
+	}
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			act := shouldRestartService(test.Restart, test.ExitCode, test.Restarts)
+			if act != test.Expected {
+				t.Errorf("expected %v, got %v", test.Expected, act)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import "time"
+
+const (
+	// restartBackoffInitial is the delay before a process is restarted for the first time
+	restartBackoffInitial = 1 * time.Second
+	// restartBackoffMax is the longest we'll ever wait before restarting a process
+	restartBackoffMax = 30 * time.Second
+	// restartBackoffReset is the time a process must have been running before we consider it stable
+	// and start over with the initial delay
+	restartBackoffReset = 1 * time.Minute
+)
+
+// restartBackoff computes the delay before a process is restarted. The delay doubles with every restart
+// until the process runs stable for a while.
+type restartBackoff struct {
+	delay time.Duration
+}
+
+// Next returns the delay before the next restart of a process that ran for the given duration
+func (b *restartBackoff) Next(ranFor time.Duration) time.Duration {
+	if b.delay == 0 || ranFor >= restartBackoffReset {
+		b.delay = restartBackoffInitial
+		return b.delay
+	}
+
+	b.delay *= 2
+	if b.delay > restartBackoffMax {
+		b.delay = restartBackoffMax
+	}
+	return b.delay
+}
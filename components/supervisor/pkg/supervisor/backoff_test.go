@@ -0,0 +1,50 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRestartBackoff(t *testing.T) {
+	tests := []struct {
+		Desc        string
+		RanFor      []time.Duration
+		Expectation []time.Duration
+	}{
+		{
+			Desc:        "doubles delay",
+			RanFor:      []time.Duration{0, 0, 0},
+			Expectation: []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second},
+		},
+		{
+			Desc:        "caps delay",
+			RanFor:      []time.Duration{0, 0, 0, 0, 0, 0, 0},
+			Expectation: []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 30 * time.Second, 30 * time.Second},
+		},
+		{
+			Desc:        "resets after stable run",
+			RanFor:      []time.Duration{0, 0, 0, 2 * time.Minute, 0},
+			Expectation: []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second, 1 * time.Second, 2 * time.Second},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			var (
+				b   restartBackoff
+				act []time.Duration
+			)
+			for _, r := range test.RanFor {
+				act = append(act, b.Next(r))
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected delays (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
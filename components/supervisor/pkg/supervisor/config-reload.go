@@ -0,0 +1,395 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/gitpod-io/gitpod/supervisor/pkg/gitpod"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v2"
+)
+
+// configReloader applies changes made to .gitpod.yml to the running workspace on request.
+//
+// The workspace starts with the config the file has initially. Afterwards, tasks and ports alike only change once
+// users apply the changes, s.t. editing the file does not disturb the running workspace and users can preview
+// what applying the file would do.
+type configReloader struct {
+	// Location is the location of .gitpod.yml
+	Location string
+	// Config watches the config file
+	Config gitpod.ConfigInterface
+	Tasks  *tasksManager
+
+	// mu serialises reloads
+	mu sync.Mutex
+
+	// applied is the config that was applied last, ports are its port configs. Both are only valid once hasApplied is true.
+	applied    *gitpod.GitpodConfig
+	ports      []*gitpod.PortsItems
+	hasApplied bool
+	portsMu    sync.Mutex
+	listeners  map[chan *gitpod.GitpodConfig]struct{}
+}
+
+// Observe provides the initial config of the config file and those applied using Reload. Later changes to the file
+// are not reported until they're applied. It implements gitpod.ConfigInterface.
+func (r *configReloader) Observe(ctx context.Context) (<-chan *gitpod.GitpodConfig, <-chan error) {
+	var (
+		configs = make(chan *gitpod.GitpodConfig)
+		errs    = make(chan error)
+		applied = make(chan *gitpod.GitpodConfig, 1)
+	)
+	r.portsMu.Lock()
+	if r.listeners == nil {
+		r.listeners = make(map[chan *gitpod.GitpodConfig]struct{})
+	}
+	r.listeners[applied] = struct{}{}
+	if r.hasApplied {
+		applied <- r.applied
+	}
+	r.portsMu.Unlock()
+
+	go func() {
+		defer close(configs)
+		defer close(errs)
+		defer func() {
+			r.portsMu.Lock()
+			delete(r.listeners, applied)
+			r.portsMu.Unlock()
+		}()
+
+		fileConfigs, fileErrs := r.Config.Observe(ctx)
+		var (
+			pending *gitpod.GitpodConfig
+			send    chan<- *gitpod.GitpodConfig
+		)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err, ok := <-fileErrs:
+				if !ok {
+					fileErrs = nil
+					continue
+				}
+				select {
+				case errs <- err:
+				case <-ctx.Done():
+					return
+				}
+			case cfg, ok := <-fileConfigs:
+				if !ok {
+					fileConfigs = nil
+					continue
+				}
+				r.portsMu.Lock()
+				if !r.hasApplied {
+					// the config the workspace starts with needs no applying
+					r.apply(cfg)
+				}
+				r.portsMu.Unlock()
+			case cfg := <-applied:
+				pending, send = cfg, configs
+			case send <- pending:
+				send = nil
+			}
+		}
+	}()
+	return configs, errs
+}
+
+// apply makes cfg the applied config and passes it on to all observers. Callers must hold portsMu.
+func (r *configReloader) apply(cfg *gitpod.GitpodConfig) {
+	r.applied, r.ports, r.hasApplied = cfg, portsOf(cfg), true
+	for l := range r.listeners {
+		// listeners are only interested in the latest config
+		select {
+		case <-l:
+		default:
+		}
+		l <- cfg
+	}
+}
+
+func portsOf(cfg *gitpod.GitpodConfig) []*gitpod.PortsItems {
+	if cfg == nil {
+		return nil
+	}
+	return cfg.Ports
+}
+
+// Reload computes how the config file differs from the running workspace and applies the changes unless it's a dry run
+func (r *configReloader) Reload(ctx context.Context, req *api.ReloadConfigRequest) (*api.ReloadConfigResponse, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cfg, tasks, err := loadGitpodConfig(r.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	r.portsMu.Lock()
+	currentPorts := r.ports
+	r.portsMu.Unlock()
+	current := r.Tasks.taskConfigs()
+	res := &api.ReloadConfigResponse{
+		Tasks: planTaskChanges(current, tasks, req.RestartChangedTasks),
+		Ports: planPortChanges(currentPorts, portsOf(cfg)),
+	}
+	if req.DryRun || (len(res.Tasks) == 0 && len(res.Ports) == 0) {
+		return res, nil
+	}
+	if r.Tasks.config.isHeadless() {
+		return nil, status.Error(codes.FailedPrecondition, "cannot apply config changes to a headless workspace")
+	}
+
+	var (
+		added   []TaskConfig
+		changed = make(map[*task]TaskConfig)
+	)
+	for _, c := range res.Tasks {
+		switch c.Action {
+		case api.TaskConfigAction_task_start:
+			added = append(added, tasks[c.Name].TaskConfig)
+		case api.TaskConfigAction_task_restart:
+			changed[current[c.Name].task] = tasks[c.Name].TaskConfig
+		}
+	}
+	if len(added) > 0 || len(changed) > 0 {
+		started, err := r.Tasks.reloadTasks(ctx, added, changed)
+		if err != nil {
+			return nil, err
+		}
+		// added tasks have their IDs now
+		var i int
+		for _, c := range res.Tasks {
+			if c.Action != api.TaskConfigAction_task_start {
+				continue
+			}
+			c.Id = started[i].Id
+			i++
+		}
+	}
+
+	if len(res.Ports) > 0 {
+		r.portsMu.Lock()
+		r.apply(cfg)
+		r.portsMu.Unlock()
+	}
+
+	log.WithField("tasks", len(res.Tasks)).WithField("ports", len(res.Ports)).Info("applied workspace config changes")
+	res.Applied = true
+	return res, nil
+}
+
+// loadGitpodConfig reads the config file. If there's no config file, the config is empty.
+func loadGitpodConfig(location string) (cfg *gitpod.GitpodConfig, tasks map[string]positionedTaskConfig, err error) {
+	data, err := ioutil.ReadFile(location)
+	if os.IsNotExist(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = yaml.Unmarshal(data, &cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot parse %s: %w", location, err)
+	}
+	// The generated config types cannot represent all task properties (e.g. env), hence we parse the tasks as we run them.
+	var file struct {
+		Tasks []TaskConfig `yaml:"tasks,omitempty"`
+	}
+	err = yaml.Unmarshal(data, &file)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot parse tasks in %s: %w", location, err)
+	}
+	return cfg, indexTaskConfigs(file.Tasks, nil), nil
+}
+
+// positionedTaskConfig is a task config and its position in the list of tasks
+type positionedTaskConfig struct {
+	TaskConfig
+	Position int
+	task     *task
+}
+
+// indexTaskConfigs identifies tasks across config changes. Named tasks are identified by their name. Unnamed
+// tasks are identified by their config, hence changing them removes the old task and adds a new one.
+// If tasks is not nil, the task at the position of a config is the one running it.
+func indexTaskConfigs(configs []TaskConfig, tasks []*task) map[string]positionedTaskConfig {
+	res := make(map[string]positionedTaskConfig, len(configs))
+	for i, config := range configs {
+		var key string
+		if config.Name != nil {
+			key = *config.Name
+		} else {
+			key = unnamedTaskConfigKey(config)
+			// identical unnamed tasks are told apart by their occurrence
+			for n := 2; ; n++ {
+				if _, exists := res[key]; !exists {
+					break
+				}
+				key = unnamedTaskConfigKey(config) + "-" + strconv.Itoa(n)
+			}
+		}
+		if _, exists := res[key]; exists {
+			continue
+		}
+		pc := positionedTaskConfig{TaskConfig: config, Position: i}
+		if tasks != nil {
+			pc.task = tasks[i]
+		}
+		res[key] = pc
+	}
+	return res
+}
+
+// unnamedTaskConfigKey derives the key of an unnamed task from its config
+func unnamedTaskConfigKey(config TaskConfig) string {
+	// json sorts map keys, hence equal configs always have the same key
+	data, _ := json.Marshal(config)
+	sum := sha256.Sum256(data)
+	return "#" + hex.EncodeToString(sum[:4])
+}
+
+// taskConfigs returns the configs of the tasks by their key
+func (tm *tasksManager) taskConfigs() map[string]positionedTaskConfig {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	configs := make([]TaskConfig, len(tm.tasks))
+	for i, t := range tm.tasks {
+		configs[i] = t.config
+	}
+	return indexTaskConfigs(configs, tm.tasks)
+}
+
+// planTaskChanges lists the tasks which were added, changed or removed. Added tasks are started, changed
+// ones are restarted if restartChanged is true. Removed tasks keep running until they're closed.
+func planTaskChanges(current, next map[string]positionedTaskConfig, restartChanged bool) []*api.TaskConfigChange {
+	var res []*api.TaskConfigChange
+	for _, key := range sortedTaskKeys(next) {
+		n := next[key]
+		c, exists := current[key]
+		if !exists {
+			res = append(res, &api.TaskConfigChange{
+				Kind:   api.ConfigChangeKind_config_added,
+				Name:   key,
+				Action: api.TaskConfigAction_task_start,
+			})
+			continue
+		}
+		if reflect.DeepEqual(c.TaskConfig, n.TaskConfig) {
+			continue
+		}
+		action := api.TaskConfigAction_task_keep
+		if restartChanged {
+			action = api.TaskConfigAction_task_restart
+		}
+		res = append(res, &api.TaskConfigChange{
+			Kind:   api.ConfigChangeKind_config_changed,
+			Name:   key,
+			Id:     c.task.Id,
+			Action: action,
+		})
+	}
+	for _, key := range sortedTaskKeys(current) {
+		if _, exists := next[key]; exists {
+			continue
+		}
+		res = append(res, &api.TaskConfigChange{
+			Kind:   api.ConfigChangeKind_config_removed,
+			Name:   key,
+			Id:     current[key].task.Id,
+			Action: api.TaskConfigAction_task_keep,
+		})
+	}
+	return res
+}
+
+// sortedTaskKeys returns the keys of the tasks in the order they're configured in
+func sortedTaskKeys(tasks map[string]positionedTaskConfig) []string {
+	keys := make([]string, len(tasks))
+	byPosition := make(map[int]string, len(tasks))
+	var positions []int
+	for key, t := range tasks {
+		byPosition[t.Position] = key
+		positions = append(positions, t.Position)
+	}
+	sort.Ints(positions)
+	for i, p := range positions {
+		keys[i] = byPosition[p]
+	}
+	return keys
+}
+
+// planPortChanges lists the ports whose config was added, changed or removed
+func planPortChanges(current, next []*gitpod.PortsItems) []*api.PortConfigChange {
+	var (
+		currentByPort, currentOrder = indexPortConfigs(current)
+		nextByPort, nextOrder       = indexPortConfigs(next)
+		res                         []*api.PortConfigChange
+	)
+	for _, port := range nextOrder {
+		n := nextByPort[port]
+		c, exists := currentByPort[port]
+		if exists && c.OnOpen == n.OnOpen && c.Visibility == n.Visibility {
+			continue
+		}
+		kind := api.ConfigChangeKind_config_changed
+		if !exists {
+			kind = api.ConfigChangeKind_config_added
+		}
+		res = append(res, &api.PortConfigChange{
+			Kind:       kind,
+			Port:       port,
+			Visibility: n.Visibility,
+			OnOpen:     n.OnOpen,
+		})
+	}
+	for _, port := range currentOrder {
+		if _, exists := nextByPort[port]; exists {
+			continue
+		}
+		res = append(res, &api.PortConfigChange{
+			Kind: api.ConfigChangeKind_config_removed,
+			Port: port,
+		})
+	}
+	return res
+}
+
+// indexPortConfigs indexes port configs by their port or range. Like the ports manager, we use the first config of a port.
+func indexPortConfigs(ports []*gitpod.PortsItems) (byPort map[string]*gitpod.PortsItems, order []string) {
+	byPort = make(map[string]*gitpod.PortsItems, len(ports))
+	for _, p := range ports {
+		if p == nil {
+			continue
+		}
+		port := fmt.Sprintf("%v", p.Port)
+		if _, exists := byPort[port]; exists {
+			continue
+		}
+		byPort[port] = p
+		order = append(order, port)
+	}
+	return
+}
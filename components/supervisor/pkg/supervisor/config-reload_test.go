@@ -0,0 +1,277 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/gitpod-io/gitpod/supervisor/pkg/gitpod"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestPlanTaskChanges(t *testing.T) {
+	running := func(id string, config TaskConfig) *task {
+		return &task{TaskStatus: api.TaskStatus{Id: id}, config: config}
+	}
+	tasks := func(ts ...*task) map[string]positionedTaskConfig {
+		cs := make([]TaskConfig, len(ts))
+		for i, t := range ts {
+			cs[i] = t.config
+		}
+		return indexTaskConfigs(cs, ts)
+	}
+	configs := func(cs ...TaskConfig) map[string]positionedTaskConfig {
+		return indexTaskConfigs(cs, nil)
+	}
+	var (
+		echoOne = TaskConfig{Command: strptr("echo one")}
+		echoTwo = TaskConfig{Command: strptr("echo two")}
+	)
+
+	tests := []struct {
+		Desc           string
+		Current        map[string]positionedTaskConfig
+		Next           map[string]positionedTaskConfig
+		RestartChanged bool
+		Expectation    []*api.TaskConfigChange
+	}{
+		{
+			Desc:    "no changes",
+			Current: tasks(running("0", TaskConfig{Name: strptr("web"), Command: strptr("npm start")})),
+			Next:    configs(TaskConfig{Name: strptr("web"), Command: strptr("npm start")}),
+		},
+		{
+			Desc:    "added task",
+			Current: tasks(running("0", TaskConfig{Name: strptr("web"), Command: strptr("npm start")})),
+			Next: configs(
+				TaskConfig{Name: strptr("db"), Command: strptr("postgres")},
+				TaskConfig{Name: strptr("web"), Command: strptr("npm start")},
+			),
+			Expectation: []*api.TaskConfigChange{
+				{Kind: api.ConfigChangeKind_config_added, Name: "db", Action: api.TaskConfigAction_task_start},
+			},
+		},
+		{
+			Desc:    "changed task",
+			Current: tasks(running("0", TaskConfig{Name: strptr("web"), Command: strptr("npm start")})),
+			Next:    configs(TaskConfig{Name: strptr("web"), Command: strptr("yarn start")}),
+			Expectation: []*api.TaskConfigChange{
+				{Kind: api.ConfigChangeKind_config_changed, Name: "web", Id: "0", Action: api.TaskConfigAction_task_keep},
+			},
+		},
+		{
+			Desc:           "changed task with restart",
+			Current:        tasks(running("0", TaskConfig{Name: strptr("web"), Env: &map[string]string{"PORT": "3000"}})),
+			Next:           configs(TaskConfig{Name: strptr("web"), Env: &map[string]string{"PORT": "8080"}}),
+			RestartChanged: true,
+			Expectation: []*api.TaskConfigChange{
+				{Kind: api.ConfigChangeKind_config_changed, Name: "web", Id: "0", Action: api.TaskConfigAction_task_restart},
+			},
+		},
+		{
+			Desc: "removed task",
+			Current: tasks(
+				running("0", echoOne),
+				running("1", TaskConfig{Name: strptr("web"), Command: strptr("npm start")}),
+			),
+			Next: configs(echoOne),
+			Expectation: []*api.TaskConfigChange{
+				{Kind: api.ConfigChangeKind_config_removed, Name: "web", Id: "1", Action: api.TaskConfigAction_task_keep},
+			},
+		},
+		{
+			Desc:    "unnamed tasks are matched by config",
+			Current: tasks(running("0", echoOne)),
+			Next:    configs(echoTwo, echoOne),
+			Expectation: []*api.TaskConfigChange{
+				{Kind: api.ConfigChangeKind_config_added, Name: unnamedTaskConfigKey(echoTwo), Action: api.TaskConfigAction_task_start},
+			},
+		},
+		{
+			Desc:    "changed unnamed task",
+			Current: tasks(running("0", echoOne)),
+			Next:    configs(echoTwo),
+			Expectation: []*api.TaskConfigChange{
+				{Kind: api.ConfigChangeKind_config_added, Name: unnamedTaskConfigKey(echoTwo), Action: api.TaskConfigAction_task_start},
+				{Kind: api.ConfigChangeKind_config_removed, Name: unnamedTaskConfigKey(echoOne), Id: "0", Action: api.TaskConfigAction_task_keep},
+			},
+		},
+		{
+			Desc:    "identical unnamed tasks",
+			Current: tasks(running("0", echoOne)),
+			Next:    configs(echoOne, echoOne),
+			Expectation: []*api.TaskConfigChange{
+				{Kind: api.ConfigChangeKind_config_added, Name: unnamedTaskConfigKey(echoOne) + "-2", Action: api.TaskConfigAction_task_start},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			act := planTaskChanges(test.Current, test.Next, test.RestartChanged)
+			if diff := cmp.Diff(test.Expectation, act, cmpopts.IgnoreUnexported(api.TaskConfigChange{})); diff != "" {
+				t.Errorf("unexpected changes (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanPortChanges(t *testing.T) {
+	tests := []struct {
+		Desc        string
+		Current     []*gitpod.PortsItems
+		Next        []*gitpod.PortsItems
+		Expectation []*api.PortConfigChange
+	}{
+		{
+			Desc:    "no changes",
+			Current: []*gitpod.PortsItems{{Port: 3000, Visibility: "private"}},
+			Next:    []*gitpod.PortsItems{{Port: 3000, Visibility: "private"}},
+		},
+		{
+			Desc:    "changed visibility and onOpen",
+			Current: []*gitpod.PortsItems{{Port: 3000, Visibility: "private"}, {Port: 8080}},
+			Next:    []*gitpod.PortsItems{{Port: 3000, Visibility: "public"}, {Port: 8080, OnOpen: "ignore"}},
+			Expectation: []*api.PortConfigChange{
+				{Kind: api.ConfigChangeKind_config_changed, Port: "3000", Visibility: "public"},
+				{Kind: api.ConfigChangeKind_config_changed, Port: "8080", OnOpen: "ignore"},
+			},
+		},
+		{
+			Desc:    "added and removed ports",
+			Current: []*gitpod.PortsItems{{Port: 3000}},
+			Next:    []*gitpod.PortsItems{{Port: "4000-4999", OnOpen: "ignore"}},
+			Expectation: []*api.PortConfigChange{
+				{Kind: api.ConfigChangeKind_config_added, Port: "4000-4999", OnOpen: "ignore"},
+				{Kind: api.ConfigChangeKind_config_removed, Port: "3000"},
+			},
+		},
+		{
+			Desc: "first config of a port wins",
+			Next: []*gitpod.PortsItems{{Port: 3000, Visibility: "private"}, {Port: 3000, Visibility: "public"}},
+			Expectation: []*api.PortConfigChange{
+				{Kind: api.ConfigChangeKind_config_added, Port: "3000", Visibility: "private"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			act := planPortChanges(test.Current, test.Next)
+			if diff := cmp.Diff(test.Expectation, act, cmpopts.IgnoreUnexported(api.PortConfigChange{})); diff != "" {
+				t.Errorf("unexpected changes (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigReloaderApplyPorts(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "supervisor-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpdir)
+	loc := filepath.Join(tmpdir, ".gitpod.yml")
+	writeConfig := func(content string) *gitpod.GitpodConfig {
+		err := ioutil.WriteFile(loc, []byte(content), 0644)
+		if err != nil {
+			t.Fatalf("cannot write config file; this is a bug in the unit test itself: %v", err)
+		}
+		cfg, _, err := loadGitpodConfig(loc)
+		if err != nil {
+			t.Fatalf("cannot load config file; this is a bug in the unit test itself: %v", err)
+		}
+		return cfg
+	}
+
+	var (
+		file = &testConfigInterface{
+			Configs: make(chan *gitpod.GitpodConfig),
+			Errors:  make(chan error),
+		}
+		reloader = &configReloader{
+			Location: loc,
+			Config:   file,
+			Tasks:    &tasksManager{config: &Config{}},
+		}
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	configs, _ := reloader.Observe(ctx)
+
+	initial := writeConfig("ports:\n- port: 3000\n  visibility: private\n")
+	file.Configs <- initial
+	if cfg := receiveConfig(t, configs); cfg != initial {
+		t.Fatalf("expected the initial config to be applied, got %v", cfg)
+	}
+
+	// changing the file does not change the ports until the change is applied
+	file.Configs <- writeConfig("ports:\n- port: 3000\n  visibility: public\n- port: 8080\n  onOpen: ignore\n")
+	select {
+	case cfg := <-configs:
+		t.Fatalf("expected the changed config not to be applied, got %v", cfg)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	expectedPlan := []*api.PortConfigChange{
+		{Kind: api.ConfigChangeKind_config_changed, Port: "3000", Visibility: "public"},
+		{Kind: api.ConfigChangeKind_config_added, Port: "8080", OnOpen: "ignore"},
+	}
+	preview, err := reloader.Reload(ctx, &api.ReloadConfigRequest{DryRun: true})
+	if err != nil {
+		t.Fatalf("cannot preview config changes: %v", err)
+	}
+	if diff := cmp.Diff(expectedPlan, preview.Ports, cmpopts.IgnoreUnexported(api.PortConfigChange{})); diff != "" {
+		t.Errorf("unexpected port changes in preview (-want +got):\n%s", diff)
+	}
+	if preview.Applied {
+		t.Errorf("preview applied the changes")
+	}
+
+	res, err := reloader.Reload(ctx, &api.ReloadConfigRequest{})
+	if err != nil {
+		t.Fatalf("cannot apply config changes: %v", err)
+	}
+	if !res.Applied {
+		t.Errorf("changes were not applied")
+	}
+	cfg := receiveConfig(t, configs)
+	if diff := cmp.Diff([]*gitpod.PortsItems{{Port: 3000, Visibility: "public"}, {Port: 8080, OnOpen: "ignore"}}, portsOf(cfg)); diff != "" {
+		t.Errorf("unexpected applied ports (-want +got):\n%s", diff)
+	}
+
+	preview, err = reloader.Reload(ctx, &api.ReloadConfigRequest{DryRun: true})
+	if err != nil {
+		t.Fatalf("cannot preview config changes: %v", err)
+	}
+	if len(preview.Ports) != 0 {
+		t.Errorf("expected no port changes after applying them, got %v", preview.Ports)
+	}
+}
+
+func receiveConfig(t *testing.T, configs <-chan *gitpod.GitpodConfig) *gitpod.GitpodConfig {
+	t.Helper()
+	select {
+	case cfg := <-configs:
+		return cfg
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout while waiting for config")
+		return nil
+	}
+}
+
+type testConfigInterface struct {
+	Configs chan *gitpod.GitpodConfig
+	Errors  chan error
+}
+
+func (c *testConfigInterface) Observe(ctx context.Context) (<-chan *gitpod.GitpodConfig, <-chan error) {
+	return c.Configs, c.Errors
+}
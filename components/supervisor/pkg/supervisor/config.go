@@ -71,6 +71,49 @@ type StaticConfig struct {
 
 	// APIEndpointPort is the port where to serve the API endpoint on
 	APIEndpointPort int `json:"apiEndpointPort"`
+
+	// SSHPort is the port where to serve the SSH server on. Use 0 to disable the SSH server.
+	SSHPort int `json:"sshPort"`
+
+	// TerminalRecording configures the recording of terminals. If this is nil, terminals aren't recorded.
+	TerminalRecording *TerminalRecordingConfig `json:"terminalRecording,omitempty"`
+
+	// TokenAccessPolicies restrict which processes in the workspace may request tokens. Tokens of a kind
+	// and host no policy applies to can be requested by any process.
+	TokenAccessPolicies []TokenAccessPolicy `json:"tokenAccessPolicies,omitempty"`
+}
+
+// TokenAccessPolicy admits callers to request tokens of a kind. If several policies apply to a token,
+// callers have to satisfy at least one of them.
+type TokenAccessPolicy struct {
+	// Kind is the kind of token this policy applies to
+	Kind string `json:"kind"`
+
+	// Host limits this policy to tokens for this host. If empty, the policy applies to all hosts.
+	Host string `json:"host,omitempty"`
+
+	// UIDs are the user IDs admitted by this policy. If empty, callers aren't restricted by their user ID.
+	UIDs []uint32 `json:"uids,omitempty"`
+
+	// Executables are glob patterns (see filepath.Match) matched against the executable path of callers.
+	// If empty, callers aren't restricted by their executable.
+	Executables []string `json:"executables,omitempty"`
+}
+
+// TerminalRecordingConfig configures the asciicast recording of terminals
+type TerminalRecordingConfig struct {
+	// Location is the directory where the recordings are stored
+	Location string `json:"location"`
+
+	// MaxRecordings is the number of recordings we keep before removing the oldest ones. Use 0 to keep all recordings.
+	MaxRecordings int `json:"maxRecordings"`
+
+	// MaxRecordingSize is the size in bytes after which we stop recording a terminal. Use 0 for no limit.
+	MaxRecordingSize int64 `json:"maxRecordingSize"`
+
+	// RecordInput makes recordings capture what's typed into terminals, including passwords and tokens.
+	// Otherwise recordings only mark when there was input.
+	RecordInput bool `json:"recordInput,omitempty"`
 }
 
 // Validate validates this configuration
@@ -84,6 +127,27 @@ func (c StaticConfig) Validate() error {
 	if !(0 < c.APIEndpointPort && c.APIEndpointPort <= math.MaxUint16) {
 		return fmt.Errorf("apiEndpointPort must be between 0 and %d", math.MaxUint16)
 	}
+	if !(0 <= c.SSHPort && c.SSHPort <= math.MaxUint16) {
+		return fmt.Errorf("sshPort must be between 0 and %d", math.MaxUint16)
+	}
+	if rec := c.TerminalRecording; rec != nil {
+		if rec.Location == "" {
+			return fmt.Errorf("terminalRecording.location is required")
+		}
+		if rec.MaxRecordings < 0 || rec.MaxRecordingSize < 0 {
+			return fmt.Errorf("terminalRecording limits must be >= 0")
+		}
+	}
+	for i, p := range c.TokenAccessPolicies {
+		if p.Kind == "" {
+			return fmt.Errorf("tokenAccessPolicies[%d].kind is required", i)
+		}
+		for _, exe := range p.Executables {
+			if _, err := filepath.Match(exe, ""); err != nil {
+				return fmt.Errorf("tokenAccessPolicies[%d]: invalid executable pattern %q: %w", i, exe, err)
+			}
+		}
+	}
 
 	return nil
 }
@@ -175,6 +239,10 @@ type WorkspaceConfig struct {
 	// Tokens is a JSON encoded list of WorkspaceGitpodToken
 	Tokens string `env:"THEIA_SUPERVISOR_TOKENS"`
 
+	// OwnerToken is the token of the workspace owner. Supervisor accepts it as password for SSH connections
+	// and as bearer token for reverse tunnels.
+	OwnerToken string `env:"THEIA_SUPERVISOR_OWNER_TOKEN"`
+
 	// WorkspaceID is the ID of the workspace
 	WorkspaceID string `env:"GITPOD_WORKSPACE_ID"`
 
@@ -187,8 +255,15 @@ type WorkspaceConfig struct {
 	// GitpodTasks is the task configuration of the workspace
 	GitpodTasks string `env:"GITPOD_TASKS"`
 
+	// GitpodServices is the background service configuration of the workspace
+	GitpodServices string `env:"GITPOD_SERVICES"`
+
 	// GitpodHeadless controls whether the workspace is running headless
 	GitpodHeadless string `env:"GITPOD_HEADLESS"`
+
+	// DotfileRepo is the Git repository of the user's dotfiles. It's cloned into the home directory and
+	// installed before the tasks start.
+	DotfileRepo string `env:"SUPERVISOR_DOTFILE_REPO"`
 }
 
 // WorkspaceGitpodToken is a list of tokens that should be added to supervisor's token service
@@ -197,16 +272,79 @@ type WorkspaceGitpodToken struct {
 	TokenOTS string `json:"tokenOTS"`
 }
 
-// TaskConfig defines gitpod task shape
+// TaskConfig defines gitpod task shape. Tasks are passed to supervisor as JSON and read from .gitpod.yml
+// when the config is reloaded.
 type TaskConfig struct {
-	Name     *string            `json:"name,omitempty"`
-	Before   *string            `json:"before,omitempty"`
-	Init     *string            `json:"init,omitempty"`
-	Prebuild *string            `json:"prebuild,omitempty"`
-	Command  *string            `json:"command,omitempty"`
-	Env      *map[string]string `json:"env,omitempty"`
-	OpenIn   *string            `json:"openIn,omitempty"`
-	OpenMode *string            `json:"openMode,omitempty"`
+	Name     *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Before   *string            `json:"before,omitempty" yaml:"before,omitempty"`
+	Init     *string            `json:"init,omitempty" yaml:"init,omitempty"`
+	Prebuild *string            `json:"prebuild,omitempty" yaml:"prebuild,omitempty"`
+	Command  *string            `json:"command,omitempty" yaml:"command,omitempty"`
+	Env      *map[string]string `json:"env,omitempty" yaml:"env,omitempty"`
+	OpenIn   *string            `json:"openIn,omitempty" yaml:"openIn,omitempty"`
+	OpenMode *string            `json:"openMode,omitempty" yaml:"openMode,omitempty"`
+
+	// DependsOn lists the names of tasks whose before, init and prebuild commands
+	// must have succeeded before this task starts.
+	DependsOn *[]string `json:"dependsOn,omitempty" yaml:"dependsOn,omitempty"`
+	// WaitFor delays the start of this task until a port is served or a file exists.
+	WaitFor *TaskWaitFor `json:"waitFor,omitempty" yaml:"waitFor,omitempty"`
+	// Restart defines if the task's command is restarted once it exits.
+	Restart *TaskRestart `json:"restart,omitempty" yaml:"restart,omitempty"`
+	// Readiness checks if the task's command is ready to serve requests.
+	Readiness *TaskReadiness `json:"readiness,omitempty" yaml:"readiness,omitempty"`
+}
+
+// ServiceConfig defines a background service, e.g. a database, which supervisor runs
+// independently of the IDE and the tasks
+type ServiceConfig struct {
+	Name    string            `json:"name"`
+	Command string            `json:"command"`
+	Env     map[string]string `json:"env,omitempty"`
+	// Restart defines if the service is restarted once it exits. By default, services are restarted if they fail.
+	Restart *TaskRestart `json:"restart,omitempty"`
+}
+
+// TaskWaitFor defines what a task waits for before it starts
+type TaskWaitFor struct {
+	Port *int    `json:"port,omitempty" yaml:"port,omitempty"`
+	File *string `json:"file,omitempty" yaml:"file,omitempty"`
+}
+
+// TaskRestartPolicy determines when a task's command is restarted
+type TaskRestartPolicy string
+
+const (
+	// TaskRestartNever never restarts the command
+	TaskRestartNever TaskRestartPolicy = "never"
+	// TaskRestartOnFailure restarts the command if it exits with a non-zero exit code
+	TaskRestartOnFailure TaskRestartPolicy = "on-failure"
+	// TaskRestartAlways restarts the command whenever it exits
+	TaskRestartAlways TaskRestartPolicy = "always"
+)
+
+// TaskRestart defines when and how often a task's command is restarted
+type TaskRestart struct {
+	Policy TaskRestartPolicy `json:"policy,omitempty" yaml:"policy,omitempty"`
+	// MaxAttempts limits the number of restarts. Zero means no limit.
+	MaxAttempts int `json:"maxAttempts,omitempty" yaml:"maxAttempts,omitempty"`
+}
+
+// TaskReadiness defines how we check if a task is ready
+type TaskReadiness struct {
+	HTTP *TaskHTTPReadiness `json:"http,omitempty" yaml:"http,omitempty"`
+	TCP  *TaskTCPReadiness  `json:"tcp,omitempty" yaml:"tcp,omitempty"`
+}
+
+// TaskHTTPReadiness considers a task ready once an HTTP GET request to localhost succeeds
+type TaskHTTPReadiness struct {
+	Port int    `json:"port" yaml:"port"`
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+}
+
+// TaskTCPReadiness considers a task ready once a TCP port on localhost accepts connections
+type TaskTCPReadiness struct {
+	Port int `json:"port" yaml:"port"`
 }
 
 // Validate validates this configuration
@@ -305,6 +443,18 @@ func (c WorkspaceConfig) getGitpodTasks() (tasks *[]TaskConfig, err error) {
 	return
 }
 
+// getGitpodServices parses the background services
+func (c WorkspaceConfig) getGitpodServices() (services []ServiceConfig, err error) {
+	if c.GitpodServices == "" {
+		return
+	}
+	err = json.Unmarshal([]byte(c.GitpodServices), &services)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse services: %w", err)
+	}
+	return
+}
+
 // GetConfig loads the supervisor configuration
 func GetConfig() (*Config, error) {
 	static, err := loadStaticConfigFromFile()
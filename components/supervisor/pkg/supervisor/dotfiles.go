@@ -0,0 +1,280 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+const (
+	// dotfilesTimeout is the time the dotfiles installation may take, including the clone
+	dotfilesTimeout = 120 * time.Second
+	// dotfilesStatusLogSize is the number of bytes of the installation log we report in the status
+	dotfilesStatusLogSize = 64 << 10
+)
+
+// dotfilesInstallScripts are the scripts we look for in a dotfiles repository, in order of precedence.
+// If a repository has none of them, we link its dotfiles into the home directory.
+var dotfilesInstallScripts = []string{
+	"install.sh",
+	"install",
+	"bootstrap.sh",
+	"bootstrap",
+	"script/bootstrap",
+	"setup.sh",
+	"setup",
+	"script/setup",
+}
+
+// dotfilesInstaller clones the dotfiles repository of a user into their home directory and installs it
+type dotfilesInstaller struct {
+	// Repository is the repository to clone. If empty, no dotfiles are installed.
+	Repository string
+	// Home is the home directory of the user. The repository is cloned to Home/.dotfiles.
+	Home string
+	// Tokens provides the credentials to clone private repositories
+	Tokens api.TokenServiceServer
+	// Env is the environment the install script runs in
+	Env []string
+	// Timeout limits the time the installation may take
+	Timeout time.Duration
+
+	mu            sync.RWMutex
+	state         api.DotfilesState
+	installScript string
+	err           string
+
+	once sync.Once
+	done chan struct{}
+}
+
+// Done is closed once the dotfiles have been installed or the installation failed
+func (d *dotfilesInstaller) Done() <-chan struct{} {
+	return d.doneChan()
+}
+
+func (d *dotfilesInstaller) doneChan() chan struct{} {
+	d.once.Do(func() { d.done = make(chan struct{}) })
+	return d.done
+}
+
+func (d *dotfilesInstaller) location() string {
+	return filepath.Join(d.Home, ".dotfiles")
+}
+
+func (d *dotfilesInstaller) logLocation() string {
+	return filepath.Join(d.Home, ".dotfiles.log")
+}
+
+// Run installs the dotfiles
+func (d *dotfilesInstaller) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	defer close(d.doneChan())
+
+	if d.Repository == "" {
+		return
+	}
+	d.setState(api.DotfilesState_dotfiles_installing, "", "")
+
+	timeout := d.Timeout
+	if timeout == 0 {
+		timeout = dotfilesTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	dlog := log.WithField("repository", d.Repository)
+	dlog.Info("installing dotfiles")
+	script, err := d.install(ctx)
+	if ctx.Err() == context.DeadlineExceeded {
+		err = fmt.Errorf("installation did not finish within %s", timeout)
+	}
+	if err != nil {
+		dlog.WithError(err).Warn("cannot install dotfiles")
+		d.setState(api.DotfilesState_dotfiles_failed, script, err.Error())
+		return
+	}
+	dlog.WithField("script", script).Info("dotfiles installed")
+	d.setState(api.DotfilesState_dotfiles_installed, script, "")
+}
+
+func (d *dotfilesInstaller) setState(state api.DotfilesState, installScript, err string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.state = state
+	d.installScript = installScript
+	d.err = err
+}
+
+func (d *dotfilesInstaller) install(ctx context.Context) (script string, err error) {
+	out, err := os.OpenFile(d.logLocation(), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return "", err
+	}
+	defer out.Close()
+
+	loc := d.location()
+	if _, err := os.Stat(loc); os.IsNotExist(err) {
+		err = d.clone(ctx, out, loc)
+		if err != nil {
+			return "", fmt.Errorf("cannot clone %s: %w", d.Repository, err)
+		}
+	} else {
+		fmt.Fprintf(out, "%s exists already - not cloning the repository again\n", loc)
+	}
+
+	for _, s := range dotfilesInstallScripts {
+		fn := filepath.Join(loc, s)
+		if stat, err := os.Stat(fn); err != nil || stat.IsDir() {
+			continue
+		}
+
+		fmt.Fprintf(out, "running %s\n", s)
+		err = os.Chmod(fn, 0755)
+		if err != nil {
+			return s, err
+		}
+		cmd := exec.Command(fn)
+		cmd.Dir = loc
+		cmd.Env = d.Env
+		err = runDotfilesCommand(ctx, cmd, out)
+		if err != nil {
+			return s, fmt.Errorf("%s failed: %w", s, err)
+		}
+		return s, nil
+	}
+
+	fmt.Fprintln(out, "no install script found - linking dotfiles into the home directory")
+	return "", linkDotfiles(loc, d.Home, out)
+}
+
+// clone clones the dotfiles repository. If we have a token for the repository's host,
+// we pass it to Git using a credential helper which only lives as long as the clone.
+func (d *dotfilesInstaller) clone(ctx context.Context, out io.Writer, dst string) error {
+	args := []string{"clone", "--depth", "1", d.Repository, dst}
+	env := append(append([]string{}, d.Env...), "GIT_TERMINAL_PROMPT=0")
+	if user, token := d.credentials(ctx); token != "" {
+		args = append([]string{
+			"-c", "credential.helper=",
+			"-c", `credential.helper=!f() { echo "username=$GITPOD_DOTFILES_USER"; echo "password=$GITPOD_DOTFILES_TOKEN"; }; f`,
+		}, args...)
+		env = append(env, "GITPOD_DOTFILES_USER="+user, "GITPOD_DOTFILES_TOKEN="+token)
+	}
+
+	fmt.Fprintf(out, "cloning %s\n", d.Repository)
+	cmd := exec.Command("git", args...)
+	cmd.Env = env
+	return runDotfilesCommand(ctx, cmd, out)
+}
+
+func (d *dotfilesInstaller) credentials(ctx context.Context) (user, token string) {
+	u, err := url.Parse(d.Repository)
+	if err != nil || u.Scheme != "https" || d.Tokens == nil {
+		return "", ""
+	}
+	resp, err := d.Tokens.GetToken(ctx, &api.GetTokenRequest{
+		Kind: KindGit,
+		Host: u.Hostname(),
+	})
+	if err != nil {
+		log.WithError(err).WithField("host", u.Hostname()).Debug("no token for dotfiles repository - cloning anonymously")
+		return "", ""
+	}
+	user = resp.User
+	if user == "" {
+		// most Git hosts only look at the token when authenticating with one
+		user = "gitpod"
+	}
+	return user, resp.Token
+}
+
+// runDotfilesCommand runs a command in its own process group and kills the whole group once ctx is done,
+// so that no process an install script started outlives the installation.
+func runDotfilesCommand(ctx context.Context, cmd *exec.Cmd, out io.Writer) error {
+	cmd.Stdout = out
+	cmd.Stderr = out
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	err := cmd.Start()
+	if err != nil {
+		return err
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+	select {
+	case err = <-done:
+		return err
+	case <-ctx.Done():
+		_ = syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+		<-done
+		return ctx.Err()
+	}
+}
+
+// linkDotfiles links the dotfiles of a repository into the home directory. Existing files are left alone.
+func linkDotfiles(repo, home string, out io.Writer) error {
+	files, err := ioutil.ReadDir(repo)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		name := f.Name()
+		if !strings.HasPrefix(name, ".") || name == ".git" {
+			continue
+		}
+		dst := filepath.Join(home, name)
+		if _, err := os.Lstat(dst); err == nil {
+			fmt.Fprintf(out, "%s exists already - not linking it\n", dst)
+			continue
+		}
+		err = os.Symlink(filepath.Join(repo, name), dst)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "linked %s\n", dst)
+	}
+	return nil
+}
+
+// Status returns the status of the installation including the end of its log
+func (d *dotfilesInstaller) Status() *api.DotfilesStatusResponse {
+	d.mu.RLock()
+	res := &api.DotfilesStatusResponse{
+		State:         d.state,
+		Repository:    d.Repository,
+		InstallScript: d.installScript,
+		Error:         d.err,
+	}
+	d.mu.RUnlock()
+	if res.State == api.DotfilesState_dotfiles_not_configured {
+		return res
+	}
+
+	f, err := os.Open(d.logLocation())
+	if err != nil {
+		return res
+	}
+	defer f.Close()
+	if stat, err := f.Stat(); err == nil && stat.Size() > dotfilesStatusLogSize {
+		_, _ = f.Seek(-dotfilesStatusLogSize, io.SeekEnd)
+	}
+	logContent, _ := ioutil.ReadAll(f)
+	res.Log = string(logContent)
+	return res
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+func TestDotfilesInstaller(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+
+	type Expectation struct {
+		State         api.DotfilesState
+		InstallScript string
+		Error         string
+		Log           string
+		Files         []string
+	}
+	tests := []struct {
+		Desc        string
+		Files       map[string]string
+		Timeout     time.Duration
+		Expectation Expectation
+	}{
+		{
+			Desc: "install script",
+			Files: map[string]string{
+				"setup.sh":   "#!/bin/sh\necho from setup > $HOME/setup",
+				"install.sh": "#!/bin/sh\necho installing\necho from install > $HOME/install",
+			},
+			Expectation: Expectation{
+				State:         api.DotfilesState_dotfiles_installed,
+				InstallScript: "install.sh",
+				Log:           "installing",
+				Files:         []string{"install"},
+			},
+		},
+		{
+			Desc: "no install script",
+			Files: map[string]string{
+				".bashrc":    "alias ll='ls -l'",
+				".gitconfig": "[user]\n\tname = foo",
+				"README.md":  "my dotfiles",
+			},
+			Expectation: Expectation{
+				State: api.DotfilesState_dotfiles_installed,
+				Log:   "no install script found",
+				Files: []string{".bashrc", ".gitconfig"},
+			},
+		},
+		{
+			Desc: "failing install script",
+			Files: map[string]string{
+				"bootstrap": "#!/bin/sh\necho broken >&2\nexit 1",
+			},
+			Expectation: Expectation{
+				State:         api.DotfilesState_dotfiles_failed,
+				InstallScript: "bootstrap",
+				Error:         "bootstrap failed: exit status 1",
+				Log:           "broken",
+			},
+		},
+		{
+			Desc: "install script times out",
+			Files: map[string]string{
+				"install": "#!/bin/sh\nsleep 60",
+			},
+			Timeout: 500 * time.Millisecond,
+			Expectation: Expectation{
+				State:         api.DotfilesState_dotfiles_failed,
+				InstallScript: "install",
+				Error:         "installation did not finish within 500ms",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			repo := createDotfilesRepo(t, test.Files)
+			defer os.RemoveAll(repo)
+			home, err := ioutil.TempDir("", "dotfiles-home")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(home)
+
+			installer := &dotfilesInstaller{
+				Repository: repo,
+				Home:       home,
+				Env:        append(os.Environ(), "HOME="+home),
+				Timeout:    test.Timeout,
+			}
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go installer.Run(context.Background(), &wg)
+			select {
+			case <-installer.Done():
+			case <-time.After(30 * time.Second):
+				t.Fatal("timeout while waiting for the installation")
+			}
+
+			act := installer.Status()
+			if act.State != test.Expectation.State {
+				t.Errorf("unexpected state: want %v, got %v", test.Expectation.State, act.State)
+			}
+			if act.InstallScript != test.Expectation.InstallScript {
+				t.Errorf("unexpected install script: want %q, got %q", test.Expectation.InstallScript, act.InstallScript)
+			}
+			if act.Error != test.Expectation.Error {
+				t.Errorf("unexpected error: want %q, got %q", test.Expectation.Error, act.Error)
+			}
+			if !strings.Contains(act.Log, test.Expectation.Log) {
+				t.Errorf("log does not contain %q:\n%s", test.Expectation.Log, act.Log)
+			}
+			for _, f := range test.Expectation.Files {
+				if _, err := os.Stat(filepath.Join(home, f)); err != nil {
+					t.Errorf("expected %s in home directory: %v", f, err)
+				}
+			}
+		})
+	}
+}
+
+func TestDotfilesInstallerNotConfigured(t *testing.T) {
+	installer := &dotfilesInstaller{}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	installer.Run(context.Background(), &wg)
+
+	select {
+	case <-installer.Done():
+	default:
+		t.Fatal("installation is not done")
+	}
+	if state := installer.Status().State; state != api.DotfilesState_dotfiles_not_configured {
+		t.Errorf("unexpected state: %v", state)
+	}
+}
+
+func createDotfilesRepo(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	repo, err := ioutil.TempDir("", "dotfiles-repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		err = ioutil.WriteFile(filepath.Join(repo, name), []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"add", "-A"},
+		{"-c", "user.name=test", "-c", "user.email=test@gitpod.io", "commit", "-q", "-m", "dotfiles"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repo
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %s failed: %v: %s", args[0], err, out)
+		}
+	}
+	return repo
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/pkg/git"
+	daemon "github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// gitStatusInterval is the time after which we compute the Git status even if we haven't seen a change.
+	// Not all changes are visible to the watcher, e.g. if a directory has too many subdirectories.
+	gitStatusInterval = 1 * time.Minute
+	// gitStatusDebounce is the time we wait after a change before we compute the Git status
+	gitStatusDebounce = 5 * time.Second
+	// maxGitStatusWatches limits the number of directories we watch for changes. inotify watches are limited per user,
+	// and we share them with the IDE and the user's tools. Changes in directories beyond the limit are picked up by polling.
+	maxGitStatusWatches = 512
+)
+
+// gitStatusReporter computes the status of the Git repo in the workspace whenever it might have changed
+// and reports it if it did
+type gitStatusReporter struct {
+	// Location is the location of the Git working copy
+	Location string
+	// ContentState tells us when the working copy is ready
+	ContentState ContentState
+	// Report passes on the Git status. If the repo has gone away, the status is nil.
+	Report func(ctx context.Context, repo *csapi.GitStatus) error
+
+	Interval time.Duration
+	Debounce time.Duration
+
+	watches           int
+	watchLimitReached bool
+}
+
+// reportGitStatusToDaemon reports the Git status to ws-daemon which passes it on to ws-manager
+func reportGitStatusToDaemon(ctx context.Context, repo *csapi.GitStatus) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	client, conn, err := ConnectToInWorkspaceStatusService(ctx)
+	if err == ErrNoInWorkspaceDaemonService {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = client.UpdateGitStatus(ctx, &daemon.UpdateGitStatusRequest{Repo: repo})
+	return err
+}
+
+// Run reports the Git status until ctx is canceled
+func (r *gitStatusReporter) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	select {
+	case <-ctx.Done():
+		return
+	case <-r.ContentState.ContentReady():
+	}
+
+	interval := r.Interval
+	if interval == 0 {
+		interval = gitStatusInterval
+	}
+	debounce := r.Debounce
+	if debounce == 0 {
+		debounce = gitStatusDebounce
+	}
+
+	var changes <-chan fsnotify.Event
+	watcher, err := r.watch()
+	if err != nil {
+		log.WithError(err).WithField("location", r.Location).Warn("cannot watch working copy - reporting the Git status periodically only")
+	} else {
+		defer watcher.Close()
+		changes = watcher.Events
+		go func() {
+			for err := range watcher.Errors {
+				log.WithError(err).Debug("error while watching working copy")
+			}
+		}()
+	}
+
+	var (
+		ticker    = time.NewTicker(interval)
+		debounced = time.NewTimer(0)
+		pending   bool
+		last      *csapi.GitStatus
+		reported  bool
+	)
+	defer ticker.Stop()
+	defer debounced.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case evt, ok := <-changes:
+			if !ok {
+				changes = nil
+				continue
+			}
+			if strings.HasSuffix(evt.Name, ".lock") {
+				// Git creates lock files for the duration of an operation - including the ones we run
+				continue
+			}
+			if evt.Op&fsnotify.Create == fsnotify.Create {
+				if stat, err := os.Stat(evt.Name); err == nil && stat.IsDir() {
+					r.addWatches(watcher, evt.Name)
+				}
+			}
+			if pending {
+				continue
+			}
+			pending = true
+			debounced.Reset(debounce)
+			continue
+		case <-debounced.C:
+			pending = false
+		case <-ticker.C:
+		}
+
+		repo, err := r.status(ctx)
+		if err != nil {
+			log.WithError(err).WithField("location", r.Location).Debug("cannot compute Git status")
+			continue
+		}
+		if reported && proto.Equal(last, repo) {
+			continue
+		}
+		err = r.Report(ctx, repo)
+		if st, ok := status.FromError(err); ok && st.Code() == codes.Unimplemented {
+			log.Debug("Git status reporting is not supported - not reporting the Git status anymore")
+			return
+		}
+		if err != nil {
+			log.WithError(err).Debug("cannot report Git status")
+			continue
+		}
+		last, reported = repo, true
+	}
+}
+
+func (r *gitStatusReporter) status(ctx context.Context) (*csapi.GitStatus, error) {
+	if !git.IsWorkingCopy(r.Location) {
+		return nil, nil
+	}
+	client := git.Client{Location: r.Location}
+	s, err := client.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ToAPI(), nil
+}
+
+func (r *gitStatusReporter) watch() (*fsnotify.Watcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	r.addWatches(watcher, r.Location)
+	return watcher, nil
+}
+
+// addWatches watches a directory and its subdirectories. Within .git we only watch the refs and Git's own files,
+// e.g. the index and HEAD. We don't watch node_modules: its content is rarely committed but it has a lot of directories.
+func (r *gitStatusReporter) addWatches(watcher *fsnotify.Watcher, root string) {
+	var (
+		gitDir  = filepath.Join(r.Location, ".git")
+		refsDir = filepath.Join(gitDir, "refs")
+	)
+	_ = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.IsDir() {
+			return nil
+		}
+		if info.Name() == "node_modules" {
+			return filepath.SkipDir
+		}
+		if strings.HasPrefix(path, gitDir+string(filepath.Separator)) && path != refsDir && !strings.HasPrefix(path, refsDir+string(filepath.Separator)) {
+			return filepath.SkipDir
+		}
+		if r.watches >= maxGitStatusWatches {
+			if !r.watchLimitReached {
+				log.WithField("limit", maxGitStatusWatches).Info("too many directories to watch - polling the Git status of the others")
+				r.watchLimitReached = true
+			}
+			return filepath.SkipDir
+		}
+		err = watcher.Add(path)
+		if err != nil {
+			log.WithError(err).WithField("path", path).Debug("cannot watch directory")
+			return nil
+		}
+		r.watches++
+		return nil
+	})
+}
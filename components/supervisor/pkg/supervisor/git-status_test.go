@@ -0,0 +1,142 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	daemon "github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc"
+)
+
+func TestGitStatusReporter(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+
+	location, err := ioutil.TempDir("", "git-status")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(location)
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"checkout", "-q", "-b", "main"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = location
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %s failed: %v: %s", args[0], err, out)
+		}
+	}
+
+	var (
+		reports     = make(chan *csapi.GitStatus, 10)
+		cstate      = NewInMemoryContentState(location)
+		ctx, cancel = context.WithCancel(context.Background())
+		wg          sync.WaitGroup
+	)
+	defer cancel()
+	reporter := &gitStatusReporter{
+		Location:     location,
+		ContentState: cstate,
+		Report: func(ctx context.Context, repo *csapi.GitStatus) error {
+			reports <- repo
+			return nil
+		},
+		Interval: 1 * time.Hour,
+		Debounce: 100 * time.Millisecond,
+	}
+	wg.Add(1)
+	go reporter.Run(ctx, &wg)
+	cstate.MarkContentReady(csapi.WorkspaceInitFromOther)
+
+	expectReport := func(expectation *csapi.GitStatus) {
+		t.Helper()
+		select {
+		case act := <-reports:
+			if diff := cmp.Diff(expectation, act, cmpopts.IgnoreUnexported(csapi.GitStatus{})); diff != "" {
+				t.Errorf("unexpected Git status (-want +got):\n%s", diff)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatal("timeout while waiting for Git status")
+		}
+	}
+
+	expectReport(&csapi.GitStatus{Branch: "main"})
+
+	err = os.MkdirAll(filepath.Join(location, "src"), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// give the reporter a chance to watch the new directory
+	time.Sleep(200 * time.Millisecond)
+	err = ioutil.WriteFile(filepath.Join(location, "src", "main.go"), []byte("package main"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectReport(&csapi.GitStatus{Branch: "main", UntrackedFiles: []string{"src/main.go"}, TotalUntrackedFiles: 1})
+
+	cancel()
+	wg.Wait()
+}
+
+type testStatusService struct {
+	daemon.UnimplementedInWorkspaceStatusServiceServer
+
+	Reports chan *csapi.GitStatus
+}
+
+func (s *testStatusService) UpdateGitStatus(ctx context.Context, req *daemon.UpdateGitStatusRequest) (*daemon.UpdateGitStatusResponse, error) {
+	s.Reports <- req.Repo
+	return &daemon.UpdateGitStatusResponse{}, nil
+}
+
+func TestReportGitStatusToDaemon(t *testing.T) {
+	// Regular workspaces have no in-workspace daemon service, only the status service which ws-daemon offers to every workspace.
+	location, err := ioutil.TempDir("", "workspace-status")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(location)
+
+	defer func(socket string) { inWorkspaceStatusSocket = socket }(inWorkspaceStatusSocket)
+	inWorkspaceStatusSocket = filepath.Join(location, "status.sock")
+	sckt, err := net.Listen("unix", inWorkspaceStatusSocket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := &testStatusService{Reports: make(chan *csapi.GitStatus, 1)}
+	srv := grpc.NewServer()
+	daemon.RegisterInWorkspaceStatusServiceServer(srv, svc)
+	go srv.Serve(sckt)
+	defer srv.Stop()
+
+	repo := &csapi.GitStatus{Branch: "main", UntrackedFiles: []string{"src/main.go"}, TotalUntrackedFiles: 1}
+	err = reportGitStatusToDaemon(context.Background(), repo)
+	if err != nil {
+		t.Fatalf("cannot report Git status: %v", err)
+	}
+	select {
+	case act := <-svc.Reports:
+		if !proto.Equal(repo, act) {
+			t.Errorf("unexpected Git status: %v", act)
+		}
+	default:
+		t.Fatal("Git status was not reported")
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxNotificationActions is the number of actions a notification may offer
+	maxNotificationActions = 10
+	// maxPendingNotifications is the number of notifications which may await a response at the same time
+	maxPendingNotifications = 100
+	// notificationSubscriberBuffer is the number of notifications we buffer per subscriber before we start dropping them
+	notificationSubscriberBuffer = 32
+)
+
+// notificationService lets processes in the workspace notify the user through the IDE frontends which subscribe to it
+type notificationService struct {
+	mu          sync.Mutex
+	nextID      uint64
+	pending     map[uint64]*pendingNotification
+	subscribers map[chan *api.SubscribeNotificationsResponse]struct{}
+}
+
+type pendingNotification struct {
+	message  *api.SubscribeNotificationsResponse
+	response chan string
+}
+
+func newNotificationService() *notificationService {
+	return &notificationService{
+		pending:     make(map[uint64]*pendingNotification),
+		subscribers: make(map[chan *api.SubscribeNotificationsResponse]struct{}),
+	}
+}
+
+// RegisterGRPC registers the gRPC notification service
+func (srv *notificationService) RegisterGRPC(s *grpc.Server) {
+	api.RegisterNotificationServiceServer(s, srv)
+}
+
+// RegisterREST registers the REST notification service
+func (srv *notificationService) RegisterREST(mux *runtime.ServeMux, grpcEndpoint string) error {
+	return api.RegisterNotificationServiceHandlerFromEndpoint(context.Background(), mux, grpcEndpoint, []grpc.DialOption{grpc.WithInsecure()})
+}
+
+// Notify shows a notification and waits for the user's response if it offers actions
+func (srv *notificationService) Notify(ctx context.Context, req *api.NotifyRequest) (*api.NotifyResponse, error) {
+	if req.Message == "" {
+		return nil, status.Error(codes.InvalidArgument, "message is required")
+	}
+	if len(req.Actions) > maxNotificationActions {
+		return nil, status.Errorf(codes.InvalidArgument, "notifications can offer at most %d actions", maxNotificationActions)
+	}
+
+	srv.mu.Lock()
+	if len(req.Actions) > 0 && len(srv.pending) >= maxPendingNotifications {
+		srv.mu.Unlock()
+		return nil, status.Error(codes.ResourceExhausted, "too many notifications await a response")
+	}
+	srv.nextID++
+	msg := &api.SubscribeNotificationsResponse{
+		RequestId: srv.nextID,
+		Request:   req,
+	}
+	var pending *pendingNotification
+	if len(req.Actions) > 0 {
+		pending = &pendingNotification{message: msg, response: make(chan string, 1)}
+		srv.pending[msg.RequestId] = pending
+	}
+	for sub := range srv.subscribers {
+		select {
+		case sub <- msg:
+		default:
+			log.WithField("requestId", msg.RequestId).Warn("dropped notification - subscriber is too slow")
+		}
+	}
+	srv.mu.Unlock()
+
+	if pending == nil {
+		return &api.NotifyResponse{}, nil
+	}
+	select {
+	case action := <-pending.response:
+		return &api.NotifyResponse{Action: action}, nil
+	case <-ctx.Done():
+		srv.mu.Lock()
+		delete(srv.pending, msg.RequestId)
+		srv.mu.Unlock()
+		return nil, status.Error(codes.Canceled, ctx.Err().Error())
+	}
+}
+
+// Subscribe streams the notifications to show, starting with those which await a response
+func (srv *notificationService) Subscribe(req *api.SubscribeNotificationsRequest, resp api.NotificationService_SubscribeServer) error {
+	srv.mu.Lock()
+	if len(srv.subscribers) >= maxSubscriptions {
+		srv.mu.Unlock()
+		return status.Error(codes.ResourceExhausted, "too many subscriptions")
+	}
+	sub := make(chan *api.SubscribeNotificationsResponse, notificationSubscriberBuffer)
+	srv.subscribers[sub] = struct{}{}
+	pending := make([]*api.SubscribeNotificationsResponse, 0, len(srv.pending))
+	for _, p := range srv.pending {
+		pending = append(pending, p.message)
+	}
+	srv.mu.Unlock()
+	defer func() {
+		srv.mu.Lock()
+		delete(srv.subscribers, sub)
+		srv.mu.Unlock()
+	}()
+
+	sort.Slice(pending, func(i, j int) bool { return pending[i].RequestId < pending[j].RequestId })
+	for _, msg := range pending {
+		err := resp.Send(msg)
+		if err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case msg := <-sub:
+			err := resp.Send(msg)
+			if err != nil {
+				return err
+			}
+		case <-resp.Context().Done():
+			return nil
+		}
+	}
+}
+
+// Respond passes the user's choice on to the process which asked
+func (srv *notificationService) Respond(ctx context.Context, req *api.RespondNotificationRequest) (*api.RespondNotificationResponse, error) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	pending, ok := srv.pending[req.RequestId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "notification %d does not await a response", req.RequestId)
+	}
+	if req.Action != "" {
+		var known bool
+		for _, a := range pending.message.Request.Actions {
+			if a == req.Action {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, status.Errorf(codes.InvalidArgument, "notification %d has no action %q", req.RequestId, req.Action)
+		}
+	}
+
+	delete(srv.pending, req.RequestId)
+	pending.response <- req.Action
+	return &api.RespondNotificationResponse{}, nil
+}
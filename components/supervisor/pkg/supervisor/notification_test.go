@@ -0,0 +1,100 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNotificationService(t *testing.T) {
+	srv := newNotificationService()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// notifications without actions don't wait for anyone
+	_, err := srv.Notify(ctx, &api.NotifyRequest{Message: "build done"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type result struct {
+		Resp *api.NotifyResponse
+		Err  error
+	}
+	results := make(chan result, 1)
+	go func() {
+		resp, err := srv.Notify(ctx, &api.NotifyRequest{Level: api.NotificationLevel_notification_warning, Message: "deploy?", Actions: []string{"Yes", "No"}})
+		results <- result{resp, err}
+	}()
+
+	// subscribers which come late see the notifications which await a response
+	waitCtx, waitCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer waitCancel()
+	if !waitFor(waitCtx, func() bool {
+		srv.mu.Lock()
+		defer srv.mu.Unlock()
+		return len(srv.pending) == 1
+	}) {
+		t.Fatal("timeout while waiting for notification to await a response")
+	}
+	stream := &testNotificationStream{ctx: ctx, msgs: make(chan *api.SubscribeNotificationsResponse, 10)}
+	go srv.Subscribe(&api.SubscribeNotificationsRequest{}, stream)
+	var msg *api.SubscribeNotificationsResponse
+	select {
+	case msg = <-stream.msgs:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout while waiting for notification")
+	}
+	if msg.Request.Message != "deploy?" {
+		t.Fatalf("unexpected notification: %v", msg)
+	}
+
+	_, err = srv.Respond(ctx, &api.RespondNotificationRequest{RequestId: msg.RequestId, Action: "Maybe"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected unknown actions to be rejected, got %v", err)
+	}
+	_, err = srv.Respond(ctx, &api.RespondNotificationRequest{RequestId: msg.RequestId, Action: "No"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case res := <-results:
+		if res.Err != nil {
+			t.Fatal(res.Err)
+		}
+		if res.Resp.Action != "No" {
+			t.Errorf("unexpected action: %q", res.Resp.Action)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout while waiting for response")
+	}
+
+	_, err = srv.Respond(ctx, &api.RespondNotificationRequest{RequestId: msg.RequestId, Action: "Yes"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected second response to be rejected, got %v", err)
+	}
+}
+
+type testNotificationStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	msgs chan *api.SubscribeNotificationsResponse
+}
+
+func (s *testNotificationStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testNotificationStream) Send(msg *api.SubscribeNotificationsResponse) error {
+	s.msgs <- msg
+	return nil
+}
@@ -6,6 +6,7 @@ package supervisor
 
 import (
 	"context"
+	"net/http"
 	"os"
 	"sync"
 	"time"
@@ -13,8 +14,10 @@ import (
 	"github.com/gitpod-io/gitpod/common-go/log"
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/supervisor/api"
+	daemon "github.com/gitpod-io/gitpod/ws-daemon/api"
 
 	"github.com/gitpod-io/gitpod/supervisor/pkg/ports"
+	"github.com/gitpod-io/gitpod/supervisor/pkg/resources"
 	"github.com/golang/protobuf/ptypes"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -38,6 +41,12 @@ type RegisterableRESTService interface {
 	RegisterREST(mux *runtime.ServeMux, grpcEndpoint string) error
 }
 
+// RegisterableHTTPService can register plain HTTP handlers, e.g. for WebSocket endpoints
+type RegisterableHTTPService interface {
+	// RegisterHTTP registers HTTP handlers
+	RegisterHTTP(mux *http.ServeMux)
+}
+
 type ideReadyState struct {
 	ready bool
 	cond  *sync.Cond
@@ -80,6 +89,9 @@ type statusService struct {
 	ContentState ContentState
 	Ports        *ports.Manager
 	Tasks        *tasksManager
+	Services     *servicesManager
+	Dotfiles     *dotfilesInstaller
+	Resources    *resources.Sampler
 	ideReady     *ideReadyState
 }
 
@@ -144,8 +156,101 @@ func (s *statusService) ContentStatus(ctx context.Context, req *api.ContentStatu
 	}, nil
 }
 
+// DotfilesStatus provides the state and log of the dotfiles installation
+func (s *statusService) DotfilesStatus(ctx context.Context, req *api.DotfilesStatusRequest) (*api.DotfilesStatusResponse, error) {
+	if s.Dotfiles == nil {
+		return &api.DotfilesStatusResponse{State: api.DotfilesState_dotfiles_not_configured}, nil
+	}
+	if req.Wait {
+		select {
+		case <-s.Dotfiles.Done():
+		case <-ctx.Done():
+			return nil, status.Error(codes.DeadlineExceeded, ctx.Err().Error())
+		}
+	}
+	return s.Dotfiles.Status(), nil
+}
+
+const (
+	// defaultTopProcesses is the number of top processes we report if the client doesn't ask for a specific number
+	defaultTopProcesses = 5
+	// maxTopProcesses is the maximum number of top processes we report
+	maxTopProcesses = 50
+	// resourceSampleInterval is the time between two resource samples
+	resourceSampleInterval = 2 * time.Second
+)
+
+// ResourceStatus reports the CPU, memory and disk usage of the workspace and its top processes
+func (s *statusService) ResourceStatus(req *api.ResourceStatusRequest, srv api.StatusService_ResourceStatusServer) error {
+	if s.Resources == nil {
+		return status.Error(codes.Unavailable, "resource usage is not available")
+	}
+	top := int(req.Top)
+	if top == 0 {
+		top = defaultTopProcesses
+	} else if top > maxTopProcesses {
+		top = maxTopProcesses
+	}
+
+	prev, err := s.Resources.Sample()
+	if err != nil {
+		log.WithError(err).Warn("cannot sample resource usage")
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	// rates need two samples - if we're not observing we report the usage of the first second only
+	interval := resourceSampleInterval
+	if !req.Observe {
+		interval = 1 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-srv.Context().Done():
+			return nil
+		}
+
+		cur, err := s.Resources.Sample()
+		if err != nil {
+			log.WithError(err).Warn("cannot sample resource usage")
+			return status.Error(codes.Internal, err.Error())
+		}
+		resp := resources.Usage(prev, cur, top)
+		resp.Disk.Location = s.Resources.DiskLocation
+		err = srv.Send(resp)
+		if err != nil {
+			return err
+		}
+		if !req.Observe {
+			return nil
+		}
+		prev = cur
+	}
+}
+
 func (s *statusService) BackupStatus(ctx context.Context, req *api.BackupStatusRequest) (*api.BackupStatusResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	// ws-daemon offers its in-workspace status service only once the workspace content is initialized
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	client, conn, err := ConnectToInWorkspaceStatusService(ctx)
+	if err == ErrNoInWorkspaceDaemonService {
+		return nil, status.Error(codes.Unimplemented, "backup status is not available in this workspace")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "workspace daemon is not available")
+	}
+	defer conn.Close()
+
+	resp, err := client.BackupStatus(ctx, &daemon.BackupStatusRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &api.BackupStatusResponse{
+		LastBackup: resp.LastBackup,
+	}, nil
 }
 
 func (s *statusService) PortsStatus(req *api.PortsStatusRequest, srv api.StatusService_PortsStatusServer) error {
@@ -217,6 +322,41 @@ func (s *statusService) TasksStatus(req *api.TasksStatusRequest, srv api.StatusS
 	}
 }
 
+func (s *statusService) ServicesStatus(req *api.ServicesStatusRequest, srv api.StatusService_ServicesStatusServer) error {
+	select {
+	case <-srv.Context().Done():
+		return nil
+	case <-s.Services.ready:
+	}
+
+	if !req.Observe {
+		return srv.Send(&api.ServicesStatusResponse{
+			Services: s.Services.Status(),
+		})
+	}
+
+	sub := s.Services.Subscribe()
+	if sub == nil {
+		return status.Error(codes.ResourceExhausted, "too many subscriptions")
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case update := <-sub.Updates():
+			if update == nil {
+				return nil
+			}
+			err := srv.Send(&api.ServicesStatusResponse{Services: update})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // RegistrableTokenService can register the token service
 type RegistrableTokenService struct {
 	Service api.TokenServiceServer
@@ -232,11 +372,23 @@ func (s RegistrableTokenService) RegisterREST(mux *runtime.ServeMux, grpcEndpoin
 	return api.RegisterTokenServiceHandlerFromEndpoint(context.Background(), mux, grpcEndpoint, []grpc.DialOption{grpc.WithInsecure()})
 }
 
+const (
+	// tokenAuditLogSize is the number of token requests we remember
+	tokenAuditLogSize = 256
+	// tokenRefreshInterval is the interval in which we look for tokens which are about to expire
+	tokenRefreshInterval = 1 * time.Minute
+	// tokenRefreshWindow is the time before their expiry when we refresh tokens
+	tokenRefreshWindow = 5 * time.Minute
+	// tokenRefreshTimeout is the time we give a provider to refresh a token
+	tokenRefreshTimeout = 30 * time.Second
+)
+
 // NewInMemoryTokenService produces a new InMemoryTokenService
 func NewInMemoryTokenService() *InMemoryTokenService {
 	return &InMemoryTokenService{
-		token:    make(map[string][]*Token),
-		provider: make(map[string][]tokenProvider),
+		token:          make(map[string][]*Token),
+		provider:       make(map[string][]tokenProvider),
+		callerResolver: resolveTokenCaller,
 	}
 }
 
@@ -248,6 +400,10 @@ type Token struct {
 	Scope      map[string]struct{}
 	ExpiryDate *time.Time
 	Reuse      api.TokenReuse
+
+	// request is the request the token was obtained from a provider with. It's nil for tokens
+	// which were set explicitly and hence cannot be refreshed.
+	request *api.GetTokenRequest
 }
 
 type tokenProvider interface {
@@ -256,18 +412,48 @@ type tokenProvider interface {
 
 // InMemoryTokenService provides an in-memory caching token service
 type InMemoryTokenService struct {
+	// Policies restrict which callers may request tokens. Policies must not change once the service is in use.
+	Policies []TokenAccessPolicy
+
 	token    map[string][]*Token
 	provider map[string][]tokenProvider
 	mu       sync.RWMutex
+
+	callerResolver tokenCallerResolver
+
+	auditLog []*api.TokenRequestRecord
+	auditMu  sync.Mutex
 }
 
 // GetToken returns a token for a host
 func (s *InMemoryTokenService) GetToken(ctx context.Context, req *api.GetTokenRequest) (*api.GetTokenResponse, error) {
+	caller, err := s.authorize(ctx, req)
+	if err != nil {
+		log.WithField("kind", req.Kind).WithField("host", req.Host).WithField("caller", caller).Warn("denied token request")
+		s.audit(req, caller, api.TokenRequestOutcome_DENIED, status.Convert(err).Message())
+		return nil, err
+	}
+
 	tkn, ok := s.getCachedTokenFor(req.Kind, req.Host, req.Scope)
 	if ok {
+		s.audit(req, caller, api.TokenRequestOutcome_GRANTED_CACHED, "")
 		return &api.GetTokenResponse{Token: tkn}, nil
 	}
 
+	ptkn := s.fromProviders(ctx, req)
+	if ptkn != nil {
+		s.cacheToken(req.Kind, ptkn)
+		s.audit(req, caller, api.TokenRequestOutcome_GRANTED_PROVIDER, "")
+		return &api.GetTokenResponse{Token: ptkn.Token, User: ptkn.User}, nil
+	}
+
+	err = status.Error(codes.NotFound, "no token available")
+	s.audit(req, caller, api.TokenRequestOutcome_NOT_FOUND, status.Convert(err).Message())
+	return nil, err
+}
+
+// fromProviders asks the providers registered for the kind of token in turn, until one provides a token
+func (s *InMemoryTokenService) fromProviders(ctx context.Context, req *api.GetTokenRequest) *Token {
 	s.mu.RLock()
 	prov := s.provider[req.Kind]
 	s.mu.RUnlock()
@@ -282,11 +468,106 @@ func (s *InMemoryTokenService) GetToken(ctx context.Context, req *api.GetTokenRe
 			continue
 		}
 
-		s.cacheToken(req.Kind, tkn)
-		return &api.GetTokenResponse{Token: tkn.Token, User: tkn.User}, nil
+		tkn.request = req
+		return tkn
+	}
+	return nil
+}
+
+// Run refreshes tokens from their providers before they expire
+func (s *InMemoryTokenService) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	ticker := time.NewTicker(tokenRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.refreshTokens(ctx)
+		}
+	}
+}
+
+func (s *InMemoryTokenService) refreshTokens(ctx context.Context) {
+	type expiringToken struct {
+		Kind  string
+		Token *Token
+	}
+	var expiring []expiringToken
+	s.mu.RLock()
+	for kind, tkns := range s.token {
+		for _, tkn := range tkns {
+			if tkn.request == nil || tkn.ExpiryDate == nil || time.Until(*tkn.ExpiryDate) > tokenRefreshWindow {
+				continue
+			}
+			expiring = append(expiring, expiringToken{Kind: kind, Token: tkn})
+		}
+	}
+	s.mu.RUnlock()
+
+	for _, e := range expiring {
+		rctx, cancel := context.WithTimeout(ctx, tokenRefreshTimeout)
+		tkn := s.fromProviders(rctx, e.Token.request)
+		cancel()
+		if tkn == nil {
+			log.WithField("kind", e.Kind).WithField("host", e.Token.Host).Warn("cannot refresh token before it expires")
+			continue
+		}
+
+		var replaced bool
+		s.mu.Lock()
+		for i, t := range s.token[e.Kind] {
+			if t == e.Token {
+				s.token[e.Kind][i] = tkn
+				replaced = true
+				break
+			}
+		}
+		s.mu.Unlock()
+		if !replaced {
+			// the token was cleared in the meantime
+			continue
+		}
+
+		log.WithField("kind", e.Kind).WithField("host", tkn.Host).Info("refreshed token")
+		s.audit(tkn.request, nil, api.TokenRequestOutcome_REFRESHED, "")
 	}
+}
+
+func (s *InMemoryTokenService) audit(req *api.GetTokenRequest, caller *api.TokenCaller, outcome api.TokenRequestOutcome, reason string) {
+	rec := &api.TokenRequestRecord{
+		Time:    ptypes.TimestampNow(),
+		Kind:    req.Kind,
+		Host:    req.Host,
+		Scope:   append([]string(nil), req.Scope...),
+		Caller:  caller,
+		Outcome: outcome,
+		Reason:  reason,
+	}
+
+	s.auditMu.Lock()
+	defer s.auditMu.Unlock()
+	s.auditLog = append(s.auditLog, rec)
+	if len(s.auditLog) > tokenAuditLogSize {
+		s.auditLog = s.auditLog[len(s.auditLog)-tokenAuditLogSize:]
+	}
+}
+
+// ListTokenRequests lists the most recent token requests
+func (s *InMemoryTokenService) ListTokenRequests(ctx context.Context, req *api.ListTokenRequestsRequest) (*api.ListTokenRequestsResponse, error) {
+	s.auditMu.Lock()
+	defer s.auditMu.Unlock()
 
-	return nil, status.Error(codes.NotFound, "no token available")
+	res := make([]*api.TokenRequestRecord, 0, len(s.auditLog))
+	for _, rec := range s.auditLog {
+		if req.Kind != "" && rec.Kind != req.Kind {
+			continue
+		}
+		res = append(res, rec)
+	}
+	return &api.ListTokenRequestsResponse{Requests: res}, nil
 }
 
 func (s *InMemoryTokenService) getCachedTokenFor(kind string, host string, scopes []string) (tkn string, ok bool) {
@@ -520,7 +801,11 @@ func (rt *remoteTokenProvider) GetToken(ctx context.Context, req *api.GetTokenRe
 		Err:  make(chan error, 1),
 		Resp: make(chan *Token, 1),
 	}
-	rt.inc <- rr
+	select {
+	case <-ctx.Done():
+		return nil, status.Error(codes.DeadlineExceeded, ctx.Err().Error())
+	case rt.inc <- rr:
+	}
 
 	select {
 	case <-ctx.Done():
@@ -584,7 +869,9 @@ func (is *InfoService) WorkspaceInfo(context.Context, *api.WorkspaceInfoRequest)
 
 // ControlService implements the supervisor control service
 type ControlService struct {
-	portsManager *ports.Manager
+	portsManager    *ports.Manager
+	servicesManager *servicesManager
+	configReloader  *configReloader
 }
 
 // RegisterGRPC registers the gRPC info service
@@ -598,6 +885,66 @@ func (c *ControlService) ExposePort(ctx context.Context, req *api.ExposePortRequ
 	return &api.ExposePortResponse{}, err
 }
 
+// StartService starts a stopped or failed background service
+func (c *ControlService) StartService(ctx context.Context, req *api.StartServiceRequest) (*api.StartServiceResponse, error) {
+	err := c.servicesManager.Start(req.Name)
+	if err != nil {
+		return nil, serviceControlError(err)
+	}
+	return &api.StartServiceResponse{}, nil
+}
+
+// StopService stops a background service
+func (c *ControlService) StopService(ctx context.Context, req *api.StopServiceRequest) (*api.StopServiceResponse, error) {
+	err := c.servicesManager.Stop(ctx, req.Name)
+	if err != nil {
+		return nil, serviceControlError(err)
+	}
+	return &api.StopServiceResponse{}, nil
+}
+
+// ReloadConfig applies the tasks and ports configured in .gitpod.yml to the running workspace
+func (c *ControlService) ReloadConfig(ctx context.Context, req *api.ReloadConfigRequest) (*api.ReloadConfigResponse, error) {
+	if c.configReloader == nil {
+		return nil, status.Error(codes.Unavailable, "config reloading is not available")
+	}
+	return c.configReloader.Reload(ctx, req)
+}
+
+// RestartService stops a background service (if it's running) and starts it again
+func (c *ControlService) RestartService(ctx context.Context, req *api.RestartServiceRequest) (*api.RestartServiceResponse, error) {
+	err := c.servicesManager.Restart(ctx, req.Name)
+	if err != nil {
+		return nil, serviceControlError(err)
+	}
+	return &api.RestartServiceResponse{}, nil
+}
+
+// CloseTunnel closes a reverse tunnel and all connections forwarded through it
+func (c *ControlService) CloseTunnel(ctx context.Context, req *api.CloseTunnelRequest) (*api.CloseTunnelResponse, error) {
+	err := c.portsManager.CloseTunnel(req.Port)
+	if err == ports.ErrTunnelNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.CloseTunnelResponse{}, nil
+}
+
+func serviceControlError(err error) error {
+	switch err {
+	case ErrServiceNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case ErrServicesNotStarted:
+		return status.Error(codes.Unavailable, err.Error())
+	case context.Canceled, context.DeadlineExceeded:
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
 // ContentState signals the workspace content state
 type ContentState interface {
 	MarkContentReady(src csapi.WorkspaceInitSource)
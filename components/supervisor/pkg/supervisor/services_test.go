@@ -382,3 +382,35 @@ type tokenProviderFunc func(ctx context.Context, req *api.GetTokenRequest) (tkn
 func (f tokenProviderFunc) GetToken(ctx context.Context, req *api.GetTokenRequest) (tkn *Token, err error) {
 	return f(ctx, req)
 }
+
+func TestInMemoryTokenServiceRefresh(t *testing.T) {
+	var (
+		soon  = time.Now().Add(tokenRefreshWindow / 2)
+		later = time.Now().Add(2 * time.Hour)
+		req   = &api.GetTokenRequest{Kind: KindGit, Host: "github.com"}
+	)
+
+	service := NewInMemoryTokenService()
+	service.provider[KindGit] = []tokenProvider{tokenProviderFunc(func(ctx context.Context, req *api.GetTokenRequest) (tkn *Token, err error) {
+		return &Token{Host: req.Host, Token: "new", ExpiryDate: &later, Reuse: api.TokenReuse_REUSE_WHEN_POSSIBLE}, nil
+	})}
+	service.token[KindGit] = []*Token{
+		{Host: "github.com", Token: "expiring", ExpiryDate: &soon, Reuse: api.TokenReuse_REUSE_WHEN_POSSIBLE, request: req},
+		{Host: "gitlab.com", Token: "explicit", ExpiryDate: &soon, Reuse: api.TokenReuse_REUSE_WHEN_POSSIBLE},
+	}
+
+	service.refreshTokens(context.Background())
+
+	var tkns []string
+	for _, tkn := range service.token[KindGit] {
+		tkns = append(tkns, tkn.Token)
+	}
+	if diff := cmp.Diff([]string{"new", "explicit"}, tkns); diff != "" {
+		t.Errorf("unexpected tokens (-want +got):\n%s", diff)
+	}
+
+	reqs, _ := service.ListTokenRequests(context.Background(), &api.ListTokenRequestsRequest{Kind: KindGit})
+	if len(reqs.Requests) != 1 || reqs.Requests[0].Outcome != api.TokenRequestOutcome_REFRESHED {
+		t.Errorf("expected one refresh to be audited, got %v", reqs.Requests)
+	}
+}
@@ -0,0 +1,601 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"net"
+	"os/exec"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/creack/pty"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/supervisor/pkg/terminal"
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// sshAuthorizedKeysTTL is the time we cache the public keys of the workspace owner
+	sshAuthorizedKeysTTL = 1 * time.Minute
+	// sshSessionGracePeriod is the time a session's process gets between SIGINT and SIGKILL once the client has left
+	sshSessionGracePeriod = 5 * time.Second
+)
+
+func startSSHServer(ctx context.Context, cfg *Config, wg *sync.WaitGroup, cst ContentState, srv *sshServer) {
+	defer wg.Done()
+
+	if cfg.SSHPort == 0 {
+		log.Info("SSH server is disabled")
+		return
+	}
+
+	// Sessions start in the workspace content, hence we have to wait for that content to become available.
+	select {
+	case <-cst.ContentReady():
+	case <-ctx.Done():
+		return
+	}
+
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.SSHPort))
+	if err != nil {
+		log.WithError(err).Error("cannot start SSH server")
+		return
+	}
+	go func() {
+		<-ctx.Done()
+		l.Close()
+	}()
+
+	log.WithField("port", cfg.SSHPort).Info("SSH server is up and running")
+	err = srv.Serve(l)
+	if err != nil && ctx.Err() == nil {
+		log.WithError(err).Error("SSH server stopped")
+	}
+}
+
+// sshServer serves SSH connections into the workspace. Users authenticate either using the
+// owner token as password, or using one of the public keys they registered with Gitpod.
+type sshServer struct {
+	OwnerToken     string
+	AuthorizedKeys func(ctx context.Context) ([]string, error)
+	Terminals      *terminal.MuxTerminalService
+
+	mu         sync.Mutex
+	keys       []ssh.PublicKey
+	keysExpiry time.Time
+}
+
+// Serve accepts SSH connections on the listener until the listener fails
+func (s *sshServer) Serve(l net.Listener) error {
+	cfg, err := s.serverConfig()
+	if err != nil {
+		return err
+	}
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go s.handleConn(conn, cfg)
+	}
+}
+
+func (s *sshServer) serverConfig() (*ssh.ServerConfig, error) {
+	hostKey, err := newSSHHostKey()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &ssh.ServerConfig{
+		PasswordCallback: func(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if s.OwnerToken == "" || subtle.ConstantTimeCompare([]byte(s.OwnerToken), password) != 1 {
+				return nil, xerrors.Errorf("invalid credentials")
+			}
+			return nil, nil
+		},
+		PublicKeyCallback: func(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			keys, err := s.authorizedKeys()
+			if err != nil {
+				log.WithError(err).Warn("cannot get authorized SSH keys")
+				return nil, xerrors.Errorf("invalid credentials")
+			}
+
+			marshalled := key.Marshal()
+			for _, k := range keys {
+				if subtle.ConstantTimeCompare(k.Marshal(), marshalled) == 1 {
+					return nil, nil
+				}
+			}
+			return nil, xerrors.Errorf("invalid credentials")
+		},
+	}
+	cfg.AddHostKey(hostKey)
+	return cfg, nil
+}
+
+// authorizedKeys returns the public keys the workspace owner registered with Gitpod
+func (s *sshServer) authorizedKeys() ([]ssh.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if time.Now().Before(s.keysExpiry) {
+		return s.keys, nil
+	}
+	if s.AuthorizedKeys == nil {
+		return nil, xerrors.Errorf("no authorized keys source available")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	raw, err := s.AuthorizedKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]ssh.PublicKey, 0, len(raw))
+	for _, r := range raw {
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(r))
+		if err != nil {
+			log.WithError(err).Warn("ignoring invalid SSH public key")
+			continue
+		}
+		keys = append(keys, key)
+	}
+	s.keys = keys
+	s.keysExpiry = time.Now().Add(sshAuthorizedKeysTTL)
+
+	return keys, nil
+}
+
+// newSSHHostKey generates the host key of the SSH server. We never store the key: anything under /workspace ends up in
+// backups and prebuild snapshots, and all workspaces started from the same snapshot would share the key.
+func newSSHHostKey() (ssh.Signer, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot generate SSH host key: %w", err)
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot generate SSH host key: %w", err)
+	}
+	return signer, nil
+}
+
+func (s *sshServer) handleConn(nconn net.Conn, cfg *ssh.ServerConfig) {
+	conn, chans, reqs, err := ssh.NewServerConn(nconn, cfg)
+	if err != nil {
+		log.WithError(err).Debug("SSH handshake failed")
+		nconn.Close()
+		return
+	}
+	log := log.WithField("remote", conn.RemoteAddr().String())
+	log.Info("new SSH connection")
+	defer log.Info("SSH connection closed")
+
+	fwd := &sshRemoteForwards{conn: conn, listener: make(map[string]net.Listener)}
+	defer fwd.Close()
+	go fwd.handleRequests(reqs)
+
+	for nc := range chans {
+		switch nc.ChannelType() {
+		case "session":
+			ch, reqs, err := nc.Accept()
+			if err != nil {
+				log.WithError(err).Warn("cannot accept SSH session")
+				continue
+			}
+			sess := &sshSession{srv: s, ch: ch, env: make(map[string]string)}
+			go sess.handleRequests(reqs)
+		case "direct-tcpip":
+			go handleSSHDirectTCPIP(nc)
+		default:
+			nc.Reject(ssh.UnknownChannelType, fmt.Sprintf("unknown channel type: %s", nc.ChannelType()))
+		}
+	}
+}
+
+// sshSession is a single SSH session, i.e. a shell, command or subsystem
+type sshSession struct {
+	srv *sshServer
+	ch  ssh.Channel
+
+	env  map[string]string
+	pty  *sshPtyRequest
+	term *terminal.Term
+
+	once sync.Once
+}
+
+type sshPtyRequest struct {
+	Term    string
+	Columns uint32
+	Rows    uint32
+	Width   uint32
+	Height  uint32
+	Modes   string
+}
+
+type sshWindowChangeRequest struct {
+	Columns uint32
+	Rows    uint32
+	Width   uint32
+	Height  uint32
+}
+
+func (sess *sshSession) handleRequests(reqs <-chan *ssh.Request) {
+	for req := range reqs {
+		var ok bool
+		switch req.Type {
+		case "pty-req":
+			var p sshPtyRequest
+			if ssh.Unmarshal(req.Payload, &p) == nil && sess.term == nil {
+				sess.pty = &p
+				ok = true
+			}
+		case "env":
+			var p struct{ Name, Value string }
+			if ssh.Unmarshal(req.Payload, &p) == nil {
+				sess.env[p.Name] = p.Value
+				ok = true
+			}
+		case "window-change":
+			var p sshWindowChangeRequest
+			if ssh.Unmarshal(req.Payload, &p) == nil && sess.term != nil {
+				ok = sess.term.Resize(&pty.Winsize{
+					Cols: uint16(p.Columns),
+					Rows: uint16(p.Rows),
+					X:    uint16(p.Width),
+					Y:    uint16(p.Height),
+				}) == nil
+			}
+		case "shell":
+			ok = sess.start("")
+		case "exec":
+			var p struct{ Command string }
+			if ssh.Unmarshal(req.Payload, &p) == nil {
+				ok = sess.start(p.Command)
+			}
+		case "subsystem":
+			var p struct{ Name string }
+			if ssh.Unmarshal(req.Payload, &p) == nil && p.Name == "sftp" {
+				ok = sess.startSFTP()
+			}
+		}
+		if req.WantReply {
+			req.Reply(ok, nil)
+		}
+	}
+}
+
+// start runs the command (or the shell if command is empty) in this session. Sessions can only
+// ever run a single command - all subsequent attempts fail.
+func (sess *sshSession) start(command string) (ok bool) {
+	sess.once.Do(func() {
+		ok = true
+
+		terms := sess.srv.Terminals
+		args := []string{}
+		if command != "" {
+			args = append(args, "-c", command)
+		}
+		cmd := exec.Command(terms.DefaultShell, args...)
+		cmd.Dir = terms.DefaultWorkdir
+		cmd.Env = append([]string{}, terms.Env...)
+		if sess.pty != nil {
+			cmd.Env = append(cmd.Env, "TERM="+sess.pty.Term)
+		}
+		for k, v := range sess.env {
+			cmd.Env = append(cmd.Env, k+"="+v)
+		}
+
+		if sess.pty == nil {
+			go sess.runWithoutPTY(cmd)
+			return
+		}
+
+		alias, err := terms.Mux.Start(cmd, terminal.TermOptions{
+			ReadTimeout: 5 * time.Second,
+			Size: &pty.Winsize{
+				Cols: uint16(sess.pty.Columns),
+				Rows: uint16(sess.pty.Rows),
+				X:    uint16(sess.pty.Width),
+				Y:    uint16(sess.pty.Height),
+			},
+		})
+		if err != nil {
+			log.WithError(err).Warn("cannot start SSH session terminal")
+			ok = false
+			return
+		}
+		term, exists := terms.Mux.Get(alias)
+		if !exists {
+			ok = false
+			return
+		}
+		sess.term = term
+
+		go sess.runWithPTY(alias, term)
+	})
+	return
+}
+
+func (sess *sshSession) runWithPTY(alias string, term *terminal.Term) {
+	stdout := term.Stdout.Listen()
+	outputDone := make(chan struct{})
+	go func() {
+		defer close(outputDone)
+		_, _ = io.Copy(sess.ch, stdout)
+	}()
+	go func() {
+		_, _ = io.Copy(term, sess.ch)
+
+		// the client has gone away - so should the terminal
+		_ = sess.srv.Terminals.Mux.CloseTerminal(alias, sshSessionGracePeriod)
+	}()
+
+	state, err := term.Wait()
+	<-outputDone
+	stdout.Close()
+
+	exitCode := 1
+	if err == nil && state != nil {
+		exitCode = state.ExitCode()
+	}
+	sess.exit(exitCode)
+}
+
+func (sess *sshSession) runWithoutPTY(cmd *exec.Cmd) {
+	cmd.Stdout = sess.ch
+	cmd.Stderr = sess.ch.Stderr()
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		log.WithError(err).Warn("cannot start SSH session command")
+		sess.exit(1)
+		return
+	}
+
+	err = cmd.Start()
+	if err != nil {
+		log.WithError(err).Warn("cannot start SSH session command")
+		sess.exit(127)
+		return
+	}
+	go func() {
+		_, _ = io.Copy(stdin, sess.ch)
+		stdin.Close()
+	}()
+
+	err = cmd.Wait()
+	exitCode := 0
+	if err != nil {
+		exitCode = 1
+		if eerr, ok := err.(*exec.ExitError); ok {
+			exitCode = eerr.ExitCode()
+		}
+	}
+	sess.exit(exitCode)
+}
+
+func (sess *sshSession) startSFTP() (ok bool) {
+	sess.once.Do(func() {
+		ok = true
+		go func() {
+			srv, err := sftp.NewServer(sess.ch)
+			if err != nil {
+				log.WithError(err).Warn("cannot start SFTP server")
+				sess.exit(1)
+				return
+			}
+			err = srv.Serve()
+			if err != nil && err != io.EOF {
+				log.WithError(err).Warn("SFTP session failed")
+			}
+			srv.Close()
+			sess.exit(0)
+		}()
+	})
+	return
+}
+
+func (sess *sshSession) exit(code int) {
+	_, _ = sess.ch.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{uint32(code)}))
+	sess.ch.Close()
+}
+
+// sshForwardRequest is the payload of direct-tcpip and forwarded-tcpip channels
+type sshForwardRequest struct {
+	DestAddr   string
+	DestPort   uint32
+	OriginAddr string
+	OriginPort uint32
+}
+
+// handleSSHDirectTCPIP serves local port forwarding, i.e. connections from the client into the workspace
+func handleSSHDirectTCPIP(nc ssh.NewChannel) {
+	var req sshForwardRequest
+	err := ssh.Unmarshal(nc.ExtraData(), &req)
+	if err != nil {
+		nc.Reject(ssh.ConnectionFailed, "invalid forward request")
+		return
+	}
+
+	conn, err := net.Dial("tcp", net.JoinHostPort(req.DestAddr, strconv.Itoa(int(req.DestPort))))
+	if err != nil {
+		nc.Reject(ssh.ConnectionFailed, err.Error())
+		return
+	}
+	ch, reqs, err := nc.Accept()
+	if err != nil {
+		conn.Close()
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+
+	pipeSSHChannel(ch, conn)
+}
+
+// sshRemoteForwards serves remote port forwarding, i.e. connections from within the workspace to the client
+type sshRemoteForwards struct {
+	conn *ssh.ServerConn
+
+	mu       sync.Mutex
+	listener map[string]net.Listener
+}
+
+type sshTCPIPForwardRequest struct {
+	BindAddr string
+	BindPort uint32
+}
+
+func (f *sshRemoteForwards) handleRequests(reqs <-chan *ssh.Request) {
+	for req := range reqs {
+		switch req.Type {
+		case "tcpip-forward":
+			var p sshTCPIPForwardRequest
+			if ssh.Unmarshal(req.Payload, &p) != nil {
+				req.Reply(false, nil)
+				continue
+			}
+			port, err := f.listen(p)
+			if err != nil {
+				log.WithError(err).WithField("addr", p.BindAddr).WithField("port", p.BindPort).Warn("cannot forward remote port")
+				req.Reply(false, nil)
+				continue
+			}
+			if p.BindPort == 0 {
+				req.Reply(true, ssh.Marshal(struct{ Port uint32 }{port}))
+			} else {
+				req.Reply(true, nil)
+			}
+		case "cancel-tcpip-forward":
+			var p sshTCPIPForwardRequest
+			if ssh.Unmarshal(req.Payload, &p) != nil {
+				req.Reply(false, nil)
+				continue
+			}
+			req.Reply(f.cancel(p), nil)
+		default:
+			if req.WantReply {
+				req.Reply(false, nil)
+			}
+		}
+	}
+}
+
+func (f *sshRemoteForwards) listen(p sshTCPIPForwardRequest) (port uint32, err error) {
+	l, err := net.Listen("tcp", net.JoinHostPort(p.BindAddr, strconv.Itoa(int(p.BindPort))))
+	if err != nil {
+		return 0, err
+	}
+	port = uint32(l.Addr().(*net.TCPAddr).Port)
+	bound := sshTCPIPForwardRequest{BindAddr: p.BindAddr, BindPort: port}
+
+	f.mu.Lock()
+	f.listener[bound.key()] = l
+	if p.BindPort == 0 {
+		// clients cancel forwards using the port they requested
+		f.listener[p.key()] = l
+	}
+	f.mu.Unlock()
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go f.forward(bound, conn)
+		}
+	}()
+	return port, nil
+}
+
+func (f *sshRemoteForwards) forward(bound sshTCPIPForwardRequest, conn net.Conn) {
+	var (
+		originAddr = conn.RemoteAddr().String()
+		originPort int
+	)
+	if host, port, err := net.SplitHostPort(originAddr); err == nil {
+		originAddr = host
+		originPort, _ = strconv.Atoi(port)
+	}
+
+	ch, reqs, err := f.conn.OpenChannel("forwarded-tcpip", ssh.Marshal(sshForwardRequest{
+		DestAddr:   bound.BindAddr,
+		DestPort:   bound.BindPort,
+		OriginAddr: originAddr,
+		OriginPort: uint32(originPort),
+	}))
+	if err != nil {
+		conn.Close()
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+
+	pipeSSHChannel(ch, conn)
+}
+
+func (f *sshRemoteForwards) cancel(p sshTCPIPForwardRequest) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	l, ok := f.listener[p.key()]
+	if !ok {
+		return false
+	}
+	l.Close()
+	for k, v := range f.listener {
+		if v == l {
+			delete(f.listener, k)
+		}
+	}
+	return true
+}
+
+// Close stops all remote forwards of this connection
+func (f *sshRemoteForwards) Close() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for k, l := range f.listener {
+		l.Close()
+		delete(f.listener, k)
+	}
+}
+
+func (p sshTCPIPForwardRequest) key() string {
+	return net.JoinHostPort(p.BindAddr, strconv.Itoa(int(p.BindPort)))
+}
+
+// pipeSSHChannel copies data between an SSH channel and a connection until either side is done
+func pipeSSHChannel(ch ssh.Channel, conn net.Conn) {
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(ch, conn)
+		ch.CloseWrite()
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(conn, ch)
+		if c, ok := conn.(interface{ CloseWrite() error }); ok {
+			c.CloseWrite()
+		}
+		done <- struct{}{}
+	}()
+	<-done
+	<-done
+	ch.Close()
+	conn.Close()
+}
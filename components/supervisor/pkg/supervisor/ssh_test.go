@@ -0,0 +1,212 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/gitpod-io/gitpod/supervisor/pkg/terminal"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestSSHServer(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "supervisor-ssh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	_, clientKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientSigner, err := ssh.NewSignerFromKey(clientKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	terms := terminal.NewMuxTerminalService(terminal.NewMux())
+	terms.DefaultWorkdir = tmpdir
+	srv := &sshServer{
+		OwnerToken: "owner-token",
+		AuthorizedKeys: func(ctx context.Context) ([]string, error) {
+			return []string{"not-a-key", string(ssh.MarshalAuthorizedKey(clientSigner.PublicKey()))}, nil
+		},
+		Terminals: terms,
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go srv.Serve(l)
+
+	dial := func(auth ssh.AuthMethod) (*ssh.Client, error) {
+		return ssh.Dial("tcp", l.Addr().String(), &ssh.ClientConfig{
+			User:            "gitpod",
+			Auth:            []ssh.AuthMethod{auth},
+			HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		})
+	}
+
+	t.Run("wrong password", func(t *testing.T) {
+		_, err := dial(ssh.Password("foobar"))
+		if err == nil {
+			t.Error("expected authentication to fail")
+		}
+	})
+	t.Run("unknown public key", func(t *testing.T) {
+		_, key, _ := ed25519.GenerateKey(rand.Reader)
+		signer, _ := ssh.NewSignerFromKey(key)
+		_, err := dial(ssh.PublicKeys(signer))
+		if err == nil {
+			t.Error("expected authentication to fail")
+		}
+	})
+	t.Run("public key", func(t *testing.T) {
+		client, err := dial(ssh.PublicKeys(clientSigner))
+		if err != nil {
+			t.Fatalf("cannot authenticate: %v", err)
+		}
+		client.Close()
+	})
+
+	client, err := dial(ssh.Password("owner-token"))
+	if err != nil {
+		t.Fatalf("cannot authenticate using the owner token: %v", err)
+	}
+	defer client.Close()
+
+	t.Run("host key is not shared", func(t *testing.T) {
+		key, err := newSSHHostKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		other, err := newSSHHostKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Equal(key.PublicKey().Marshal(), other.PublicKey().Marshal()) {
+			t.Error("host keys are equal")
+		}
+	})
+
+	t.Run("exec", func(t *testing.T) {
+		sess, err := client.NewSession()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer sess.Close()
+
+		sess.Setenv("GREETING", "hello")
+		out, err := sess.Output("echo $GREETING; exit 3")
+		if string(out) != "hello\n" {
+			t.Errorf("unexpected output: %q", string(out))
+		}
+		if eerr, ok := err.(*ssh.ExitError); !ok || eerr.ExitStatus() != 3 {
+			t.Errorf("expected exit status 3, got %v", err)
+		}
+	})
+
+	t.Run("pty", func(t *testing.T) {
+		sess, err := client.NewSession()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer sess.Close()
+
+		err = sess.RequestPty("xterm", 24, 80, ssh.TerminalModes{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := sess.Output("echo $TERM")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !bytes.Contains(out, []byte("xterm")) {
+			t.Errorf("unexpected output: %q", string(out))
+		}
+	})
+
+	t.Run("local forward", func(t *testing.T) {
+		echo := startEchoServer(t)
+		defer echo.Close()
+
+		conn, err := client.Dial("tcp", echo.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+		expectEcho(t, conn)
+	})
+
+	t.Run("remote forward", func(t *testing.T) {
+		rl, err := client.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rl.Close()
+		go func() {
+			conn, err := rl.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			io.Copy(conn, conn)
+		}()
+
+		conn, err := net.Dial("tcp", rl.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+		expectEcho(t, conn)
+	})
+}
+
+func startEchoServer(t *testing.T) net.Listener {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+	return l
+}
+
+func expectEcho(t *testing.T, conn io.ReadWriter) {
+	msg := []byte("hello world")
+	_, err := conn.Write(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, len(msg))
+	_, err = io.ReadFull(conn, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf, msg) {
+		t.Errorf("unexpected echo: %q", string(buf))
+	}
+}
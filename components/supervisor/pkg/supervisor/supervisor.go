@@ -7,6 +7,7 @@ package supervisor
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net"
@@ -14,6 +15,7 @@ import (
 	"os"
 	"os/exec"
 	"os/signal"
+	"path/filepath"
 	"runtime"
 	"strconv"
 	"strings"
@@ -29,6 +31,7 @@ import (
 	"github.com/gitpod-io/gitpod/supervisor/pkg/dropwriter"
 	"github.com/gitpod-io/gitpod/supervisor/pkg/gitpod"
 	"github.com/gitpod-io/gitpod/supervisor/pkg/ports"
+	"github.com/gitpod-io/gitpod/supervisor/pkg/resources"
 	"github.com/gitpod-io/gitpod/supervisor/pkg/terminal"
 	daemon "github.com/gitpod-io/gitpod/ws-daemon/api"
 	"golang.org/x/sys/unix"
@@ -119,6 +122,7 @@ func Run(options ...RunOption) {
 	configureGit(cfg)
 
 	tokenService := NewInMemoryTokenService()
+	tokenService.Policies = cfg.TokenAccessPolicies
 	tkns, err := cfg.GetTokens(true)
 	if err != nil {
 		log.WithError(err).Warn("cannot prepare tokens")
@@ -137,45 +141,99 @@ func Run(options ...RunOption) {
 		cstate              = NewInMemoryContentState(cfg.RepoRoot)
 		gitpodService       = createGitpodService(cfg, tokenService)
 		gitpodConfigService = gitpod.NewConfigService(cfg.RepoRoot+"/.gitpod.yml", cstate.ContentReady())
+		configReloader      = &configReloader{Location: cfg.RepoRoot + "/.gitpod.yml", Config: gitpodConfigService}
 		portMgmt            = ports.NewManager(
 			createExposedPortsImpl(cfg, gitpodService),
 			&ports.PollingServedPortsObserver{
 				RefreshInterval: 2 * time.Second,
 			},
-			ports.NewConfigService(cfg.WorkspaceID, gitpodConfigService, gitpodService),
+			ports.NewConfigService(cfg.WorkspaceID, configReloader, gitpodService),
 			uint32(cfg.IDEPort),
 			uint32(cfg.APIEndpointPort),
+			uint32(cfg.SSHPort),
 		)
-		termMux     = terminal.NewMux()
-		termMuxSrv  = terminal.NewMuxTerminalService(termMux)
-		taskManager = newTasksManager(cfg, termMuxSrv, cstate, &loggingHeadlessTaskProgressReporter{})
+		termMux         = terminal.NewMux()
+		termMuxSrv      = terminal.NewMuxTerminalService(termMux)
+		taskManager     = newTasksManager(cfg, termMuxSrv, cstate, &loggingHeadlessTaskProgressReporter{})
+		servicesManager = newServicesManager(cfg, cstate)
 	)
 	tokenService.provider[KindGit] = []tokenProvider{NewGitTokenProvider(gitpodService)}
+	configReloader.Tasks = taskManager
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		log.WithError(err).Warn("cannot determine home directory - assuming /home/gitpod")
+		home = "/home/gitpod"
+	}
+	dotfiles := &dotfilesInstaller{
+		Repository: cfg.DotfileRepo,
+		Home:       home,
+		Tokens:     tokenService,
+		Env:        buildIDEEnv(cfg),
+	}
+	// tasks run in the environment the user's dotfiles set up
+	taskManager.prerequisites = append(taskManager.prerequisites, dotfiles.Done())
+	gitStatus := &gitStatusReporter{
+		Location:     cfg.RepoRoot,
+		ContentState: cstate,
+		Report:       reportGitStatusToDaemon,
+	}
 
 	termMuxSrv.DefaultWorkdir = cfg.RepoRoot
 	termMuxSrv.Env = buildIDEEnv(cfg)
+	if rec := cfg.TerminalRecording; rec != nil {
+		termMux.Recordings = &terminal.RecordingStore{
+			Location:         rec.Location,
+			MaxRecordings:    rec.MaxRecordings,
+			MaxRecordingSize: rec.MaxRecordingSize,
+			RecordInput:      rec.RecordInput,
+		}
+	}
+
+	sshSrv := &sshServer{
+		OwnerToken: cfg.OwnerToken,
+		Terminals:  termMuxSrv,
+	}
+	if gitpodService != nil {
+		sshSrv.AuthorizedKeys = gitpodService.GetSSHPublicKeys
+	}
 
 	apiServices := []RegisterableService{
 		&statusService{
 			ContentState: cstate,
 			Ports:        portMgmt,
 			Tasks:        taskManager,
-			ideReady:     ideReady,
+			Services:     servicesManager,
+			Dotfiles:     dotfiles,
+			Resources: &resources.Sampler{
+				CgroupRoot:        "/sys/fs/cgroup",
+				ProcRoot:          "/proc",
+				DiskLocation:      "/workspace",
+				DiskUsageInterval: 30 * time.Second,
+			},
+			ideReady: ideReady,
 		},
 		termMuxSrv,
 		RegistrableTokenService{tokenService},
 		&InfoService{cfg: cfg},
-		&ControlService{portsManager: portMgmt},
+		&ControlService{portsManager: portMgmt, servicesManager: servicesManager, configReloader: configReloader},
+		&tunnelService{OwnerToken: cfg.OwnerToken, Ports: portMgmt},
+		newNotificationService(),
 	}
 	apiServices = append(apiServices, additionalServices...)
 
 	var wg sync.WaitGroup
-	wg.Add(6)
+	wg.Add(11)
 	go reaper(ctx, &wg)
 	go startAndWatchIDE(ctx, cfg, &wg, ideReady)
 	go startContentInit(ctx, cfg, &wg, cstate)
 	go startAPIEndpoint(ctx, cfg, &wg, apiServices, apiEndpointOpts...)
+	go startSSHServer(ctx, cfg, &wg, cstate, sshSrv)
 	go taskManager.Run(ctx, &wg)
+	go servicesManager.Run(ctx, &wg)
+	go tokenService.Run(ctx, &wg)
+	go dotfiles.Run(ctx, &wg)
+	go gitStatus.Run(ctx, &wg)
 	go func() {
 		defer wg.Done()
 		portMgmt.Run()
@@ -225,6 +283,7 @@ func createGitpodService(cfg *Config, tknsrv api.TokenServiceServer) *gitpod.API
 			"function:getToken",
 			"function:openPort",
 			"function:getOpenPorts",
+			"function:getSSHPublicKeys",
 		},
 	})
 	if err != nil {
@@ -340,6 +399,7 @@ func startAndWatchIDE(ctx context.Context, cfg *Config, wg *sync.WaitGroup, ideR
 	var (
 		cmd        *exec.Cmd
 		ideStopped chan struct{}
+		backoff    restartBackoff
 	)
 supervisorLoop:
 	for {
@@ -348,6 +408,7 @@ supervisorLoop:
 		}
 
 		ideStopped = make(chan struct{}, 1)
+		started := time.Now()
 		go func() {
 			cmd = prepareIDELaunch(cfg)
 
@@ -386,11 +447,18 @@ supervisorLoop:
 
 		select {
 		case <-ideStopped:
-			// IDE was stopped - let's just restart it after a small delay (in case the IDE doesn't start at all) in the next round
+			// IDE was stopped - let's just restart it in the next round. We back off in case the IDE keeps crashing.
 			if s == statusShouldShutdown {
 				break supervisorLoop
 			}
-			time.Sleep(1 * time.Second)
+			delay := backoff.Next(time.Since(started))
+			log.WithField("delay", delay.String()).Info("restarting IDE")
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				// the IDE is down already - there's nothing left to shut down
+				break supervisorLoop
+			}
 		case <-ctx.Done():
 			// we've been asked to shut down
 			s = statusShouldShutdown
@@ -534,6 +602,7 @@ func startAPIEndpoint(ctx context.Context, cfg *Config, wg *sync.WaitGroup, serv
 	grpcMux := m.MatchWithWriters(cmux.HTTP2MatchHeaderFieldSendSettings("content-type", "application/grpc"))
 	grpcServer := grpc.NewServer(opts...)
 	grpcEndpoint := fmt.Sprintf("localhost:%d", cfg.APIEndpointPort)
+	routes := http.NewServeMux()
 	for _, reg := range services {
 		if reg, ok := reg.(RegisterableGRPCService); ok {
 			reg.RegisterGRPC(grpcServer)
@@ -544,11 +613,13 @@ func startAPIEndpoint(ctx context.Context, cfg *Config, wg *sync.WaitGroup, serv
 				log.WithError(err).Fatal("cannot register REST service")
 			}
 		}
+		if reg, ok := reg.(RegisterableHTTPService); ok {
+			reg.RegisterHTTP(routes)
+		}
 	}
 	go grpcServer.Serve(grpcMux)
 
 	httpMux := m.Match(cmux.HTTP1Fast())
-	routes := http.NewServeMux()
 	routes.Handle("/_supervisor/v1/", http.StripPrefix("/_supervisor", restMux))
 	routes.Handle("/_supervisor/frontend", http.FileServer(http.Dir(cfg.FrontendLocation)))
 	go http.Serve(httpMux, routes)
@@ -653,9 +724,37 @@ func callDaemonTeardown() {
 	}
 }
 
+// ErrNoInWorkspaceDaemonService is returned when ws-daemon does not offer its InWorkspaceService to this workspace.
+// Only user-namespaced and full workspace backup workspaces get the service.
+var ErrNoInWorkspaceDaemonService = errors.New("ws-daemon does not serve this workspace")
+
 // ConnectToInWorkspaceDaemonService attempts to connect to the InWorkspaceService offered by the ws-daemon.
 func ConnectToInWorkspaceDaemonService(ctx context.Context) (daemon.InWorkspaceServiceClient, *grpc.ClientConn, error) {
-	const socketFN = "/.workspace/daemon.sock"
+	conn, err := dialWorkspaceDaemonSocket(ctx, "/.workspace/daemon.sock")
+	if err != nil {
+		return nil, nil, err
+	}
+	return daemon.NewInWorkspaceServiceClient(conn), conn, nil
+}
+
+// inWorkspaceStatusSocket is the socket on which ws-daemon serves the InWorkspaceStatusService
+var inWorkspaceStatusSocket = "/.workspace-status/status.sock"
+
+// ConnectToInWorkspaceStatusService attempts to connect to the InWorkspaceStatusService offered by the ws-daemon.
+// Contrary to the InWorkspaceService, ws-daemon offers this service to every workspace.
+func ConnectToInWorkspaceStatusService(ctx context.Context) (daemon.InWorkspaceStatusServiceClient, *grpc.ClientConn, error) {
+	conn, err := dialWorkspaceDaemonSocket(ctx, inWorkspaceStatusSocket)
+	if err != nil {
+		return nil, nil, err
+	}
+	return daemon.NewInWorkspaceStatusServiceClient(conn), conn, nil
+}
+
+func dialWorkspaceDaemonSocket(ctx context.Context, socketFN string) (*grpc.ClientConn, error) {
+	if _, err := os.Stat(filepath.Dir(socketFN)); os.IsNotExist(err) {
+		// ws-manager mounts the socket's directory only if ws-daemon serves the workspace
+		return nil, ErrNoInWorkspaceDaemonService
+	}
 
 	t := time.NewTicker(500 * time.Millisecond)
 	defer t.Stop()
@@ -668,13 +767,9 @@ func ConnectToInWorkspaceDaemonService(ctx context.Context) (daemon.InWorkspaceS
 		case <-t.C:
 			continue
 		case <-ctx.Done():
-			return nil, nil, fmt.Errorf("socket did not appear before context was canceled")
+			return nil, fmt.Errorf("socket did not appear before context was canceled")
 		}
 	}
 
-	conn, err := grpc.DialContext(ctx, "unix://"+socketFN, grpc.WithInsecure())
-	if err != nil {
-		return nil, nil, err
-	}
-	return daemon.NewInWorkspaceServiceClient(conn), conn, nil
+	return grpc.DialContext(ctx, "unix://"+socketFN, grpc.WithInsecure())
 }
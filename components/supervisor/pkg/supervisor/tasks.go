@@ -7,19 +7,26 @@ package supervisor
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
+	"net/http"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/supervisor/api"
 	"github.com/gitpod-io/gitpod/supervisor/pkg/terminal"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 )
 
 type tasksSubscription struct {
@@ -60,21 +67,78 @@ func (tm *tasksManager) Subscribe() *tasksSubscription {
 	return sub
 }
 
+const (
+	// phaseCommand is the name of the task phase which runs the main command
+	phaseCommand = "command"
+
+	// waitForInterval is the time between two checks of a task's waitFor condition
+	waitForInterval = 1 * time.Second
+
+	// readinessInterval is the time between two readiness checks of a task
+	readinessInterval = 2 * time.Second
+)
+
 type task struct {
 	api.TaskStatus
 	config      TaskConfig
 	command     string
 	successChan chan bool
+
+	// dependsOn are the tasks this task waits for before it starts
+	dependsOn []*task
+	// invalid describes why this task cannot be run, e.g. because its dependencies are unknown
+	invalid string
+	// lastSetupPhase is the name of the last phase before the main command.
+	// Dependent tasks can start once this phase has succeeded.
+	lastSetupPhase string
+	// failure describes why the task failed without running, e.g. because a dependency failed
+	failure string
+	// settled is closed once the task has either completed its setup phases or failed
+	settled    chan struct{}
+	settleOnce sync.Once
+	// stopReadiness stops the readiness checks of the currently running command. Guarded by the task manager's mu.
+	stopReadiness context.CancelFunc
+	// cancelStart stops waiting for the task's dependencies and waitFor condition
+	cancelStart context.CancelFunc
+	// exited is closed once the task's terminal has exited and its phase reporting has ended
+	exited chan struct{}
+}
+
+// taskPhase is a named part of a task command, e.g. its init command
+type taskPhase struct {
+	// name is the name we report the phase's progress under. Phases without name are not reported.
+	name    string
+	command *string
+}
+
+// taskFailure describes a failed task
+type taskFailure struct {
+	TaskID   string
+	Task     string
+	Phase    string
+	ExitCode int32
+	Cause    string
+}
+
+func (f taskFailure) String() string {
+	if f.Cause != "" {
+		return fmt.Sprintf("task %q %s", f.Task, f.Cause)
+	}
+	if f.Phase != "" {
+		return fmt.Sprintf("task %q failed in %s with exit code %d", f.Task, f.Phase, f.ExitCode)
+	}
+	return fmt.Sprintf("task %q failed with exit code %d", f.Task, f.ExitCode)
 }
 
 type headlessTaskProgressReporter interface {
 	write(data string, task *task, terminal *terminal.Term)
-	done(success bool)
+	done(failures []taskFailure)
 }
 
 type tasksManager struct {
 	config          *Config
 	storeLocation   string
+	runLocation     string
 	contentSource   csapi.WorkspaceInitSource
 	tasks           []*task
 	subscriptions   map[*tasksSubscription]struct{}
@@ -83,6 +147,13 @@ type tasksManager struct {
 	terminalService *terminal.MuxTerminalService
 	contentState    ContentState
 	reporter        headlessTaskProgressReporter
+
+	// started is closed once the configured tasks have been launched
+	started chan struct{}
+	// runCtx is the context the tasks manager runs in. It's set before started is closed.
+	runCtx context.Context
+	// prerequisites must be closed before the tasks are started, e.g. because the tasks depend on the user's dotfiles
+	prerequisites []<-chan struct{}
 }
 
 func newTasksManager(config *Config, terminalService *terminal.MuxTerminalService, contentState ContentState, reporter headlessTaskProgressReporter) *tasksManager {
@@ -93,6 +164,7 @@ func newTasksManager(config *Config, terminalService *terminal.MuxTerminalServic
 		reporter:        reporter,
 		subscriptions:   make(map[*tasksSubscription]struct{}),
 		ready:           make(chan struct{}),
+		started:         make(chan struct{}),
 		storeLocation:   "/workspace/.gitpod",
 	}
 }
@@ -109,7 +181,8 @@ func (tm *tasksManager) Status() []*api.TaskStatus {
 func (tm *tasksManager) getStatus() []*api.TaskStatus {
 	status := make([]*api.TaskStatus, 0, len(tm.tasks))
 	for _, t := range tm.tasks {
-		status = append(status, &t.TaskStatus)
+		// the task status keeps changing after we've handed it out, hence we hand out a copy
+		status = append(status, proto.Clone(&t.TaskStatus).(*api.TaskStatus))
 	}
 	return status
 }
@@ -134,17 +207,6 @@ func (tm *tasksManager) updateState(doUpdate func() (changed bool)) {
 	}
 }
 
-func (tm *tasksManager) setTaskState(t *task, newState api.TaskState) {
-	tm.updateState(func() bool {
-		if t.State == newState {
-			return false
-		}
-
-		t.State = newState
-		return true
-	})
-}
-
 func (tm *tasksManager) init(ctx context.Context) {
 	defer close(tm.ready)
 
@@ -162,40 +224,156 @@ func (tm *tasksManager) init(ctx context.Context) {
 		return
 	case <-tm.contentState.ContentReady():
 	}
+	for _, p := range tm.prerequisites {
+		select {
+		case <-ctx.Done():
+			return
+		case <-p:
+		}
+	}
 
 	contentSource, _ := tm.contentState.ContentSource()
 	tm.contentSource = contentSource
 
+	tm.runLocation, err = ioutil.TempDir("", "gitpod-tasks")
+	if err != nil {
+		log.WithError(err).Warn("cannot create tasks run location - task phases will not be reported")
+		tm.runLocation = ""
+	}
+
 	for i, config := range *tasks {
-		id := strconv.Itoa(i)
-		presentation := &api.TaskPresentation{}
-		if config.Name != nil {
-			presentation.Name = *config.Name
-		} else {
-			presentation.Name = tm.terminalService.DefaultWorkdir
+		tm.tasks = append(tm.tasks, tm.newTask(strconv.Itoa(i), config))
+	}
+	resolveTaskDependencies(tm.tasks)
+}
+
+// newTask prepares a task for its configuration
+func (tm *tasksManager) newTask(id string, config TaskConfig) *task {
+	presentation := &api.TaskPresentation{}
+	if config.Name != nil {
+		presentation.Name = *config.Name
+	} else {
+		presentation.Name = tm.terminalService.DefaultWorkdir
+	}
+	if config.OpenIn != nil {
+		presentation.OpenIn = *config.OpenIn
+	}
+	if config.OpenMode != nil {
+		presentation.OpenMode = *config.OpenMode
+	}
+	task := &task{
+		TaskStatus: api.TaskStatus{
+			Id:           id,
+			State:        api.TaskState_opening,
+			Presentation: presentation,
+		},
+		config:      config,
+		successChan: make(chan bool, 1),
+		settled:     make(chan struct{}),
+		exited:      make(chan struct{}),
+	}
+	if config.Readiness != nil {
+		task.Health = api.TaskHealth_not_ready
+	}
+	task.command = tm.getCommand(task)
+	if tm.config.isHeadless() && task.command == "exit" {
+		task.State = api.TaskState_closed
+		task.successChan <- true
+		task.settle()
+	}
+	return task
+}
+
+// resolveTaskDependencies links tasks to the tasks they depend on and marks tasks
+// which depend on unknown tasks or are part of a dependency cycle as invalid.
+func resolveTaskDependencies(tasks []*task) {
+	resolveTaskDependenciesAmong(tasks, tasks)
+}
+
+// resolveTaskDependenciesAmong works like resolveTaskDependencies, except that tasks may depend on
+// any of the known tasks. Only tasks are marked invalid, the known tasks remain untouched.
+func resolveTaskDependenciesAmong(tasks []*task, known []*task) {
+	byName := make(map[string]*task, len(known))
+	for _, t := range known {
+		if t.config.Name == nil {
+			continue
 		}
-		if config.OpenIn != nil {
-			presentation.OpenIn = *config.OpenIn
+		if _, exists := byName[*t.config.Name]; exists {
+			log.WithField("name", *t.config.Name).Warn("multiple tasks share the same name - dependencies refer to the first one")
+			continue
 		}
-		if config.OpenMode != nil {
-			presentation.OpenMode = *config.OpenMode
+		byName[*t.config.Name] = t
+	}
+
+	for _, t := range tasks {
+		if t.config.DependsOn == nil {
+			continue
 		}
-		task := &task{
-			TaskStatus: api.TaskStatus{
-				Id:           id,
-				State:        api.TaskState_opening,
-				Presentation: presentation,
-			},
-			config:      config,
-			successChan: make(chan bool, 1),
+		for _, name := range *t.config.DependsOn {
+			dep, ok := byName[name]
+			if !ok {
+				t.invalid = fmt.Sprintf("depends on unknown task %q", name)
+				break
+			}
+			t.dependsOn = append(t.dependsOn, dep)
 		}
-		task.command = tm.getCommand(task)
-		if tm.config.isHeadless() && task.command == "exit" {
-			task.State = api.TaskState_closed
-			task.successChan <- true
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	var (
+		state = make(map[*task]int, len(tasks))
+		stack []*task
+		visit func(t *task)
+	)
+	isResolved := make(map[*task]bool, len(tasks))
+	for _, t := range tasks {
+		isResolved[t] = true
+	}
+	visit = func(t *task) {
+		switch state[t] {
+		case visiting:
+			// every task on the stack since we last saw t is part of the cycle
+			for i := len(stack) - 1; i >= 0; i-- {
+				if stack[i].invalid == "" && isResolved[stack[i]] {
+					stack[i].invalid = "is part of a dependency cycle"
+				}
+				if stack[i] == t {
+					break
+				}
+			}
+			return
+		case visited:
+			return
+		}
+
+		state[t] = visiting
+		stack = append(stack, t)
+		for _, dep := range t.dependsOn {
+			visit(dep)
 		}
-		tm.tasks = append(tm.tasks, task)
+		stack = stack[:len(stack)-1]
+		state[t] = visited
 	}
+	for _, t := range tasks {
+		visit(t)
+	}
+}
+
+// settle marks the task as settled, i.e. dependent tasks can stop waiting for it
+func (t *task) settle() {
+	t.settleOnce.Do(func() { close(t.settled) })
+}
+
+// name returns the name dependencies refer to this task with
+func (t *task) name() string {
+	if t.config.Name != nil {
+		return *t.config.Name
+	}
+	return t.Presentation.Name
 }
 
 func (tm *tasksManager) Run(ctx context.Context, wg *sync.WaitGroup) {
@@ -203,87 +381,563 @@ func (tm *tasksManager) Run(ctx context.Context, wg *sync.WaitGroup) {
 	tm.init(ctx)
 
 	for _, t := range tm.tasks {
-		if t.State == api.TaskState_closed {
+		tm.launch(ctx, t)
+	}
+	// once started, tasks can be replaced by reloading the config - we wait for the tasks we launched
+	tasks := append([]*task(nil), tm.tasks...)
+	tm.runCtx = ctx
+	close(tm.started)
+
+	for _, task := range tasks {
+		select {
+		case <-ctx.Done():
+			return
+		case <-task.successChan:
+		}
+	}
+	if tm.config.isHeadless() {
+		err := tm.writePrebuildReport()
+		if err != nil {
+			log.WithError(err).Error("cannot write prebuild report")
+		}
+		tm.reporter.done(tm.failures())
+	}
+}
+
+// launch starts a task right away or once its dependencies and waitFor condition allow
+func (tm *tasksManager) launch(ctx context.Context, t *task) {
+	if t.State == api.TaskState_closed {
+		return
+	}
+	if t.invalid != "" {
+		log.WithField("task", t.name()).Error("task " + t.invalid)
+		tm.failTask(t, t.invalid)
+		return
+	}
+	if len(t.dependsOn) == 0 && t.config.WaitFor == nil {
+		// tasks without dependencies start right away and in the order they were configured in
+		tm.startTask(ctx, t)
+		return
+	}
+	ctx, t.cancelStart = context.WithCancel(ctx)
+	go tm.awaitAndStartTask(ctx, t)
+}
+
+// reloadTaskCloseGracePeriod is the time we give a task to shut down before it's restarted with a new configuration
+const reloadTaskCloseGracePeriod = 5 * time.Second
+
+// reloadTasks starts tasks for added configurations and restarts tasks whose configuration changed.
+// Restarted tasks keep their ID. It returns the added tasks in the order of their configurations.
+// Tasks can only be reloaded once the configured tasks have been launched.
+func (tm *tasksManager) reloadTasks(ctx context.Context, added []TaskConfig, changed map[*task]TaskConfig) ([]*task, error) {
+	select {
+	case <-tm.started:
+	default:
+		return nil, fmt.Errorf("tasks have not been started yet")
+	}
+
+	for old := range changed {
+		if old.cancelStart != nil {
+			old.cancelStart()
+		}
+		tm.mu.RLock()
+		alias, state := old.Terminal, old.State
+		tm.mu.RUnlock()
+		if alias == "" {
+			// the task never opened a terminal, hence there's nothing to wait for
 			continue
 		}
-		taskLog := log.WithField("command", t.command)
-		taskLog.Info("starting a task terminal...")
-		openRequest := &api.OpenTerminalRequest{}
-		if t.config.Env != nil {
-			openRequest.Env = *t.config.Env
+		if state != api.TaskState_closed {
+			err := tm.terminalService.Mux.CloseTerminal(alias, reloadTaskCloseGracePeriod)
+			if err != nil {
+				log.WithError(err).WithField("terminal", alias).Warn("cannot close task terminal")
+			}
 		}
-		var readTimeout time.Duration
-		if !tm.config.isHeadless() {
-			readTimeout = 5 * time.Second
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-old.exited:
 		}
-		resp, err := tm.terminalService.OpenWithOptions(ctx, openRequest, terminal.TermOptions{
-			ReadTimeout: readTimeout,
-		})
-		if err != nil {
-			taskLog.WithError(err).Error("cannot open new task terminal")
-			t.successChan <- false
-			tm.setTaskState(t, api.TaskState_closed)
+	}
+
+	var (
+		fresh    []*task
+		replaced = make(map[*task]*task, len(changed))
+	)
+	for old, config := range changed {
+		t := tm.newTask(old.Id, config)
+		replaced[old] = t
+		fresh = append(fresh, t)
+	}
+	tm.mu.RLock()
+	next := len(tm.tasks)
+	tm.mu.RUnlock()
+	for i, config := range added {
+		fresh = append(fresh, tm.newTask(strconv.Itoa(next+i), config))
+	}
+
+	var known []*task
+	tm.updateState(func() bool {
+		for i, t := range tm.tasks {
+			if r, ok := replaced[t]; ok {
+				tm.tasks[i] = r
+			}
+			// tasks which depend on a restarted task wait for the new one
+			for j, dep := range t.dependsOn {
+				if r, ok := replaced[dep]; ok {
+					t.dependsOn[j] = r
+				}
+			}
+		}
+		tm.tasks = append(tm.tasks, fresh[len(changed):]...)
+		known = append(known, tm.tasks...)
+		return true
+	})
+	resolveTaskDependenciesAmong(fresh, known)
+	for old := range changed {
+		// wakes up tasks which were waiting for a restarted task that never started
+		old.settle()
+	}
+
+	for _, t := range fresh {
+		tm.launch(tm.runCtx, t)
+	}
+	return fresh[len(changed):], nil
+}
+
+// failures lists the tasks which have failed so far
+func (tm *tasksManager) failures() []taskFailure {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	var res []taskFailure
+	for _, t := range tm.tasks {
+		if !t.Failed {
 			continue
 		}
+		failure := taskFailure{
+			TaskID:   t.Id,
+			Task:     t.name(),
+			ExitCode: t.ExitCode,
+			Cause:    t.failure,
+		}
+		for _, p := range t.Phases {
+			if p.Done && p.ExitCode != 0 {
+				failure.Phase = p.Name
+				break
+			}
+		}
+		res = append(res, failure)
+	}
+	return res
+}
+
+// awaitAndStartTask starts a task once its dependencies have completed their setup and its waitFor condition is met
+func (tm *tasksManager) awaitAndStartTask(ctx context.Context, t *task) {
+	for i := 0; ; i++ {
+		tm.mu.RLock()
+		if i >= len(t.dependsOn) {
+			tm.mu.RUnlock()
+			break
+		}
+		dep := t.dependsOn[i]
+		tm.mu.RUnlock()
+
+		tm.setWaitingFor(t, "task "+dep.name())
+		select {
+		case <-ctx.Done():
+			return
+		case <-dep.settled:
+		}
 
-		taskLog = taskLog.WithField("terminal", resp.Alias)
-		term, ok := tm.terminalService.Mux.Get(resp.Alias)
-		if !ok {
-			taskLog.Error("cannot find a task terminal")
-			t.successChan <- false
-			tm.setTaskState(t, api.TaskState_closed)
+		tm.mu.RLock()
+		depFailed := dep.Failed
+		depReplaced := t.dependsOn[i] != dep
+		tm.mu.RUnlock()
+		if depReplaced {
+			// the dependency was restarted while we were waiting for it - wait for the new task instead
+			i--
 			continue
 		}
+		if depFailed {
+			tm.failTask(t, fmt.Sprintf("did not start because task %q failed", dep.name()))
+			return
+		}
+	}
+
+	if wf := t.config.WaitFor; wf != nil {
+		if wf.Port != nil {
+			tm.setWaitingFor(t, "port "+strconv.Itoa(*wf.Port))
+			if !waitFor(ctx, func() bool { return isPortServed(*wf.Port) }) {
+				return
+			}
+		}
+		if wf.File != nil {
+			fn := *wf.File
+			if !filepath.IsAbs(fn) {
+				fn = filepath.Join(tm.terminalService.DefaultWorkdir, fn)
+			}
+			tm.setWaitingFor(t, "file "+fn)
+			if !waitFor(ctx, func() bool { _, err := os.Stat(fn); return err == nil }) {
+				return
+			}
+		}
+	}
+
+	tm.startTask(ctx, t)
+}
+
+// waitFor polls cond until it is met or ctx is canceled. Returns true if the condition was met.
+func waitFor(ctx context.Context, cond func() bool) bool {
+	for !cond() {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(waitForInterval):
+		}
+	}
+	return true
+}
+
+func isPortServed(port int) bool {
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort("localhost", strconv.Itoa(port)), waitForInterval)
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
+
+func (tm *tasksManager) setWaitingFor(t *task, waitingFor string) {
+	tm.updateState(func() bool {
+		if t.WaitingFor == waitingFor {
+			return false
+		}
+
+		t.WaitingFor = waitingFor
+		return true
+	})
+}
+
+// failTask closes a task which could not be started
+func (tm *tasksManager) failTask(t *task, cause string) {
+	tm.updateState(func() bool {
+		t.State = api.TaskState_closed
+		t.WaitingFor = ""
+		t.Failed = true
+		t.failure = cause
+		return true
+	})
+	t.successChan <- false
+	t.settle()
+}
+
+func (tm *tasksManager) startTask(ctx context.Context, t *task) {
+	taskLog := log.WithField("command", t.command)
+	taskLog.Info("starting a task terminal...")
+	openRequest := &api.OpenTerminalRequest{}
+	if t.config.Env != nil {
+		openRequest.Env = *t.config.Env
+	}
+	var readTimeout time.Duration
+	if !tm.config.isHeadless() {
+		readTimeout = 5 * time.Second
+	}
+
+	phases, err := tm.openPhaseReporting(t)
+	if err != nil {
+		taskLog.WithError(err).Warn("cannot report task phases")
+	}
+
+	resp, err := tm.terminalService.OpenWithOptions(ctx, openRequest, terminal.TermOptions{
+		ReadTimeout: readTimeout,
+	})
+	if err != nil {
+		taskLog.WithError(err).Error("cannot open new task terminal")
+		phases.Close()
+		tm.failTask(t, "could not open a terminal")
+		return
+	}
+
+	taskLog = taskLog.WithField("terminal", resp.Alias)
+	term, ok := tm.terminalService.Mux.Get(resp.Alias)
+	if !ok {
+		taskLog.Error("cannot find a task terminal")
+		phases.Close()
+		tm.failTask(t, "could not open a terminal")
+		return
+	}
+
+	taskLog = taskLog.WithField("pid", term.Command.Process.Pid)
+	taskLog.Info("task terminal has been started")
+	tm.updateState(func() bool {
+		t.Terminal = resp.Alias
+		t.State = api.TaskState_running
+		t.WaitingFor = ""
+		return true
+	})
+	if t.lastSetupPhase == "" || phases == nil {
+		// there's nothing dependent tasks could wait for
+		t.settle()
+	}
+
+	go func(t *task, term *terminal.Term) {
+		state, _ := term.Wait()
+
+		// all phase reports have been written by the time the terminal exits
+		phases.Close()
+		if stop := tm.swapStopReadiness(t, nil); stop != nil {
+			stop()
+		}
 
-		taskLog = taskLog.WithField("pid", term.Command.Process.Pid)
-		taskLog.Info("task terminal has been started")
+		exitCode := int32(-1)
+		if state != nil {
+			exitCode = int32(state.ExitCode())
+		}
+		var success bool
 		tm.updateState(func() bool {
-			t.Terminal = resp.Alias
-			t.State = api.TaskState_running
+			now := time.Now()
+			for _, p := range t.Phases {
+				if p.Done {
+					continue
+				}
+				// the terminal exited while this phase was running
+				endPhase(p, exitCode, now)
+			}
+			if !t.Failed {
+				t.ExitCode = exitCode
+				t.Failed = exitCode != 0
+			}
+			if t.Health != api.TaskHealth_unchecked {
+				t.Health = api.TaskHealth_not_ready
+			}
+			t.State = api.TaskState_closed
+			success = !t.Failed
 			return true
 		})
+		t.successChan <- success
+		t.settle()
+		close(t.exited)
+		taskLog.Info("task terminal has been closed")
+	}(t, term)
+
+	tm.watch(t, term)
+
+	if t.command != "" {
+		term.Write([]byte(t.command + "\n"))
+	}
+}
+
+// taskPhaseReporting receives the phase reports of a task script
+type taskPhaseReporting struct {
+	keepOpen *os.File
+	done     chan struct{}
+}
 
-		go func(t *task, term *terminal.Term) {
-			state, _ := term.Wait()
-			if state != nil {
-				t.successChan <- state.Success()
-			} else {
-				t.successChan <- false
+// Close stops phase reporting once all pending reports have been processed
+func (r *taskPhaseReporting) Close() {
+	if r == nil {
+		return
+	}
+	r.keepOpen.Close()
+	<-r.done
+}
+
+// openPhaseReporting creates the named pipe a task script reports its phases to.
+func (tm *tasksManager) openPhaseReporting(t *task) (*taskPhaseReporting, error) {
+	if tm.runLocation == "" {
+		return nil, nil
+	}
+
+	fn := tm.phaseReportPipe(t)
+	err := syscall.Mkfifo(fn, 0600)
+	if err != nil {
+		return nil, err
+	}
+	// We must not block on opening the pipe for reading, as nobody's writing to it yet.
+	reader, err := os.OpenFile(fn, os.O_RDONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		os.Remove(fn)
+		return nil, err
+	}
+	// Every phase report opens and closes the pipe. We hold on to a writer so that the reader
+	// does not see EOF in between reports, but only once we've closed the writer.
+	keepOpen, err := os.OpenFile(fn, os.O_WRONLY, 0)
+	if err != nil {
+		reader.Close()
+		os.Remove(fn)
+		return nil, err
+	}
+
+	r := &taskPhaseReporting{keepOpen: keepOpen, done: make(chan struct{})}
+	go func() {
+		defer close(r.done)
+		defer os.Remove(fn)
+		defer reader.Close()
+
+		scanner := bufio.NewScanner(reader)
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+			switch {
+			case len(fields) == 2 && fields[0] == "start":
+				tm.phaseStarted(t, fields[1])
+			case len(fields) == 3 && fields[0] == "end":
+				exitCode, err := strconv.Atoi(fields[2])
+				if err != nil {
+					continue
+				}
+				tm.phaseEnded(t, fields[1], int32(exitCode))
+			}
+		}
+	}()
+	return r, nil
+}
+
+func (tm *tasksManager) phaseStarted(t *task, name string) {
+	tm.updateState(func() bool {
+		if name == phaseCommand {
+			for _, p := range t.Phases {
+				if p.Name != phaseCommand {
+					continue
+				}
+				// the command ran before, hence it's being restarted. Any failure must have come from the
+				// command itself, as it would not have run in the first place if any other phase failed.
+				t.Restarts++
+				t.Failed = false
+				t.ExitCode = 0
+				break
 			}
-			taskLog.Info("task terminal has been closed")
-			tm.setTaskState(t, api.TaskState_closed)
-		}(t, term)
+		}
+		t.Phases = append(t.Phases, &api.TaskPhaseStatus{
+			Name:    name,
+			Started: ptypes.TimestampNow(),
+		})
+		return true
+	})
+	if name != phaseCommand {
+		return
+	}
+
+	t.settle()
+	if t.config.Readiness != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		if stop := tm.swapStopReadiness(t, cancel); stop != nil {
+			stop()
+		}
+		go tm.watchReadiness(ctx, t)
+	}
+}
 
-		tm.watch(t, term)
+// swapStopReadiness replaces the function which stops the readiness checks of a task and returns the previous one
+func (tm *tasksManager) swapStopReadiness(t *task, stop context.CancelFunc) context.CancelFunc {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	prev := t.stopReadiness
+	t.stopReadiness = stop
+	return prev
+}
+
+func (tm *tasksManager) phaseEnded(t *task, name string, exitCode int32) {
+	tm.updateState(func() bool {
+		var phase *api.TaskPhaseStatus
+		for i := len(t.Phases) - 1; i >= 0; i-- {
+			if t.Phases[i].Name == name {
+				phase = t.Phases[i]
+				break
+			}
+		}
+		if phase == nil || phase.Done {
+			return false
+		}
 
-		if t.command != "" {
-			term.PTY.Write([]byte(t.command + "\n"))
+		endPhase(phase, exitCode, time.Now())
+		if exitCode != 0 && !t.Failed {
+			t.Failed = true
+			t.ExitCode = exitCode
 		}
+		return true
+	})
+	if exitCode != 0 || name == t.lastSetupPhase {
+		t.settle()
+	}
+	if name != phaseCommand {
+		return
+	}
+	if stop := tm.swapStopReadiness(t, nil); stop != nil {
+		stop()
+		tm.setTaskHealth(context.Background(), t, api.TaskHealth_not_ready)
 	}
+}
+
+// watchReadiness periodically checks if the command of a task is ready until ctx is canceled
+func (tm *tasksManager) watchReadiness(ctx context.Context, t *task) {
+	tick := time.NewTicker(readinessInterval)
+	defer tick.Stop()
+	for {
+		health := api.TaskHealth_not_ready
+		if isTaskReady(t.config.Readiness) {
+			health = api.TaskHealth_ready
+		}
+		tm.setTaskHealth(ctx, t, health)
 
-	success := true
-	for _, task := range tm.tasks {
 		select {
 		case <-ctx.Done():
 			return
-		case taskSuccess := <-task.successChan:
-			if !taskSuccess {
-				success = false
-			}
+		case <-tick.C:
 		}
 	}
-	if tm.config.isHeadless() {
-		tm.reporter.done(success)
+}
+
+// setTaskHealth updates the health of a task unless ctx is canceled, i.e. the check is outdated
+func (tm *tasksManager) setTaskHealth(ctx context.Context, t *task, health api.TaskHealth) {
+	tm.updateState(func() bool {
+		if ctx.Err() != nil || t.Health == health {
+			return false
+		}
+
+		t.Health = health
+		return true
+	})
+}
+
+// isTaskReady runs a readiness check once
+func isTaskReady(readiness *TaskReadiness) bool {
+	if readiness.HTTP != nil {
+		var (
+			url    = fmt.Sprintf("http://localhost:%d/%s", readiness.HTTP.Port, strings.TrimPrefix(readiness.HTTP.Path, "/"))
+			client = http.Client{Timeout: readinessInterval}
+		)
+		resp, err := client.Get(url)
+		if err != nil {
+			return false
+		}
+		resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+			return false
+		}
+	}
+	if readiness.TCP != nil && !isPortServed(readiness.TCP.Port) {
+		return false
 	}
+	return true
+}
+
+func endPhase(phase *api.TaskPhaseStatus, exitCode int32, now time.Time) {
+	phase.Done = true
+	phase.ExitCode = exitCode
+	if started, err := ptypes.Timestamp(phase.Started); err == nil {
+		phase.DurationMs = now.Sub(started).Milliseconds()
+	}
+}
+
+func (tm *tasksManager) phaseReportPipe(task *task) string {
+	return filepath.Join(tm.runLocation, "task-"+task.Id+".phases")
 }
 
 func (tm *tasksManager) getCommand(task *task) string {
-	commands := tm.getCommands(task)
-	command := composeCommand(composeCommandOptions{
-		commands: commands,
-		format:   "{\n%s\n}",
-		sep:      " && ",
-	})
+	phases := tm.getPhases(task)
+	command := tm.getTaskScriptCommand(task, phases)
 
 	if tm.config.isHeadless() {
 		// it's important that prebuild tasks exit eventually
@@ -294,7 +948,7 @@ func (tm *tasksManager) getCommand(task *task) string {
 		return command + "; exit"
 	}
 
-	histfileCommand := tm.getHistfileCommand(task, commands)
+	histfileCommand := tm.getHistfileCommand(task, phases)
 	if strings.TrimSpace(command) == "" {
 		return histfileCommand
 	}
@@ -304,10 +958,116 @@ func (tm *tasksManager) getCommand(task *task) string {
 	return histfileCommand + "; " + command
 }
 
-func (tm *tasksManager) getHistfileCommand(task *task, commands []*string) string {
-	histfileCommands := commands
+// getTaskScriptCommand writes a script which runs the task phases and reports their progress,
+// and returns the command to run that script. If we cannot write the script we run the phases
+// without reporting their progress.
+func (tm *tasksManager) getTaskScriptCommand(task *task, phases []taskPhase) string {
+	var commands []*string
+	for _, p := range phases {
+		commands = append(commands, p.command)
+	}
+	plainCommand := composeCommand(composeCommandOptions{
+		commands: commands,
+		format:   "{\n%s\n}",
+		sep:      " && ",
+	})
+	if tm.runLocation == "" || strings.TrimSpace(plainCommand) == "" {
+		return plainCommand
+	}
+
+	var restart *TaskRestart
+	if r := task.config.Restart; r != nil && !tm.config.isHeadless() {
+		switch r.Policy {
+		case "", TaskRestartNever, TaskRestartOnFailure, TaskRestartAlways:
+			restart = r
+		default:
+			log.WithField("policy", r.Policy).WithField("task", task.name()).Warn("unknown restart policy - the task will not be restarted")
+		}
+	}
+	script, lastSetupPhase := composeTaskScript(phases, tm.phaseReportPipe(task), restart)
+	fn := filepath.Join(tm.runLocation, "task-"+task.Id+".sh")
+	err := ioutil.WriteFile(fn, []byte(script), 0644)
+	if err != nil {
+		log.WithField("script", fn).WithError(err).Error("cannot write task script")
+		return plainCommand
+	}
+	task.lastSetupPhase = lastSetupPhase
+
+	// the space at the beginning prevents the command from appearing in the bash history
+	return " . " + fn
+}
+
+// composeTaskScript produces a script which runs the phases one after the other until one fails.
+// Named phases report when they start and end to the report pipe. The command phase is restarted
+// according to the restart policy.
+func composeTaskScript(phases []taskPhase, reportPipe string, restart *TaskRestart) (script string, lastSetupPhase string) {
+	var (
+		res   strings.Builder
+		first = true
+	)
+	for _, p := range phases {
+		if p.command == nil || strings.TrimSpace(*p.command) == "" {
+			continue
+		}
+		if !first {
+			fmt.Fprintf(&res, "[ $__gp_task_rc -eq 0 ] || %s\n", taskScriptReturn)
+		}
+		first = false
+
+		if p.name == "" {
+			fmt.Fprintf(&res, "{\n%s\n}\n__gp_task_rc=$?\n", *p.command)
+			continue
+		}
+		if p.name == phaseCommand && restart != nil && restart.Policy != "" && restart.Policy != TaskRestartNever {
+			composeRestartingPhase(&res, p, reportPipe, restart)
+			continue
+		}
+		if p.name != phaseCommand {
+			lastSetupPhase = p.name
+		}
+		fmt.Fprintf(&res, "printf 'start %s\\n' > '%s'\n", p.name, reportPipe)
+		fmt.Fprintf(&res, "{\n%s\n}\n__gp_task_rc=$?\n", *p.command)
+		fmt.Fprintf(&res, "printf 'end %s %%d\\n' $__gp_task_rc > '%s'\n", p.name, reportPipe)
+	}
+	res.WriteString(taskScriptReturn + "\n")
+	return res.String(), lastSetupPhase
+}
+
+// taskScriptReturn ends a task script. The script is sourced, hence it removes its variables from the
+// user's shell before it returns. eval expands the exit code before the variables are removed.
+const taskScriptReturn = `eval "unset __gp_task_rc __gp_task_restarts __gp_task_delay __gp_task_started; return $__gp_task_rc"`
+
+// composeRestartingPhase produces a loop which restarts a phase with exponential backoff, see restartBackoff.
+func composeRestartingPhase(res *strings.Builder, p taskPhase, reportPipe string, restart *TaskRestart) {
+	res.WriteString("__gp_task_restarts=0\n")
+	fmt.Fprintf(res, "__gp_task_delay=%d\n", int(restartBackoffInitial.Seconds()))
+	res.WriteString("while :; do\n")
+	fmt.Fprintf(res, "printf 'start %s\\n' > '%s'\n", p.name, reportPipe)
+	res.WriteString("__gp_task_started=$SECONDS\n")
+	fmt.Fprintf(res, "{\n%s\n}\n__gp_task_rc=$?\n", *p.command)
+	fmt.Fprintf(res, "printf 'end %s %%d\\n' $__gp_task_rc > '%s'\n", p.name, reportPipe)
+	if restart.Policy != TaskRestartAlways {
+		res.WriteString("[ $__gp_task_rc -ne 0 ] || break\n")
+	}
+	if restart.MaxAttempts > 0 {
+		fmt.Fprintf(res, "[ $__gp_task_restarts -lt %d ] || break\n", restart.MaxAttempts)
+	}
+	res.WriteString("__gp_task_restarts=$((__gp_task_restarts + 1))\n")
+	fmt.Fprintf(res, "[ $((SECONDS - __gp_task_started)) -lt %d ] || __gp_task_delay=%d\n", int(restartBackoffReset.Seconds()), int(restartBackoffInitial.Seconds()))
+	res.WriteString("echo \"gitpod: command exited with code $__gp_task_rc - restarting in ${__gp_task_delay}s\"\n")
+	res.WriteString("sleep $__gp_task_delay\n")
+	fmt.Fprintf(res, "__gp_task_delay=$((__gp_task_delay * 2 > %[1]d ? %[1]d : __gp_task_delay * 2))\n", int(restartBackoffMax.Seconds()))
+	res.WriteString("done\n")
+}
+
+func (tm *tasksManager) getHistfileCommand(task *task, phases []taskPhase) string {
+	var histfileCommands []*string
 	if tm.contentSource == csapi.WorkspaceInitFromPrebuild {
 		histfileCommands = []*string{task.config.Before, task.config.Init, task.config.Prebuild, task.config.Command}
+	} else {
+		for _, p := range phases {
+			histfileCommands = append(histfileCommands, p.command)
+		}
 	}
 	histfileContent := composeCommand(composeCommandOptions{
 		commands: histfileCommands,
@@ -329,31 +1089,68 @@ func (tm *tasksManager) getHistfileCommand(task *task, commands []*string) strin
 	return " HISTFILE=" + histfile + " history -r"
 }
 
-func (tm *tasksManager) getCommands(task *task) []*string {
+func (tm *tasksManager) getPhases(task *task) []taskPhase {
 	if tm.config.isHeadless() {
 		// prebuild
-		return []*string{task.config.Before, task.config.Init, task.config.Prebuild}
+		return []taskPhase{{"before", task.config.Before}, {"init", task.config.Init}, {"prebuild", task.config.Prebuild}}
 	}
 	if tm.contentSource == csapi.WorkspaceInitFromPrebuild {
 		// prebuilt
 		prebuildLogFileName := tm.prebuildLogFileName(task)
 		legacyPrebuildLogFileName := "/workspace/.prebuild-log-" + task.Id
 		printlogs := "[ -r " + legacyPrebuildLogFileName + " ] && cat " + legacyPrebuildLogFileName + "; [ -r " + prebuildLogFileName + " ] && cat " + prebuildLogFileName + "; true"
-		return []*string{task.config.Before, &printlogs, task.config.Command}
+		return []taskPhase{{"before", task.config.Before}, {"", &printlogs}, {phaseCommand, task.config.Command}}
 	}
 	if tm.contentSource == csapi.WorkspaceInitFromBackup {
 		// restart
-		return []*string{task.config.Before, task.config.Command}
+		return []taskPhase{{"before", task.config.Before}, {phaseCommand, task.config.Command}}
 	}
 	// init
-	return []*string{task.config.Before, task.config.Init, task.config.Command}
-
+	return []taskPhase{{"before", task.config.Before}, {"init", task.config.Init}, {phaseCommand, task.config.Command}}
 }
 
 func (tm *tasksManager) prebuildLogFileName(task *task) string {
 	return tm.storeLocation + "/prebuild-log-" + task.Id
 }
 
+func (tm *tasksManager) prebuildReportFileName() string {
+	return filepath.Join(tm.storeLocation, filepath.Base(csapi.PrebuildReportFile))
+}
+
+// writePrebuildReport writes the report ws-daemon uploads next to the prebuild's snapshot
+func (tm *tasksManager) writePrebuildReport() error {
+	tm.mu.RLock()
+	report := csapi.PrebuildReport{Tasks: make([]csapi.PrebuildTaskReport, 0, len(tm.tasks))}
+	for _, t := range tm.tasks {
+		tr := csapi.PrebuildTaskReport{
+			ID:       t.Id,
+			Name:     t.name(),
+			Failed:   t.Failed,
+			Failure:  t.failure,
+			ExitCode: t.ExitCode,
+		}
+		for _, p := range t.Phases {
+			tr.Phases = append(tr.Phases, csapi.PrebuildTaskPhase{
+				Name:       p.Name,
+				ExitCode:   p.ExitCode,
+				DurationMS: p.DurationMs,
+			})
+		}
+		if _, err := os.Stat(tm.prebuildLogFileName(t)); err == nil {
+			// the log file lives next to the report
+			tr.LogFile = filepath.Base(tm.prebuildLogFileName(t))
+		}
+		report.Tasks = append(report.Tasks, tr)
+	}
+	tm.mu.RUnlock()
+
+	fc, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(tm.prebuildReportFileName(), fc, 0644)
+}
+
 func (tm *tasksManager) watch(task *task, terminal *terminal.Term) {
 	if !tm.config.isHeadless() {
 		return
@@ -437,11 +1234,15 @@ func (r *loggingHeadlessTaskProgressReporter) write(data string, task *task, ter
 		WithField("taskLogMsg", taskLogMessage{Type: "workspaceTaskOutput", Data: data}).Info()
 }
 
-func (r *loggingHeadlessTaskProgressReporter) done(success bool) {
+func (r *loggingHeadlessTaskProgressReporter) done(failures []taskFailure) {
 	workspaceLog := log.WithField("component", "workspace")
 	workspaceLog.WithField("taskLogMsg", taskLogMessage{Type: "workspaceTaskOutput", Data: "🚛 uploading prebuilt workspace"}).Info()
-	if !success {
-		workspaceLog.WithField("error", "one of the tasks failed with non-zero exit code").
+	if len(failures) > 0 {
+		msgs := make([]string, 0, len(failures))
+		for _, f := range failures {
+			msgs = append(msgs, f.String())
+		}
+		workspaceLog.WithField("error", strings.Join(msgs, "; ")).
 			WithField("taskLogMsg", taskLogMessage{Type: "workspaceTaskFailed"}).Info()
 		return
 	}
@@ -7,14 +7,20 @@ package supervisor
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
+	"os/exec"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/content-service/api"
+	supervisor "github.com/gitpod-io/gitpod/supervisor/api"
 	"github.com/gitpod-io/gitpod/supervisor/pkg/terminal"
 
 	"github.com/google/go-cmp/cmp"
@@ -24,6 +30,8 @@ import (
 var skipCommand = "echo \"skip\""
 var failCommand = "exit 1"
 
+func strptr(s string) *string { return &s }
+
 func TestTaskManager(t *testing.T) {
 	log.Log.Logger.SetLevel(logrus.FatalLevel)
 	tests := []struct {
@@ -33,6 +41,8 @@ func TestTaskManager(t *testing.T) {
 		GitpodTasks *[]TaskConfig
 
 		ExpectedReporter testHeadlessTaskProgressReporter
+		// ExpectedPhases maps task IDs to their phases and exit codes. Only checked if set.
+		ExpectedPhases map[string][]string
 	}{
 		{
 			Desc:     "headless prebuild should finish without tasks",
@@ -73,8 +83,9 @@ func TestTaskManager(t *testing.T) {
 			GitpodTasks: &[]TaskConfig{{Init: &failCommand}, {Init: &failCommand}},
 
 			ExpectedReporter: testHeadlessTaskProgressReporter{
-				Done:    true,
-				Success: false,
+				Done:     true,
+				Success:  false,
+				Failures: []string{"0:init:1", "1:init:1"},
 			},
 		},
 		{
@@ -84,8 +95,9 @@ func TestTaskManager(t *testing.T) {
 			GitpodTasks: &[]TaskConfig{{Init: &failCommand}, {Init: &skipCommand}},
 
 			ExpectedReporter: testHeadlessTaskProgressReporter{
-				Done:    true,
-				Success: false,
+				Done:     true,
+				Success:  false,
+				Failures: []string{"0:init:1"},
 			},
 		},
 		{
@@ -94,9 +106,107 @@ func TestTaskManager(t *testing.T) {
 			Source:      api.WorkspaceInitFromOther,
 			GitpodTasks: &[]TaskConfig{{Init: &skipCommand}, {Init: &failCommand}},
 
+			ExpectedReporter: testHeadlessTaskProgressReporter{
+				Done:     true,
+				Success:  false,
+				Failures: []string{"1:init:1"},
+			},
+		},
+		{
+			Desc:     "headless prebuild should report phase exit codes",
+			Headless: true,
+			Source:   api.WorkspaceInitFromOther,
+			GitpodTasks: &[]TaskConfig{
+				{Before: &skipCommand, Init: &skipCommand, Prebuild: strptr("false")},
+				{Before: &skipCommand, Init: strptr("(exit 3)"), Prebuild: &skipCommand},
+			},
+
+			ExpectedReporter: testHeadlessTaskProgressReporter{
+				Done:     true,
+				Success:  false,
+				Failures: []string{"0:prebuild:1", "1:init:3"},
+			},
+			ExpectedPhases: map[string][]string{
+				"0": {"before:0", "init:0", "prebuild:1"},
+				"1": {"before:0", "init:3"},
+			},
+		},
+		{
+			Desc:     "headless prebuild should run dependent tasks after their dependencies",
+			Headless: true,
+			Source:   api.WorkspaceInitFromOther,
+			GitpodTasks: &[]TaskConfig{
+				{Name: strptr("second"), Init: strptr("[ -f first ] && touch second"), DependsOn: &[]string{"first"}},
+				{Name: strptr("first"), Init: strptr("sleep 0.5; touch first")},
+				{Name: strptr("third"), Init: strptr("[ -f second ]"), DependsOn: &[]string{"first", "second"}},
+			},
+
+			ExpectedReporter: testHeadlessTaskProgressReporter{
+				Done:    true,
+				Success: true,
+			},
+		},
+		{
+			Desc:     "headless prebuild should not run tasks whose dependencies failed",
+			Headless: true,
+			Source:   api.WorkspaceInitFromOther,
+			GitpodTasks: &[]TaskConfig{
+				{Name: strptr("first"), Init: &failCommand},
+				{Name: strptr("second"), Init: &skipCommand, DependsOn: &[]string{"first"}},
+			},
+
+			ExpectedReporter: testHeadlessTaskProgressReporter{
+				Done:     true,
+				Success:  false,
+				Failures: []string{"0:init:1", "1:did not start because task \"first\" failed"},
+			},
+		},
+		{
+			Desc:     "headless prebuild should fail tasks with unknown dependencies",
+			Headless: true,
+			Source:   api.WorkspaceInitFromOther,
+			GitpodTasks: &[]TaskConfig{
+				{Name: strptr("first"), Init: &skipCommand, DependsOn: &[]string{"unknown"}},
+			},
+
+			ExpectedReporter: testHeadlessTaskProgressReporter{
+				Done:     true,
+				Success:  false,
+				Failures: []string{"0:depends on unknown task \"unknown\""},
+			},
+		},
+		{
+			Desc:     "headless prebuild should fail tasks with cyclic dependencies",
+			Headless: true,
+			Source:   api.WorkspaceInitFromOther,
+			GitpodTasks: &[]TaskConfig{
+				{Name: strptr("first"), Init: &skipCommand, DependsOn: &[]string{"second"}},
+				{Name: strptr("second"), Init: &skipCommand, DependsOn: &[]string{"first"}},
+				{Name: strptr("third"), Init: &skipCommand, DependsOn: &[]string{"second"}},
+			},
+
 			ExpectedReporter: testHeadlessTaskProgressReporter{
 				Done:    true,
 				Success: false,
+				Failures: []string{
+					"0:is part of a dependency cycle",
+					"1:is part of a dependency cycle",
+					"2:did not start because task \"second\" failed",
+				},
+			},
+		},
+		{
+			Desc:     "headless prebuild should wait for files",
+			Headless: true,
+			Source:   api.WorkspaceInitFromOther,
+			GitpodTasks: &[]TaskConfig{
+				{Init: strptr("[ -f ready ]"), WaitFor: &TaskWaitFor{File: strptr("ready")}},
+				{Init: strptr("sleep 0.5; touch ready")},
+			},
+
+			ExpectedReporter: testHeadlessTaskProgressReporter{
+				Done:    true,
+				Success: true,
 			},
 		},
 	}
@@ -129,6 +239,7 @@ func TestTaskManager(t *testing.T) {
 				}, terminalService, contentState, &reporter)
 			)
 			taskManager.storeLocation = storeLocation
+			terminalService.DefaultWorkdir = storeLocation
 			contentState.MarkContentReady(test.Source)
 			var wg sync.WaitGroup
 			wg.Add(1)
@@ -137,20 +248,333 @@ func TestTaskManager(t *testing.T) {
 			if diff := cmp.Diff(test.ExpectedReporter, reporter); diff != "" {
 				t.Errorf("unexpected output (-want +got):\n%s", diff)
 			}
+			if test.ExpectedPhases != nil {
+				phases := make(map[string][]string)
+				for _, task := range taskManager.Status() {
+					for _, p := range task.Phases {
+						phases[task.Id] = append(phases[task.Id], fmt.Sprintf("%s:%d", p.Name, p.ExitCode))
+					}
+				}
+				if diff := cmp.Diff(test.ExpectedPhases, phases); diff != "" {
+					t.Errorf("unexpected phases (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+
+}
+
+func TestTaskManagerRestartsAndReadiness(t *testing.T) {
+	log.Log.Logger.SetLevel(logrus.FatalLevel)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	port := l.Addr().(*net.TCPAddr).Port
+
+	tests := []struct {
+		Desc      string
+		Task      TaskConfig
+		Condition func(status *supervisor.TaskStatus) bool
+	}{
+		{
+			Desc: "restarts failed command",
+			Task: TaskConfig{
+				Command: strptr("(exit 2)"),
+				Restart: &TaskRestart{Policy: TaskRestartOnFailure, MaxAttempts: 1},
+			},
+			Condition: func(status *supervisor.TaskStatus) bool {
+				return status.Restarts == 1 && status.Failed && status.ExitCode == 2 &&
+					len(status.Phases) == 2 && status.Phases[1].Done && status.Phases[1].ExitCode == 2
+			},
+		},
+		{
+			Desc: "does not restart successful command on failure",
+			Task: TaskConfig{
+				Command: &skipCommand,
+				Restart: &TaskRestart{Policy: TaskRestartOnFailure},
+			},
+			Condition: func(status *supervisor.TaskStatus) bool {
+				return status.Restarts == 0 && !status.Failed && len(status.Phases) == 1 && status.Phases[0].Done
+			},
+		},
+		{
+			Desc: "reports readiness",
+			Task: TaskConfig{
+				Command:   strptr("sleep 30"),
+				Readiness: &TaskReadiness{TCP: &TaskTCPReadiness{Port: port}},
+			},
+			Condition: func(status *supervisor.TaskStatus) bool {
+				return status.Health == supervisor.TaskHealth_ready
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			storeLocation, err := ioutil.TempDir("", "tasktest")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(storeLocation)
+
+			gitpodTasks, err := json.Marshal([]TaskConfig{test.Task})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var (
+				terminalService = terminal.NewMuxTerminalService(terminal.NewMux())
+				contentState    = NewInMemoryContentState("")
+				taskManager     = newTasksManager(&Config{
+					WorkspaceConfig: WorkspaceConfig{
+						GitpodTasks: string(gitpodTasks),
+					},
+				}, terminalService, contentState, &testHeadlessTaskProgressReporter{})
+			)
+			taskManager.storeLocation = storeLocation
+			terminalService.DefaultWorkdir = storeLocation
+			defer func() {
+				for _, status := range taskManager.Status() {
+					_ = terminalService.Mux.CloseTerminal(status.Terminal, 0)
+				}
+			}()
+			contentState.MarkContentReady(api.WorkspaceInitFromOther)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go taskManager.Run(ctx, &wg)
+			<-taskManager.ready
+
+			var last *supervisor.TaskStatus
+			for deadline := time.Now().Add(20 * time.Second); time.Now().Before(deadline); time.Sleep(100 * time.Millisecond) {
+				status := taskManager.Status()
+				if len(status) == 0 {
+					continue
+				}
+				last = status[0]
+				if test.Condition(last) {
+					return
+				}
+			}
+			t.Errorf("task did not reach the expected state: %v", last)
 		})
 	}
+}
+
+func TestTaskManagerReloadTasks(t *testing.T) {
+	log.Log.Logger.SetLevel(logrus.FatalLevel)
+
+	storeLocation, err := ioutil.TempDir("", "tasktest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(storeLocation)
+
+	gitpodTasks, err := json.Marshal([]TaskConfig{
+		{Name: strptr("web"), Command: strptr("sleep 30")},
+		{Name: strptr("app"), Command: strptr("sleep 30"), DependsOn: &[]string{"web"}, WaitFor: &TaskWaitFor{File: strptr("never")}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		terminalService = terminal.NewMuxTerminalService(terminal.NewMux())
+		contentState    = NewInMemoryContentState("")
+		taskManager     = newTasksManager(&Config{
+			WorkspaceConfig: WorkspaceConfig{
+				GitpodTasks: string(gitpodTasks),
+			},
+		}, terminalService, contentState, &testHeadlessTaskProgressReporter{})
+	)
+	taskManager.storeLocation = storeLocation
+	terminalService.DefaultWorkdir = storeLocation
+	defer func() {
+		for _, status := range taskManager.Status() {
+			_ = terminalService.Mux.CloseTerminal(status.Terminal, 0)
+		}
+	}()
+	contentState.MarkContentReady(api.WorkspaceInitFromOther)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go taskManager.Run(ctx, &wg)
+	<-taskManager.started
+
+	before := taskManager.Status()
+	if len(before) != 2 || before[0].State != supervisor.TaskState_running {
+		t.Fatalf("expected a running and a waiting task, got %v", before)
+	}
 
+	current := taskManager.taskConfigs()
+	added, err := taskManager.reloadTasks(ctx,
+		[]TaskConfig{{Name: strptr("db"), Command: strptr("sleep 30")}},
+		map[*task]TaskConfig{current["web"].task: {Name: strptr("web"), Command: strptr("sleep 20")}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(added) != 1 || added[0].Id != "2" {
+		t.Fatalf("expected the added task to have ID 2, got %v", added)
+	}
+
+	after := taskManager.Status()
+	if len(after) != 3 {
+		t.Fatalf("expected three tasks, got %v", after)
+	}
+	if after[0].Id != "0" || after[0].Terminal == before[0].Terminal || after[0].State != supervisor.TaskState_running {
+		t.Errorf("expected task 0 to run in a new terminal, got %v", after[0])
+	}
+	if after[2].Presentation.Name != "db" || after[2].State != supervisor.TaskState_running {
+		t.Errorf("expected the added task to run, got %v", after[2])
+	}
+	reloaded := taskManager.taskConfigs()
+	if cmd := reloaded["web"].Command; cmd == nil || *cmd != "sleep 20" {
+		t.Errorf("expected the restarted task to use the new config")
+	}
+	taskManager.mu.RLock()
+	deps := reloaded["app"].task.dependsOn
+	taskManager.mu.RUnlock()
+	if len(deps) != 1 || deps[0] != reloaded["web"].task {
+		t.Errorf("expected the waiting task to depend on the restarted task, got %v", deps)
+	}
+}
+
+func TestComposeTaskScript(t *testing.T) {
+	tests := []struct {
+		Desc         string
+		Phases       []taskPhase
+		Restart      *TaskRestart
+		ExpectedCode string
+	}{
+		{
+			Desc:         "successful phases",
+			Phases:       []taskPhase{{name: "init", command: strptr("true")}, {name: phaseCommand, command: strptr("true")}},
+			ExpectedCode: "0",
+		},
+		{
+			Desc:         "failed setup phase",
+			Phases:       []taskPhase{{name: "init", command: strptr("(exit 3)")}, {name: phaseCommand, command: strptr("true")}},
+			ExpectedCode: "3",
+		},
+		{
+			Desc:         "restarting command",
+			Phases:       []taskPhase{{name: phaseCommand, command: strptr("true")}},
+			Restart:      &TaskRestart{Policy: TaskRestartOnFailure},
+			ExpectedCode: "0",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			script, _ := composeTaskScript(test.Phases, os.DevNull, test.Restart)
+			fn, err := ioutil.TempFile("", "task-script")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.Remove(fn.Name())
+			_, err = fn.WriteString(script)
+			fn.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// the script is sourced into the user's shell, hence it must not leave any of its variables behind
+			out, err := exec.Command("sh", "-c", ". "+fn.Name()+"; echo $? $(set | grep -c __gp_task)").CombinedOutput()
+			if err != nil {
+				t.Fatalf("cannot run task script: %v: %s", err, out)
+			}
+			if act, exp := strings.TrimSpace(string(out)), test.ExpectedCode+" 0"; act != exp {
+				t.Errorf("unexpected exit code and variable count: expected %q, got %q", exp, act)
+			}
+		})
+	}
+}
+
+func TestWritePrebuildReport(t *testing.T) {
+	storeLocation, err := ioutil.TempDir("", "tasktest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(storeLocation)
+
+	taskManager := newTasksManager(&Config{}, terminal.NewMuxTerminalService(terminal.NewMux()), NewInMemoryContentState(""), &testHeadlessTaskProgressReporter{})
+	taskManager.storeLocation = storeLocation
+	build := taskManager.newTask("0", TaskConfig{Name: strptr("build"), Init: strptr("make")})
+	build.Phases = []*supervisor.TaskPhaseStatus{
+		{Name: "before", Done: true, DurationMs: 10},
+		{Name: "init", Done: true, ExitCode: 2, DurationMs: 2000},
+	}
+	build.Failed, build.ExitCode = true, 2
+	lint := taskManager.newTask("1", TaskConfig{Name: strptr("lint")})
+	lint.Failed, lint.failure = true, "depends on failed task \"build\""
+	taskManager.tasks = []*task{build, lint}
+
+	err = ioutil.WriteFile(taskManager.prebuildLogFileName(build), []byte("make: *** [all] Error 2"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = taskManager.writePrebuildReport()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fc, err := ioutil.ReadFile(taskManager.prebuildReportFileName())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var act api.PrebuildReport
+	err = json.Unmarshal(fc, &act)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := api.PrebuildReport{
+		Tasks: []api.PrebuildTaskReport{
+			{
+				ID:       "0",
+				Name:     "build",
+				Failed:   true,
+				ExitCode: 2,
+				Phases: []api.PrebuildTaskPhase{
+					{Name: "before", DurationMS: 10},
+					{Name: "init", ExitCode: 2, DurationMS: 2000},
+				},
+				LogFile: "prebuild-log-0",
+			},
+			{
+				ID:      "1",
+				Name:    "lint",
+				Failed:  true,
+				Failure: "depends on failed task \"build\"",
+			},
+		},
+	}
+	if diff := cmp.Diff(exp, act); diff != "" {
+		t.Errorf("unexpected prebuild report (-want +got):\n%s", diff)
+	}
 }
 
 type testHeadlessTaskProgressReporter struct {
-	Done    bool
-	Success bool
+	Done     bool
+	Success  bool
+	Failures []string
 }
 
 func (r *testHeadlessTaskProgressReporter) write(data string, task *task, terminal *terminal.Term) {
 }
 
-func (r *testHeadlessTaskProgressReporter) done(success bool) {
+func (r *testHeadlessTaskProgressReporter) done(failures []taskFailure) {
 	r.Done = true
-	r.Success = success
+	r.Success = len(failures) == 0
+	for _, f := range failures {
+		if f.Cause != "" {
+			r.Failures = append(r.Failures, f.TaskID+":"+f.Cause)
+			continue
+		}
+		r.Failures = append(r.Failures, fmt.Sprintf("%s:%s:%d", f.TaskID, f.Phase, f.ExitCode))
+	}
 }
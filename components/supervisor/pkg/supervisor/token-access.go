@@ -0,0 +1,239 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// tokenCallerResolver identifies the process which holds the client end of a connection to supervisor
+type tokenCallerResolver func(addr net.Addr) (*api.TokenCaller, error)
+
+// appliesTo returns true if the policy governs tokens of this kind and host
+func (p *TokenAccessPolicy) appliesTo(kind, host string) bool {
+	return p.Kind == kind && (p.Host == "" || p.Host == host)
+}
+
+// admits returns true if the caller satisfies the policy
+func (p *TokenAccessPolicy) admits(caller *api.TokenCaller) bool {
+	if len(p.UIDs) > 0 {
+		var found bool
+		for _, uid := range p.UIDs {
+			if uid == caller.Uid {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(p.Executables) > 0 {
+		var found bool
+		for _, exe := range p.Executables {
+			if ok, _ := filepath.Match(exe, caller.Executable); ok {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// authorize checks the access policies for a token request. It returns the caller if it could be identified.
+//
+// Requests without a peer originate from supervisor itself and are always admitted. Requests made through the
+// REST gateway arrive on a connection supervisor opened itself, so we cannot tell who actually made them. We
+// deny those requests if a policy applies.
+func (s *InMemoryTokenService) authorize(ctx context.Context, req *api.GetTokenRequest) (caller *api.TokenCaller, err error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil, nil
+	}
+
+	if s.callerResolver != nil {
+		var rerr error
+		caller, rerr = s.callerResolver(p.Addr)
+		if rerr != nil {
+			log.WithError(rerr).WithField("addr", p.Addr.String()).Debug("cannot identify token caller")
+		}
+	}
+
+	var policies []*TokenAccessPolicy
+	for i := range s.Policies {
+		if s.Policies[i].appliesTo(req.Kind, req.Host) {
+			policies = append(policies, &s.Policies[i])
+		}
+	}
+	if len(policies) == 0 {
+		return caller, nil
+	}
+	if caller != nil && caller.Pid == int64(os.Getpid()) {
+		return caller, status.Errorf(codes.PermissionDenied, "%s tokens for %s cannot be requested through the REST API", req.Kind, req.Host)
+	}
+	for _, policy := range policies {
+		if caller != nil && policy.admits(caller) {
+			return caller, nil
+		}
+	}
+	if caller == nil {
+		return nil, status.Error(codes.PermissionDenied, "cannot identify caller")
+	}
+	return caller, status.Errorf(codes.PermissionDenied, "%s (uid %d) is not allowed to request %s tokens for %s", caller.Executable, caller.Uid, req.Kind, req.Host)
+}
+
+// resolveTokenCaller finds the process which owns the client end of a TCP connection using procfs
+func resolveTokenCaller(addr net.Addr) (*api.TokenCaller, error) {
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return nil, xerrors.Errorf("unsupported address type %T", addr)
+	}
+
+	var (
+		uid   uint32
+		inode uint64
+		found bool
+	)
+	for _, fn := range []string{"/proc/net/tcp", "/proc/net/tcp6"} {
+		f, err := os.Open(fn)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		uid, inode, found, err = findProcNetTCPSocket(f, tcpAddr)
+		f.Close()
+		if err != nil {
+			return nil, xerrors.Errorf("cannot parse %s: %w", fn, err)
+		}
+		if found {
+			break
+		}
+	}
+	if !found {
+		return nil, xerrors.Errorf("no socket found for %s", tcpAddr)
+	}
+
+	pid, err := findSocketOwner(inode)
+	if err != nil {
+		return nil, err
+	}
+	exe, err := os.Readlink(fmt.Sprintf("/proc/%d/exe", pid))
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read executable of process %d: %w", pid, err)
+	}
+	return &api.TokenCaller{
+		Pid:        int64(pid),
+		Uid:        uid,
+		Executable: exe,
+	}, nil
+}
+
+// tcpEstablished is the state of established connections in /proc/net/tcp
+const tcpEstablished = "01"
+
+// findProcNetTCPSocket finds an established socket with the given local address in the
+// /proc/net/tcp format and returns its owner UID and inode.
+func findProcNetTCPSocket(r io.Reader, addr *net.TCPAddr) (uid uint32, inode uint64, found bool, err error) {
+	scanner := bufio.NewScanner(r)
+	// skip the header line
+	scanner.Scan()
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 || fields[3] != tcpEstablished {
+			continue
+		}
+		ip, port, err := parseProcNetAddr(fields[1])
+		if err != nil {
+			return 0, 0, false, err
+		}
+		if port != addr.Port || !ip.Equal(addr.IP) {
+			continue
+		}
+
+		u, err := strconv.ParseUint(fields[7], 10, 32)
+		if err != nil {
+			return 0, 0, false, err
+		}
+		inode, err = strconv.ParseUint(fields[9], 10, 64)
+		if err != nil {
+			return 0, 0, false, err
+		}
+		return uint32(u), inode, true, nil
+	}
+	return 0, 0, false, scanner.Err()
+}
+
+// parseProcNetAddr parses an address as found in /proc/net/tcp, e.g. 0100007F:1F90. The IP is written
+// as a sequence of 32 bit words in host byte order, the port in network byte order.
+func parseProcNetAddr(s string) (ip net.IP, port int, err error) {
+	segs := strings.Split(s, ":")
+	if len(segs) != 2 {
+		return nil, 0, xerrors.Errorf("invalid address %q", s)
+	}
+	raw, err := hex.DecodeString(segs[0])
+	if err != nil || (len(raw) != net.IPv4len && len(raw) != net.IPv6len) {
+		return nil, 0, xerrors.Errorf("invalid address %q", s)
+	}
+	ip = make(net.IP, len(raw))
+	for i := 0; i < len(raw); i += 4 {
+		ip[i], ip[i+1], ip[i+2], ip[i+3] = raw[i+3], raw[i+2], raw[i+1], raw[i]
+	}
+	p, err := strconv.ParseUint(segs[1], 16, 16)
+	if err != nil {
+		return nil, 0, xerrors.Errorf("invalid port in address %q", s)
+	}
+	return ip, int(p), nil
+}
+
+// findSocketOwner finds the process which holds a file descriptor for the socket
+func findSocketOwner(inode uint64) (pid int, err error) {
+	procs, err := ioutil.ReadDir("/proc")
+	if err != nil {
+		return 0, err
+	}
+
+	link := fmt.Sprintf("socket:[%d]", inode)
+	for _, p := range procs {
+		pid, err := strconv.Atoi(p.Name())
+		if err != nil {
+			continue
+		}
+		fdDir := filepath.Join("/proc", p.Name(), "fd")
+		fds, err := ioutil.ReadDir(fdDir)
+		if err != nil {
+			// the process might have gone away or we're not allowed to look at it
+			continue
+		}
+		for _, fd := range fds {
+			tgt, err := os.Readlink(filepath.Join(fdDir, fd.Name()))
+			if err == nil && tgt == link {
+				return pid, nil
+			}
+		}
+	}
+	return 0, xerrors.Errorf("no process holds socket %d", inode)
+}
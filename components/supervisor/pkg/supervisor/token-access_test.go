@@ -0,0 +1,222 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"net"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestInMemoryTokenServicePolicies(t *testing.T) {
+	var (
+		gitCaller   = &api.TokenCaller{Pid: 42, Uid: 33333, Executable: "/usr/bin/git"}
+		otherCaller = &api.TokenCaller{Pid: 43, Uid: 33333, Executable: "/usr/bin/curl"}
+		rootCaller  = &api.TokenCaller{Pid: 44, Uid: 0, Executable: "/usr/bin/git"}
+		// the REST gateway connects from within supervisor and would satisfy the policy's UID otherwise
+		gatewayCaller = &api.TokenCaller{Pid: int64(os.Getpid()), Uid: 33333, Executable: "/usr/bin/git"}
+		policies      = []TokenAccessPolicy{
+			{Kind: KindGit, Host: "github.com", UIDs: []uint32{33333}, Executables: []string{"/usr/bin/git", "/usr/lib/git-core/*"}},
+		}
+	)
+
+	type Expectation struct {
+		Err     string
+		Outcome api.TokenRequestOutcome
+		Caller  *api.TokenCaller
+	}
+	tests := []struct {
+		Desc        string
+		Req         *api.GetTokenRequest
+		NoPeer      bool
+		Caller      *api.TokenCaller
+		Expectation Expectation
+	}{
+		{
+			Desc:        "admitted caller",
+			Req:         &api.GetTokenRequest{Kind: KindGit, Host: "github.com"},
+			Caller:      gitCaller,
+			Expectation: Expectation{Outcome: api.TokenRequestOutcome_GRANTED_CACHED, Caller: gitCaller},
+		},
+		{
+			Desc:        "admitted executable pattern",
+			Req:         &api.GetTokenRequest{Kind: KindGit, Host: "github.com"},
+			Caller:      &api.TokenCaller{Pid: 45, Uid: 33333, Executable: "/usr/lib/git-core/git-remote-https"},
+			Expectation: Expectation{Outcome: api.TokenRequestOutcome_GRANTED_CACHED, Caller: &api.TokenCaller{Pid: 45, Uid: 33333, Executable: "/usr/lib/git-core/git-remote-https"}},
+		},
+		{
+			Desc:   "wrong executable",
+			Req:    &api.GetTokenRequest{Kind: KindGit, Host: "github.com"},
+			Caller: otherCaller,
+			Expectation: Expectation{
+				Err:     status.Error(codes.PermissionDenied, "/usr/bin/curl (uid 33333) is not allowed to request git tokens for github.com").Error(),
+				Outcome: api.TokenRequestOutcome_DENIED,
+				Caller:  otherCaller,
+			},
+		},
+		{
+			Desc:   "wrong uid",
+			Req:    &api.GetTokenRequest{Kind: KindGit, Host: "github.com"},
+			Caller: rootCaller,
+			Expectation: Expectation{
+				Err:     status.Error(codes.PermissionDenied, "/usr/bin/git (uid 0) is not allowed to request git tokens for github.com").Error(),
+				Outcome: api.TokenRequestOutcome_DENIED,
+				Caller:  rootCaller,
+			},
+		},
+		{
+			Desc: "unknown caller",
+			Req:  &api.GetTokenRequest{Kind: KindGit, Host: "github.com"},
+			Expectation: Expectation{
+				Err:     status.Error(codes.PermissionDenied, "cannot identify caller").Error(),
+				Outcome: api.TokenRequestOutcome_DENIED,
+			},
+		},
+		{
+			Desc:        "no applicable policy",
+			Req:         &api.GetTokenRequest{Kind: KindGit, Host: "gitlab.com"},
+			Caller:      otherCaller,
+			Expectation: Expectation{Outcome: api.TokenRequestOutcome_GRANTED_CACHED, Caller: otherCaller},
+		},
+		{
+			Desc:   "REST gateway",
+			Req:    &api.GetTokenRequest{Kind: KindGit, Host: "github.com"},
+			Caller: gatewayCaller,
+			Expectation: Expectation{
+				Err:     status.Error(codes.PermissionDenied, "git tokens for github.com cannot be requested through the REST API").Error(),
+				Outcome: api.TokenRequestOutcome_DENIED,
+				Caller:  gatewayCaller,
+			},
+		},
+		{
+			Desc:        "REST gateway without applicable policy",
+			Req:         &api.GetTokenRequest{Kind: KindGit, Host: "gitlab.com"},
+			Caller:      gatewayCaller,
+			Expectation: Expectation{Outcome: api.TokenRequestOutcome_GRANTED_CACHED, Caller: gatewayCaller},
+		},
+		{
+			Desc:        "supervisor itself",
+			Req:         &api.GetTokenRequest{Kind: KindGit, Host: "github.com"},
+			NoPeer:      true,
+			Expectation: Expectation{Outcome: api.TokenRequestOutcome_GRANTED_CACHED},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			service := NewInMemoryTokenService()
+			service.Policies = policies
+			service.token[KindGit] = []*Token{
+				{Host: "github.com", Token: "gh", Reuse: api.TokenReuse_REUSE_WHEN_POSSIBLE},
+				{Host: "gitlab.com", Token: "gl", Reuse: api.TokenReuse_REUSE_WHEN_POSSIBLE},
+			}
+			service.callerResolver = func(addr net.Addr) (*api.TokenCaller, error) {
+				if test.Caller == nil {
+					return nil, xerrors.Errorf("no socket found for %s", addr)
+				}
+				return test.Caller, nil
+			}
+
+			ctx := context.Background()
+			if !test.NoPeer {
+				ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 41234}})
+			}
+			_, err := service.GetToken(ctx, test.Req)
+
+			var res Expectation
+			if err != nil {
+				res.Err = err.Error()
+			}
+			reqs, _ := service.ListTokenRequests(context.Background(), &api.ListTokenRequestsRequest{})
+			if len(reqs.Requests) != 1 {
+				t.Fatalf("expected one audited request, got %d", len(reqs.Requests))
+			}
+			res.Outcome = reqs.Requests[0].Outcome
+			res.Caller = reqs.Requests[0].Caller
+
+			if diff := cmp.Diff(test.Expectation, res, cmpopts.IgnoreUnexported(api.TokenCaller{})); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindProcNetTCPSocket(t *testing.T) {
+	const procNetTCP = `  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 0100007F:59D8 00000000:0000 0A 00000000:00000000 00:00000000 00000000 33333        0 20710 1 0000000000000000 100 0 0 10 0
+   1: 0100007F:A102 0100007F:59D8 01 00000000:00000000 00:00000000 00000000 33333        0 31337 1 0000000000000000 20 4 30 10 -1
+   2: 0100007F:59D8 0100007F:A102 01 00000000:00000000 00:00000000 00000000 33333        0 31338 1 0000000000000000 20 4 30 10 -1
+   3: 0200007F:A103 0100007F:59D8 01 00000000:00000000 00:00000000 00000000     0        0 31339 1 0000000000000000 20 4 30 10 -1
+`
+	const procNetTCP6 = `  sl  local_address                         remote_address                        st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 0000000000000000FFFF00000100007F:A104 0000000000000000FFFF00000100007F:59D8 01 00000000:00000000 00:00000000 00000000  1000        0 41414 1 0000000000000000 20 4 30 10 -1
+`
+
+	type Expectation struct {
+		UID   uint32
+		Inode uint64
+		Found bool
+	}
+	tests := []struct {
+		Desc        string
+		Input       string
+		Addr        *net.TCPAddr
+		Expectation Expectation
+	}{
+		{
+			Desc:        "client end",
+			Input:       procNetTCP,
+			Addr:        &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 0xA102},
+			Expectation: Expectation{UID: 33333, Inode: 31337, Found: true},
+		},
+		{
+			Desc:        "listening socket is ignored",
+			Input:       procNetTCP,
+			Addr:        &net.TCPAddr{IP: net.IPv4(127, 0, 0, 2), Port: 0x59D8},
+			Expectation: Expectation{},
+		},
+		{
+			Desc:        "other IP",
+			Input:       procNetTCP,
+			Addr:        &net.TCPAddr{IP: net.IPv4(127, 0, 0, 2), Port: 0xA103},
+			Expectation: Expectation{UID: 0, Inode: 31339, Found: true},
+		},
+		{
+			Desc:        "IPv4 mapped IPv6",
+			Input:       procNetTCP6,
+			Addr:        &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 0xA104},
+			Expectation: Expectation{UID: 1000, Inode: 41414, Found: true},
+		},
+		{
+			Desc:        "not found",
+			Input:       procNetTCP,
+			Addr:        &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1},
+			Expectation: Expectation{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			uid, inode, found, err := findProcNetTCPSocket(strings.NewReader(test.Input), test.Addr)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(test.Expectation, Expectation{UID: uid, Inode: inode, Found: found}); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
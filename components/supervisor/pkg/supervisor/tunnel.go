@@ -0,0 +1,96 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/supervisor/pkg/ports"
+	"github.com/gorilla/websocket"
+	"golang.org/x/xerrors"
+)
+
+// tunnelBufferSize is the size of the WebSocket buffers of reverse tunnels
+const tunnelBufferSize = 32 << 10
+
+var tunnelUpgrader = websocket.Upgrader{
+	ReadBufferSize:  tunnelBufferSize,
+	WriteBufferSize: tunnelBufferSize,
+	// Tunnel clients are not browsers and authenticate with a bearer token rather than ambient
+	// credentials that a foreign origin could abuse. Hence there's no point in checking the origin.
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// tunnelService serves reverse tunnels which make a service on a client's machine available in the workspace.
+// Clients open a WebSocket to /_supervisor/tunnel?port=<workspace-port>&target=<client-address> and authenticate
+// using the owner token as bearer token.
+type tunnelService struct {
+	OwnerToken string
+	Ports      *ports.Manager
+}
+
+// RegisterHTTP registers the tunnel endpoint
+func (s *tunnelService) RegisterHTTP(mux *http.ServeMux) {
+	mux.HandleFunc("/_supervisor/tunnel", s.serveTunnel)
+}
+
+func (s *tunnelService) serveTunnel(resp http.ResponseWriter, req *http.Request) {
+	if !s.authenticated(req) {
+		resp.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if !websocket.IsWebSocketUpgrade(req) {
+		http.Error(resp, "tunnels require a WebSocket connection", http.StatusBadRequest)
+		return
+	}
+	port, err := strconv.ParseUint(req.URL.Query().Get("port"), 10, 16)
+	if err != nil || port == 0 {
+		http.Error(resp, "port must be between 1 and 65535", http.StatusBadRequest)
+		return
+	}
+	target := req.URL.Query().Get("target")
+	if target == "" {
+		http.Error(resp, "target is required", http.StatusBadRequest)
+		return
+	}
+
+	tunnel, err := s.Ports.OpenTunnel(uint32(port), target, tunnelClient(req))
+	if xerrors.Is(err, ports.ErrPortInUse) {
+		http.Error(resp, err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		log.WithError(err).WithField("port", port).Warn("cannot open reverse tunnel")
+		http.Error(resp, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	conn, err := tunnelUpgrader.Upgrade(resp, req, nil)
+	if err != nil {
+		// the upgrader has already responded to the client
+		log.WithError(err).Debug("cannot upgrade reverse tunnel connection")
+		tunnel.Close()
+		return
+	}
+	tunnel.Serve(conn)
+}
+
+func (s *tunnelService) authenticated(req *http.Request) bool {
+	tkn := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	return s.OwnerToken != "" && subtle.ConstantTimeCompare([]byte(s.OwnerToken), []byte(tkn)) == 1
+}
+
+// tunnelClient identifies the client of a tunnel request. Tunnels usually come through ws-proxy,
+// hence we prefer the address it forwarded the request for.
+func tunnelClient(req *http.Request) string {
+	if fwd := req.Header.Get("X-Forwarded-For"); fwd != "" {
+		return strings.TrimSpace(strings.Split(fwd, ",")[0])
+	}
+	return req.RemoteAddr
+}
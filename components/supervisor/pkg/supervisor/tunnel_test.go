@@ -0,0 +1,65 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTunnelServiceRejectsRequests(t *testing.T) {
+	tests := []struct {
+		Desc   string
+		Query  string
+		Header http.Header
+		Status int
+	}{
+		{
+			Desc:   "no token",
+			Query:  "port=5432&target=localhost:5432",
+			Header: http.Header{"Connection": {"upgrade"}, "Upgrade": {"websocket"}},
+			Status: http.StatusUnauthorized,
+		},
+		{
+			Desc:   "wrong token",
+			Query:  "port=5432&target=localhost:5432",
+			Header: http.Header{"Authorization": {"Bearer foobar"}, "Connection": {"upgrade"}, "Upgrade": {"websocket"}},
+			Status: http.StatusUnauthorized,
+		},
+		{
+			Desc:   "no websocket",
+			Query:  "port=5432&target=localhost:5432",
+			Header: http.Header{"Authorization": {"Bearer owner-token"}},
+			Status: http.StatusBadRequest,
+		},
+		{
+			Desc:   "invalid port",
+			Query:  "port=70000&target=localhost:5432",
+			Header: http.Header{"Authorization": {"Bearer owner-token"}, "Connection": {"upgrade"}, "Upgrade": {"websocket"}},
+			Status: http.StatusBadRequest,
+		},
+		{
+			Desc:   "missing target",
+			Query:  "port=5432",
+			Header: http.Header{"Authorization": {"Bearer owner-token"}, "Connection": {"upgrade"}, "Upgrade": {"websocket"}},
+			Status: http.StatusBadRequest,
+		},
+	}
+
+	srv := &tunnelService{OwnerToken: "owner-token"}
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/_supervisor/tunnel?"+test.Query, nil)
+			req.Header = test.Header
+			resp := httptest.NewRecorder()
+			srv.serveTunnel(resp, req)
+
+			if resp.Code != test.Status {
+				t.Errorf("unexpected status: want %d, got %d", test.Status, resp.Code)
+			}
+		})
+	}
+}
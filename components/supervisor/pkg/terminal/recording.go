@@ -0,0 +1,362 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package terminal
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// recordingFileExtension is the file extension of asciicast recordings
+	recordingFileExtension = ".cast"
+
+	// defaultRecordingWidth and defaultRecordingHeight are the terminal dimensions we record if the
+	// terminal's size isn't known when it's started.
+	defaultRecordingWidth  = 80
+	defaultRecordingHeight = 24
+)
+
+// RecordingEventType is the type of an asciicast v2 event
+type RecordingEventType string
+
+const (
+	// RecordingEventOutput is data written by the process to the terminal
+	RecordingEventOutput RecordingEventType = "o"
+	// RecordingEventInput marks input written to the process through the terminal. Its data is
+	// empty unless the recording store captures input content.
+	RecordingEventInput RecordingEventType = "i"
+	// RecordingEventResize marks a change of the terminal size. Its data is "<cols>x<rows>".
+	RecordingEventResize RecordingEventType = "r"
+)
+
+// RecordingStore stores terminal recordings as asciicast v2 files in a directory. Once there are more than
+// MaxRecordings in that directory, the oldest recordings are removed.
+type RecordingStore struct {
+	// Location is the directory where recordings are stored
+	Location string
+	// MaxRecordings is the number of recordings we keep. Use 0 to keep all recordings.
+	MaxRecordings int
+	// MaxRecordingSize is the size in bytes after which we stop recording a terminal. Use 0 for no limit.
+	MaxRecordingSize int64
+	// RecordInput makes recordings capture what was typed into terminals, including passwords and tokens.
+	// Otherwise input events only mark when there was input.
+	RecordInput bool
+
+	mu sync.Mutex
+}
+
+// RecordingHeader is the header of an asciicast v2 recording
+type RecordingHeader struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp,omitempty"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// RecordingEvent is a single event of an asciicast v2 recording
+type RecordingEvent struct {
+	Time time.Duration
+	Type RecordingEventType
+	Data string
+}
+
+// MarshalJSON produces the asciicast v2 representation of an event, i.e. [time, type, data]
+func (e RecordingEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{e.Time.Seconds(), e.Type, e.Data})
+}
+
+// UnmarshalJSON parses the asciicast v2 representation of an event
+func (e *RecordingEvent) UnmarshalJSON(b []byte) error {
+	var raw []interface{}
+	err := json.Unmarshal(b, &raw)
+	if err != nil {
+		return err
+	}
+	if len(raw) != 3 {
+		return xerrors.Errorf("invalid event: expected 3 elements, got %d", len(raw))
+	}
+	t, ok0 := raw[0].(float64)
+	tpe, ok1 := raw[1].(string)
+	data, ok2 := raw[2].(string)
+	if !ok0 || !ok1 || !ok2 {
+		return xerrors.Errorf("invalid event: %s", string(b))
+	}
+
+	e.Time = time.Duration(t * float64(time.Second))
+	e.Type = RecordingEventType(tpe)
+	e.Data = data
+	return nil
+}
+
+// Path returns the path of the recording of the terminal with the given alias
+func (s *RecordingStore) Path(alias string) string {
+	return filepath.Join(s.Location, alias+recordingFileExtension)
+}
+
+// Open opens the recording of the terminal with the given alias for reading
+func (s *RecordingStore) Open(alias string) (*os.File, error) {
+	if alias == "" || strings.ContainsAny(alias, `/\`) || alias == "." || alias == ".." {
+		return nil, xerrors.Errorf("invalid terminal alias: %q", alias)
+	}
+	return os.Open(s.Path(alias))
+}
+
+// Create starts a new recording for a terminal
+func (s *RecordingStore) Create(alias string, header RecordingHeader) (*Recorder, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := os.MkdirAll(s.Location, 0755)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot create recording location: %w", err)
+	}
+	err = s.rotate()
+	if err != nil {
+		log.WithError(err).Warn("cannot remove old terminal recordings")
+	}
+
+	f, err := os.OpenFile(s.Path(alias), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot create recording: %w", err)
+	}
+
+	header.Version = 2
+	if header.Width == 0 || header.Height == 0 {
+		header.Width, header.Height = defaultRecordingWidth, defaultRecordingHeight
+	}
+	if header.Timestamp == 0 {
+		header.Timestamp = time.Now().Unix()
+	}
+	hdr, err := json.Marshal(header)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	_, err = f.Write(append(hdr, '\n'))
+	if err != nil {
+		f.Close()
+		return nil, xerrors.Errorf("cannot write recording header: %w", err)
+	}
+
+	return &Recorder{
+		out:     f,
+		start:   time.Now(),
+		size:    int64(len(hdr) + 1),
+		maxSize: s.MaxRecordingSize,
+		input:   s.RecordInput,
+		partial: make(map[RecordingEventType][]byte),
+	}, nil
+}
+
+// rotate removes the oldest recordings s.t. there's space for a new one.
+// Callers are expected to hold mu.
+func (s *RecordingStore) rotate() error {
+	if s.MaxRecordings <= 0 {
+		return nil
+	}
+
+	files, err := ioutil.ReadDir(s.Location)
+	if err != nil {
+		return err
+	}
+	var recordings []os.FileInfo
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), recordingFileExtension) {
+			continue
+		}
+		recordings = append(recordings, f)
+	}
+	if len(recordings) < s.MaxRecordings {
+		return nil
+	}
+
+	sort.Slice(recordings, func(i, j int) bool { return recordings[i].ModTime().Before(recordings[j].ModTime()) })
+	for _, f := range recordings[:len(recordings)-s.MaxRecordings+1] {
+		err = os.Remove(filepath.Join(s.Location, f.Name()))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Recorder records a terminal session in asciicast v2 format
+type Recorder struct {
+	mu      sync.Mutex
+	out     io.WriteCloser
+	start   time.Time
+	size    int64
+	maxSize int64
+	input   bool
+	closed  bool
+
+	// partial holds incomplete UTF-8 sequences per event type which we'll complete with the next write
+	partial map[RecordingEventType][]byte
+}
+
+// Output records data written to the terminal by its process
+func (r *Recorder) Output(p []byte) {
+	r.record(RecordingEventOutput, p)
+}
+
+// Input records that data was written to the process through the terminal. The data itself is only recorded
+// if the recorder captures input.
+func (r *Recorder) Input(p []byte) {
+	if !r.input {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.writeEvent(RecordingEventInput, "")
+		return
+	}
+	r.record(RecordingEventInput, p)
+}
+
+// Resize records a change of the terminal size
+func (r *Recorder) Resize(cols, rows uint16) {
+	r.record(RecordingEventResize, []byte(fmt.Sprintf("%dx%d", cols, rows)))
+}
+
+func (r *Recorder) record(tpe RecordingEventType, p []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return
+	}
+
+	// asciicast events carry strings, hence we must not split UTF-8 sequences across events
+	data := append(r.partial[tpe], p...)
+	n := completeUTF8Len(data)
+	r.partial[tpe] = append([]byte(nil), data[n:]...)
+	if n == 0 {
+		return
+	}
+
+	r.writeEvent(tpe, string(data[:n]))
+}
+
+// writeEvent appends an event to the recording. Callers are expected to hold mu.
+func (r *Recorder) writeEvent(tpe RecordingEventType, data string) {
+	if r.closed {
+		return
+	}
+
+	line, err := json.Marshal(RecordingEvent{Time: time.Since(r.start), Type: tpe, Data: data})
+	if err != nil {
+		return
+	}
+	line = append(line, '\n')
+	if r.maxSize > 0 && r.size+int64(len(line)) > r.maxSize {
+		log.WithField("maxSize", r.maxSize).Warn("terminal recording reached its maximum size - stopping recording")
+		r.doClose()
+		return
+	}
+
+	_, err = r.out.Write(line)
+	if err != nil {
+		log.WithError(err).Warn("cannot write terminal recording - stopping recording")
+		r.doClose()
+		return
+	}
+	r.size += int64(len(line))
+}
+
+// Close ends the recording
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.doClose()
+}
+
+func (r *Recorder) doClose() error {
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	return r.out.Close()
+}
+
+// completeUTF8Len returns the length of the prefix of p that does not end in an incomplete UTF-8 sequence
+func completeUTF8Len(p []byte) int {
+	// a UTF-8 sequence is at most utf8.UTFMax bytes long, so we only need to look at the last few bytes
+	for i := 1; i < utf8.UTFMax && i <= len(p); i++ {
+		c := p[len(p)-i]
+		if !utf8.RuneStart(c) {
+			continue
+		}
+		if !utf8.FullRune(p[len(p)-i:]) {
+			return len(p) - i
+		}
+		break
+	}
+	return len(p)
+}
+
+// ReplayRecording reads the recording from r and calls fn for each event at or after offset.
+// If realtime is true, the events are delivered with their original timing.
+func ReplayRecording(ctx context.Context, r io.Reader, offset time.Duration, realtime bool, fn func(RecordingEvent) error) (*RecordingHeader, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64<<10), 16<<20)
+
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, xerrors.Errorf("recording has no header")
+	}
+	var header RecordingHeader
+	err := json.Unmarshal(scanner.Bytes(), &header)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid recording header: %w", err)
+	}
+	if header.Version != 2 {
+		return nil, xerrors.Errorf("unsupported recording version %d", header.Version)
+	}
+
+	var last = offset
+	for scanner.Scan() {
+		var evt RecordingEvent
+		err := json.Unmarshal(scanner.Bytes(), &evt)
+		if err != nil {
+			return &header, xerrors.Errorf("invalid recording event: %w", err)
+		}
+		if evt.Time < offset {
+			continue
+		}
+
+		if realtime && evt.Time > last {
+			select {
+			case <-time.After(evt.Time - last):
+			case <-ctx.Done():
+				return &header, ctx.Err()
+			}
+		}
+		last = evt.Time
+
+		err = fn(evt)
+		if err != nil {
+			return &header, err
+		}
+	}
+	return &header, scanner.Err()
+}
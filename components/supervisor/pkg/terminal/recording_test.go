@@ -0,0 +1,198 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package terminal
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRecording(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "terminal-recording")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	store := &RecordingStore{Location: tmpdir, MaxRecordings: 2}
+	rec, err := store.Create("foo", RecordingHeader{Width: 120, Height: 40, Title: "bash"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	euro := []byte("€")
+	rec.Output([]byte("hello "))
+	// a UTF-8 sequence split across writes must end up in a single event
+	rec.Output(euro[:1])
+	rec.Output(euro[1:])
+	rec.Input([]byte("ls\n"))
+	rec.Resize(100, 30)
+	rec.Close()
+	// writes after close must be ignored
+	rec.Output([]byte("too late"))
+
+	f, err := store.Open("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var evts []RecordingEvent
+	hdr, err := ReplayRecording(context.Background(), f, 0, false, func(evt RecordingEvent) error {
+		evts = append(evts, evt)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&RecordingHeader{Version: 2, Width: 120, Height: 40, Timestamp: hdr.Timestamp, Title: "bash"}, hdr); diff != "" {
+		t.Errorf("unexpected header (-want +got):\n%s", diff)
+	}
+
+	type event struct {
+		Type RecordingEventType
+		Data string
+	}
+	var act []event
+	for _, evt := range evts {
+		act = append(act, event{evt.Type, evt.Data})
+	}
+	exp := []event{
+		{RecordingEventOutput, "hello "},
+		{RecordingEventOutput, "€"},
+		// input must not end up in the recording unless asked for
+		{RecordingEventInput, ""},
+		{RecordingEventResize, "100x30"},
+	}
+	if diff := cmp.Diff(exp, act); diff != "" {
+		t.Errorf("unexpected events (-want +got):\n%s", diff)
+	}
+}
+
+func TestRecordingInput(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "terminal-recording")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	store := &RecordingStore{Location: tmpdir, RecordInput: true}
+	rec, err := store.Create("foo", RecordingHeader{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec.Input([]byte("ls\n"))
+	rec.Close()
+
+	f, err := store.Open("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var evts []RecordingEvent
+	_, err = ReplayRecording(context.Background(), f, 0, false, func(evt RecordingEvent) error {
+		evts = append(evts, evt)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evts) != 1 || evts[0].Type != RecordingEventInput || evts[0].Data != "ls\n" {
+		t.Errorf("unexpected events: %v", evts)
+	}
+}
+
+func TestRecordingStoreRotation(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "terminal-recording")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	store := &RecordingStore{Location: tmpdir, MaxRecordings: 2}
+	for i, alias := range []string{"a", "b", "c"} {
+		rec, err := store.Create(alias, RecordingHeader{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		rec.Close()
+
+		// make sure the modification times are distinct
+		mt := time.Now().Add(time.Duration(i-10) * time.Minute)
+		os.Chtimes(store.Path(alias), mt, mt)
+	}
+
+	files, err := filepath.Glob(filepath.Join(tmpdir, "*"+recordingFileExtension))
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []string{store.Path("b"), store.Path("c")}
+	if diff := cmp.Diff(exp, files); diff != "" {
+		t.Errorf("unexpected recordings (-want +got):\n%s", diff)
+	}
+
+	if _, err := store.Open("../c"); err == nil {
+		t.Error("expected opening a recording outside of the store to fail")
+	}
+}
+
+func TestRecordingMaxSize(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "terminal-recording")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	store := &RecordingStore{Location: tmpdir, MaxRecordingSize: 128}
+	rec, err := store.Create("foo", RecordingHeader{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100; i++ {
+		rec.Output([]byte("0123456789"))
+	}
+	rec.Close()
+
+	fc, err := ioutil.ReadFile(store.Path("foo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fc) > 128 {
+		t.Errorf("recording exceeds its maximum size: %d bytes", len(fc))
+	}
+}
+
+func TestReplayRecordingOffset(t *testing.T) {
+	recording := `{"version":2,"width":80,"height":24}
+[0.1,"o","a"]
+[1.5,"o","b"]
+[2.5,"i","c"]
+[3,"o","d"]
+`
+	var act []string
+	_, err := ReplayRecording(context.Background(), bytes.NewReader([]byte(recording)), 2*time.Second, false, func(evt RecordingEvent) error {
+		act = append(act, evt.Data)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"c", "d"}, act); diff != "" {
+		t.Errorf("unexpected events (-want +got):\n%s", diff)
+	}
+
+	_, err = ReplayRecording(context.Background(), bytes.NewReader([]byte(`{"version":1}`)), 0, false, func(RecordingEvent) error { return nil })
+	if err == nil {
+		t.Error("expected unsupported version to fail")
+	}
+}
@@ -84,6 +84,23 @@ func (b *RingBuffer) Bytes() []byte {
 	}
 }
 
+// BytesFrom provides the bytes written from offset on, where offset counts all bytes ever
+// written to the buffer. If the data at offset is no longer retained, the slice starts at
+// the oldest byte we still have. BytesFrom returns the offset of the first byte of the slice.
+// This slice should not be written to.
+func (b *RingBuffer) BytesFrom(offset int64) ([]byte, int64) {
+	data := b.Bytes()
+	start := b.written - int64(len(data))
+	switch {
+	case offset <= start:
+		return data, start
+	case offset >= b.written:
+		return nil, b.written
+	default:
+		return data[offset-start:], offset
+	}
+}
+
 // Reset resets the buffer so it has no content.
 func (b *RingBuffer) Reset() {
 	b.writeCursor = 0
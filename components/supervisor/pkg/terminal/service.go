@@ -6,9 +6,11 @@ package terminal
 
 import (
 	"context"
+	"errors"
 	"io"
 	"os"
 	"os/exec"
+	"sync"
 	"time"
 
 	"github.com/creack/pty"
@@ -24,6 +26,11 @@ const (
 	// closeTerminaldefaultGracePeriod is the time terminal
 	// processes get between SIGTERM and SIGKILL.
 	closeTerminaldefaultGracePeriod = 10 * time.Second
+
+	// attachDefaultWindow is the number of bytes we send to an attached client before we wait for an ack
+	attachDefaultWindow = 64 << 10
+	// attachMaxFrameSize is the maximum number of output bytes we send in a single frame
+	attachMaxFrameSize = 4096
 )
 
 // NewMuxTerminalService creates a new terminal service
@@ -123,6 +130,10 @@ func (srv *MuxTerminalService) List(ctx context.Context, req *api.ListTerminalsR
 
 // Listen listens to a terminal
 func (srv *MuxTerminalService) Listen(req *api.ListenTerminalRequest, resp api.TerminalService_ListenServer) error {
+	if req.Replay != nil {
+		return srv.replay(req, resp)
+	}
+
 	srv.Mux.mu.RLock()
 	term, ok := srv.Mux.terms[req.Alias]
 	srv.Mux.mu.RUnlock()
@@ -164,6 +175,36 @@ func (srv *MuxTerminalService) Listen(req *api.ListenTerminalRequest, resp api.T
 	}
 }
 
+// replay streams the recorded output of a terminal
+func (srv *MuxTerminalService) replay(req *api.ListenTerminalRequest, resp api.TerminalService_ListenServer) error {
+	if srv.Mux.Recordings == nil {
+		return status.Error(codes.FailedPrecondition, "terminal recording is disabled")
+	}
+	f, err := srv.Mux.Recordings.Open(req.Alias)
+	if os.IsNotExist(err) {
+		return status.Error(codes.NotFound, "recording not found")
+	}
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer f.Close()
+
+	offset := time.Duration(req.Replay.OffsetMs) * time.Millisecond
+	_, err = ReplayRecording(resp.Context(), f, offset, req.Replay.Realtime, func(evt RecordingEvent) error {
+		if evt.Type != RecordingEventOutput {
+			return nil
+		}
+		return resp.Send(&api.ListenTerminalResponse{Output: &api.ListenTerminalResponse_Stdout{Stdout: []byte(evt.Data)}})
+	})
+	if err == context.Canceled || err == context.DeadlineExceeded {
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
 // Write writes to a terminal
 func (srv *MuxTerminalService) Write(ctx context.Context, req *api.WriteTerminalRequest) (*api.WriteTerminalResponse, error) {
 	srv.Mux.mu.RLock()
@@ -173,7 +214,7 @@ func (srv *MuxTerminalService) Write(ctx context.Context, req *api.WriteTerminal
 		return nil, status.Error(codes.NotFound, "terminal not found")
 	}
 
-	n, err := term.PTY.Write(req.Stdin)
+	n, err := term.Write(req.Stdin)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -195,7 +236,7 @@ func (srv *MuxTerminalService) SetSize(ctx context.Context, req *api.SetTerminal
 		return nil, status.Error(codes.FailedPrecondition, "wrong token or force not set")
 	}
 
-	err := pty.Setsize(term.PTY, &pty.Winsize{
+	err := term.Resize(&pty.Winsize{
 		Cols: uint16(req.Cols),
 		Rows: uint16(req.Rows),
 		X:    uint16(req.WidthPx),
@@ -207,3 +248,259 @@ func (srv *MuxTerminalService) SetSize(ctx context.Context, req *api.SetTerminal
 
 	return &api.SetTerminalSizeResponse{}, nil
 }
+
+// Attach attaches to a terminal, multiplexing its input, size changes and output over a single stream
+func (srv *MuxTerminalService) Attach(stream api.TerminalService_AttachServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	start := req.GetStart()
+	if start == nil {
+		return status.Error(codes.InvalidArgument, "first frame must be start")
+	}
+
+	srv.Mux.mu.RLock()
+	term, ok := srv.Mux.terms[start.Alias]
+	srv.Mux.mu.RUnlock()
+	if !ok {
+		return status.Error(codes.NotFound, "terminal not found")
+	}
+
+	window := uint64(start.Window)
+	if window == 0 {
+		window = attachDefaultWindow
+	}
+	att := &attachment{
+		Stream:    stream,
+		Term:      term,
+		Session:   start.Session,
+		CanResize: start.GetForce() || (start.GetToken() != "" && start.GetToken() == term.StarterToken),
+		window:    window,
+		acked:     start.Offset,
+		sent:      start.Offset,
+		update:    make(chan struct{}, 1),
+	}
+
+	log.WithField("alias", start.Alias).WithField("offset", start.Offset).Info("new terminal client attached")
+	defer log.WithField("alias", start.Alias).Info("attached terminal client left")
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	errchan := make(chan error, 2)
+	go func() {
+		err := att.handleInput(ctx)
+		if err == io.EOF {
+			// the client has stopped sending but might still want to receive output
+			return
+		}
+		errchan <- err
+	}()
+	go func() {
+		errchan <- att.handleOutput(ctx)
+	}()
+
+	select {
+	case err = <-errchan:
+	case <-ctx.Done():
+		return status.Error(codes.DeadlineExceeded, ctx.Err().Error())
+	}
+	if err == nil || err == io.EOF {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// attachment is a single client attached to a terminal using Attach()
+type attachment struct {
+	Stream    api.TerminalService_AttachServer
+	Term      *Term
+	Session   string
+	CanResize bool
+
+	sendMu sync.Mutex
+
+	mu     sync.Mutex
+	window uint64
+	acked  uint64
+	sent   uint64
+	update chan struct{}
+}
+
+func (att *attachment) send(resp *api.AttachTerminalResponse) error {
+	att.sendMu.Lock()
+	defer att.sendMu.Unlock()
+	return att.Stream.Send(resp)
+}
+
+// handleInput reads frames from the client until the stream ends
+func (att *attachment) handleInput(ctx context.Context) error {
+	for {
+		req, err := att.Stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		switch frame := req.Frame.(type) {
+		case *api.AttachTerminalRequest_Stdin:
+			// we ack dropped duplicates, too, s.t. the client stops re-sending them
+			_, err = att.Term.WriteSeq(att.Session, frame.Stdin.Seq, frame.Stdin.Data)
+			if err != nil {
+				return err
+			}
+			err = att.send(&api.AttachTerminalResponse{Frame: &api.AttachTerminalResponse_StdinAck{StdinAck: frame.Stdin.Seq}})
+			if err != nil {
+				return err
+			}
+		case *api.AttachTerminalRequest_Resize:
+			if !att.CanResize {
+				continue
+			}
+			err = att.Term.Resize(&pty.Winsize{
+				Cols: uint16(frame.Resize.Cols),
+				Rows: uint16(frame.Resize.Rows),
+				X:    uint16(frame.Resize.WidthPx),
+				Y:    uint16(frame.Resize.HeightPx),
+			})
+			if err != nil {
+				log.WithError(err).Warn("cannot resize attached terminal")
+			}
+		case *api.AttachTerminalRequest_Ack:
+			att.ack(frame.Ack)
+		default:
+			return status.Error(codes.InvalidArgument, "unexpected frame")
+		}
+	}
+}
+
+// handleOutput streams the terminal's output to the client while honouring the flow control window
+func (att *attachment) handleOutput(ctx context.Context) error {
+	buf := make([]byte, attachMaxFrameSize)
+	for {
+		err := att.waitForWindow(ctx)
+		if err != nil {
+			return err
+		}
+
+		att.mu.Lock()
+		offset := att.sent
+		att.mu.Unlock()
+		stdout, start := att.Term.Stdout.ListenFrom(int64(offset))
+		att.skipTo(uint64(start))
+
+		err = att.streamOutput(ctx, stdout, uint64(start), buf)
+		if err == errAttachWindowFull || err == ErrReadTimeout {
+			// We stop listening while the client catches up s.t. we don't hold up the terminal.
+			// Once the window opens up again we continue from where we left off using the backlog.
+			continue
+		}
+		return err
+	}
+}
+
+// errAttachWindowFull is returned by streamOutput when the client needs to ack output before we can send more
+var errAttachWindowFull = errors.New("flow control window is full")
+
+func (att *attachment) streamOutput(ctx context.Context, stdout io.ReadCloser, pos uint64, buf []byte) error {
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+		stdout.Close()
+	}()
+
+	for {
+		avail := att.windowAvailable()
+		if avail == 0 {
+			return errAttachWindowFull
+		}
+		if avail > uint64(len(buf)) {
+			avail = uint64(len(buf))
+		}
+
+		n, err := stdout.Read(buf[:avail])
+		if n > 0 {
+			serr := att.send(&api.AttachTerminalResponse{Frame: &api.AttachTerminalResponse_Output{Output: &api.TerminalOutput{
+				Offset: pos,
+				Data:   buf[:n],
+			}}})
+			if serr != nil {
+				return serr
+			}
+			pos += uint64(n)
+			att.mu.Lock()
+			att.sent = pos
+			att.mu.Unlock()
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+	}
+}
+
+// ack marks all output up to offset as acknowledged by the client
+func (att *attachment) ack(offset uint64) {
+	att.mu.Lock()
+	if offset > att.sent {
+		offset = att.sent
+	}
+	if offset > att.acked {
+		att.acked = offset
+	}
+	att.mu.Unlock()
+
+	select {
+	case att.update <- struct{}{}:
+	default:
+	}
+}
+
+// skipTo moves the stream forward to offset if the output before offset is no longer available
+func (att *attachment) skipTo(offset uint64) {
+	att.mu.Lock()
+	defer att.mu.Unlock()
+
+	if offset > att.sent {
+		att.sent = offset
+	}
+	if offset > att.acked {
+		att.acked = offset
+	}
+}
+
+// windowAvailable returns the number of bytes we can send before the client needs to ack
+func (att *attachment) windowAvailable() uint64 {
+	att.mu.Lock()
+	defer att.mu.Unlock()
+
+	inflight := att.sent - att.acked
+	if inflight >= att.window {
+		return 0
+	}
+	return att.window - inflight
+}
+
+// waitForWindow blocks until there's room to send more output
+func (att *attachment) waitForWindow(ctx context.Context) error {
+	for {
+		if att.windowAvailable() > 0 {
+			return nil
+		}
+
+		select {
+		case <-att.update:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
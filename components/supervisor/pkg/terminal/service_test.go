@@ -0,0 +1,207 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package terminal
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+)
+
+type fakeAttachServer struct {
+	grpc.ServerStream
+
+	ctx  context.Context
+	in   chan *api.AttachTerminalRequest
+	resp chan *api.AttachTerminalResponse
+}
+
+func newFakeAttachServer(ctx context.Context) *fakeAttachServer {
+	return &fakeAttachServer{
+		ctx:  ctx,
+		in:   make(chan *api.AttachTerminalRequest, 16),
+		resp: make(chan *api.AttachTerminalResponse, 1024),
+	}
+}
+
+func (s *fakeAttachServer) Context() context.Context { return s.ctx }
+
+func (s *fakeAttachServer) Send(resp *api.AttachTerminalResponse) error {
+	// like gRPC, which serializes on send, we must not hold on to the message: the server re-uses the output buffer
+	s.resp <- proto.Clone(resp).(*api.AttachTerminalResponse)
+	return nil
+}
+
+func (s *fakeAttachServer) Recv() (*api.AttachTerminalRequest, error) {
+	select {
+	case req := <-s.in:
+		return req, nil
+	case <-s.ctx.Done():
+		return nil, io.EOF
+	}
+}
+
+func (s *fakeAttachServer) next(t *testing.T) *api.AttachTerminalResponse {
+	select {
+	case resp := <-s.resp:
+		return resp
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout while waiting for attach response")
+		return nil
+	}
+}
+
+// readOutput reads output frames until it has seen n bytes, acking each frame if ack is true
+func (s *fakeAttachServer) readOutput(t *testing.T, n int, ack bool) (offset uint64, data []byte) {
+	first := true
+	for len(data) < n {
+		out := s.next(t).GetOutput()
+		if out == nil {
+			continue
+		}
+		if first {
+			offset, first = out.Offset, false
+		}
+		data = append(data, out.Data...)
+		if ack {
+			s.in <- &api.AttachTerminalRequest{Frame: &api.AttachTerminalRequest_Ack{Ack: out.Offset + uint64(len(out.Data))}}
+		}
+	}
+	return
+}
+
+func TestAttach(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "terminal-attach")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	srv := NewMuxTerminalService(NewMux())
+	cmd := exec.Command("/bin/sh", "-c", "stty -echo; printf 0123456789; cat")
+	cmd.Dir = tmpdir
+	alias, err := srv.Mux.Start(cmd, TermOptions{ReadTimeout: 5 * time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Mux.CloseTerminal(alias, 0)
+
+	attach := func(start *api.AttachTerminalStart) (*fakeAttachServer, context.CancelFunc, chan error) {
+		ctx, cancel := context.WithCancel(context.Background())
+		stream := newFakeAttachServer(ctx)
+		stream.in <- &api.AttachTerminalRequest{Frame: &api.AttachTerminalRequest_Start{Start: start}}
+		errchan := make(chan error, 1)
+		go func() { errchan <- srv.Attach(stream) }()
+		return stream, cancel, errchan
+	}
+
+	t.Run("flow control", func(t *testing.T) {
+		stream, cancel, _ := attach(&api.AttachTerminalStart{Alias: alias, Window: 4})
+		defer cancel()
+
+		offset, data := stream.readOutput(t, 4, false)
+		if offset != 0 || string(data) != "0123" {
+			t.Fatalf("unexpected output at %d: %q", offset, string(data))
+		}
+		select {
+		case resp := <-stream.resp:
+			t.Fatalf("received output beyond the window: %v", resp)
+		case <-time.After(200 * time.Millisecond):
+		}
+
+		stream.in <- &api.AttachTerminalRequest{Frame: &api.AttachTerminalRequest_Ack{Ack: 4}}
+		offset, data = stream.readOutput(t, 4, false)
+		if offset != 4 || string(data) != "4567" {
+			t.Fatalf("unexpected output at %d: %q", offset, string(data))
+		}
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		stream, cancel, _ := attach(&api.AttachTerminalStart{Alias: alias, Offset: 6})
+		defer cancel()
+
+		offset, data := stream.readOutput(t, 4, true)
+		if offset != 6 || string(data) != "6789" {
+			t.Fatalf("unexpected output at %d: %q", offset, string(data))
+		}
+	})
+
+	t.Run("stdin", func(t *testing.T) {
+		stream, cancel, _ := attach(&api.AttachTerminalStart{Alias: alias, Offset: 10})
+		defer cancel()
+
+		stream.in <- &api.AttachTerminalRequest{Frame: &api.AttachTerminalRequest_Stdin{Stdin: &api.TerminalInput{Seq: 42, Data: []byte("hello\n")}}}
+
+		var (
+			acked bool
+			out   []byte
+		)
+		for !acked || !bytes.Contains(out, []byte("hello")) {
+			resp := stream.next(t)
+			switch frame := resp.Frame.(type) {
+			case *api.AttachTerminalResponse_StdinAck:
+				if frame.StdinAck != 42 {
+					t.Fatalf("unexpected stdin ack: %d", frame.StdinAck)
+				}
+				acked = true
+			case *api.AttachTerminalResponse_Output:
+				out = append(out, frame.Output.Data...)
+			}
+		}
+	})
+
+	t.Run("stdin resume", func(t *testing.T) {
+		stdin := func(seq uint64, data string) *api.AttachTerminalRequest {
+			return &api.AttachTerminalRequest{Frame: &api.AttachTerminalRequest_Stdin{Stdin: &api.TerminalInput{Seq: seq, Data: []byte(data)}}}
+		}
+		waitForAck := func(stream *fakeAttachServer, seq uint64) {
+			for {
+				if ack, ok := stream.next(t).Frame.(*api.AttachTerminalResponse_StdinAck); ok && ack.StdinAck == seq {
+					return
+				}
+			}
+		}
+
+		stream, cancel, errchan := attach(&api.AttachTerminalStart{Alias: alias, Offset: 10, Session: "resume"})
+		stream.in <- stdin(1, "first\n")
+		waitForAck(stream, 1)
+		cancel()
+		<-errchan
+
+		// the client does not know that the first frame made it and sends it again after resuming
+		stream, cancel, _ = attach(&api.AttachTerminalStart{Alias: alias, Session: "resume"})
+		defer cancel()
+		stream.in <- stdin(1, "first\n")
+		stream.in <- stdin(2, "second\n")
+
+		var out []byte
+		for !bytes.Contains(out, []byte("second")) {
+			out = append(out, stream.next(t).GetOutput().GetData()...)
+		}
+		if n := bytes.Count(out, []byte("first")); n != 1 {
+			t.Errorf("re-sent input was written %d times: %q", n, string(out))
+		}
+	})
+
+	t.Run("missing start", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		stream := newFakeAttachServer(ctx)
+		stream.in <- &api.AttachTerminalRequest{Frame: &api.AttachTerminalRequest_Ack{Ack: 0}}
+		err := srv.Attach(stream)
+		if err == nil {
+			t.Fatal("expected attach without start to fail")
+		}
+	})
+}
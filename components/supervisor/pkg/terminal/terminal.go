@@ -5,12 +5,14 @@
 package terminal
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"strings"
 	"sync"
 	"time"
 
@@ -30,6 +32,9 @@ func NewMux() *Mux {
 
 // Mux can mux pseudo-terminals
 type Mux struct {
+	// Recordings, if set, makes the mux record all terminals it starts
+	Recordings *RecordingStore
+
 	terms map[string]*Term
 	mu    sync.RWMutex
 }
@@ -48,7 +53,7 @@ func (m *Mux) Start(cmd *exec.Cmd, options TermOptions) (alias string, err error
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	pty, err := pty.Start(cmd)
+	pty, err := pty.StartWithSize(cmd, options.Size)
 	if err != nil {
 		return "", xerrors.Errorf("cannot start PTY: %w", err)
 	}
@@ -59,9 +64,20 @@ func (m *Mux) Start(cmd *exec.Cmd, options TermOptions) (alias string, err error
 	}
 	alias = uid.String()
 
-	term, err := newTerm(pty, cmd, options)
+	var recorder *Recorder
+	if m.Recordings != nil {
+		recorder, err = m.Recordings.Create(alias, recordingHeader(cmd, options))
+		if err != nil {
+			log.WithError(err).WithField("alias", alias).Warn("cannot record terminal")
+		}
+	}
+
+	term, err := newTerm(pty, cmd, options, recorder)
 	if err != nil {
 		pty.Close()
+		if recorder != nil {
+			recorder.Close()
+		}
 		return "", err
 	}
 	m.terms[alias] = term
@@ -70,6 +86,13 @@ func (m *Mux) Start(cmd *exec.Cmd, options TermOptions) (alias string, err error
 
 	go func() {
 		term.waitErr = cmd.Wait()
+
+		// give the remaining output a chance to reach the listeners before we close the terminal
+		select {
+		case <-term.outputDone:
+		case <-time.After(terminalOutputDrainTimeout):
+		}
+
 		close(term.waitDone)
 		m.CloseTerminal(alias, 0*time.Second)
 	}()
@@ -128,6 +151,12 @@ func (m *Mux) doClose(alias string, gracePeriod time.Duration) error {
 	if err != nil {
 		log.WithError(err).Warn("cannot close pseudo-terminal")
 	}
+	if term.recorder != nil {
+		err = term.recorder.Close()
+		if err != nil {
+			log.WithError(err).Warn("cannot close terminal recording")
+		}
+	}
 	delete(m.terms, alias)
 
 	return nil
@@ -169,7 +198,28 @@ func gracefullyShutdownProcess(p *os.Process, gracePeriod time.Duration) error {
 // For now we assume an average of five terminals per workspace, which makes this consume 1MiB of RAM.
 const terminalBacklogSize = 256 << 10
 
-func newTerm(pty *os.File, cmd *exec.Cmd, options TermOptions) (*Term, error) {
+// terminalOutputDrainTimeout is the time we wait for the output of an exited process before closing its terminal.
+// Processes that were started in the background may keep the terminal open, hence we cannot wait forever.
+const terminalOutputDrainTimeout = 1 * time.Second
+
+func recordingHeader(cmd *exec.Cmd, options TermOptions) RecordingHeader {
+	hdr := RecordingHeader{
+		Title: strings.Join(cmd.Args, " "),
+		Env:   make(map[string]string),
+	}
+	if options.Size != nil {
+		hdr.Width, hdr.Height = int(options.Size.Cols), int(options.Size.Rows)
+	}
+	for _, e := range cmd.Env {
+		if strings.HasPrefix(e, "TERM=") || strings.HasPrefix(e, "SHELL=") {
+			segs := strings.SplitN(e, "=", 2)
+			hdr.Env[segs[0]] = segs[1]
+		}
+	}
+	return hdr
+}
+
+func newTerm(pty *os.File, cmd *exec.Cmd, options TermOptions, rec *Recorder) (*Term, error) {
 	token, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
@@ -195,9 +245,21 @@ func newTerm(pty *os.File, cmd *exec.Cmd, options TermOptions) (*Term, error) {
 
 		StarterToken: token.String(),
 
-		waitDone: make(chan struct{}),
+		recorder: rec,
+
+		stdinSeqs: make(map[string]stdinSeq),
+
+		waitDone:   make(chan struct{}),
+		outputDone: make(chan struct{}),
 	}
-	go io.Copy(res.Stdout, pty)
+	var out io.Writer = res.Stdout
+	if rec != nil {
+		out = io.MultiWriter(res.Stdout, recordedOutput{rec})
+	}
+	go func() {
+		io.Copy(out, pty)
+		close(res.outputDone)
+	}()
 	return res, nil
 }
 
@@ -205,6 +267,9 @@ func newTerm(pty *os.File, cmd *exec.Cmd, options TermOptions) (*Term, error) {
 type TermOptions struct {
 	// timeout after which a listener is dropped. Use 0 for no timeout.
 	ReadTimeout time.Duration
+
+	// Size is the initial size of the pseudo-terminal. Use nil for the default size.
+	Size *pty.Winsize
 }
 
 // Term is a pseudo-terminal
@@ -216,8 +281,81 @@ type Term struct {
 
 	Stdout *multiWriter
 
-	waitErr  error
-	waitDone chan struct{}
+	recorder *Recorder
+
+	// stdinSeqs is the seq of the last stdin frame we wrote per attach session
+	stdinMu   sync.Mutex
+	stdinSeqs map[string]stdinSeq
+
+	waitErr    error
+	waitDone   chan struct{}
+	outputDone chan struct{}
+}
+
+// Write writes to the process running in the terminal, i.e. acts as the process' input
+func (term *Term) Write(p []byte) (n int, err error) {
+	n, err = term.PTY.Write(p)
+	if n > 0 && term.recorder != nil {
+		term.recorder.Input(p[:n])
+	}
+	return
+}
+
+// maxStdinSessions is the number of attach sessions per terminal whose stdin seq we remember
+const maxStdinSessions = 64
+
+type stdinSeq struct {
+	Seq      uint64
+	LastUsed time.Time
+}
+
+// WriteSeq writes p to the terminal unless this session has written a frame with the same or a later seq before.
+// Clients re-send unacknowledged input when they resume an attachment, hence input might arrive more than once.
+// A seq of zero disables this check.
+func (term *Term) WriteSeq(session string, seq uint64, p []byte) (written bool, err error) {
+	if seq == 0 {
+		_, err = term.Write(p)
+		return err == nil, err
+	}
+
+	term.stdinMu.Lock()
+	defer term.stdinMu.Unlock()
+
+	last, ok := term.stdinSeqs[session]
+	if ok && seq <= last.Seq {
+		return false, nil
+	}
+	if !ok && len(term.stdinSeqs) >= maxStdinSessions {
+		var (
+			oldest   string
+			oldestAt time.Time
+		)
+		for s, l := range term.stdinSeqs {
+			if oldestAt.IsZero() || l.LastUsed.Before(oldestAt) {
+				oldest, oldestAt = s, l.LastUsed
+			}
+		}
+		delete(term.stdinSeqs, oldest)
+	}
+
+	_, err = term.Write(p)
+	if err != nil {
+		return false, err
+	}
+	term.stdinSeqs[session] = stdinSeq{Seq: seq, LastUsed: time.Now()}
+	return true, nil
+}
+
+// Resize changes the size of the terminal
+func (term *Term) Resize(size *pty.Winsize) error {
+	err := pty.Setsize(term.PTY, size)
+	if err != nil {
+		return err
+	}
+	if term.recorder != nil {
+		term.recorder.Resize(size.Cols, size.Rows)
+	}
+	return nil
 }
 
 // Wait waits for the terminal to exit and returns the resulted process state
@@ -245,7 +383,6 @@ var ErrReadTimeout = errors.New("read timeout")
 type multiWriterListener struct {
 	io.Reader
 
-	closed    bool
 	once      sync.Once
 	closeErr  error
 	closeChan chan struct{}
@@ -263,7 +400,6 @@ func (l *multiWriterListener) CloseWithError(err error) error {
 			l.closeErr = err
 		}
 		close(l.closeChan)
-		l.closed = true
 
 		// actual cleanup happens in a go routine started by Listen()
 	})
@@ -274,22 +410,23 @@ func (l *multiWriterListener) Done() <-chan struct{} {
 	return l.closeChan
 }
 
-type closedTerminalListener struct {
-}
-
-func (closedTerminalListener) Read(p []byte) (n int, err error) {
-	return 0, io.EOF
-}
-
-var closedListener = ioutil.NopCloser(closedTerminalListener{})
-
 // Listen listens in on the multi-writer stream
 func (mw *multiWriter) Listen() io.ReadCloser {
+	r, _ := mw.ListenFrom(0)
+	return r
+}
+
+// ListenFrom listens in on the multi-writer stream starting at the given output offset.
+// If the output at offset is no longer retained, the listener starts with the oldest output
+// we still have. ListenFrom returns the offset at which the listener starts.
+func (mw *multiWriter) ListenFrom(offset int64) (io.ReadCloser, int64) {
 	mw.mu.Lock()
 	defer mw.mu.Unlock()
 
+	recording, start := mw.recorder.BytesFrom(offset)
 	if mw.closed {
-		return closedListener
+		// the terminal is gone, but listeners still get to see what happened in it
+		return ioutil.NopCloser(bytes.NewReader(recording)), start
 	}
 
 	r, w := io.Pipe()
@@ -301,7 +438,6 @@ func (mw *multiWriter) Listen() io.ReadCloser {
 		closeChan: closeChan,
 	}
 
-	recording := mw.recorder.Bytes()
 	go func() {
 		w.Write(recording)
 
@@ -328,16 +464,17 @@ func (mw *multiWriter) Listen() io.ReadCloser {
 		} else {
 			w.Close()
 		}
-		close(cchan)
 
+		// Write sends to cchan while holding the lock, hence we must not close it without
 		mw.mu.Lock()
+		close(cchan)
 		delete(mw.listener, res)
 		mw.mu.Unlock()
 	}()
 
 	mw.listener[res] = struct{}{}
 
-	return res
+	return res, start
 }
 
 func (mw *multiWriter) Write(p []byte) (n int, err error) {
@@ -347,8 +484,10 @@ func (mw *multiWriter) Write(p []byte) (n int, err error) {
 	mw.recorder.Write(p)
 
 	for lstr := range mw.listener {
-		if lstr.closed {
+		select {
+		case <-lstr.closeChan:
 			continue
+		default:
 		}
 
 		select {
@@ -395,3 +534,13 @@ type opCloser struct {
 }
 
 func (c *opCloser) Close() error { return c.Op() }
+
+// recordedOutput records everything written to it as terminal output
+type recordedOutput struct {
+	*Recorder
+}
+
+func (r recordedOutput) Write(p []byte) (int, error) {
+	r.Output(p)
+	return len(p), nil
+}
@@ -6,7 +6,7 @@ mv github.com/gitpod-io/gitpod/ws-daemon/api/* go && rm -rf github.com
 
 GO111MODULE=on go get github.com/golang/mock/mockgen@latest
 cd go
-mockgen -package mock -source=daemon.pb.go > mock/mock_wsdaemon.go
+mockgen -package mock github.com/gitpod-io/gitpod/ws-daemon/api WorkspaceContentServiceClient,WorkspaceContentServiceServer,WorkspaceContentService_WatchWorkspaceEventsClient,WorkspaceContentService_WatchWorkspaceEventsServer,InventoryServiceClient,InventoryServiceServer > mock/mock_wsdaemon.go
 
 
 echo "updating JSON tags"
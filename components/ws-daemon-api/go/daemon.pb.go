@@ -12,6 +12,7 @@ import (
 	fmt "fmt"
 	api "github.com/gitpod-io/gitpod/content-service/api"
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -312,7 +313,10 @@ var xxx_messageInfo_WaitForInitResponse proto.InternalMessageInfo
 // TakeSnapshotRequest creates a backup/snapshot of a workspace
 type TakeSnapshotRequest struct {
 	// ID is the identifier of the workspace of which we want to create a snapshot of
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// prebuild marks the snapshot as the outcome of a prebuild. We upload the prebuild report and task logs
+	// the workspace holds next to the snapshot.
+	Prebuild             bool     `protobuf:"varint,2,opt,name=prebuild,proto3" json:"prebuild,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -350,6 +354,13 @@ func (m *TakeSnapshotRequest) GetId() string {
 	return ""
 }
 
+func (m *TakeSnapshotRequest) GetPrebuild() bool {
+	if m != nil {
+		return m.Prebuild
+	}
+	return false
+}
+
 type TakeSnapshotResponse struct {
 	// url is the name of the resulting snapshot
 	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
@@ -480,6 +491,767 @@ func (m *DisposeWorkspaceResponse) GetGitStatus() *api.GitStatus {
 	return nil
 }
 
+type WatchWorkspaceEventsRequest struct {
+	// ID is the identifier of the workspace whose events we want to watch
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchWorkspaceEventsRequest) Reset()         { *m = WatchWorkspaceEventsRequest{} }
+func (m *WatchWorkspaceEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchWorkspaceEventsRequest) ProtoMessage()    {}
+func (*WatchWorkspaceEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{9}
+}
+
+func (m *WatchWorkspaceEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchWorkspaceEventsRequest.Unmarshal(m, b)
+}
+func (m *WatchWorkspaceEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchWorkspaceEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchWorkspaceEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchWorkspaceEventsRequest.Merge(m, src)
+}
+func (m *WatchWorkspaceEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchWorkspaceEventsRequest.Size(m)
+}
+func (m *WatchWorkspaceEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchWorkspaceEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchWorkspaceEventsRequest proto.InternalMessageInfo
+
+func (m *WatchWorkspaceEventsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// WorkspaceLifecycleEvent describes progress or failure of work ws-daemon does on a workspace's content
+type WorkspaceLifecycleEvent struct {
+	// ID is the identifier of the workspace this event refers to
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// time is when the event occured
+	Time *timestamp.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//	*WorkspaceLifecycleEvent_Initializer
+	//	*WorkspaceLifecycleEvent_Backup
+	//	*WorkspaceLifecycleEvent_Error
+	//	*WorkspaceLifecycleEvent_GitStatus
+	Payload              isWorkspaceLifecycleEvent_Payload `protobuf_oneof:"payload"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *WorkspaceLifecycleEvent) Reset()         { *m = WorkspaceLifecycleEvent{} }
+func (m *WorkspaceLifecycleEvent) String() string { return proto.CompactTextString(m) }
+func (*WorkspaceLifecycleEvent) ProtoMessage()    {}
+func (*WorkspaceLifecycleEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{10}
+}
+
+func (m *WorkspaceLifecycleEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkspaceLifecycleEvent.Unmarshal(m, b)
+}
+func (m *WorkspaceLifecycleEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkspaceLifecycleEvent.Marshal(b, m, deterministic)
+}
+func (m *WorkspaceLifecycleEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkspaceLifecycleEvent.Merge(m, src)
+}
+func (m *WorkspaceLifecycleEvent) XXX_Size() int {
+	return xxx_messageInfo_WorkspaceLifecycleEvent.Size(m)
+}
+func (m *WorkspaceLifecycleEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkspaceLifecycleEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkspaceLifecycleEvent proto.InternalMessageInfo
+
+func (m *WorkspaceLifecycleEvent) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *WorkspaceLifecycleEvent) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+type isWorkspaceLifecycleEvent_Payload interface {
+	isWorkspaceLifecycleEvent_Payload()
+}
+
+type WorkspaceLifecycleEvent_Initializer struct {
+	Initializer *InitializerProgress `protobuf:"bytes,3,opt,name=initializer,proto3,oneof"`
+}
+
+type WorkspaceLifecycleEvent_Backup struct {
+	Backup *BackupProgress `protobuf:"bytes,4,opt,name=backup,proto3,oneof"`
+}
+
+type WorkspaceLifecycleEvent_Error struct {
+	Error *ContentError `protobuf:"bytes,5,opt,name=error,proto3,oneof"`
+}
+
+type WorkspaceLifecycleEvent_GitStatus struct {
+	GitStatus *api.GitStatus `protobuf:"bytes,6,opt,name=git_status,json=gitStatus,proto3,oneof"`
+}
+
+func (*WorkspaceLifecycleEvent_Initializer) isWorkspaceLifecycleEvent_Payload() {}
+
+func (*WorkspaceLifecycleEvent_Backup) isWorkspaceLifecycleEvent_Payload() {}
+
+func (*WorkspaceLifecycleEvent_Error) isWorkspaceLifecycleEvent_Payload() {}
+
+func (*WorkspaceLifecycleEvent_GitStatus) isWorkspaceLifecycleEvent_Payload() {}
+
+func (m *WorkspaceLifecycleEvent) GetPayload() isWorkspaceLifecycleEvent_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *WorkspaceLifecycleEvent) GetInitializer() *InitializerProgress {
+	if x, ok := m.GetPayload().(*WorkspaceLifecycleEvent_Initializer); ok {
+		return x.Initializer
+	}
+	return nil
+}
+
+func (m *WorkspaceLifecycleEvent) GetBackup() *BackupProgress {
+	if x, ok := m.GetPayload().(*WorkspaceLifecycleEvent_Backup); ok {
+		return x.Backup
+	}
+	return nil
+}
+
+func (m *WorkspaceLifecycleEvent) GetError() *ContentError {
+	if x, ok := m.GetPayload().(*WorkspaceLifecycleEvent_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *WorkspaceLifecycleEvent) GetGitStatus() *api.GitStatus {
+	if x, ok := m.GetPayload().(*WorkspaceLifecycleEvent_GitStatus); ok {
+		return x.GitStatus
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*WorkspaceLifecycleEvent) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*WorkspaceLifecycleEvent_Initializer)(nil),
+		(*WorkspaceLifecycleEvent_Backup)(nil),
+		(*WorkspaceLifecycleEvent_Error)(nil),
+		(*WorkspaceLifecycleEvent_GitStatus)(nil),
+	}
+}
+
+// InitializerProgress describes the progress of workspace content initialization
+type InitializerProgress struct {
+	// phase is the step the initializer is currently in, e.g. "downloading" or "cloning"
+	Phase string `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	// bytes_downloaded is the amount of content downloaded so far in this phase
+	BytesDownloaded int64 `protobuf:"varint,2,opt,name=bytes_downloaded,json=bytesDownloaded,proto3" json:"bytesDownloaded,omitempty"`
+	// bytes_total is the amount of content to download in this phase. Zero if unknown.
+	BytesTotal int64 `protobuf:"varint,3,opt,name=bytes_total,json=bytesTotal,proto3" json:"bytesTotal,omitempty"`
+	// done is true once the initialization is complete
+	Done                 bool     `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitializerProgress) Reset()         { *m = InitializerProgress{} }
+func (m *InitializerProgress) String() string { return proto.CompactTextString(m) }
+func (*InitializerProgress) ProtoMessage()    {}
+func (*InitializerProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{11}
+}
+
+func (m *InitializerProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitializerProgress.Unmarshal(m, b)
+}
+func (m *InitializerProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitializerProgress.Marshal(b, m, deterministic)
+}
+func (m *InitializerProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitializerProgress.Merge(m, src)
+}
+func (m *InitializerProgress) XXX_Size() int {
+	return xxx_messageInfo_InitializerProgress.Size(m)
+}
+func (m *InitializerProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitializerProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitializerProgress proto.InternalMessageInfo
+
+func (m *InitializerProgress) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *InitializerProgress) GetBytesDownloaded() int64 {
+	if m != nil {
+		return m.BytesDownloaded
+	}
+	return 0
+}
+
+func (m *InitializerProgress) GetBytesTotal() int64 {
+	if m != nil {
+		return m.BytesTotal
+	}
+	return 0
+}
+
+func (m *InitializerProgress) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+// BackupProgress describes the progress of a workspace content backup
+type BackupProgress struct {
+	// phase is the step the backup is currently in, e.g. "archiving" or "uploading"
+	Phase string `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	// bytes_uploaded is the amount of the archive uploaded so far
+	BytesUploaded int64 `protobuf:"varint,2,opt,name=bytes_uploaded,json=bytesUploaded,proto3" json:"bytesUploaded,omitempty"`
+	// bytes_total is the size of the archive
+	BytesTotal int64 `protobuf:"varint,3,opt,name=bytes_total,json=bytesTotal,proto3" json:"bytesTotal,omitempty"`
+	// attempt counts the attempts of the current phase, starting at one
+	Attempt int32 `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// final is true if this is the final backup taken when the workspace is disposed of
+	Final bool `protobuf:"varint,5,opt,name=final,proto3" json:"final,omitempty"`
+	// done is true once the backup is complete
+	Done                 bool     `protobuf:"varint,6,opt,name=done,proto3" json:"done,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupProgress) Reset()         { *m = BackupProgress{} }
+func (m *BackupProgress) String() string { return proto.CompactTextString(m) }
+func (*BackupProgress) ProtoMessage()    {}
+func (*BackupProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{12}
+}
+
+func (m *BackupProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupProgress.Unmarshal(m, b)
+}
+func (m *BackupProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupProgress.Marshal(b, m, deterministic)
+}
+func (m *BackupProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupProgress.Merge(m, src)
+}
+func (m *BackupProgress) XXX_Size() int {
+	return xxx_messageInfo_BackupProgress.Size(m)
+}
+func (m *BackupProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupProgress proto.InternalMessageInfo
+
+func (m *BackupProgress) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *BackupProgress) GetBytesUploaded() int64 {
+	if m != nil {
+		return m.BytesUploaded
+	}
+	return 0
+}
+
+func (m *BackupProgress) GetBytesTotal() int64 {
+	if m != nil {
+		return m.BytesTotal
+	}
+	return 0
+}
+
+func (m *BackupProgress) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *BackupProgress) GetFinal() bool {
+	if m != nil {
+		return m.Final
+	}
+	return false
+}
+
+func (m *BackupProgress) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+// ContentError describes a failed attempt to work with a workspace's content
+type ContentError struct {
+	// operation is the operation which failed, e.g. "initialize" or "backup"
+	Operation string `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	// message describes the error
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// will_retry is true if ws-daemon will try the operation again
+	WillRetry            bool     `protobuf:"varint,3,opt,name=will_retry,json=willRetry,proto3" json:"willRetry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContentError) Reset()         { *m = ContentError{} }
+func (m *ContentError) String() string { return proto.CompactTextString(m) }
+func (*ContentError) ProtoMessage()    {}
+func (*ContentError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{13}
+}
+
+func (m *ContentError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContentError.Unmarshal(m, b)
+}
+func (m *ContentError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContentError.Marshal(b, m, deterministic)
+}
+func (m *ContentError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContentError.Merge(m, src)
+}
+func (m *ContentError) XXX_Size() int {
+	return xxx_messageInfo_ContentError.Size(m)
+}
+func (m *ContentError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContentError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContentError proto.InternalMessageInfo
+
+func (m *ContentError) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *ContentError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ContentError) GetWillRetry() bool {
+	if m != nil {
+		return m.WillRetry
+	}
+	return false
+}
+
+type ListWorkspacesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListWorkspacesRequest) Reset()         { *m = ListWorkspacesRequest{} }
+func (m *ListWorkspacesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListWorkspacesRequest) ProtoMessage()    {}
+func (*ListWorkspacesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{14}
+}
+
+func (m *ListWorkspacesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWorkspacesRequest.Unmarshal(m, b)
+}
+func (m *ListWorkspacesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWorkspacesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListWorkspacesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWorkspacesRequest.Merge(m, src)
+}
+func (m *ListWorkspacesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListWorkspacesRequest.Size(m)
+}
+func (m *ListWorkspacesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWorkspacesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWorkspacesRequest proto.InternalMessageInfo
+
+type ListWorkspacesResponse struct {
+	// node is the name of the node this daemon runs on
+	Node string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	// workspaces are all workspaces this daemon currently manages
+	Workspaces           []*WorkspaceInventoryEntry `protobuf:"bytes,2,rep,name=workspaces,proto3" json:"workspaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ListWorkspacesResponse) Reset()         { *m = ListWorkspacesResponse{} }
+func (m *ListWorkspacesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListWorkspacesResponse) ProtoMessage()    {}
+func (*ListWorkspacesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{15}
+}
+
+func (m *ListWorkspacesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWorkspacesResponse.Unmarshal(m, b)
+}
+func (m *ListWorkspacesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWorkspacesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListWorkspacesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWorkspacesResponse.Merge(m, src)
+}
+func (m *ListWorkspacesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListWorkspacesResponse.Size(m)
+}
+func (m *ListWorkspacesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWorkspacesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWorkspacesResponse proto.InternalMessageInfo
+
+func (m *ListWorkspacesResponse) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
+func (m *ListWorkspacesResponse) GetWorkspaces() []*WorkspaceInventoryEntry {
+	if m != nil {
+		return m.Workspaces
+	}
+	return nil
+}
+
+// WorkspaceInventoryEntry describes a single workspace managed by a daemon
+type WorkspaceInventoryEntry struct {
+	// ID is the instance ID of the workspace
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// metadata is the data associated with the workspace when it was initialized
+	Metadata *WorkspaceMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// state is the lifecycle state of the workspace content, e.g. initializing, ready or disposing
+	State string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	// full_workspace_backup is true if this workspace uses full workspace backup
+	FullWorkspaceBackup bool `protobuf:"varint,4,opt,name=full_workspace_backup,json=fullWorkspaceBackup,proto3" json:"fullWorkspaceBackup,omitempty"`
+	// created_at is the time the workspace was created on this node
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"createdAt,omitempty"`
+	// backup describes the state of backups of this workspace
+	Backup *BackupInventory `protobuf:"bytes,6,opt,name=backup,proto3" json:"backup,omitempty"`
+	// resources describes the resource use and limits of this workspace
+	Resources *ResourceInventory `protobuf:"bytes,7,opt,name=resources,proto3" json:"resources,omitempty"`
+	// container describes the workspace container. If the container has not been seen yet, this field is nil.
+	Container            *ContainerInventory `protobuf:"bytes,8,opt,name=container,proto3" json:"container,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *WorkspaceInventoryEntry) Reset()         { *m = WorkspaceInventoryEntry{} }
+func (m *WorkspaceInventoryEntry) String() string { return proto.CompactTextString(m) }
+func (*WorkspaceInventoryEntry) ProtoMessage()    {}
+func (*WorkspaceInventoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{16}
+}
+
+func (m *WorkspaceInventoryEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkspaceInventoryEntry.Unmarshal(m, b)
+}
+func (m *WorkspaceInventoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkspaceInventoryEntry.Marshal(b, m, deterministic)
+}
+func (m *WorkspaceInventoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkspaceInventoryEntry.Merge(m, src)
+}
+func (m *WorkspaceInventoryEntry) XXX_Size() int {
+	return xxx_messageInfo_WorkspaceInventoryEntry.Size(m)
+}
+func (m *WorkspaceInventoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkspaceInventoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkspaceInventoryEntry proto.InternalMessageInfo
+
+func (m *WorkspaceInventoryEntry) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *WorkspaceInventoryEntry) GetMetadata() *WorkspaceMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *WorkspaceInventoryEntry) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *WorkspaceInventoryEntry) GetFullWorkspaceBackup() bool {
+	if m != nil {
+		return m.FullWorkspaceBackup
+	}
+	return false
+}
+
+func (m *WorkspaceInventoryEntry) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *WorkspaceInventoryEntry) GetBackup() *BackupInventory {
+	if m != nil {
+		return m.Backup
+	}
+	return nil
+}
+
+func (m *WorkspaceInventoryEntry) GetResources() *ResourceInventory {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *WorkspaceInventoryEntry) GetContainer() *ContainerInventory {
+	if m != nil {
+		return m.Container
+	}
+	return nil
+}
+
+// BackupInventory describes the state of backups of a workspace
+type BackupInventory struct {
+	// live_backup_running is true if a live backup is currently maintained for this workspace
+	LiveBackupRunning bool `protobuf:"varint,1,opt,name=live_backup_running,json=liveBackupRunning,proto3" json:"liveBackupRunning,omitempty"`
+	// last_live_backup is the time the last live backup was created
+	LastLiveBackup *timestamp.Timestamp `protobuf:"bytes,2,opt,name=last_live_backup,json=lastLiveBackup,proto3" json:"lastLiveBackup,omitempty"`
+	// last_backup is the time the last backup was uploaded to remote storage
+	LastBackup *timestamp.Timestamp `protobuf:"bytes,3,opt,name=last_backup,json=lastBackup,proto3" json:"lastBackup,omitempty"`
+	// last_backup_size is the size of the last backup uploaded to remote storage in bytes
+	LastBackupSize       int64    `protobuf:"varint,4,opt,name=last_backup_size,json=lastBackupSize,proto3" json:"lastBackupSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupInventory) Reset()         { *m = BackupInventory{} }
+func (m *BackupInventory) String() string { return proto.CompactTextString(m) }
+func (*BackupInventory) ProtoMessage()    {}
+func (*BackupInventory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{17}
+}
+
+func (m *BackupInventory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupInventory.Unmarshal(m, b)
+}
+func (m *BackupInventory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupInventory.Marshal(b, m, deterministic)
+}
+func (m *BackupInventory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupInventory.Merge(m, src)
+}
+func (m *BackupInventory) XXX_Size() int {
+	return xxx_messageInfo_BackupInventory.Size(m)
+}
+func (m *BackupInventory) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupInventory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupInventory proto.InternalMessageInfo
+
+func (m *BackupInventory) GetLiveBackupRunning() bool {
+	if m != nil {
+		return m.LiveBackupRunning
+	}
+	return false
+}
+
+func (m *BackupInventory) GetLastLiveBackup() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastLiveBackup
+	}
+	return nil
+}
+
+func (m *BackupInventory) GetLastBackup() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastBackup
+	}
+	return nil
+}
+
+func (m *BackupInventory) GetLastBackupSize() int64 {
+	if m != nil {
+		return m.LastBackupSize
+	}
+	return 0
+}
+
+// ResourceInventory describes the resource use and limits of a workspace
+type ResourceInventory struct {
+	// cpu_governed is true if a resource governer is running for this workspace
+	CpuGoverned bool `protobuf:"varint,1,opt,name=cpu_governed,json=cpuGoverned,proto3" json:"cpuGoverned,omitempty"`
+	// cpu_limit is the CPU limit last enforced by the governer in jiffies/sec (100 jiffies/sec equal one CPU)
+	CpuLimit int64 `protobuf:"varint,2,opt,name=cpu_limit,json=cpuLimit,proto3" json:"cpuLimit,omitempty"`
+	// cpu_load is the CPU time consumed during the last sampling period in jiffies
+	CpuLoad int64 `protobuf:"varint,3,opt,name=cpu_load,json=cpuLoad,proto3" json:"cpuLoad,omitempty"`
+	// cpu_budget_spent is the CPU budget spent during the current control period in jiffies
+	CpuBudgetSpent int64 `protobuf:"varint,4,opt,name=cpu_budget_spent,json=cpuBudgetSpent,proto3" json:"cpuBudgetSpent,omitempty"`
+	// disk_usage is the disk space occupied by the workspace content in bytes
+	DiskUsage            int64    `protobuf:"varint,5,opt,name=disk_usage,json=diskUsage,proto3" json:"diskUsage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceInventory) Reset()         { *m = ResourceInventory{} }
+func (m *ResourceInventory) String() string { return proto.CompactTextString(m) }
+func (*ResourceInventory) ProtoMessage()    {}
+func (*ResourceInventory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{18}
+}
+
+func (m *ResourceInventory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceInventory.Unmarshal(m, b)
+}
+func (m *ResourceInventory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceInventory.Marshal(b, m, deterministic)
+}
+func (m *ResourceInventory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceInventory.Merge(m, src)
+}
+func (m *ResourceInventory) XXX_Size() int {
+	return xxx_messageInfo_ResourceInventory.Size(m)
+}
+func (m *ResourceInventory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceInventory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceInventory proto.InternalMessageInfo
+
+func (m *ResourceInventory) GetCpuGoverned() bool {
+	if m != nil {
+		return m.CpuGoverned
+	}
+	return false
+}
+
+func (m *ResourceInventory) GetCpuLimit() int64 {
+	if m != nil {
+		return m.CpuLimit
+	}
+	return 0
+}
+
+func (m *ResourceInventory) GetCpuLoad() int64 {
+	if m != nil {
+		return m.CpuLoad
+	}
+	return 0
+}
+
+func (m *ResourceInventory) GetCpuBudgetSpent() int64 {
+	if m != nil {
+		return m.CpuBudgetSpent
+	}
+	return 0
+}
+
+func (m *ResourceInventory) GetDiskUsage() int64 {
+	if m != nil {
+		return m.DiskUsage
+	}
+	return 0
+}
+
+// ContainerInventory describes a workspace container
+type ContainerInventory struct {
+	// id is the container runtime ID of the workspace container
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// cgroup_path is the path of the container's cgroup relative to the cgroup base path
+	CgroupPath           string   `protobuf:"bytes,2,opt,name=cgroup_path,json=cgroupPath,proto3" json:"cgroupPath,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContainerInventory) Reset()         { *m = ContainerInventory{} }
+func (m *ContainerInventory) String() string { return proto.CompactTextString(m) }
+func (*ContainerInventory) ProtoMessage()    {}
+func (*ContainerInventory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{19}
+}
+
+func (m *ContainerInventory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContainerInventory.Unmarshal(m, b)
+}
+func (m *ContainerInventory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContainerInventory.Marshal(b, m, deterministic)
+}
+func (m *ContainerInventory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerInventory.Merge(m, src)
+}
+func (m *ContainerInventory) XXX_Size() int {
+	return xxx_messageInfo_ContainerInventory.Size(m)
+}
+func (m *ContainerInventory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerInventory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerInventory proto.InternalMessageInfo
+
+func (m *ContainerInventory) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ContainerInventory) GetCgroupPath() string {
+	if m != nil {
+		return m.CgroupPath
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("wsdaemon.WorkspaceContentState", WorkspaceContentState_name, WorkspaceContentState_value)
 	proto.RegisterType((*InitWorkspaceRequest)(nil), "wsdaemon.InitWorkspaceRequest")
@@ -491,6 +1263,17 @@ func init() {
 	proto.RegisterType((*TakeSnapshotResponse)(nil), "wsdaemon.TakeSnapshotResponse")
 	proto.RegisterType((*DisposeWorkspaceRequest)(nil), "wsdaemon.DisposeWorkspaceRequest")
 	proto.RegisterType((*DisposeWorkspaceResponse)(nil), "wsdaemon.DisposeWorkspaceResponse")
+	proto.RegisterType((*WatchWorkspaceEventsRequest)(nil), "wsdaemon.WatchWorkspaceEventsRequest")
+	proto.RegisterType((*WorkspaceLifecycleEvent)(nil), "wsdaemon.WorkspaceLifecycleEvent")
+	proto.RegisterType((*InitializerProgress)(nil), "wsdaemon.InitializerProgress")
+	proto.RegisterType((*BackupProgress)(nil), "wsdaemon.BackupProgress")
+	proto.RegisterType((*ContentError)(nil), "wsdaemon.ContentError")
+	proto.RegisterType((*ListWorkspacesRequest)(nil), "wsdaemon.ListWorkspacesRequest")
+	proto.RegisterType((*ListWorkspacesResponse)(nil), "wsdaemon.ListWorkspacesResponse")
+	proto.RegisterType((*WorkspaceInventoryEntry)(nil), "wsdaemon.WorkspaceInventoryEntry")
+	proto.RegisterType((*BackupInventory)(nil), "wsdaemon.BackupInventory")
+	proto.RegisterType((*ResourceInventory)(nil), "wsdaemon.ResourceInventory")
+	proto.RegisterType((*ContainerInventory)(nil), "wsdaemon.ContainerInventory")
 }
 
 func init() {
@@ -498,44 +1281,90 @@ func init() {
 }
 
 var fileDescriptor_3ec90cbc4aa12fc6 = []byte{
-	// 582 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x5f, 0x73, 0xd2, 0x40,
-	0x10, 0x6f, 0xd2, 0x16, 0x61, 0xe9, 0x9f, 0x78, 0x2d, 0x12, 0x51, 0x2b, 0x66, 0xda, 0x91, 0xea,
-	0x00, 0x33, 0xf8, 0xa0, 0xaf, 0xa0, 0x6d, 0x87, 0x99, 0x96, 0x62, 0x40, 0x99, 0xd1, 0x87, 0xcc,
-	0x41, 0xae, 0xf4, 0x06, 0xc8, 0xc5, 0xdc, 0x45, 0x66, 0xfc, 0x52, 0x7e, 0x2e, 0xbf, 0x85, 0x93,
-	0xe4, 0x08, 0x01, 0x1a, 0x7d, 0xbb, 0xdd, 0xdf, 0x9f, 0xbd, 0xec, 0xee, 0x05, 0xf6, 0x6c, 0x4c,
-	0x66, 0xcc, 0xa9, 0xb9, 0x1e, 0x13, 0x0c, 0x65, 0xe7, 0x3c, 0x8a, 0x4b, 0x67, 0x23, 0xe6, 0x08,
-	0xe2, 0x88, 0x2a, 0x27, 0xde, 0x4f, 0x3a, 0x22, 0x55, 0xec, 0xd2, 0x3a, 0x75, 0xa8, 0xa0, 0x78,
-	0x4a, 0x7f, 0x11, 0x2f, 0x12, 0x18, 0xbf, 0x55, 0x38, 0x6e, 0x3b, 0x54, 0x0c, 0x98, 0x37, 0xe1,
-	0x2e, 0x1e, 0x11, 0x93, 0xfc, 0xf0, 0x09, 0x17, 0xe8, 0x00, 0x54, 0x6a, 0xeb, 0x4a, 0x59, 0xa9,
-	0xe4, 0x4c, 0x95, 0xda, 0xe8, 0x3d, 0x64, 0x67, 0x44, 0x60, 0x1b, 0x0b, 0xac, 0xab, 0x65, 0xa5,
-	0x92, 0x6f, 0x3c, 0xab, 0x2d, 0x8a, 0xd5, 0x62, 0xf5, 0x8d, 0xa4, 0x98, 0x31, 0x19, 0x5d, 0x42,
-	0x3e, 0x51, 0x56, 0xdf, 0x0e, 0xb5, 0xa7, 0x35, 0x79, 0x3d, 0x79, 0xbb, 0xa5, 0x43, 0x7b, 0xc9,
-	0x35, 0x93, 0x42, 0xd4, 0x80, 0xc2, 0x9d, 0x3f, 0x9d, 0x5a, 0xf3, 0x05, 0xd3, 0x1a, 0xe2, 0xd1,
-	0xc4, 0x77, 0xf5, 0x9d, 0xb2, 0x52, 0xc9, 0x9a, 0x47, 0x01, 0x18, 0xbb, 0xb4, 0x42, 0x08, 0x9d,
-	0x83, 0x26, 0xeb, 0x58, 0x33, 0xec, 0xd0, 0x3b, 0xc2, 0x85, 0xbe, 0x5b, 0x56, 0x2a, 0x7b, 0xe6,
-	0xa1, 0xcc, 0xdf, 0xc8, 0x34, 0x7a, 0x0d, 0x87, 0x3e, 0x27, 0x9e, 0xe5, 0xe0, 0x19, 0x09, 0x2d,
-	0x6c, 0x3d, 0x13, 0x1a, 0x1f, 0x04, 0xe9, 0x4e, 0x9c, 0x35, 0x5a, 0xf0, 0x78, 0xe3, 0x73, 0xd1,
-	0x31, 0xec, 0xb2, 0xb9, 0x43, 0x3c, 0xd9, 0xb0, 0x28, 0x40, 0x45, 0x78, 0x14, 0xb4, 0xc1, 0xa2,
-	0x76, 0xd8, 0xb2, 0x9c, 0x99, 0x09, 0xc2, 0xb6, 0x6d, 0x14, 0xa1, 0xb0, 0xd6, 0x74, 0xee, 0x32,
-	0x87, 0x13, 0xe3, 0x14, 0xd0, 0x00, 0x53, 0x71, 0xc9, 0xbc, 0x00, 0x4f, 0x99, 0x85, 0x51, 0x80,
-	0xa3, 0x15, 0x96, 0x14, 0x9f, 0xc1, 0x51, 0x1f, 0x4f, 0x48, 0xcf, 0xc1, 0x2e, 0xbf, 0x67, 0xa9,
-	0xea, 0x0a, 0x1c, 0xaf, 0xd2, 0x22, 0x39, 0xd2, 0x60, 0xdb, 0xf7, 0xa6, 0x92, 0x18, 0x1c, 0x8d,
-	0x26, 0x14, 0x3f, 0x51, 0xee, 0x32, 0x4e, 0xfe, 0xbb, 0x1e, 0x4f, 0x20, 0x23, 0xc7, 0xa1, 0x86,
-	0x5d, 0x93, 0x91, 0xd1, 0x07, 0x7d, 0xd3, 0x42, 0x16, 0xfc, 0x00, 0x30, 0xa6, 0xc2, 0xe2, 0x02,
-	0x0b, 0x9f, 0x87, 0x5e, 0xf9, 0xc6, 0xd3, 0xf5, 0xc5, 0xb8, 0xa2, 0xa2, 0x17, 0x12, 0xcc, 0xdc,
-	0x78, 0x71, 0x7c, 0xf3, 0x19, 0x0a, 0xb1, 0xdd, 0xc7, 0x88, 0x1f, 0x20, 0x04, 0x65, 0x61, 0xa7,
-	0x73, 0xdb, 0xb9, 0xd0, 0xb6, 0xd0, 0x01, 0x40, 0xef, 0xa2, 0xdf, 0x6f, 0x77, 0xae, 0xac, 0x2f,
-	0x5d, 0x4d, 0x41, 0xfb, 0x90, 0x6b, 0x7e, 0x6d, 0xb6, 0xaf, 0x9b, 0xad, 0xeb, 0x0b, 0x4d, 0x45,
-	0x87, 0x90, 0x1f, 0x98, 0xcd, 0x6e, 0x57, 0xe2, 0xdb, 0x8d, 0x3f, 0x2a, 0x14, 0x37, 0x3c, 0xa3,
-	0x3b, 0x20, 0x13, 0xf6, 0x57, 0xc6, 0x85, 0x4e, 0x96, 0xab, 0xff, 0xd0, 0xe3, 0x29, 0xbd, 0x4c,
-	0xc5, 0xe5, 0xa8, 0xb6, 0xd0, 0x35, 0xe4, 0x13, 0x33, 0x44, 0xcf, 0x13, 0x8f, 0x69, 0x63, 0x01,
-	0x4a, 0x2f, 0x52, 0xd0, 0xd8, 0xed, 0x16, 0xf6, 0x92, 0x33, 0x45, 0x09, 0xc1, 0x03, 0x2b, 0x51,
-	0x3a, 0x49, 0x83, 0x63, 0xc3, 0xef, 0xa0, 0xad, 0xcf, 0x0d, 0xbd, 0x5a, 0xaa, 0x52, 0xd6, 0xa2,
-	0x64, 0xfc, 0x8b, 0xb2, 0x30, 0x6f, 0xbd, 0xfd, 0x76, 0x3e, 0xa6, 0xe2, 0xde, 0x1f, 0xd6, 0x46,
-	0x6c, 0x56, 0x1f, 0x53, 0xe1, 0x32, 0xbb, 0x4a, 0x99, 0x3c, 0xd5, 0xe7, 0xbc, 0x1a, 0x79, 0xd4,
-	0xb1, 0x4b, 0x87, 0x99, 0xf0, 0x47, 0xf5, 0xee, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa1, 0xbf,
-	0x58, 0xdb, 0xe9, 0x04, 0x00, 0x00,
+	// 1319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6e, 0xdb, 0xc6,
+	0x13, 0xb7, 0x24, 0x4b, 0x96, 0x46, 0x8e, 0xac, 0xac, 0xed, 0x58, 0x51, 0x3e, 0xec, 0x10, 0x09,
+	0xfe, 0xca, 0xbf, 0xb0, 0xdc, 0xaa, 0x87, 0x36, 0xe9, 0x49, 0x6e, 0x94, 0xc4, 0x80, 0xe2, 0xb8,
+	0xb4, 0x5d, 0x03, 0xed, 0x81, 0x58, 0x91, 0x6b, 0x79, 0x61, 0x8a, 0xcb, 0xee, 0x2e, 0x6d, 0xd8,
+	0xaf, 0xd0, 0x53, 0x9f, 0xa4, 0x40, 0x2f, 0x7d, 0x84, 0x1e, 0xfb, 0x26, 0x7d, 0x86, 0x62, 0x97,
+	0x4b, 0x8a, 0xfa, 0xaa, 0x83, 0xa2, 0x37, 0xce, 0xcc, 0xef, 0x37, 0x9c, 0x9d, 0x9d, 0x99, 0x1d,
+	0x58, 0xf5, 0x30, 0x19, 0xb1, 0xa0, 0x1d, 0x72, 0x26, 0x19, 0x2a, 0x5f, 0x8b, 0x58, 0x6e, 0xbe,
+	0x70, 0x59, 0x20, 0x49, 0x20, 0x77, 0x05, 0xe1, 0x57, 0xd4, 0x25, 0xbb, 0x38, 0xa4, 0x7b, 0x34,
+	0xa0, 0x92, 0x62, 0x9f, 0xde, 0x12, 0x1e, 0x13, 0x9a, 0xdb, 0x43, 0xc6, 0x86, 0x3e, 0xd9, 0xd3,
+	0xd2, 0x20, 0x3a, 0xdf, 0x93, 0x74, 0x44, 0x84, 0xc4, 0xa3, 0x30, 0x06, 0x58, 0xbf, 0xe6, 0x61,
+	0xe3, 0x20, 0xa0, 0xf2, 0x8c, 0xf1, 0x4b, 0x11, 0x62, 0x97, 0xd8, 0xe4, 0xa7, 0x88, 0x08, 0x89,
+	0x6a, 0x90, 0xa7, 0x5e, 0x23, 0xb7, 0x93, 0x6b, 0x55, 0xec, 0x3c, 0xf5, 0xd0, 0x57, 0x50, 0x1e,
+	0x11, 0x89, 0x3d, 0x2c, 0x71, 0x23, 0xbf, 0x93, 0x6b, 0x55, 0x3b, 0x8f, 0xda, 0x49, 0x34, 0xed,
+	0x94, 0xfd, 0xc1, 0x40, 0xec, 0x14, 0x8c, 0xde, 0x42, 0x35, 0x13, 0x57, 0xa3, 0xa0, 0xb9, 0xcf,
+	0xdb, 0x26, 0x7e, 0x13, 0xfe, 0xd8, 0xc3, 0xc1, 0x18, 0x6b, 0x67, 0x89, 0xa8, 0x03, 0x9b, 0xe7,
+	0x91, 0xef, 0x3b, 0xd7, 0x09, 0xd2, 0x19, 0x60, 0xf7, 0x32, 0x0a, 0x1b, 0xcb, 0x3b, 0xb9, 0x56,
+	0xd9, 0x5e, 0x57, 0xc6, 0xd4, 0xcb, 0xbe, 0x36, 0xa1, 0x97, 0x50, 0x37, 0xff, 0x71, 0x46, 0x38,
+	0xa0, 0xe7, 0x44, 0xc8, 0x46, 0x71, 0x27, 0xd7, 0x5a, 0xb5, 0xd7, 0x8c, 0xfe, 0x83, 0x51, 0xa3,
+	0xff, 0xc1, 0x5a, 0x24, 0x08, 0x77, 0x02, 0x3c, 0x22, 0xda, 0x85, 0xd7, 0x28, 0x69, 0xc7, 0x35,
+	0xa5, 0x3e, 0x4c, 0xb5, 0xd6, 0x3e, 0xdc, 0x9f, 0x39, 0x2e, 0xda, 0x80, 0x22, 0xbb, 0x0e, 0x08,
+	0x37, 0x09, 0x8b, 0x05, 0xb4, 0x05, 0x2b, 0x2a, 0x0d, 0x0e, 0xf5, 0x74, 0xca, 0x2a, 0x76, 0x49,
+	0x89, 0x07, 0x9e, 0xb5, 0x05, 0x9b, 0x53, 0x49, 0x17, 0x21, 0x0b, 0x04, 0xb1, 0x9e, 0x03, 0x3a,
+	0xc3, 0x54, 0xbe, 0x65, 0x5c, 0xd9, 0x17, 0xdc, 0x85, 0xb5, 0x09, 0xeb, 0x13, 0x28, 0x43, 0xee,
+	0xc2, 0xfa, 0x09, 0xbe, 0x24, 0xc7, 0x01, 0x0e, 0xc5, 0x05, 0x5b, 0xc4, 0x46, 0x4d, 0x28, 0x87,
+	0x9c, 0x0c, 0x22, 0xea, 0xc7, 0x61, 0x95, 0xed, 0x54, 0xb6, 0x5a, 0xb0, 0x31, 0xe9, 0x22, 0x76,
+	0x8d, 0xea, 0x50, 0x88, 0xb8, 0x6f, 0x9c, 0xa8, 0x4f, 0xab, 0x0b, 0x5b, 0x6f, 0xa8, 0x08, 0x99,
+	0x20, 0x77, 0x96, 0xce, 0x03, 0x28, 0x99, 0xab, 0x8a, 0x7f, 0x67, 0x24, 0xeb, 0x04, 0x1a, 0xb3,
+	0x2e, 0xcc, 0x0f, 0xbf, 0x06, 0x18, 0x52, 0xe9, 0x08, 0x89, 0x65, 0x24, 0xb4, 0xaf, 0x6a, 0xe7,
+	0xe1, 0x74, 0xd1, 0xbc, 0xa3, 0xf2, 0x58, 0x03, 0xec, 0xca, 0x30, 0xf9, 0xb4, 0x76, 0xe1, 0xd1,
+	0x19, 0x96, 0xee, 0x45, 0xea, 0xb3, 0x77, 0xa5, 0x28, 0x8b, 0x72, 0xf9, 0x47, 0x1e, 0xb6, 0x52,
+	0x68, 0x9f, 0x9e, 0x13, 0xf7, 0xc6, 0xf5, 0x63, 0xce, 0xcc, 0x41, 0xda, 0xb0, 0xac, 0xfa, 0xc7,
+	0xd4, 0x7f, 0xb3, 0x1d, 0x37, 0x57, 0x3b, 0x69, 0xae, 0xf6, 0x49, 0xd2, 0x5c, 0xb6, 0xc6, 0xa1,
+	0xee, 0xbc, 0xd2, 0x7f, 0x32, 0x6e, 0x9b, 0x4c, 0xad, 0x1f, 0x71, 0x36, 0xe4, 0x44, 0x88, 0xf7,
+	0x4b, 0xd3, 0x55, 0x5f, 0xca, 0x94, 0x79, 0xb5, 0xd3, 0x18, 0xb3, 0xe3, 0x1a, 0xcf, 0x10, 0x0d,
+	0x12, 0xb5, 0xa1, 0x48, 0x38, 0x67, 0x5c, 0x97, 0x7a, 0xb5, 0xf3, 0x60, 0x4c, 0xf9, 0x36, 0xce,
+	0x5f, 0x4f, 0x59, 0xdf, 0x2f, 0xd9, 0x31, 0x0c, 0xbd, 0x9e, 0xc8, 0x75, 0xe9, 0x8e, 0x5c, 0xbf,
+	0x5f, 0xca, 0x64, 0x7b, 0xbf, 0x02, 0x2b, 0x21, 0xbe, 0xf1, 0x19, 0xf6, 0xac, 0x9f, 0x73, 0xb0,
+	0x3e, 0xe7, 0x44, 0xaa, 0x37, 0xc2, 0x0b, 0x2c, 0x48, 0xd2, 0x1b, 0x5a, 0x50, 0xad, 0x39, 0xb8,
+	0x91, 0x44, 0x38, 0x1e, 0xbb, 0x0e, 0x14, 0x9f, 0xc4, 0xd5, 0x58, 0xb0, 0xd7, 0xb4, 0xfe, 0x4d,
+	0xaa, 0x46, 0xdb, 0x50, 0x8d, 0xa1, 0x92, 0x49, 0xec, 0xeb, 0x34, 0x16, 0x6c, 0xd0, 0xaa, 0x13,
+	0xa5, 0x41, 0x08, 0x96, 0x3d, 0x16, 0x10, 0x33, 0x09, 0xf4, 0xb7, 0xf5, 0x5b, 0x0e, 0x6a, 0x93,
+	0x19, 0x5a, 0x10, 0xc8, 0x0b, 0xa8, 0xc5, 0xde, 0xa3, 0x70, 0x22, 0x8c, 0x7b, 0x5a, 0x7b, 0x1a,
+	0x7e, 0x6a, 0x10, 0x0d, 0x58, 0xc1, 0x52, 0x92, 0x51, 0x28, 0x75, 0x1c, 0x45, 0x3b, 0x11, 0xd5,
+	0x7f, 0xcf, 0x69, 0x80, 0x7d, 0x7d, 0x1f, 0x65, 0x3b, 0x16, 0xd2, 0xa0, 0x4b, 0x99, 0xa0, 0x09,
+	0xac, 0x66, 0xaf, 0x08, 0x3d, 0x86, 0x0a, 0x0b, 0x09, 0xc7, 0x92, 0xb2, 0xc0, 0x44, 0x3d, 0x56,
+	0xa8, 0x3f, 0x8e, 0x88, 0x10, 0x78, 0x48, 0xcc, 0x78, 0x49, 0x44, 0xf4, 0x04, 0xe0, 0x9a, 0xfa,
+	0xbe, 0xc3, 0x89, 0xe4, 0x37, 0x3a, 0xd6, 0xb2, 0x5d, 0x51, 0x1a, 0x5b, 0x29, 0xd4, 0xf8, 0xe9,
+	0x53, 0x31, 0x1e, 0x3f, 0x49, 0x73, 0x58, 0x0c, 0x1e, 0x4c, 0x1b, 0x4c, 0x3f, 0x22, 0x58, 0x0e,
+	0x98, 0x97, 0xa4, 0x4e, 0x7f, 0xa3, 0x2e, 0x40, 0x3a, 0x8c, 0x45, 0x23, 0xbf, 0x53, 0x68, 0x55,
+	0x3b, 0xcf, 0xe6, 0x3c, 0x0a, 0x07, 0x81, 0xea, 0x26, 0xc6, 0x6f, 0x7a, 0x81, 0xe4, 0x37, 0x76,
+	0x86, 0x64, 0xfd, 0x52, 0x80, 0xad, 0x05, 0xb8, 0xff, 0xee, 0x05, 0xda, 0x80, 0xa2, 0xaa, 0x6d,
+	0xa2, 0x13, 0x51, 0xb1, 0x63, 0xe1, 0x5f, 0xbd, 0x27, 0xaf, 0x00, 0x5c, 0x4e, 0xb0, 0x24, 0x9e,
+	0x83, 0x65, 0xa3, 0x78, 0xe7, 0x18, 0xa8, 0x18, 0x74, 0x57, 0xa2, 0x2f, 0xd2, 0x46, 0x4e, 0x1a,
+	0x6c, 0xaa, 0x91, 0xd3, 0xd3, 0xa7, 0x7d, 0xfc, 0x0a, 0x2a, 0x9c, 0x08, 0x16, 0x71, 0x95, 0xde,
+	0x95, 0xe9, 0x13, 0xdb, 0xc6, 0x34, 0xe6, 0x8d, 0xd1, 0xe8, 0x35, 0x54, 0x54, 0xff, 0x62, 0xaa,
+	0xde, 0xa4, 0xb2, 0xa6, 0x3e, 0x9e, 0x1c, 0x03, 0xda, 0x94, 0xe1, 0xa6, 0x70, 0xeb, 0xaf, 0x1c,
+	0xac, 0x4d, 0x85, 0x84, 0xda, 0xb0, 0xee, 0xd3, 0xab, 0x24, 0x45, 0x0e, 0x8f, 0x82, 0x80, 0x06,
+	0x43, 0x7d, 0x39, 0x65, 0xfb, 0xbe, 0x32, 0xc5, 0x0c, 0x3b, 0x36, 0xa0, 0x37, 0x50, 0xf7, 0xb1,
+	0x90, 0x4e, 0x86, 0xf4, 0x09, 0x53, 0xb3, 0xa6, 0x38, 0xfd, 0xd4, 0x19, 0xfa, 0x06, 0xaa, 0xda,
+	0x8b, 0x71, 0x50, 0xb8, 0xd3, 0x01, 0x28, 0xb8, 0x21, 0xb7, 0xa0, 0x9e, 0x21, 0x3b, 0x82, 0xde,
+	0xc6, 0x03, 0xa2, 0x60, 0xd7, 0xc6, 0xa8, 0x63, 0x7a, 0x4b, 0xac, 0xdf, 0x73, 0x70, 0x7f, 0x26,
+	0x9b, 0xe8, 0x19, 0xac, 0xba, 0x61, 0xe4, 0x0c, 0xd9, 0x15, 0xe1, 0x01, 0xf1, 0xcc, 0x59, 0xab,
+	0x6e, 0x18, 0xbd, 0x33, 0x2a, 0xf4, 0x08, 0x2a, 0x0a, 0xe2, 0xd3, 0x11, 0x95, 0x66, 0x6a, 0x94,
+	0xdd, 0x30, 0xea, 0x2b, 0x19, 0x3d, 0x84, 0xb2, 0x36, 0x32, 0xec, 0x99, 0x69, 0xb1, 0xa2, 0x6c,
+	0x0c, 0x7b, 0x2a, 0x34, 0x65, 0x1a, 0x44, 0xde, 0x90, 0x48, 0x47, 0x84, 0x24, 0x90, 0x49, 0x68,
+	0x6e, 0x18, 0xed, 0x6b, 0xf5, 0xb1, 0xd2, 0xaa, 0x46, 0xf6, 0xa8, 0xb8, 0x74, 0x22, 0xdd, 0xe5,
+	0x45, 0x8d, 0xa9, 0x28, 0xcd, 0xa9, 0x52, 0x58, 0x3d, 0x40, 0xb3, 0x77, 0x39, 0xd3, 0x38, 0xdb,
+	0x50, 0x75, 0x87, 0x9c, 0x45, 0xa1, 0x13, 0x62, 0x79, 0x61, 0x66, 0x05, 0xc4, 0xaa, 0x23, 0x2c,
+	0x2f, 0xfe, 0xff, 0x1d, 0x6c, 0xa6, 0x95, 0x6e, 0xe6, 0xcf, 0xb1, 0xee, 0x91, 0x32, 0x2c, 0x1f,
+	0x7e, 0x3c, 0xec, 0xd5, 0x97, 0x50, 0x0d, 0xe0, 0xb8, 0x77, 0x72, 0x72, 0x70, 0xf8, 0xce, 0x39,
+	0x3d, 0xaa, 0xe7, 0xd0, 0x3d, 0xa8, 0x74, 0xbf, 0xef, 0x1e, 0xf4, 0xbb, 0xfb, 0xfd, 0x5e, 0x3d,
+	0x8f, 0xd6, 0xa0, 0x7a, 0x66, 0x77, 0x8f, 0x8e, 0x8c, 0xbd, 0xd0, 0xf9, 0x33, 0xdb, 0xd8, 0x89,
+	0xcf, 0xf8, 0x29, 0x41, 0x36, 0xdc, 0x9b, 0xd8, 0x7e, 0xd0, 0xd3, 0xc9, 0x27, 0x71, 0x7a, 0xa1,
+	0x68, 0x6e, 0x2f, 0xb4, 0x9b, 0xcd, 0x67, 0x09, 0xf5, 0xa1, 0x9a, 0x59, 0x89, 0x50, 0xa6, 0xd8,
+	0x67, 0xf7, 0xa9, 0xe6, 0x93, 0x05, 0xd6, 0xd4, 0xdb, 0x47, 0x58, 0xcd, 0xae, 0x41, 0x28, 0x43,
+	0x98, 0xb3, 0x61, 0x35, 0x9f, 0x2e, 0x32, 0xa7, 0x0e, 0x7f, 0x84, 0xfa, 0xf4, 0xaa, 0x83, 0x32,
+	0xa3, 0x72, 0xc1, 0x26, 0xd5, 0xb4, 0xfe, 0x09, 0x92, 0x3a, 0xf7, 0x60, 0x63, 0xde, 0xc6, 0x83,
+	0x5e, 0x64, 0x8f, 0xb9, 0x70, 0x23, 0x6a, 0xce, 0x1b, 0xd9, 0x93, 0x8b, 0x90, 0xb5, 0xf4, 0x79,
+	0xae, 0x43, 0xa1, 0x9e, 0x96, 0x58, 0x72, 0x93, 0xa7, 0x50, 0x9b, 0x7c, 0x2f, 0x50, 0xe6, 0xaa,
+	0xe6, 0x3e, 0x31, 0xcd, 0x9d, 0xc5, 0x80, 0xe4, 0x40, 0xfb, 0x9f, 0xfd, 0xf0, 0x72, 0x48, 0xe5,
+	0x45, 0x34, 0x68, 0xbb, 0x6c, 0xb4, 0x37, 0xa4, 0x32, 0x64, 0xde, 0x2e, 0x65, 0xe6, 0x6b, 0xef,
+	0x5a, 0xec, 0xc6, 0x1e, 0xf6, 0x70, 0x48, 0x07, 0x25, 0x3d, 0x07, 0xbe, 0xfc, 0x7b, 0x00, 0x3b,
+	0xdb, 0x64, 0x24, 0x2a, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -560,6 +1389,9 @@ type WorkspaceContentServiceClient interface {
 	TakeSnapshot(ctx context.Context, in *TakeSnapshotRequest, opts ...grpc.CallOption) (*TakeSnapshotResponse, error)
 	// disposeWorkspace cleans up a workspace, possibly after taking a final backup
 	DisposeWorkspace(ctx context.Context, in *DisposeWorkspaceRequest, opts ...grpc.CallOption) (*DisposeWorkspaceResponse, error)
+	// WatchWorkspaceEvents streams the lifecycle events of a workspace's content, e.g. initializer and backup progress.
+	// The stream ends when the workspace is disposed of or the caller cancels the request.
+	WatchWorkspaceEvents(ctx context.Context, in *WatchWorkspaceEventsRequest, opts ...grpc.CallOption) (WorkspaceContentService_WatchWorkspaceEventsClient, error)
 }
 
 type workspaceContentServiceClient struct {
@@ -606,6 +1438,38 @@ func (c *workspaceContentServiceClient) DisposeWorkspace(ctx context.Context, in
 	return out, nil
 }
 
+func (c *workspaceContentServiceClient) WatchWorkspaceEvents(ctx context.Context, in *WatchWorkspaceEventsRequest, opts ...grpc.CallOption) (WorkspaceContentService_WatchWorkspaceEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WorkspaceContentService_serviceDesc.Streams[0], "/wsdaemon.WorkspaceContentService/WatchWorkspaceEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workspaceContentServiceWatchWorkspaceEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WorkspaceContentService_WatchWorkspaceEventsClient interface {
+	Recv() (*WorkspaceLifecycleEvent, error)
+	grpc.ClientStream
+}
+
+type workspaceContentServiceWatchWorkspaceEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *workspaceContentServiceWatchWorkspaceEventsClient) Recv() (*WorkspaceLifecycleEvent, error) {
+	m := new(WorkspaceLifecycleEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // WorkspaceContentServiceServer is the server API for WorkspaceContentService service.
 type WorkspaceContentServiceServer interface {
 	// initWorkspace intialises a new workspace folder in the working area
@@ -618,6 +1482,9 @@ type WorkspaceContentServiceServer interface {
 	TakeSnapshot(context.Context, *TakeSnapshotRequest) (*TakeSnapshotResponse, error)
 	// disposeWorkspace cleans up a workspace, possibly after taking a final backup
 	DisposeWorkspace(context.Context, *DisposeWorkspaceRequest) (*DisposeWorkspaceResponse, error)
+	// WatchWorkspaceEvents streams the lifecycle events of a workspace's content, e.g. initializer and backup progress.
+	// The stream ends when the workspace is disposed of or the caller cancels the request.
+	WatchWorkspaceEvents(*WatchWorkspaceEventsRequest, WorkspaceContentService_WatchWorkspaceEventsServer) error
 }
 
 // UnimplementedWorkspaceContentServiceServer can be embedded to have forward compatible implementations.
@@ -636,6 +1503,9 @@ func (*UnimplementedWorkspaceContentServiceServer) TakeSnapshot(ctx context.Cont
 func (*UnimplementedWorkspaceContentServiceServer) DisposeWorkspace(ctx context.Context, req *DisposeWorkspaceRequest) (*DisposeWorkspaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisposeWorkspace not implemented")
 }
+func (*UnimplementedWorkspaceContentServiceServer) WatchWorkspaceEvents(req *WatchWorkspaceEventsRequest, srv WorkspaceContentService_WatchWorkspaceEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchWorkspaceEvents not implemented")
+}
 
 func RegisterWorkspaceContentServiceServer(s *grpc.Server, srv WorkspaceContentServiceServer) {
 	s.RegisterService(&_WorkspaceContentService_serviceDesc, srv)
@@ -713,6 +1583,27 @@ func _WorkspaceContentService_DisposeWorkspace_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceContentService_WatchWorkspaceEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchWorkspaceEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkspaceContentServiceServer).WatchWorkspaceEvents(m, &workspaceContentServiceWatchWorkspaceEventsServer{stream})
+}
+
+type WorkspaceContentService_WatchWorkspaceEventsServer interface {
+	Send(*WorkspaceLifecycleEvent) error
+	grpc.ServerStream
+}
+
+type workspaceContentServiceWatchWorkspaceEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *workspaceContentServiceWatchWorkspaceEventsServer) Send(m *WorkspaceLifecycleEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _WorkspaceContentService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "wsdaemon.WorkspaceContentService",
 	HandlerType: (*WorkspaceContentServiceServer)(nil),
@@ -734,6 +1625,86 @@ var _WorkspaceContentService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _WorkspaceContentService_DisposeWorkspace_Handler,
 		},
 	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchWorkspaceEvents",
+			Handler:       _WorkspaceContentService_WatchWorkspaceEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "daemon.proto",
+}
+
+// InventoryServiceClient is the client API for InventoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type InventoryServiceClient interface {
+	// ListWorkspaces lists all workspaces this daemon currently knows about on its node
+	ListWorkspaces(ctx context.Context, in *ListWorkspacesRequest, opts ...grpc.CallOption) (*ListWorkspacesResponse, error)
+}
+
+type inventoryServiceClient struct {
+	cc grpc.ClientConnInterface `json:"cc,omitempty"`
+}
+
+func NewInventoryServiceClient(cc grpc.ClientConnInterface) InventoryServiceClient {
+	return &inventoryServiceClient{cc}
+}
+
+func (c *inventoryServiceClient) ListWorkspaces(ctx context.Context, in *ListWorkspacesRequest, opts ...grpc.CallOption) (*ListWorkspacesResponse, error) {
+	out := new(ListWorkspacesResponse)
+	err := c.cc.Invoke(ctx, "/wsdaemon.InventoryService/ListWorkspaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InventoryServiceServer is the server API for InventoryService service.
+type InventoryServiceServer interface {
+	// ListWorkspaces lists all workspaces this daemon currently knows about on its node
+	ListWorkspaces(context.Context, *ListWorkspacesRequest) (*ListWorkspacesResponse, error)
+}
+
+// UnimplementedInventoryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedInventoryServiceServer struct {
+}
+
+func (*UnimplementedInventoryServiceServer) ListWorkspaces(ctx context.Context, req *ListWorkspacesRequest) (*ListWorkspacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspaces not implemented")
+}
+
+func RegisterInventoryServiceServer(s *grpc.Server, srv InventoryServiceServer) {
+	s.RegisterService(&_InventoryService_serviceDesc, srv)
+}
+
+func _InventoryService_ListWorkspaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkspacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListWorkspaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsdaemon.InventoryService/ListWorkspaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListWorkspaces(ctx, req.(*ListWorkspacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _InventoryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "wsdaemon.InventoryService",
+	HandlerType: (*InventoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListWorkspaces",
+			Handler:    _InventoryService_ListWorkspaces_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "daemon.proto",
 }
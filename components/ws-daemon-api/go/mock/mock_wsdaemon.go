@@ -3,7 +3,7 @@
 // See License-AGPL.txt in the project root for license information.
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: daemon.pb.go
+// Source: github.com/gitpod-io/gitpod/ws-daemon/api (interfaces: WorkspaceContentServiceClient,WorkspaceContentServiceServer,WorkspaceContentService_WatchWorkspaceEventsClient,WorkspaceContentService_WatchWorkspaceEventsServer,InventoryServiceClient,InventoryServiceServer)
 
 // Package mock is a generated GoMock package.
 package mock
@@ -13,6 +13,7 @@ import (
 	api "github.com/gitpod-io/gitpod/ws-daemon/api"
 	gomock "github.com/golang/mock/gomock"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 	reflect "reflect"
 )
 
@@ -39,51 +40,51 @@ func (m *MockWorkspaceContentServiceClient) EXPECT() *MockWorkspaceContentServic
 	return m.recorder
 }
 
-// InitWorkspace mocks base method
-func (m *MockWorkspaceContentServiceClient) InitWorkspace(ctx context.Context, in *api.InitWorkspaceRequest, opts ...grpc.CallOption) (*api.InitWorkspaceResponse, error) {
+// DisposeWorkspace mocks base method
+func (m *MockWorkspaceContentServiceClient) DisposeWorkspace(arg0 context.Context, arg1 *api.DisposeWorkspaceRequest, arg2 ...grpc.CallOption) (*api.DisposeWorkspaceResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{ctx, in}
-	for _, a := range opts {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "InitWorkspace", varargs...)
-	ret0, _ := ret[0].(*api.InitWorkspaceResponse)
+	ret := m.ctrl.Call(m, "DisposeWorkspace", varargs...)
+	ret0, _ := ret[0].(*api.DisposeWorkspaceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// InitWorkspace indicates an expected call of InitWorkspace
-func (mr *MockWorkspaceContentServiceClientMockRecorder) InitWorkspace(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// DisposeWorkspace indicates an expected call of DisposeWorkspace
+func (mr *MockWorkspaceContentServiceClientMockRecorder) DisposeWorkspace(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitWorkspace", reflect.TypeOf((*MockWorkspaceContentServiceClient)(nil).InitWorkspace), varargs...)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisposeWorkspace", reflect.TypeOf((*MockWorkspaceContentServiceClient)(nil).DisposeWorkspace), varargs...)
 }
 
-// WaitForInit mocks base method
-func (m *MockWorkspaceContentServiceClient) WaitForInit(ctx context.Context, in *api.WaitForInitRequest, opts ...grpc.CallOption) (*api.WaitForInitResponse, error) {
+// InitWorkspace mocks base method
+func (m *MockWorkspaceContentServiceClient) InitWorkspace(arg0 context.Context, arg1 *api.InitWorkspaceRequest, arg2 ...grpc.CallOption) (*api.InitWorkspaceResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{ctx, in}
-	for _, a := range opts {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "WaitForInit", varargs...)
-	ret0, _ := ret[0].(*api.WaitForInitResponse)
+	ret := m.ctrl.Call(m, "InitWorkspace", varargs...)
+	ret0, _ := ret[0].(*api.InitWorkspaceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// WaitForInit indicates an expected call of WaitForInit
-func (mr *MockWorkspaceContentServiceClientMockRecorder) WaitForInit(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// InitWorkspace indicates an expected call of InitWorkspace
+func (mr *MockWorkspaceContentServiceClientMockRecorder) InitWorkspace(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForInit", reflect.TypeOf((*MockWorkspaceContentServiceClient)(nil).WaitForInit), varargs...)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitWorkspace", reflect.TypeOf((*MockWorkspaceContentServiceClient)(nil).InitWorkspace), varargs...)
 }
 
 // TakeSnapshot mocks base method
-func (m *MockWorkspaceContentServiceClient) TakeSnapshot(ctx context.Context, in *api.TakeSnapshotRequest, opts ...grpc.CallOption) (*api.TakeSnapshotResponse, error) {
+func (m *MockWorkspaceContentServiceClient) TakeSnapshot(arg0 context.Context, arg1 *api.TakeSnapshotRequest, arg2 ...grpc.CallOption) (*api.TakeSnapshotResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{ctx, in}
-	for _, a := range opts {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "TakeSnapshot", varargs...)
@@ -93,30 +94,50 @@ func (m *MockWorkspaceContentServiceClient) TakeSnapshot(ctx context.Context, in
 }
 
 // TakeSnapshot indicates an expected call of TakeSnapshot
-func (mr *MockWorkspaceContentServiceClientMockRecorder) TakeSnapshot(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockWorkspaceContentServiceClientMockRecorder) TakeSnapshot(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx, in}, opts...)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeSnapshot", reflect.TypeOf((*MockWorkspaceContentServiceClient)(nil).TakeSnapshot), varargs...)
 }
 
-// DisposeWorkspace mocks base method
-func (m *MockWorkspaceContentServiceClient) DisposeWorkspace(ctx context.Context, in *api.DisposeWorkspaceRequest, opts ...grpc.CallOption) (*api.DisposeWorkspaceResponse, error) {
+// WaitForInit mocks base method
+func (m *MockWorkspaceContentServiceClient) WaitForInit(arg0 context.Context, arg1 *api.WaitForInitRequest, arg2 ...grpc.CallOption) (*api.WaitForInitResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{ctx, in}
-	for _, a := range opts {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DisposeWorkspace", varargs...)
-	ret0, _ := ret[0].(*api.DisposeWorkspaceResponse)
+	ret := m.ctrl.Call(m, "WaitForInit", varargs...)
+	ret0, _ := ret[0].(*api.WaitForInitResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DisposeWorkspace indicates an expected call of DisposeWorkspace
-func (mr *MockWorkspaceContentServiceClientMockRecorder) DisposeWorkspace(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// WaitForInit indicates an expected call of WaitForInit
+func (mr *MockWorkspaceContentServiceClientMockRecorder) WaitForInit(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisposeWorkspace", reflect.TypeOf((*MockWorkspaceContentServiceClient)(nil).DisposeWorkspace), varargs...)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForInit", reflect.TypeOf((*MockWorkspaceContentServiceClient)(nil).WaitForInit), varargs...)
+}
+
+// WatchWorkspaceEvents mocks base method
+func (m *MockWorkspaceContentServiceClient) WatchWorkspaceEvents(arg0 context.Context, arg1 *api.WatchWorkspaceEventsRequest, arg2 ...grpc.CallOption) (api.WorkspaceContentService_WatchWorkspaceEventsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchWorkspaceEvents", varargs...)
+	ret0, _ := ret[0].(api.WorkspaceContentService_WatchWorkspaceEventsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchWorkspaceEvents indicates an expected call of WatchWorkspaceEvents
+func (mr *MockWorkspaceContentServiceClientMockRecorder) WatchWorkspaceEvents(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchWorkspaceEvents", reflect.TypeOf((*MockWorkspaceContentServiceClient)(nil).WatchWorkspaceEvents), varargs...)
 }
 
 // MockWorkspaceContentServiceServer is a mock of WorkspaceContentServiceServer interface
@@ -142,6 +163,21 @@ func (m *MockWorkspaceContentServiceServer) EXPECT() *MockWorkspaceContentServic
 	return m.recorder
 }
 
+// DisposeWorkspace mocks base method
+func (m *MockWorkspaceContentServiceServer) DisposeWorkspace(arg0 context.Context, arg1 *api.DisposeWorkspaceRequest) (*api.DisposeWorkspaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisposeWorkspace", arg0, arg1)
+	ret0, _ := ret[0].(*api.DisposeWorkspaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisposeWorkspace indicates an expected call of DisposeWorkspace
+func (mr *MockWorkspaceContentServiceServerMockRecorder) DisposeWorkspace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisposeWorkspace", reflect.TypeOf((*MockWorkspaceContentServiceServer)(nil).DisposeWorkspace), arg0, arg1)
+}
+
 // InitWorkspace mocks base method
 func (m *MockWorkspaceContentServiceServer) InitWorkspace(arg0 context.Context, arg1 *api.InitWorkspaceRequest) (*api.InitWorkspaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -157,6 +193,21 @@ func (mr *MockWorkspaceContentServiceServerMockRecorder) InitWorkspace(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitWorkspace", reflect.TypeOf((*MockWorkspaceContentServiceServer)(nil).InitWorkspace), arg0, arg1)
 }
 
+// TakeSnapshot mocks base method
+func (m *MockWorkspaceContentServiceServer) TakeSnapshot(arg0 context.Context, arg1 *api.TakeSnapshotRequest) (*api.TakeSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*api.TakeSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeSnapshot indicates an expected call of TakeSnapshot
+func (mr *MockWorkspaceContentServiceServerMockRecorder) TakeSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeSnapshot", reflect.TypeOf((*MockWorkspaceContentServiceServer)(nil).TakeSnapshot), arg0, arg1)
+}
+
 // WaitForInit mocks base method
 func (m *MockWorkspaceContentServiceServer) WaitForInit(arg0 context.Context, arg1 *api.WaitForInitRequest) (*api.WaitForInitResponse, error) {
 	m.ctrl.T.Helper()
@@ -172,32 +223,339 @@ func (mr *MockWorkspaceContentServiceServerMockRecorder) WaitForInit(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForInit", reflect.TypeOf((*MockWorkspaceContentServiceServer)(nil).WaitForInit), arg0, arg1)
 }
 
-// TakeSnapshot mocks base method
-func (m *MockWorkspaceContentServiceServer) TakeSnapshot(arg0 context.Context, arg1 *api.TakeSnapshotRequest) (*api.TakeSnapshotResponse, error) {
+// WatchWorkspaceEvents mocks base method
+func (m *MockWorkspaceContentServiceServer) WatchWorkspaceEvents(arg0 *api.WatchWorkspaceEventsRequest, arg1 api.WorkspaceContentService_WatchWorkspaceEventsServer) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TakeSnapshot", arg0, arg1)
-	ret0, _ := ret[0].(*api.TakeSnapshotResponse)
+	ret := m.ctrl.Call(m, "WatchWorkspaceEvents", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchWorkspaceEvents indicates an expected call of WatchWorkspaceEvents
+func (mr *MockWorkspaceContentServiceServerMockRecorder) WatchWorkspaceEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchWorkspaceEvents", reflect.TypeOf((*MockWorkspaceContentServiceServer)(nil).WatchWorkspaceEvents), arg0, arg1)
+}
+
+// MockWorkspaceContentService_WatchWorkspaceEventsClient is a mock of WorkspaceContentService_WatchWorkspaceEventsClient interface
+type MockWorkspaceContentService_WatchWorkspaceEventsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceContentService_WatchWorkspaceEventsClientMockRecorder
+}
+
+// MockWorkspaceContentService_WatchWorkspaceEventsClientMockRecorder is the mock recorder for MockWorkspaceContentService_WatchWorkspaceEventsClient
+type MockWorkspaceContentService_WatchWorkspaceEventsClientMockRecorder struct {
+	mock *MockWorkspaceContentService_WatchWorkspaceEventsClient
+}
+
+// NewMockWorkspaceContentService_WatchWorkspaceEventsClient creates a new mock instance
+func NewMockWorkspaceContentService_WatchWorkspaceEventsClient(ctrl *gomock.Controller) *MockWorkspaceContentService_WatchWorkspaceEventsClient {
+	mock := &MockWorkspaceContentService_WatchWorkspaceEventsClient{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceContentService_WatchWorkspaceEventsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWorkspaceContentService_WatchWorkspaceEventsClient) EXPECT() *MockWorkspaceContentService_WatchWorkspaceEventsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method
+func (m *MockWorkspaceContentService_WatchWorkspaceEventsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockWorkspaceContentService_WatchWorkspaceEventsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockWorkspaceContentService_WatchWorkspaceEventsClient)(nil).CloseSend))
+}
+
+// Context mocks base method
+func (m *MockWorkspaceContentService_WatchWorkspaceEventsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockWorkspaceContentService_WatchWorkspaceEventsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockWorkspaceContentService_WatchWorkspaceEventsClient)(nil).Context))
+}
+
+// Header mocks base method
+func (m *MockWorkspaceContentService_WatchWorkspaceEventsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// TakeSnapshot indicates an expected call of TakeSnapshot
-func (mr *MockWorkspaceContentServiceServerMockRecorder) TakeSnapshot(arg0, arg1 interface{}) *gomock.Call {
+// Header indicates an expected call of Header
+func (mr *MockWorkspaceContentService_WatchWorkspaceEventsClientMockRecorder) Header() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeSnapshot", reflect.TypeOf((*MockWorkspaceContentServiceServer)(nil).TakeSnapshot), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockWorkspaceContentService_WatchWorkspaceEventsClient)(nil).Header))
 }
 
-// DisposeWorkspace mocks base method
-func (m *MockWorkspaceContentServiceServer) DisposeWorkspace(arg0 context.Context, arg1 *api.DisposeWorkspaceRequest) (*api.DisposeWorkspaceResponse, error) {
+// Recv mocks base method
+func (m *MockWorkspaceContentService_WatchWorkspaceEventsClient) Recv() (*api.WorkspaceLifecycleEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DisposeWorkspace", arg0, arg1)
-	ret0, _ := ret[0].(*api.DisposeWorkspaceResponse)
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*api.WorkspaceLifecycleEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DisposeWorkspace indicates an expected call of DisposeWorkspace
-func (mr *MockWorkspaceContentServiceServerMockRecorder) DisposeWorkspace(arg0, arg1 interface{}) *gomock.Call {
+// Recv indicates an expected call of Recv
+func (mr *MockWorkspaceContentService_WatchWorkspaceEventsClientMockRecorder) Recv() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisposeWorkspace", reflect.TypeOf((*MockWorkspaceContentServiceServer)(nil).DisposeWorkspace), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockWorkspaceContentService_WatchWorkspaceEventsClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method
+func (m *MockWorkspaceContentService_WatchWorkspaceEventsClient) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockWorkspaceContentService_WatchWorkspaceEventsClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockWorkspaceContentService_WatchWorkspaceEventsClient)(nil).RecvMsg), arg0)
+}
+
+// SendMsg mocks base method
+func (m *MockWorkspaceContentService_WatchWorkspaceEventsClient) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockWorkspaceContentService_WatchWorkspaceEventsClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockWorkspaceContentService_WatchWorkspaceEventsClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method
+func (m *MockWorkspaceContentService_WatchWorkspaceEventsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer
+func (mr *MockWorkspaceContentService_WatchWorkspaceEventsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockWorkspaceContentService_WatchWorkspaceEventsClient)(nil).Trailer))
+}
+
+// MockWorkspaceContentService_WatchWorkspaceEventsServer is a mock of WorkspaceContentService_WatchWorkspaceEventsServer interface
+type MockWorkspaceContentService_WatchWorkspaceEventsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceContentService_WatchWorkspaceEventsServerMockRecorder
+}
+
+// MockWorkspaceContentService_WatchWorkspaceEventsServerMockRecorder is the mock recorder for MockWorkspaceContentService_WatchWorkspaceEventsServer
+type MockWorkspaceContentService_WatchWorkspaceEventsServerMockRecorder struct {
+	mock *MockWorkspaceContentService_WatchWorkspaceEventsServer
+}
+
+// NewMockWorkspaceContentService_WatchWorkspaceEventsServer creates a new mock instance
+func NewMockWorkspaceContentService_WatchWorkspaceEventsServer(ctrl *gomock.Controller) *MockWorkspaceContentService_WatchWorkspaceEventsServer {
+	mock := &MockWorkspaceContentService_WatchWorkspaceEventsServer{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceContentService_WatchWorkspaceEventsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWorkspaceContentService_WatchWorkspaceEventsServer) EXPECT() *MockWorkspaceContentService_WatchWorkspaceEventsServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method
+func (m *MockWorkspaceContentService_WatchWorkspaceEventsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockWorkspaceContentService_WatchWorkspaceEventsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockWorkspaceContentService_WatchWorkspaceEventsServer)(nil).Context))
+}
+
+// RecvMsg mocks base method
+func (m *MockWorkspaceContentService_WatchWorkspaceEventsServer) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockWorkspaceContentService_WatchWorkspaceEventsServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockWorkspaceContentService_WatchWorkspaceEventsServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method
+func (m *MockWorkspaceContentService_WatchWorkspaceEventsServer) Send(arg0 *api.WorkspaceLifecycleEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockWorkspaceContentService_WatchWorkspaceEventsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockWorkspaceContentService_WatchWorkspaceEventsServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockWorkspaceContentService_WatchWorkspaceEventsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockWorkspaceContentService_WatchWorkspaceEventsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockWorkspaceContentService_WatchWorkspaceEventsServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method
+func (m *MockWorkspaceContentService_WatchWorkspaceEventsServer) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockWorkspaceContentService_WatchWorkspaceEventsServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockWorkspaceContentService_WatchWorkspaceEventsServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockWorkspaceContentService_WatchWorkspaceEventsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockWorkspaceContentService_WatchWorkspaceEventsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockWorkspaceContentService_WatchWorkspaceEventsServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockWorkspaceContentService_WatchWorkspaceEventsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockWorkspaceContentService_WatchWorkspaceEventsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockWorkspaceContentService_WatchWorkspaceEventsServer)(nil).SetTrailer), arg0)
+}
+
+// MockInventoryServiceClient is a mock of InventoryServiceClient interface
+type MockInventoryServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockInventoryServiceClientMockRecorder
+}
+
+// MockInventoryServiceClientMockRecorder is the mock recorder for MockInventoryServiceClient
+type MockInventoryServiceClientMockRecorder struct {
+	mock *MockInventoryServiceClient
+}
+
+// NewMockInventoryServiceClient creates a new mock instance
+func NewMockInventoryServiceClient(ctrl *gomock.Controller) *MockInventoryServiceClient {
+	mock := &MockInventoryServiceClient{ctrl: ctrl}
+	mock.recorder = &MockInventoryServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockInventoryServiceClient) EXPECT() *MockInventoryServiceClientMockRecorder {
+	return m.recorder
+}
+
+// ListWorkspaces mocks base method
+func (m *MockInventoryServiceClient) ListWorkspaces(arg0 context.Context, arg1 *api.ListWorkspacesRequest, arg2 ...grpc.CallOption) (*api.ListWorkspacesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWorkspaces", varargs...)
+	ret0, _ := ret[0].(*api.ListWorkspacesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkspaces indicates an expected call of ListWorkspaces
+func (mr *MockInventoryServiceClientMockRecorder) ListWorkspaces(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkspaces", reflect.TypeOf((*MockInventoryServiceClient)(nil).ListWorkspaces), varargs...)
+}
+
+// MockInventoryServiceServer is a mock of InventoryServiceServer interface
+type MockInventoryServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockInventoryServiceServerMockRecorder
+}
+
+// MockInventoryServiceServerMockRecorder is the mock recorder for MockInventoryServiceServer
+type MockInventoryServiceServerMockRecorder struct {
+	mock *MockInventoryServiceServer
+}
+
+// NewMockInventoryServiceServer creates a new mock instance
+func NewMockInventoryServiceServer(ctrl *gomock.Controller) *MockInventoryServiceServer {
+	mock := &MockInventoryServiceServer{ctrl: ctrl}
+	mock.recorder = &MockInventoryServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockInventoryServiceServer) EXPECT() *MockInventoryServiceServerMockRecorder {
+	return m.recorder
+}
+
+// ListWorkspaces mocks base method
+func (m *MockInventoryServiceServer) ListWorkspaces(arg0 context.Context, arg1 *api.ListWorkspacesRequest) (*api.ListWorkspacesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkspaces", arg0, arg1)
+	ret0, _ := ret[0].(*api.ListWorkspacesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkspaces indicates an expected call of ListWorkspaces
+func (mr *MockInventoryServiceServerMockRecorder) ListWorkspaces(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkspaces", reflect.TypeOf((*MockInventoryServiceServer)(nil).ListWorkspaces), arg0, arg1)
 }
@@ -10,7 +10,9 @@ package api
 import (
 	context "context"
 	fmt "fmt"
+	api "github.com/gitpod-io/gitpod/content-service/api"
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -395,6 +397,159 @@ func (m *TeardownResponse) GetSuccess() bool {
 	return false
 }
 
+type BackupStatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupStatusRequest) Reset()         { *m = BackupStatusRequest{} }
+func (m *BackupStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupStatusRequest) ProtoMessage()    {}
+func (*BackupStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac718ecaafc2333, []int{8}
+}
+
+func (m *BackupStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupStatusRequest.Unmarshal(m, b)
+}
+func (m *BackupStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *BackupStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupStatusRequest.Merge(m, src)
+}
+func (m *BackupStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_BackupStatusRequest.Size(m)
+}
+func (m *BackupStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupStatusRequest proto.InternalMessageInfo
+
+type BackupStatusResponse struct {
+	// last_backup is the time the last backup was uploaded to remote storage. If the workspace has not been
+	// backed up yet, this field is nil.
+	LastBackup *timestamp.Timestamp `protobuf:"bytes,1,opt,name=last_backup,json=lastBackup,proto3" json:"last_backup,omitempty"`
+	// last_backup_size is the size of the last backup in bytes
+	LastBackupSize       int64    `protobuf:"varint,2,opt,name=last_backup_size,json=lastBackupSize,proto3" json:"last_backup_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupStatusResponse) Reset()         { *m = BackupStatusResponse{} }
+func (m *BackupStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupStatusResponse) ProtoMessage()    {}
+func (*BackupStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac718ecaafc2333, []int{9}
+}
+
+func (m *BackupStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupStatusResponse.Unmarshal(m, b)
+}
+func (m *BackupStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *BackupStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupStatusResponse.Merge(m, src)
+}
+func (m *BackupStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_BackupStatusResponse.Size(m)
+}
+func (m *BackupStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupStatusResponse proto.InternalMessageInfo
+
+func (m *BackupStatusResponse) GetLastBackup() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastBackup
+	}
+	return nil
+}
+
+func (m *BackupStatusResponse) GetLastBackupSize() int64 {
+	if m != nil {
+		return m.LastBackupSize
+	}
+	return 0
+}
+
+type UpdateGitStatusRequest struct {
+	// repo is the state of the Git repo at the checkout location of the workspace.
+	// If the workspace has no Git repo there, this field is nil.
+	Repo                 *api.GitStatus `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *UpdateGitStatusRequest) Reset()         { *m = UpdateGitStatusRequest{} }
+func (m *UpdateGitStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateGitStatusRequest) ProtoMessage()    {}
+func (*UpdateGitStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac718ecaafc2333, []int{10}
+}
+
+func (m *UpdateGitStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateGitStatusRequest.Unmarshal(m, b)
+}
+func (m *UpdateGitStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateGitStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateGitStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateGitStatusRequest.Merge(m, src)
+}
+func (m *UpdateGitStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateGitStatusRequest.Size(m)
+}
+func (m *UpdateGitStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateGitStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateGitStatusRequest proto.InternalMessageInfo
+
+func (m *UpdateGitStatusRequest) GetRepo() *api.GitStatus {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+type UpdateGitStatusResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateGitStatusResponse) Reset()         { *m = UpdateGitStatusResponse{} }
+func (m *UpdateGitStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateGitStatusResponse) ProtoMessage()    {}
+func (*UpdateGitStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac718ecaafc2333, []int{11}
+}
+
+func (m *UpdateGitStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateGitStatusResponse.Unmarshal(m, b)
+}
+func (m *UpdateGitStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateGitStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateGitStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateGitStatusResponse.Merge(m, src)
+}
+func (m *UpdateGitStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateGitStatusResponse.Size(m)
+}
+func (m *UpdateGitStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateGitStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateGitStatusResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*PrepareForUserNSRequest)(nil), "iws.PrepareForUserNSRequest")
 	proto.RegisterType((*PrepareForUserNSResponse)(nil), "iws.PrepareForUserNSResponse")
@@ -405,6 +560,10 @@ func init() {
 	proto.RegisterType((*MountProcResponse)(nil), "iws.MountProcResponse")
 	proto.RegisterType((*TeardownRequest)(nil), "iws.TeardownRequest")
 	proto.RegisterType((*TeardownResponse)(nil), "iws.TeardownResponse")
+	proto.RegisterType((*BackupStatusRequest)(nil), "iws.BackupStatusRequest")
+	proto.RegisterType((*BackupStatusResponse)(nil), "iws.BackupStatusResponse")
+	proto.RegisterType((*UpdateGitStatusRequest)(nil), "iws.UpdateGitStatusRequest")
+	proto.RegisterType((*UpdateGitStatusResponse)(nil), "iws.UpdateGitStatusResponse")
 }
 
 func init() {
@@ -412,35 +571,47 @@ func init() {
 }
 
 var fileDescriptor_dac718ecaafc2333 = []byte{
-	// 447 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xd1, 0x6e, 0xd3, 0x30,
-	0x14, 0xa5, 0x0b, 0x6a, 0xd7, 0x3b, 0xca, 0x3a, 0x43, 0xbb, 0x10, 0x98, 0x54, 0xf2, 0x54, 0x04,
-	0x4b, 0xa5, 0xf1, 0x84, 0xd4, 0x27, 0x40, 0x48, 0x15, 0x1a, 0xda, 0x32, 0xd0, 0x04, 0x2f, 0x95,
-	0x17, 0x5f, 0x65, 0x16, 0x6a, 0xae, 0xb1, 0x5d, 0x22, 0xf1, 0x4f, 0xfc, 0x0d, 0x1f, 0x84, 0x6a,
-	0xec, 0x0c, 0xc2, 0xfa, 0x76, 0xef, 0xb9, 0x3e, 0x27, 0xbe, 0xe7, 0x38, 0xb0, 0x5f, 0x93, 0xfe,
-	0x6a, 0x14, 0x2f, 0x30, 0x53, 0x9a, 0x2c, 0xb1, 0x48, 0xd6, 0x26, 0x7d, 0x04, 0x87, 0x67, 0x1a,
-	0x15, 0xd7, 0xf8, 0x8e, 0xf4, 0x27, 0x83, 0xfa, 0xc3, 0x45, 0x8e, 0xdf, 0xd6, 0x68, 0x6c, 0x9a,
-	0x40, 0xfc, 0xff, 0xc8, 0x28, 0xaa, 0x0c, 0xa6, 0xe7, 0x30, 0xbe, 0xd4, 0xd2, 0xe2, 0xe2, 0xed,
-	0x29, 0x57, 0x4a, 0x56, 0x65, 0x98, 0xb0, 0x18, 0x7a, 0x2b, 0x34, 0x86, 0x97, 0x18, 0x77, 0x26,
-	0x9d, 0x69, 0x3f, 0x0f, 0x2d, 0x3b, 0x02, 0x40, 0xad, 0x49, 0x2f, 0x0b, 0x12, 0x18, 0xef, 0x4c,
-	0x3a, 0xd3, 0x41, 0xde, 0x77, 0xc8, 0x1b, 0x12, 0x98, 0xfe, 0xea, 0xc0, 0xa8, 0xad, 0xe9, 0x2e,
-	0xc2, 0x86, 0x10, 0x29, 0x29, 0x9c, 0x5c, 0x94, 0x6f, 0xca, 0x0d, 0x52, 0x4a, 0xe1, 0x34, 0x76,
-	0xf3, 0x4d, 0xc9, 0xe6, 0xd0, 0x5b, 0xfd, 0x61, 0xc5, 0xd1, 0x24, 0x9a, 0xee, 0x9d, 0xa4, 0x99,
-	0xac, 0x4d, 0x76, 0xab, 0x60, 0x16, 0xda, 0x40, 0x49, 0x3e, 0x43, 0xcf, 0x63, 0xec, 0x29, 0xdc,
-	0x2b, 0xa8, 0xb2, 0x5c, 0x56, 0xa8, 0x97, 0xfe, 0xab, 0x83, 0x7c, 0xaf, 0xc1, 0x16, 0x82, 0x1d,
-	0x42, 0xef, 0x9a, 0x8c, 0x5d, 0xfa, 0x1b, 0x0c, 0xf2, 0xee, 0xa6, 0x5d, 0x08, 0xc6, 0xe0, 0xae,
-	0x91, 0x3f, 0x30, 0x8e, 0x1c, 0xea, 0xea, 0x74, 0x0e, 0xc3, 0x53, 0x5a, 0x57, 0xf6, 0x4c, 0x53,
-	0x11, 0x16, 0x1a, 0x43, 0xd7, 0x72, 0x5d, 0xa2, 0xf5, 0x16, 0xf9, 0x2e, 0x2c, 0xba, 0xd3, 0x2c,
-	0x9a, 0x3e, 0x80, 0x83, 0xbf, 0xd8, 0xde, 0xfc, 0x03, 0xd8, 0xff, 0x88, 0x5c, 0x0b, 0xaa, 0xab,
-	0x90, 0xd5, 0x0b, 0x18, 0xde, 0x40, 0x37, 0x49, 0x98, 0x75, 0x51, 0xa0, 0x31, 0xde, 0xa8, 0xd0,
-	0x9e, 0xfc, 0xdc, 0x01, 0xb6, 0xa8, 0x2e, 0xc3, 0x7b, 0xb8, 0x40, 0xfd, 0x5d, 0x16, 0xc8, 0xce,
-	0x61, 0xd8, 0x0e, 0x9c, 0x3d, 0x71, 0x36, 0x6e, 0x79, 0x22, 0xc9, 0xd1, 0x96, 0xa9, 0xbf, 0xe8,
-	0x1d, 0xf6, 0x1e, 0xee, 0xff, 0x1b, 0x01, 0x4b, 0xb6, 0xe7, 0x92, 0x3c, 0xbe, 0x75, 0xd6, 0x88,
-	0xcd, 0xa1, 0xdf, 0x98, 0xc1, 0x46, 0xee, 0x6c, 0xdb, 0xda, 0x64, 0xdc, 0x86, 0x1b, 0xf6, 0x2b,
-	0xd8, 0x0d, 0x16, 0xb1, 0x87, 0xee, 0x54, 0xcb, 0xc4, 0x64, 0xd4, 0x42, 0x03, 0xf5, 0xf5, 0xf3,
-	0x2f, 0xcf, 0x4a, 0x69, 0xaf, 0xd7, 0x57, 0x59, 0x41, 0xab, 0x59, 0x29, 0xad, 0x22, 0x71, 0x2c,
-	0xc9, 0x57, 0xb3, 0xda, 0x1c, 0x0b, 0x8e, 0x2b, 0xaa, 0x66, 0x5c, 0xc9, 0xab, 0xae, 0xfb, 0xbb,
-	0x5e, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x70, 0x6c, 0x8d, 0x70, 0x03, 0x00, 0x00,
+	// 636 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x5d, 0x4f, 0xdb, 0x3a,
+	0x18, 0xc7, 0x4f, 0x29, 0xa2, 0xf0, 0x94, 0x97, 0x62, 0x68, 0x09, 0x39, 0xa0, 0xc3, 0x89, 0x34,
+	0xa9, 0xd3, 0xd6, 0x54, 0xea, 0xae, 0xa6, 0x71, 0xc5, 0x5e, 0x50, 0x35, 0x81, 0x20, 0x05, 0xa1,
+	0xed, 0xa6, 0x72, 0x93, 0x67, 0xc1, 0x82, 0xc6, 0x9e, 0xed, 0xac, 0x12, 0xd2, 0x3e, 0xd2, 0x2e,
+	0xf6, 0x5d, 0xf6, 0x81, 0xa6, 0x38, 0x71, 0x5b, 0x42, 0xb9, 0xf3, 0xf3, 0xf6, 0x8f, 0x9f, 0xbf,
+	0x7f, 0x81, 0xad, 0x09, 0x97, 0x77, 0x4a, 0xd0, 0x10, 0x7d, 0x21, 0xb9, 0xe6, 0xa4, 0xca, 0x26,
+	0xca, 0x7d, 0x11, 0xf2, 0x44, 0x63, 0xa2, 0x3b, 0x0a, 0xe5, 0x0f, 0x16, 0x62, 0x87, 0x0a, 0xd6,
+	0x65, 0x09, 0xd3, 0x8c, 0xde, 0xb3, 0x07, 0x94, 0x79, 0xaf, 0xfb, 0x5f, 0xcc, 0x79, 0x7c, 0x8f,
+	0x5d, 0x13, 0x8d, 0xd2, 0x6f, 0x5d, 0xcd, 0xc6, 0xa8, 0x34, 0x1d, 0x8b, 0xbc, 0xc1, 0xdb, 0x87,
+	0xbd, 0x0b, 0x89, 0x82, 0x4a, 0xfc, 0xc4, 0xe5, 0xb5, 0x42, 0x79, 0x3e, 0x08, 0xf0, 0x7b, 0x8a,
+	0x4a, 0x7b, 0x2e, 0x38, 0x4f, 0x4b, 0x4a, 0xf0, 0x44, 0xa1, 0x77, 0x09, 0xad, 0x1b, 0xc9, 0x34,
+	0xf6, 0x3f, 0x9c, 0x51, 0x21, 0x58, 0x12, 0xdb, 0x0a, 0x71, 0xa0, 0x36, 0x46, 0xa5, 0x68, 0x8c,
+	0x4e, 0xe5, 0xa8, 0xd2, 0x5e, 0x0b, 0x6c, 0x48, 0x0e, 0x01, 0x50, 0x4a, 0x2e, 0x87, 0x21, 0x8f,
+	0xd0, 0x59, 0x3a, 0xaa, 0xb4, 0x37, 0x82, 0x35, 0x93, 0x79, 0xcf, 0x23, 0xf4, 0xfe, 0x54, 0xa0,
+	0x59, 0xd6, 0x34, 0x17, 0x21, 0x0d, 0xa8, 0x0a, 0x16, 0x19, 0xb9, 0x6a, 0x90, 0x1d, 0xb3, 0x4c,
+	0xcc, 0x22, 0xa3, 0xb1, 0x1a, 0x64, 0x47, 0x72, 0x0c, 0xb5, 0x71, 0x3e, 0xe5, 0x54, 0x8f, 0xaa,
+	0xed, 0x7a, 0xcf, 0xf3, 0xd9, 0x44, 0xf9, 0x0b, 0x05, 0x7d, 0x1b, 0xda, 0x11, 0xf7, 0x0b, 0xd4,
+	0x8a, 0x1c, 0xf9, 0x1f, 0xd6, 0x33, 0x6b, 0x29, 0x4b, 0x50, 0x0e, 0x8b, 0xaf, 0x6e, 0x04, 0xf5,
+	0x69, 0xae, 0x1f, 0x91, 0x3d, 0xa8, 0xdd, 0x72, 0xa5, 0x87, 0xc5, 0x0d, 0x36, 0x82, 0x95, 0x2c,
+	0xec, 0x47, 0x84, 0xc0, 0xb2, 0x62, 0x0f, 0xe8, 0x54, 0x4d, 0xd6, 0x9c, 0xbd, 0x63, 0x68, 0x9c,
+	0xf1, 0x34, 0xd1, 0x17, 0x92, 0x87, 0x76, 0xa1, 0x16, 0xac, 0x68, 0x2a, 0x63, 0xd4, 0x85, 0x45,
+	0x45, 0x64, 0x17, 0x5d, 0x9a, 0x2e, 0xea, 0xed, 0xc0, 0xf6, 0xdc, 0x74, 0x61, 0xfe, 0x36, 0x6c,
+	0x5d, 0x21, 0x95, 0x11, 0x9f, 0x24, 0xf6, 0xad, 0x5e, 0x43, 0x63, 0x96, 0x9a, 0xbd, 0x84, 0x4a,
+	0xc3, 0x10, 0x95, 0x2a, 0x8c, 0xb2, 0xa1, 0xd7, 0x84, 0x9d, 0x13, 0x1a, 0xde, 0xa5, 0x62, 0xa0,
+	0xa9, 0x4e, 0x95, 0x15, 0xf9, 0x09, 0xbb, 0x8f, 0xd3, 0x85, 0xd0, 0x3b, 0xa8, 0xdf, 0x53, 0xa5,
+	0x87, 0x23, 0x53, 0x34, 0x77, 0xae, 0xf7, 0x5c, 0x3f, 0x47, 0xcb, 0xb7, 0x68, 0xf9, 0x57, 0x16,
+	0xad, 0x00, 0xb2, 0xf6, 0x5c, 0x8a, 0xb4, 0xa1, 0x31, 0x37, 0x3c, 0x34, 0xfe, 0xe4, 0x0b, 0x6e,
+	0xce, 0xba, 0x06, 0x99, 0x53, 0xa7, 0xd0, 0xba, 0x16, 0x11, 0xd5, 0x78, 0xca, 0xf4, 0xa3, 0x8b,
+	0x91, 0x0e, 0x2c, 0x4b, 0x14, 0xbc, 0xf8, 0xf2, 0xbe, 0x5f, 0xb0, 0x5f, 0xa0, 0xef, 0xcf, 0xfa,
+	0x4d, 0x5b, 0xc6, 0xf4, 0x13, 0xa1, 0x7c, 0x95, 0xde, 0xaf, 0x25, 0x20, 0xfd, 0xe4, 0xc6, 0xfe,
+	0x51, 0x83, 0x5c, 0x81, 0x5c, 0x42, 0xa3, 0x8c, 0x3a, 0x39, 0x30, 0x00, 0x3d, 0xf3, 0x73, 0xb8,
+	0x87, 0xcf, 0x54, 0x8b, 0x27, 0xfa, 0x87, 0x7c, 0x86, 0xcd, 0xc7, 0xf0, 0x11, 0xf7, 0x79, 0x22,
+	0xdd, 0x7f, 0x17, 0xd6, 0xa6, 0x62, 0xc7, 0xb0, 0x36, 0xc5, 0x80, 0x34, 0x4d, 0x6f, 0x19, 0x2a,
+	0xb7, 0x55, 0x4e, 0x4f, 0xa7, 0xdf, 0xc2, 0xaa, 0x85, 0x83, 0xec, 0x9a, 0xae, 0x12, 0x3e, 0x6e,
+	0xb3, 0x94, 0xb5, 0xa3, 0xbd, 0xdf, 0x15, 0x70, 0xe6, 0xfd, 0x32, 0x6e, 0x5a, 0xd7, 0x3e, 0xc2,
+	0xfa, 0x3c, 0x2f, 0xc4, 0x31, 0x2a, 0x0b, 0xc8, 0x72, 0xf7, 0x17, 0x54, 0xa6, 0xd7, 0x3b, 0x87,
+	0xad, 0xd2, 0x73, 0x91, 0xdc, 0x8e, 0xc5, 0x34, 0xb8, 0x07, 0x8b, 0x8b, 0x56, 0xef, 0xe4, 0xd5,
+	0xd7, 0x97, 0x31, 0xd3, 0xb7, 0xe9, 0xc8, 0x0f, 0xf9, 0xb8, 0x1b, 0x33, 0x2d, 0x78, 0xd4, 0x61,
+	0xbc, 0x38, 0x75, 0x27, 0xaa, 0x13, 0x51, 0x1c, 0xf3, 0xa4, 0x4b, 0x05, 0x1b, 0xad, 0x18, 0x7c,
+	0xdf, 0xfc, 0x1d, 0x00, 0xf7, 0x58, 0x54, 0x1f, 0x66, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -654,3 +825,117 @@ var _InWorkspaceService_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "workspace.proto",
 }
+
+// InWorkspaceStatusServiceClient is the client API for InWorkspaceStatusService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type InWorkspaceStatusServiceClient interface {
+	// BackupStatus provides information about the last backup of the workspace content that was uploaded to remote storage.
+	BackupStatus(ctx context.Context, in *BackupStatusRequest, opts ...grpc.CallOption) (*BackupStatusResponse, error)
+	// UpdateGitStatus reports the current state of the Git repo in the workspace. ws-daemon passes it on
+	// as lifecycle event of the workspace, s.t. the workspace status reflects it while the workspace is running.
+	UpdateGitStatus(ctx context.Context, in *UpdateGitStatusRequest, opts ...grpc.CallOption) (*UpdateGitStatusResponse, error)
+}
+
+type inWorkspaceStatusServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInWorkspaceStatusServiceClient(cc grpc.ClientConnInterface) InWorkspaceStatusServiceClient {
+	return &inWorkspaceStatusServiceClient{cc}
+}
+
+func (c *inWorkspaceStatusServiceClient) BackupStatus(ctx context.Context, in *BackupStatusRequest, opts ...grpc.CallOption) (*BackupStatusResponse, error) {
+	out := new(BackupStatusResponse)
+	err := c.cc.Invoke(ctx, "/iws.InWorkspaceStatusService/BackupStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inWorkspaceStatusServiceClient) UpdateGitStatus(ctx context.Context, in *UpdateGitStatusRequest, opts ...grpc.CallOption) (*UpdateGitStatusResponse, error) {
+	out := new(UpdateGitStatusResponse)
+	err := c.cc.Invoke(ctx, "/iws.InWorkspaceStatusService/UpdateGitStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InWorkspaceStatusServiceServer is the server API for InWorkspaceStatusService service.
+type InWorkspaceStatusServiceServer interface {
+	// BackupStatus provides information about the last backup of the workspace content that was uploaded to remote storage.
+	BackupStatus(context.Context, *BackupStatusRequest) (*BackupStatusResponse, error)
+	// UpdateGitStatus reports the current state of the Git repo in the workspace. ws-daemon passes it on
+	// as lifecycle event of the workspace, s.t. the workspace status reflects it while the workspace is running.
+	UpdateGitStatus(context.Context, *UpdateGitStatusRequest) (*UpdateGitStatusResponse, error)
+}
+
+// UnimplementedInWorkspaceStatusServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedInWorkspaceStatusServiceServer struct {
+}
+
+func (*UnimplementedInWorkspaceStatusServiceServer) BackupStatus(ctx context.Context, req *BackupStatusRequest) (*BackupStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupStatus not implemented")
+}
+func (*UnimplementedInWorkspaceStatusServiceServer) UpdateGitStatus(ctx context.Context, req *UpdateGitStatusRequest) (*UpdateGitStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGitStatus not implemented")
+}
+
+func RegisterInWorkspaceStatusServiceServer(s *grpc.Server, srv InWorkspaceStatusServiceServer) {
+	s.RegisterService(&_InWorkspaceStatusService_serviceDesc, srv)
+}
+
+func _InWorkspaceStatusService_BackupStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InWorkspaceStatusServiceServer).BackupStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iws.InWorkspaceStatusService/BackupStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InWorkspaceStatusServiceServer).BackupStatus(ctx, req.(*BackupStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InWorkspaceStatusService_UpdateGitStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGitStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InWorkspaceStatusServiceServer).UpdateGitStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iws.InWorkspaceStatusService/UpdateGitStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InWorkspaceStatusServiceServer).UpdateGitStatus(ctx, req.(*UpdateGitStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _InWorkspaceStatusService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "iws.InWorkspaceStatusService",
+	HandlerType: (*InWorkspaceStatusServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BackupStatus",
+			Handler:    _InWorkspaceStatusService_BackupStatus_Handler,
+		},
+		{
+			MethodName: "UpdateGitStatus",
+			Handler:    _InWorkspaceStatusService_UpdateGitStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "workspace.proto",
+}
@@ -0,0 +1,147 @@
+/**
+ * Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+ * Licensed under the GNU Affero General Public License (AGPL).
+ * See License-AGPL.txt in the project root for license information.
+ */
+
+// package: wsdaemon
+// file: daemon.proto
+
+/* tslint:disable */
+
+import * as grpc from "grpc";
+import * as daemon_pb from "./daemon_pb";
+import * as content_service_api_initializer_pb from "@gitpod/content-service/lib";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
+
+interface IWorkspaceContentServiceService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
+    initWorkspace: IWorkspaceContentServiceService_IInitWorkspace;
+    waitForInit: IWorkspaceContentServiceService_IWaitForInit;
+    takeSnapshot: IWorkspaceContentServiceService_ITakeSnapshot;
+    disposeWorkspace: IWorkspaceContentServiceService_IDisposeWorkspace;
+    watchWorkspaceEvents: IWorkspaceContentServiceService_IWatchWorkspaceEvents;
+}
+
+interface IWorkspaceContentServiceService_IInitWorkspace extends grpc.MethodDefinition<daemon_pb.InitWorkspaceRequest, daemon_pb.InitWorkspaceResponse> {
+    path: string; // "/wsdaemon.WorkspaceContentService/InitWorkspace"
+    requestStream: boolean; // false
+    responseStream: boolean; // false
+    requestSerialize: grpc.serialize<daemon_pb.InitWorkspaceRequest>;
+    requestDeserialize: grpc.deserialize<daemon_pb.InitWorkspaceRequest>;
+    responseSerialize: grpc.serialize<daemon_pb.InitWorkspaceResponse>;
+    responseDeserialize: grpc.deserialize<daemon_pb.InitWorkspaceResponse>;
+}
+interface IWorkspaceContentServiceService_IWaitForInit extends grpc.MethodDefinition<daemon_pb.WaitForInitRequest, daemon_pb.WaitForInitResponse> {
+    path: string; // "/wsdaemon.WorkspaceContentService/WaitForInit"
+    requestStream: boolean; // false
+    responseStream: boolean; // false
+    requestSerialize: grpc.serialize<daemon_pb.WaitForInitRequest>;
+    requestDeserialize: grpc.deserialize<daemon_pb.WaitForInitRequest>;
+    responseSerialize: grpc.serialize<daemon_pb.WaitForInitResponse>;
+    responseDeserialize: grpc.deserialize<daemon_pb.WaitForInitResponse>;
+}
+interface IWorkspaceContentServiceService_ITakeSnapshot extends grpc.MethodDefinition<daemon_pb.TakeSnapshotRequest, daemon_pb.TakeSnapshotResponse> {
+    path: string; // "/wsdaemon.WorkspaceContentService/TakeSnapshot"
+    requestStream: boolean; // false
+    responseStream: boolean; // false
+    requestSerialize: grpc.serialize<daemon_pb.TakeSnapshotRequest>;
+    requestDeserialize: grpc.deserialize<daemon_pb.TakeSnapshotRequest>;
+    responseSerialize: grpc.serialize<daemon_pb.TakeSnapshotResponse>;
+    responseDeserialize: grpc.deserialize<daemon_pb.TakeSnapshotResponse>;
+}
+interface IWorkspaceContentServiceService_IDisposeWorkspace extends grpc.MethodDefinition<daemon_pb.DisposeWorkspaceRequest, daemon_pb.DisposeWorkspaceResponse> {
+    path: string; // "/wsdaemon.WorkspaceContentService/DisposeWorkspace"
+    requestStream: boolean; // false
+    responseStream: boolean; // false
+    requestSerialize: grpc.serialize<daemon_pb.DisposeWorkspaceRequest>;
+    requestDeserialize: grpc.deserialize<daemon_pb.DisposeWorkspaceRequest>;
+    responseSerialize: grpc.serialize<daemon_pb.DisposeWorkspaceResponse>;
+    responseDeserialize: grpc.deserialize<daemon_pb.DisposeWorkspaceResponse>;
+}
+interface IWorkspaceContentServiceService_IWatchWorkspaceEvents extends grpc.MethodDefinition<daemon_pb.WatchWorkspaceEventsRequest, daemon_pb.WorkspaceLifecycleEvent> {
+    path: string; // "/wsdaemon.WorkspaceContentService/WatchWorkspaceEvents"
+    requestStream: boolean; // false
+    responseStream: boolean; // true
+    requestSerialize: grpc.serialize<daemon_pb.WatchWorkspaceEventsRequest>;
+    requestDeserialize: grpc.deserialize<daemon_pb.WatchWorkspaceEventsRequest>;
+    responseSerialize: grpc.serialize<daemon_pb.WorkspaceLifecycleEvent>;
+    responseDeserialize: grpc.deserialize<daemon_pb.WorkspaceLifecycleEvent>;
+}
+
+export const WorkspaceContentServiceService: IWorkspaceContentServiceService;
+
+export interface IWorkspaceContentServiceServer {
+    initWorkspace: grpc.handleUnaryCall<daemon_pb.InitWorkspaceRequest, daemon_pb.InitWorkspaceResponse>;
+    waitForInit: grpc.handleUnaryCall<daemon_pb.WaitForInitRequest, daemon_pb.WaitForInitResponse>;
+    takeSnapshot: grpc.handleUnaryCall<daemon_pb.TakeSnapshotRequest, daemon_pb.TakeSnapshotResponse>;
+    disposeWorkspace: grpc.handleUnaryCall<daemon_pb.DisposeWorkspaceRequest, daemon_pb.DisposeWorkspaceResponse>;
+    watchWorkspaceEvents: grpc.handleServerStreamingCall<daemon_pb.WatchWorkspaceEventsRequest, daemon_pb.WorkspaceLifecycleEvent>;
+}
+
+export interface IWorkspaceContentServiceClient {
+    initWorkspace(request: daemon_pb.InitWorkspaceRequest, callback: (error: grpc.ServiceError | null, response: daemon_pb.InitWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    initWorkspace(request: daemon_pb.InitWorkspaceRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: daemon_pb.InitWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    initWorkspace(request: daemon_pb.InitWorkspaceRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: daemon_pb.InitWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    waitForInit(request: daemon_pb.WaitForInitRequest, callback: (error: grpc.ServiceError | null, response: daemon_pb.WaitForInitResponse) => void): grpc.ClientUnaryCall;
+    waitForInit(request: daemon_pb.WaitForInitRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: daemon_pb.WaitForInitResponse) => void): grpc.ClientUnaryCall;
+    waitForInit(request: daemon_pb.WaitForInitRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: daemon_pb.WaitForInitResponse) => void): grpc.ClientUnaryCall;
+    takeSnapshot(request: daemon_pb.TakeSnapshotRequest, callback: (error: grpc.ServiceError | null, response: daemon_pb.TakeSnapshotResponse) => void): grpc.ClientUnaryCall;
+    takeSnapshot(request: daemon_pb.TakeSnapshotRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: daemon_pb.TakeSnapshotResponse) => void): grpc.ClientUnaryCall;
+    takeSnapshot(request: daemon_pb.TakeSnapshotRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: daemon_pb.TakeSnapshotResponse) => void): grpc.ClientUnaryCall;
+    disposeWorkspace(request: daemon_pb.DisposeWorkspaceRequest, callback: (error: grpc.ServiceError | null, response: daemon_pb.DisposeWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    disposeWorkspace(request: daemon_pb.DisposeWorkspaceRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: daemon_pb.DisposeWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    disposeWorkspace(request: daemon_pb.DisposeWorkspaceRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: daemon_pb.DisposeWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    watchWorkspaceEvents(request: daemon_pb.WatchWorkspaceEventsRequest, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<daemon_pb.WorkspaceLifecycleEvent>;
+    watchWorkspaceEvents(request: daemon_pb.WatchWorkspaceEventsRequest, metadata?: grpc.Metadata, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<daemon_pb.WorkspaceLifecycleEvent>;
+}
+
+export class WorkspaceContentServiceClient extends grpc.Client implements IWorkspaceContentServiceClient {
+    constructor(address: string, credentials: grpc.ChannelCredentials, options?: object);
+    public initWorkspace(request: daemon_pb.InitWorkspaceRequest, callback: (error: grpc.ServiceError | null, response: daemon_pb.InitWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    public initWorkspace(request: daemon_pb.InitWorkspaceRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: daemon_pb.InitWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    public initWorkspace(request: daemon_pb.InitWorkspaceRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: daemon_pb.InitWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    public waitForInit(request: daemon_pb.WaitForInitRequest, callback: (error: grpc.ServiceError | null, response: daemon_pb.WaitForInitResponse) => void): grpc.ClientUnaryCall;
+    public waitForInit(request: daemon_pb.WaitForInitRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: daemon_pb.WaitForInitResponse) => void): grpc.ClientUnaryCall;
+    public waitForInit(request: daemon_pb.WaitForInitRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: daemon_pb.WaitForInitResponse) => void): grpc.ClientUnaryCall;
+    public takeSnapshot(request: daemon_pb.TakeSnapshotRequest, callback: (error: grpc.ServiceError | null, response: daemon_pb.TakeSnapshotResponse) => void): grpc.ClientUnaryCall;
+    public takeSnapshot(request: daemon_pb.TakeSnapshotRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: daemon_pb.TakeSnapshotResponse) => void): grpc.ClientUnaryCall;
+    public takeSnapshot(request: daemon_pb.TakeSnapshotRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: daemon_pb.TakeSnapshotResponse) => void): grpc.ClientUnaryCall;
+    public disposeWorkspace(request: daemon_pb.DisposeWorkspaceRequest, callback: (error: grpc.ServiceError | null, response: daemon_pb.DisposeWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    public disposeWorkspace(request: daemon_pb.DisposeWorkspaceRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: daemon_pb.DisposeWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    public disposeWorkspace(request: daemon_pb.DisposeWorkspaceRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: daemon_pb.DisposeWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    public watchWorkspaceEvents(request: daemon_pb.WatchWorkspaceEventsRequest, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<daemon_pb.WorkspaceLifecycleEvent>;
+    public watchWorkspaceEvents(request: daemon_pb.WatchWorkspaceEventsRequest, metadata?: grpc.Metadata, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<daemon_pb.WorkspaceLifecycleEvent>;
+}
+
+interface IInventoryServiceService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
+    listWorkspaces: IInventoryServiceService_IListWorkspaces;
+}
+
+interface IInventoryServiceService_IListWorkspaces extends grpc.MethodDefinition<daemon_pb.ListWorkspacesRequest, daemon_pb.ListWorkspacesResponse> {
+    path: string; // "/wsdaemon.InventoryService/ListWorkspaces"
+    requestStream: boolean; // false
+    responseStream: boolean; // false
+    requestSerialize: grpc.serialize<daemon_pb.ListWorkspacesRequest>;
+    requestDeserialize: grpc.deserialize<daemon_pb.ListWorkspacesRequest>;
+    responseSerialize: grpc.serialize<daemon_pb.ListWorkspacesResponse>;
+    responseDeserialize: grpc.deserialize<daemon_pb.ListWorkspacesResponse>;
+}
+
+export const InventoryServiceService: IInventoryServiceService;
+
+export interface IInventoryServiceServer {
+    listWorkspaces: grpc.handleUnaryCall<daemon_pb.ListWorkspacesRequest, daemon_pb.ListWorkspacesResponse>;
+}
+
+export interface IInventoryServiceClient {
+    listWorkspaces(request: daemon_pb.ListWorkspacesRequest, callback: (error: grpc.ServiceError | null, response: daemon_pb.ListWorkspacesResponse) => void): grpc.ClientUnaryCall;
+    listWorkspaces(request: daemon_pb.ListWorkspacesRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: daemon_pb.ListWorkspacesResponse) => void): grpc.ClientUnaryCall;
+    listWorkspaces(request: daemon_pb.ListWorkspacesRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: daemon_pb.ListWorkspacesResponse) => void): grpc.ClientUnaryCall;
+}
+
+export class InventoryServiceClient extends grpc.Client implements IInventoryServiceClient {
+    constructor(address: string, credentials: grpc.ChannelCredentials, options?: object);
+    public listWorkspaces(request: daemon_pb.ListWorkspacesRequest, callback: (error: grpc.ServiceError | null, response: daemon_pb.ListWorkspacesResponse) => void): grpc.ClientUnaryCall;
+    public listWorkspaces(request: daemon_pb.ListWorkspacesRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: daemon_pb.ListWorkspacesResponse) => void): grpc.ClientUnaryCall;
+    public listWorkspaces(request: daemon_pb.ListWorkspacesRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: daemon_pb.ListWorkspacesResponse) => void): grpc.ClientUnaryCall;
+}
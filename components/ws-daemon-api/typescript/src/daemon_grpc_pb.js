@@ -10,6 +10,7 @@
 var grpc = require('grpc');
 var daemon_pb = require('./daemon_pb.js');
 var content$service$api_initializer_pb = require('@gitpod/content-service/lib');
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
 
 function serialize_wsdaemon_DisposeWorkspaceRequest(arg) {
   if (!(arg instanceof daemon_pb.DisposeWorkspaceRequest)) {
@@ -55,6 +56,28 @@ function deserialize_wsdaemon_InitWorkspaceResponse(buffer_arg) {
   return daemon_pb.InitWorkspaceResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_wsdaemon_ListWorkspacesRequest(arg) {
+  if (!(arg instanceof daemon_pb.ListWorkspacesRequest)) {
+    throw new Error('Expected argument of type wsdaemon.ListWorkspacesRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsdaemon_ListWorkspacesRequest(buffer_arg) {
+  return daemon_pb.ListWorkspacesRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsdaemon_ListWorkspacesResponse(arg) {
+  if (!(arg instanceof daemon_pb.ListWorkspacesResponse)) {
+    throw new Error('Expected argument of type wsdaemon.ListWorkspacesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsdaemon_ListWorkspacesResponse(buffer_arg) {
+  return daemon_pb.ListWorkspacesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_wsdaemon_TakeSnapshotRequest(arg) {
   if (!(arg instanceof daemon_pb.TakeSnapshotRequest)) {
     throw new Error('Expected argument of type wsdaemon.TakeSnapshotRequest');
@@ -99,6 +122,28 @@ function deserialize_wsdaemon_WaitForInitResponse(buffer_arg) {
   return daemon_pb.WaitForInitResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_wsdaemon_WatchWorkspaceEventsRequest(arg) {
+  if (!(arg instanceof daemon_pb.WatchWorkspaceEventsRequest)) {
+    throw new Error('Expected argument of type wsdaemon.WatchWorkspaceEventsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsdaemon_WatchWorkspaceEventsRequest(buffer_arg) {
+  return daemon_pb.WatchWorkspaceEventsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsdaemon_WorkspaceLifecycleEvent(arg) {
+  if (!(arg instanceof daemon_pb.WorkspaceLifecycleEvent)) {
+    throw new Error('Expected argument of type wsdaemon.WorkspaceLifecycleEvent');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsdaemon_WorkspaceLifecycleEvent(buffer_arg) {
+  return daemon_pb.WorkspaceLifecycleEvent.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 
 var WorkspaceContentServiceService = exports.WorkspaceContentServiceService = {
   // initWorkspace intialises a new workspace folder in the working area
@@ -151,6 +196,36 @@ disposeWorkspace: {
     responseSerialize: serialize_wsdaemon_DisposeWorkspaceResponse,
     responseDeserialize: deserialize_wsdaemon_DisposeWorkspaceResponse,
   },
+  // WatchWorkspaceEvents streams the lifecycle events of a workspace's content, e.g. initializer and backup progress.
+// The stream ends when the workspace is disposed of or the caller cancels the request.
+watchWorkspaceEvents: {
+    path: '/wsdaemon.WorkspaceContentService/WatchWorkspaceEvents',
+    requestStream: false,
+    responseStream: true,
+    requestType: daemon_pb.WatchWorkspaceEventsRequest,
+    responseType: daemon_pb.WorkspaceLifecycleEvent,
+    requestSerialize: serialize_wsdaemon_WatchWorkspaceEventsRequest,
+    requestDeserialize: deserialize_wsdaemon_WatchWorkspaceEventsRequest,
+    responseSerialize: serialize_wsdaemon_WorkspaceLifecycleEvent,
+    responseDeserialize: deserialize_wsdaemon_WorkspaceLifecycleEvent,
+  },
 };
 
 exports.WorkspaceContentServiceClient = grpc.makeGenericClientConstructor(WorkspaceContentServiceService);
+// InventoryService provides read-only insight into the workspaces managed by a daemon
+var InventoryServiceService = exports.InventoryServiceService = {
+  // ListWorkspaces lists all workspaces this daemon currently knows about on its node
+listWorkspaces: {
+    path: '/wsdaemon.InventoryService/ListWorkspaces',
+    requestStream: false,
+    responseStream: false,
+    requestType: daemon_pb.ListWorkspacesRequest,
+    responseType: daemon_pb.ListWorkspacesResponse,
+    requestSerialize: serialize_wsdaemon_ListWorkspacesRequest,
+    requestDeserialize: deserialize_wsdaemon_ListWorkspacesRequest,
+    responseSerialize: serialize_wsdaemon_ListWorkspacesResponse,
+    responseDeserialize: deserialize_wsdaemon_ListWorkspacesResponse,
+  },
+};
+
+exports.InventoryServiceClient = grpc.makeGenericClientConstructor(InventoryServiceService);
@@ -9,6 +9,7 @@
 
 import * as jspb from "google-protobuf";
 import * as content_service_api_initializer_pb from "@gitpod/content-service/lib";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
 
 export class InitWorkspaceRequest extends jspb.Message {
   getId(): string;
@@ -136,6 +137,9 @@ export class TakeSnapshotRequest extends jspb.Message {
   getId(): string;
   setId(value: string): void;
 
+  getPrebuild(): boolean;
+  setPrebuild(value: boolean): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): TakeSnapshotRequest.AsObject;
   static toObject(includeInstance: boolean, msg: TakeSnapshotRequest): TakeSnapshotRequest.AsObject;
@@ -149,6 +153,7 @@ export class TakeSnapshotRequest extends jspb.Message {
 export namespace TakeSnapshotRequest {
   export type AsObject = {
     id: string,
+    prebuild: boolean,
   }
 }
 
@@ -218,6 +223,381 @@ export namespace DisposeWorkspaceResponse {
   }
 }
 
+export class WatchWorkspaceEventsRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): WatchWorkspaceEventsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: WatchWorkspaceEventsRequest): WatchWorkspaceEventsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: WatchWorkspaceEventsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): WatchWorkspaceEventsRequest;
+  static deserializeBinaryFromReader(message: WatchWorkspaceEventsRequest, reader: jspb.BinaryReader): WatchWorkspaceEventsRequest;
+}
+
+export namespace WatchWorkspaceEventsRequest {
+  export type AsObject = {
+    id: string,
+  }
+}
+
+export class WorkspaceLifecycleEvent extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  hasTime(): boolean;
+  clearTime(): void;
+  getTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTime(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasInitializer(): boolean;
+  clearInitializer(): void;
+  getInitializer(): InitializerProgress | undefined;
+  setInitializer(value?: InitializerProgress): void;
+
+  hasBackup(): boolean;
+  clearBackup(): void;
+  getBackup(): BackupProgress | undefined;
+  setBackup(value?: BackupProgress): void;
+
+  hasError(): boolean;
+  clearError(): void;
+  getError(): ContentError | undefined;
+  setError(value?: ContentError): void;
+
+  hasGitStatus(): boolean;
+  clearGitStatus(): void;
+  getGitStatus(): content_service_api_initializer_pb.GitStatus | undefined;
+  setGitStatus(value?: content_service_api_initializer_pb.GitStatus): void;
+
+  getPayloadCase(): WorkspaceLifecycleEvent.PayloadCase;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): WorkspaceLifecycleEvent.AsObject;
+  static toObject(includeInstance: boolean, msg: WorkspaceLifecycleEvent): WorkspaceLifecycleEvent.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: WorkspaceLifecycleEvent, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): WorkspaceLifecycleEvent;
+  static deserializeBinaryFromReader(message: WorkspaceLifecycleEvent, reader: jspb.BinaryReader): WorkspaceLifecycleEvent;
+}
+
+export namespace WorkspaceLifecycleEvent {
+  export type AsObject = {
+    id: string,
+    time?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    initializer?: InitializerProgress.AsObject,
+    backup?: BackupProgress.AsObject,
+    error?: ContentError.AsObject,
+    gitStatus?: content_service_api_initializer_pb.GitStatus.AsObject,
+  }
+
+  export enum PayloadCase {
+    PAYLOAD_NOT_SET = 0,
+    INITIALIZER = 3,
+    BACKUP = 4,
+    ERROR = 5,
+    GIT_STATUS = 6,
+  }
+}
+
+export class InitializerProgress extends jspb.Message {
+  getPhase(): string;
+  setPhase(value: string): void;
+
+  getBytesDownloaded(): number;
+  setBytesDownloaded(value: number): void;
+
+  getBytesTotal(): number;
+  setBytesTotal(value: number): void;
+
+  getDone(): boolean;
+  setDone(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): InitializerProgress.AsObject;
+  static toObject(includeInstance: boolean, msg: InitializerProgress): InitializerProgress.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: InitializerProgress, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): InitializerProgress;
+  static deserializeBinaryFromReader(message: InitializerProgress, reader: jspb.BinaryReader): InitializerProgress;
+}
+
+export namespace InitializerProgress {
+  export type AsObject = {
+    phase: string,
+    bytesDownloaded: number,
+    bytesTotal: number,
+    done: boolean,
+  }
+}
+
+export class BackupProgress extends jspb.Message {
+  getPhase(): string;
+  setPhase(value: string): void;
+
+  getBytesUploaded(): number;
+  setBytesUploaded(value: number): void;
+
+  getBytesTotal(): number;
+  setBytesTotal(value: number): void;
+
+  getAttempt(): number;
+  setAttempt(value: number): void;
+
+  getFinal(): boolean;
+  setFinal(value: boolean): void;
+
+  getDone(): boolean;
+  setDone(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BackupProgress.AsObject;
+  static toObject(includeInstance: boolean, msg: BackupProgress): BackupProgress.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: BackupProgress, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BackupProgress;
+  static deserializeBinaryFromReader(message: BackupProgress, reader: jspb.BinaryReader): BackupProgress;
+}
+
+export namespace BackupProgress {
+  export type AsObject = {
+    phase: string,
+    bytesUploaded: number,
+    bytesTotal: number,
+    attempt: number,
+    pb_final: boolean,
+    done: boolean,
+  }
+}
+
+export class ContentError extends jspb.Message {
+  getOperation(): string;
+  setOperation(value: string): void;
+
+  getMessage(): string;
+  setMessage(value: string): void;
+
+  getWillRetry(): boolean;
+  setWillRetry(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ContentError.AsObject;
+  static toObject(includeInstance: boolean, msg: ContentError): ContentError.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ContentError, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ContentError;
+  static deserializeBinaryFromReader(message: ContentError, reader: jspb.BinaryReader): ContentError;
+}
+
+export namespace ContentError {
+  export type AsObject = {
+    operation: string,
+    message: string,
+    willRetry: boolean,
+  }
+}
+
+export class ListWorkspacesRequest extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ListWorkspacesRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ListWorkspacesRequest): ListWorkspacesRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ListWorkspacesRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ListWorkspacesRequest;
+  static deserializeBinaryFromReader(message: ListWorkspacesRequest, reader: jspb.BinaryReader): ListWorkspacesRequest;
+}
+
+export namespace ListWorkspacesRequest {
+  export type AsObject = {
+  }
+}
+
+export class ListWorkspacesResponse extends jspb.Message {
+  getNode(): string;
+  setNode(value: string): void;
+
+  clearWorkspacesList(): void;
+  getWorkspacesList(): Array<WorkspaceInventoryEntry>;
+  setWorkspacesList(value: Array<WorkspaceInventoryEntry>): void;
+  addWorkspaces(value?: WorkspaceInventoryEntry, index?: number): WorkspaceInventoryEntry;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ListWorkspacesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ListWorkspacesResponse): ListWorkspacesResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ListWorkspacesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ListWorkspacesResponse;
+  static deserializeBinaryFromReader(message: ListWorkspacesResponse, reader: jspb.BinaryReader): ListWorkspacesResponse;
+}
+
+export namespace ListWorkspacesResponse {
+  export type AsObject = {
+    node: string,
+    workspacesList: Array<WorkspaceInventoryEntry.AsObject>,
+  }
+}
+
+export class WorkspaceInventoryEntry extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  hasMetadata(): boolean;
+  clearMetadata(): void;
+  getMetadata(): WorkspaceMetadata | undefined;
+  setMetadata(value?: WorkspaceMetadata): void;
+
+  getState(): string;
+  setState(value: string): void;
+
+  getFullWorkspaceBackup(): boolean;
+  setFullWorkspaceBackup(value: boolean): void;
+
+  hasCreatedAt(): boolean;
+  clearCreatedAt(): void;
+  getCreatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setCreatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasBackup(): boolean;
+  clearBackup(): void;
+  getBackup(): BackupInventory | undefined;
+  setBackup(value?: BackupInventory): void;
+
+  hasResources(): boolean;
+  clearResources(): void;
+  getResources(): ResourceInventory | undefined;
+  setResources(value?: ResourceInventory): void;
+
+  hasContainer(): boolean;
+  clearContainer(): void;
+  getContainer(): ContainerInventory | undefined;
+  setContainer(value?: ContainerInventory): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): WorkspaceInventoryEntry.AsObject;
+  static toObject(includeInstance: boolean, msg: WorkspaceInventoryEntry): WorkspaceInventoryEntry.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: WorkspaceInventoryEntry, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): WorkspaceInventoryEntry;
+  static deserializeBinaryFromReader(message: WorkspaceInventoryEntry, reader: jspb.BinaryReader): WorkspaceInventoryEntry;
+}
+
+export namespace WorkspaceInventoryEntry {
+  export type AsObject = {
+    id: string,
+    metadata?: WorkspaceMetadata.AsObject,
+    state: string,
+    fullWorkspaceBackup: boolean,
+    createdAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    backup?: BackupInventory.AsObject,
+    resources?: ResourceInventory.AsObject,
+    container?: ContainerInventory.AsObject,
+  }
+}
+
+export class BackupInventory extends jspb.Message {
+  getLiveBackupRunning(): boolean;
+  setLiveBackupRunning(value: boolean): void;
+
+  hasLastLiveBackup(): boolean;
+  clearLastLiveBackup(): void;
+  getLastLiveBackup(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setLastLiveBackup(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasLastBackup(): boolean;
+  clearLastBackup(): void;
+  getLastBackup(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setLastBackup(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getLastBackupSize(): number;
+  setLastBackupSize(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BackupInventory.AsObject;
+  static toObject(includeInstance: boolean, msg: BackupInventory): BackupInventory.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: BackupInventory, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BackupInventory;
+  static deserializeBinaryFromReader(message: BackupInventory, reader: jspb.BinaryReader): BackupInventory;
+}
+
+export namespace BackupInventory {
+  export type AsObject = {
+    liveBackupRunning: boolean,
+    lastLiveBackup?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    lastBackup?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    lastBackupSize: number,
+  }
+}
+
+export class ResourceInventory extends jspb.Message {
+  getCpuGoverned(): boolean;
+  setCpuGoverned(value: boolean): void;
+
+  getCpuLimit(): number;
+  setCpuLimit(value: number): void;
+
+  getCpuLoad(): number;
+  setCpuLoad(value: number): void;
+
+  getCpuBudgetSpent(): number;
+  setCpuBudgetSpent(value: number): void;
+
+  getDiskUsage(): number;
+  setDiskUsage(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ResourceInventory.AsObject;
+  static toObject(includeInstance: boolean, msg: ResourceInventory): ResourceInventory.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ResourceInventory, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ResourceInventory;
+  static deserializeBinaryFromReader(message: ResourceInventory, reader: jspb.BinaryReader): ResourceInventory;
+}
+
+export namespace ResourceInventory {
+  export type AsObject = {
+    cpuGoverned: boolean,
+    cpuLimit: number,
+    cpuLoad: number,
+    cpuBudgetSpent: number,
+    diskUsage: number,
+  }
+}
+
+export class ContainerInventory extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getCgroupPath(): string;
+  setCgroupPath(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ContainerInventory.AsObject;
+  static toObject(includeInstance: boolean, msg: ContainerInventory): ContainerInventory.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ContainerInventory, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ContainerInventory;
+  static deserializeBinaryFromReader(message: ContainerInventory, reader: jspb.BinaryReader): ContainerInventory;
+}
+
+export namespace ContainerInventory {
+  export type AsObject = {
+    id: string,
+    cgroupPath: string,
+  }
+}
+
 export interface WorkspaceContentStateMap {
   NONE: 0;
   SETTING_UP: 1;
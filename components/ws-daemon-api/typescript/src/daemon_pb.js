@@ -19,15 +19,28 @@ var global = Function('return this')();
 
 var content$service$api_initializer_pb = require('@gitpod/content-service/lib');
 goog.object.extend(proto, content$service$api_initializer_pb);
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
+goog.exportSymbol('proto.wsdaemon.BackupInventory', null, global);
+goog.exportSymbol('proto.wsdaemon.BackupProgress', null, global);
+goog.exportSymbol('proto.wsdaemon.ContainerInventory', null, global);
+goog.exportSymbol('proto.wsdaemon.ContentError', null, global);
 goog.exportSymbol('proto.wsdaemon.DisposeWorkspaceRequest', null, global);
 goog.exportSymbol('proto.wsdaemon.DisposeWorkspaceResponse', null, global);
 goog.exportSymbol('proto.wsdaemon.InitWorkspaceRequest', null, global);
 goog.exportSymbol('proto.wsdaemon.InitWorkspaceResponse', null, global);
+goog.exportSymbol('proto.wsdaemon.InitializerProgress', null, global);
+goog.exportSymbol('proto.wsdaemon.ListWorkspacesRequest', null, global);
+goog.exportSymbol('proto.wsdaemon.ListWorkspacesResponse', null, global);
+goog.exportSymbol('proto.wsdaemon.ResourceInventory', null, global);
 goog.exportSymbol('proto.wsdaemon.TakeSnapshotRequest', null, global);
 goog.exportSymbol('proto.wsdaemon.TakeSnapshotResponse', null, global);
 goog.exportSymbol('proto.wsdaemon.WaitForInitRequest', null, global);
 goog.exportSymbol('proto.wsdaemon.WaitForInitResponse', null, global);
+goog.exportSymbol('proto.wsdaemon.WatchWorkspaceEventsRequest', null, global);
 goog.exportSymbol('proto.wsdaemon.WorkspaceContentState', null, global);
+goog.exportSymbol('proto.wsdaemon.WorkspaceInventoryEntry', null, global);
+goog.exportSymbol('proto.wsdaemon.WorkspaceLifecycleEvent', null, global);
 goog.exportSymbol('proto.wsdaemon.WorkspaceMetadata', null, global);
 /**
  * Generated by JsPbCodeGenerator.
@@ -218,6 +231,237 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.wsdaemon.DisposeWorkspaceResponse.displayName = 'proto.wsdaemon.DisposeWorkspaceResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsdaemon.WatchWorkspaceEventsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsdaemon.WatchWorkspaceEventsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsdaemon.WatchWorkspaceEventsRequest.displayName = 'proto.wsdaemon.WatchWorkspaceEventsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.wsdaemon.WorkspaceLifecycleEvent.oneofGroups_);
+};
+goog.inherits(proto.wsdaemon.WorkspaceLifecycleEvent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsdaemon.WorkspaceLifecycleEvent.displayName = 'proto.wsdaemon.WorkspaceLifecycleEvent';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsdaemon.InitializerProgress = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsdaemon.InitializerProgress, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsdaemon.InitializerProgress.displayName = 'proto.wsdaemon.InitializerProgress';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsdaemon.BackupProgress = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsdaemon.BackupProgress, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsdaemon.BackupProgress.displayName = 'proto.wsdaemon.BackupProgress';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsdaemon.ContentError = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsdaemon.ContentError, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsdaemon.ContentError.displayName = 'proto.wsdaemon.ContentError';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsdaemon.ListWorkspacesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsdaemon.ListWorkspacesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsdaemon.ListWorkspacesRequest.displayName = 'proto.wsdaemon.ListWorkspacesRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsdaemon.ListWorkspacesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsdaemon.ListWorkspacesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.wsdaemon.ListWorkspacesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsdaemon.ListWorkspacesResponse.displayName = 'proto.wsdaemon.ListWorkspacesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsdaemon.WorkspaceInventoryEntry = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsdaemon.WorkspaceInventoryEntry, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsdaemon.WorkspaceInventoryEntry.displayName = 'proto.wsdaemon.WorkspaceInventoryEntry';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsdaemon.BackupInventory = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsdaemon.BackupInventory, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsdaemon.BackupInventory.displayName = 'proto.wsdaemon.BackupInventory';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsdaemon.ResourceInventory = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsdaemon.ResourceInventory, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsdaemon.ResourceInventory.displayName = 'proto.wsdaemon.ResourceInventory';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsdaemon.ContainerInventory = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsdaemon.ContainerInventory, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsdaemon.ContainerInventory.displayName = 'proto.wsdaemon.ContainerInventory';
+}
 
 
 
@@ -1051,7 +1295,8 @@ proto.wsdaemon.TakeSnapshotRequest.prototype.toObject = function(opt_includeInst
  */
 proto.wsdaemon.TakeSnapshotRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    prebuild: jspb.Message.getFieldWithDefault(msg, 2, false)
   };
 
   if (includeInstance) {
@@ -1092,6 +1337,10 @@ proto.wsdaemon.TakeSnapshotRequest.deserializeBinaryFromReader = function(msg, r
       var value = /** @type {string} */ (reader.readString());
       msg.setId(value);
       break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setPrebuild(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1128,6 +1377,13 @@ proto.wsdaemon.TakeSnapshotRequest.serializeBinaryToWriter = function(message, w
       f
     );
   }
+  f = message.getPrebuild();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
 };
 
 
@@ -1146,6 +1402,23 @@ proto.wsdaemon.TakeSnapshotRequest.prototype.setId = function(value) {
 };
 
 
+/**
+ * optional bool prebuild = 2;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.wsdaemon.TakeSnapshotRequest.prototype.getPrebuild = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 2, false));
+};
+
+
+/** @param {boolean} value */
+proto.wsdaemon.TakeSnapshotRequest.prototype.setPrebuild = function(value) {
+  jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
 
 
 
@@ -1570,6 +1843,2503 @@ proto.wsdaemon.DisposeWorkspaceResponse.prototype.hasGitStatus = function() {
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsdaemon.WatchWorkspaceEventsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.WatchWorkspaceEventsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsdaemon.WatchWorkspaceEventsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.WatchWorkspaceEventsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsdaemon.WatchWorkspaceEventsRequest}
+ */
+proto.wsdaemon.WatchWorkspaceEventsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsdaemon.WatchWorkspaceEventsRequest;
+  return proto.wsdaemon.WatchWorkspaceEventsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsdaemon.WatchWorkspaceEventsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsdaemon.WatchWorkspaceEventsRequest}
+ */
+proto.wsdaemon.WatchWorkspaceEventsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsdaemon.WatchWorkspaceEventsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsdaemon.WatchWorkspaceEventsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsdaemon.WatchWorkspaceEventsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.WatchWorkspaceEventsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsdaemon.WatchWorkspaceEventsRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.wsdaemon.WatchWorkspaceEventsRequest.prototype.setId = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.oneofGroups_ = [[3,4,5,6]];
+
+/**
+ * @enum {number}
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.PayloadCase = {
+  PAYLOAD_NOT_SET: 0,
+  INITIALIZER: 3,
+  BACKUP: 4,
+  ERROR: 5,
+  GIT_STATUS: 6
+};
+
+/**
+ * @return {proto.wsdaemon.WorkspaceLifecycleEvent.PayloadCase}
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.getPayloadCase = function() {
+  return /** @type {proto.wsdaemon.WorkspaceLifecycleEvent.PayloadCase} */(jspb.Message.computeOneofCase(this, proto.wsdaemon.WorkspaceLifecycleEvent.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.WorkspaceLifecycleEvent.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsdaemon.WorkspaceLifecycleEvent} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    time: (f = msg.getTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    initializer: (f = msg.getInitializer()) && proto.wsdaemon.InitializerProgress.toObject(includeInstance, f),
+    backup: (f = msg.getBackup()) && proto.wsdaemon.BackupProgress.toObject(includeInstance, f),
+    error: (f = msg.getError()) && proto.wsdaemon.ContentError.toObject(includeInstance, f),
+    gitStatus: (f = msg.getGitStatus()) && content$service$api_initializer_pb.GitStatus.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsdaemon.WorkspaceLifecycleEvent}
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsdaemon.WorkspaceLifecycleEvent;
+  return proto.wsdaemon.WorkspaceLifecycleEvent.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsdaemon.WorkspaceLifecycleEvent} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsdaemon.WorkspaceLifecycleEvent}
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTime(value);
+      break;
+    case 3:
+      var value = new proto.wsdaemon.InitializerProgress;
+      reader.readMessage(value,proto.wsdaemon.InitializerProgress.deserializeBinaryFromReader);
+      msg.setInitializer(value);
+      break;
+    case 4:
+      var value = new proto.wsdaemon.BackupProgress;
+      reader.readMessage(value,proto.wsdaemon.BackupProgress.deserializeBinaryFromReader);
+      msg.setBackup(value);
+      break;
+    case 5:
+      var value = new proto.wsdaemon.ContentError;
+      reader.readMessage(value,proto.wsdaemon.ContentError.deserializeBinaryFromReader);
+      msg.setError(value);
+      break;
+    case 6:
+      var value = new content$service$api_initializer_pb.GitStatus;
+      reader.readMessage(value,content$service$api_initializer_pb.GitStatus.deserializeBinaryFromReader);
+      msg.setGitStatus(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsdaemon.WorkspaceLifecycleEvent.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsdaemon.WorkspaceLifecycleEvent} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTime();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getInitializer();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.wsdaemon.InitializerProgress.serializeBinaryToWriter
+    );
+  }
+  f = message.getBackup();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.wsdaemon.BackupProgress.serializeBinaryToWriter
+    );
+  }
+  f = message.getError();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.wsdaemon.ContentError.serializeBinaryToWriter
+    );
+  }
+  f = message.getGitStatus();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      content$service$api_initializer_pb.GitStatus.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.setId = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp time = 2;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.getTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+};
+
+
+/** @param {?proto.google.protobuf.Timestamp|undefined} value */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.setTime = function(value) {
+  jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.clearTime = function() {
+  this.setTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.hasTime = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional InitializerProgress initializer = 3;
+ * @return {?proto.wsdaemon.InitializerProgress}
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.getInitializer = function() {
+  return /** @type{?proto.wsdaemon.InitializerProgress} */ (
+    jspb.Message.getWrapperField(this, proto.wsdaemon.InitializerProgress, 3));
+};
+
+
+/** @param {?proto.wsdaemon.InitializerProgress|undefined} value */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.setInitializer = function(value) {
+  jspb.Message.setOneofWrapperField(this, 3, proto.wsdaemon.WorkspaceLifecycleEvent.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.clearInitializer = function() {
+  this.setInitializer(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.hasInitializer = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional BackupProgress backup = 4;
+ * @return {?proto.wsdaemon.BackupProgress}
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.getBackup = function() {
+  return /** @type{?proto.wsdaemon.BackupProgress} */ (
+    jspb.Message.getWrapperField(this, proto.wsdaemon.BackupProgress, 4));
+};
+
+
+/** @param {?proto.wsdaemon.BackupProgress|undefined} value */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.setBackup = function(value) {
+  jspb.Message.setOneofWrapperField(this, 4, proto.wsdaemon.WorkspaceLifecycleEvent.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.clearBackup = function() {
+  this.setBackup(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.hasBackup = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional ContentError error = 5;
+ * @return {?proto.wsdaemon.ContentError}
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.getError = function() {
+  return /** @type{?proto.wsdaemon.ContentError} */ (
+    jspb.Message.getWrapperField(this, proto.wsdaemon.ContentError, 5));
+};
+
+
+/** @param {?proto.wsdaemon.ContentError|undefined} value */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.setError = function(value) {
+  jspb.Message.setOneofWrapperField(this, 5, proto.wsdaemon.WorkspaceLifecycleEvent.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.clearError = function() {
+  this.setError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.hasError = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional contentservice.GitStatus git_status = 6;
+ * @return {?proto.contentservice.GitStatus}
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.getGitStatus = function() {
+  return /** @type{?proto.contentservice.GitStatus} */ (
+    jspb.Message.getWrapperField(this, content$service$api_initializer_pb.GitStatus, 6));
+};
+
+
+/** @param {?proto.contentservice.GitStatus|undefined} value */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.setGitStatus = function(value) {
+  jspb.Message.setOneofWrapperField(this, 6, proto.wsdaemon.WorkspaceLifecycleEvent.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.clearGitStatus = function() {
+  this.setGitStatus(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsdaemon.WorkspaceLifecycleEvent.prototype.hasGitStatus = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsdaemon.InitializerProgress.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.InitializerProgress.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsdaemon.InitializerProgress} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.InitializerProgress.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    phase: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    bytesDownloaded: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    bytesTotal: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    done: jspb.Message.getFieldWithDefault(msg, 4, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsdaemon.InitializerProgress}
+ */
+proto.wsdaemon.InitializerProgress.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsdaemon.InitializerProgress;
+  return proto.wsdaemon.InitializerProgress.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsdaemon.InitializerProgress} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsdaemon.InitializerProgress}
+ */
+proto.wsdaemon.InitializerProgress.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPhase(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setBytesDownloaded(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setBytesTotal(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDone(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsdaemon.InitializerProgress.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsdaemon.InitializerProgress.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsdaemon.InitializerProgress} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.InitializerProgress.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPhase();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getBytesDownloaded();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getBytesTotal();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getDone();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string phase = 1;
+ * @return {string}
+ */
+proto.wsdaemon.InitializerProgress.prototype.getPhase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.wsdaemon.InitializerProgress.prototype.setPhase = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int64 bytes_downloaded = 2;
+ * @return {number}
+ */
+proto.wsdaemon.InitializerProgress.prototype.getBytesDownloaded = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/** @param {number} value */
+proto.wsdaemon.InitializerProgress.prototype.setBytesDownloaded = function(value) {
+  jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int64 bytes_total = 3;
+ * @return {number}
+ */
+proto.wsdaemon.InitializerProgress.prototype.getBytesTotal = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/** @param {number} value */
+proto.wsdaemon.InitializerProgress.prototype.setBytesTotal = function(value) {
+  jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional bool done = 4;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.wsdaemon.InitializerProgress.prototype.getDone = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 4, false));
+};
+
+
+/** @param {boolean} value */
+proto.wsdaemon.InitializerProgress.prototype.setDone = function(value) {
+  jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsdaemon.BackupProgress.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.BackupProgress.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsdaemon.BackupProgress} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.BackupProgress.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    phase: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    bytesUploaded: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    bytesTotal: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    attempt: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    pb_final: jspb.Message.getFieldWithDefault(msg, 5, false),
+    done: jspb.Message.getFieldWithDefault(msg, 6, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsdaemon.BackupProgress}
+ */
+proto.wsdaemon.BackupProgress.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsdaemon.BackupProgress;
+  return proto.wsdaemon.BackupProgress.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsdaemon.BackupProgress} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsdaemon.BackupProgress}
+ */
+proto.wsdaemon.BackupProgress.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPhase(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setBytesUploaded(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setBytesTotal(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setAttempt(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setFinal(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDone(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsdaemon.BackupProgress.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsdaemon.BackupProgress.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsdaemon.BackupProgress} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.BackupProgress.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPhase();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getBytesUploaded();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getBytesTotal();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getAttempt();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getFinal();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getDone();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string phase = 1;
+ * @return {string}
+ */
+proto.wsdaemon.BackupProgress.prototype.getPhase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.wsdaemon.BackupProgress.prototype.setPhase = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int64 bytes_uploaded = 2;
+ * @return {number}
+ */
+proto.wsdaemon.BackupProgress.prototype.getBytesUploaded = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/** @param {number} value */
+proto.wsdaemon.BackupProgress.prototype.setBytesUploaded = function(value) {
+  jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int64 bytes_total = 3;
+ * @return {number}
+ */
+proto.wsdaemon.BackupProgress.prototype.getBytesTotal = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/** @param {number} value */
+proto.wsdaemon.BackupProgress.prototype.setBytesTotal = function(value) {
+  jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int32 attempt = 4;
+ * @return {number}
+ */
+proto.wsdaemon.BackupProgress.prototype.getAttempt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/** @param {number} value */
+proto.wsdaemon.BackupProgress.prototype.setAttempt = function(value) {
+  jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional bool final = 5;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.wsdaemon.BackupProgress.prototype.getFinal = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 5, false));
+};
+
+
+/** @param {boolean} value */
+proto.wsdaemon.BackupProgress.prototype.setFinal = function(value) {
+  jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * optional bool done = 6;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.wsdaemon.BackupProgress.prototype.getDone = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 6, false));
+};
+
+
+/** @param {boolean} value */
+proto.wsdaemon.BackupProgress.prototype.setDone = function(value) {
+  jspb.Message.setProto3BooleanField(this, 6, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsdaemon.ContentError.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.ContentError.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsdaemon.ContentError} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.ContentError.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    operation: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    message: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    willRetry: jspb.Message.getFieldWithDefault(msg, 3, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsdaemon.ContentError}
+ */
+proto.wsdaemon.ContentError.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsdaemon.ContentError;
+  return proto.wsdaemon.ContentError.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsdaemon.ContentError} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsdaemon.ContentError}
+ */
+proto.wsdaemon.ContentError.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOperation(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setWillRetry(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsdaemon.ContentError.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsdaemon.ContentError.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsdaemon.ContentError} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.ContentError.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getOperation();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getWillRetry();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string operation = 1;
+ * @return {string}
+ */
+proto.wsdaemon.ContentError.prototype.getOperation = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.wsdaemon.ContentError.prototype.setOperation = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string message = 2;
+ * @return {string}
+ */
+proto.wsdaemon.ContentError.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.wsdaemon.ContentError.prototype.setMessage = function(value) {
+  jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional bool will_retry = 3;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.wsdaemon.ContentError.prototype.getWillRetry = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 3, false));
+};
+
+
+/** @param {boolean} value */
+proto.wsdaemon.ContentError.prototype.setWillRetry = function(value) {
+  jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsdaemon.ListWorkspacesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.ListWorkspacesRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsdaemon.ListWorkspacesRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.ListWorkspacesRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsdaemon.ListWorkspacesRequest}
+ */
+proto.wsdaemon.ListWorkspacesRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsdaemon.ListWorkspacesRequest;
+  return proto.wsdaemon.ListWorkspacesRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsdaemon.ListWorkspacesRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsdaemon.ListWorkspacesRequest}
+ */
+proto.wsdaemon.ListWorkspacesRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsdaemon.ListWorkspacesRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsdaemon.ListWorkspacesRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsdaemon.ListWorkspacesRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.ListWorkspacesRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsdaemon.ListWorkspacesResponse.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsdaemon.ListWorkspacesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.ListWorkspacesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsdaemon.ListWorkspacesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.ListWorkspacesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    node: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    workspacesList: jspb.Message.toObjectList(msg.getWorkspacesList(),
+    proto.wsdaemon.WorkspaceInventoryEntry.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsdaemon.ListWorkspacesResponse}
+ */
+proto.wsdaemon.ListWorkspacesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsdaemon.ListWorkspacesResponse;
+  return proto.wsdaemon.ListWorkspacesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsdaemon.ListWorkspacesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsdaemon.ListWorkspacesResponse}
+ */
+proto.wsdaemon.ListWorkspacesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNode(value);
+      break;
+    case 2:
+      var value = new proto.wsdaemon.WorkspaceInventoryEntry;
+      reader.readMessage(value,proto.wsdaemon.WorkspaceInventoryEntry.deserializeBinaryFromReader);
+      msg.addWorkspaces(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsdaemon.ListWorkspacesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsdaemon.ListWorkspacesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsdaemon.ListWorkspacesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.ListWorkspacesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNode();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getWorkspacesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.wsdaemon.WorkspaceInventoryEntry.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string node = 1;
+ * @return {string}
+ */
+proto.wsdaemon.ListWorkspacesResponse.prototype.getNode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.wsdaemon.ListWorkspacesResponse.prototype.setNode = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated WorkspaceInventoryEntry workspaces = 2;
+ * @return {!Array<!proto.wsdaemon.WorkspaceInventoryEntry>}
+ */
+proto.wsdaemon.ListWorkspacesResponse.prototype.getWorkspacesList = function() {
+  return /** @type{!Array<!proto.wsdaemon.WorkspaceInventoryEntry>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsdaemon.WorkspaceInventoryEntry, 2));
+};
+
+
+/** @param {!Array<!proto.wsdaemon.WorkspaceInventoryEntry>} value */
+proto.wsdaemon.ListWorkspacesResponse.prototype.setWorkspacesList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.wsdaemon.WorkspaceInventoryEntry=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsdaemon.WorkspaceInventoryEntry}
+ */
+proto.wsdaemon.ListWorkspacesResponse.prototype.addWorkspaces = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.wsdaemon.WorkspaceInventoryEntry, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ */
+proto.wsdaemon.ListWorkspacesResponse.prototype.clearWorkspacesList = function() {
+  this.setWorkspacesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.WorkspaceInventoryEntry.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsdaemon.WorkspaceInventoryEntry} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    metadata: (f = msg.getMetadata()) && proto.wsdaemon.WorkspaceMetadata.toObject(includeInstance, f),
+    state: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    fullWorkspaceBackup: jspb.Message.getFieldWithDefault(msg, 4, false),
+    createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    backup: (f = msg.getBackup()) && proto.wsdaemon.BackupInventory.toObject(includeInstance, f),
+    resources: (f = msg.getResources()) && proto.wsdaemon.ResourceInventory.toObject(includeInstance, f),
+    container: (f = msg.getContainer()) && proto.wsdaemon.ContainerInventory.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsdaemon.WorkspaceInventoryEntry}
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsdaemon.WorkspaceInventoryEntry;
+  return proto.wsdaemon.WorkspaceInventoryEntry.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsdaemon.WorkspaceInventoryEntry} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsdaemon.WorkspaceInventoryEntry}
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = new proto.wsdaemon.WorkspaceMetadata;
+      reader.readMessage(value,proto.wsdaemon.WorkspaceMetadata.deserializeBinaryFromReader);
+      msg.setMetadata(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setState(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setFullWorkspaceBackup(value);
+      break;
+    case 5:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreatedAt(value);
+      break;
+    case 6:
+      var value = new proto.wsdaemon.BackupInventory;
+      reader.readMessage(value,proto.wsdaemon.BackupInventory.deserializeBinaryFromReader);
+      msg.setBackup(value);
+      break;
+    case 7:
+      var value = new proto.wsdaemon.ResourceInventory;
+      reader.readMessage(value,proto.wsdaemon.ResourceInventory.deserializeBinaryFromReader);
+      msg.setResources(value);
+      break;
+    case 8:
+      var value = new proto.wsdaemon.ContainerInventory;
+      reader.readMessage(value,proto.wsdaemon.ContainerInventory.deserializeBinaryFromReader);
+      msg.setContainer(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsdaemon.WorkspaceInventoryEntry.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsdaemon.WorkspaceInventoryEntry} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getMetadata();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.wsdaemon.WorkspaceMetadata.serializeBinaryToWriter
+    );
+  }
+  f = message.getState();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getFullWorkspaceBackup();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getCreatedAt();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getBackup();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.wsdaemon.BackupInventory.serializeBinaryToWriter
+    );
+  }
+  f = message.getResources();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.wsdaemon.ResourceInventory.serializeBinaryToWriter
+    );
+  }
+  f = message.getContainer();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      proto.wsdaemon.ContainerInventory.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.setId = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional WorkspaceMetadata metadata = 2;
+ * @return {?proto.wsdaemon.WorkspaceMetadata}
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.getMetadata = function() {
+  return /** @type{?proto.wsdaemon.WorkspaceMetadata} */ (
+    jspb.Message.getWrapperField(this, proto.wsdaemon.WorkspaceMetadata, 2));
+};
+
+
+/** @param {?proto.wsdaemon.WorkspaceMetadata|undefined} value */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.setMetadata = function(value) {
+  jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.clearMetadata = function() {
+  this.setMetadata(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.hasMetadata = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional string state = 3;
+ * @return {string}
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.getState = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/** @param {string} value */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.setState = function(value) {
+  jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional bool full_workspace_backup = 4;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.getFullWorkspaceBackup = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 4, false));
+};
+
+
+/** @param {boolean} value */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.setFullWorkspaceBackup = function(value) {
+  jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp created_at = 5;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.getCreatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
+};
+
+
+/** @param {?proto.google.protobuf.Timestamp|undefined} value */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.setCreatedAt = function(value) {
+  jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.clearCreatedAt = function() {
+  this.setCreatedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.hasCreatedAt = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional BackupInventory backup = 6;
+ * @return {?proto.wsdaemon.BackupInventory}
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.getBackup = function() {
+  return /** @type{?proto.wsdaemon.BackupInventory} */ (
+    jspb.Message.getWrapperField(this, proto.wsdaemon.BackupInventory, 6));
+};
+
+
+/** @param {?proto.wsdaemon.BackupInventory|undefined} value */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.setBackup = function(value) {
+  jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.clearBackup = function() {
+  this.setBackup(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.hasBackup = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional ResourceInventory resources = 7;
+ * @return {?proto.wsdaemon.ResourceInventory}
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.getResources = function() {
+  return /** @type{?proto.wsdaemon.ResourceInventory} */ (
+    jspb.Message.getWrapperField(this, proto.wsdaemon.ResourceInventory, 7));
+};
+
+
+/** @param {?proto.wsdaemon.ResourceInventory|undefined} value */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.setResources = function(value) {
+  jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.clearResources = function() {
+  this.setResources(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.hasResources = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional ContainerInventory container = 8;
+ * @return {?proto.wsdaemon.ContainerInventory}
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.getContainer = function() {
+  return /** @type{?proto.wsdaemon.ContainerInventory} */ (
+    jspb.Message.getWrapperField(this, proto.wsdaemon.ContainerInventory, 8));
+};
+
+
+/** @param {?proto.wsdaemon.ContainerInventory|undefined} value */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.setContainer = function(value) {
+  jspb.Message.setWrapperField(this, 8, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.clearContainer = function() {
+  this.setContainer(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsdaemon.WorkspaceInventoryEntry.prototype.hasContainer = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsdaemon.BackupInventory.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.BackupInventory.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsdaemon.BackupInventory} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.BackupInventory.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    liveBackupRunning: jspb.Message.getFieldWithDefault(msg, 1, false),
+    lastLiveBackup: (f = msg.getLastLiveBackup()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    lastBackup: (f = msg.getLastBackup()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    lastBackupSize: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsdaemon.BackupInventory}
+ */
+proto.wsdaemon.BackupInventory.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsdaemon.BackupInventory;
+  return proto.wsdaemon.BackupInventory.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsdaemon.BackupInventory} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsdaemon.BackupInventory}
+ */
+proto.wsdaemon.BackupInventory.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setLiveBackupRunning(value);
+      break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setLastLiveBackup(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setLastBackup(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLastBackupSize(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsdaemon.BackupInventory.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsdaemon.BackupInventory.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsdaemon.BackupInventory} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.BackupInventory.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLiveBackupRunning();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getLastLiveBackup();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getLastBackup();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getLastBackupSize();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool live_backup_running = 1;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.wsdaemon.BackupInventory.prototype.getLiveBackupRunning = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 1, false));
+};
+
+
+/** @param {boolean} value */
+proto.wsdaemon.BackupInventory.prototype.setLiveBackupRunning = function(value) {
+  jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp last_live_backup = 2;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.wsdaemon.BackupInventory.prototype.getLastLiveBackup = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+};
+
+
+/** @param {?proto.google.protobuf.Timestamp|undefined} value */
+proto.wsdaemon.BackupInventory.prototype.setLastLiveBackup = function(value) {
+  jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ */
+proto.wsdaemon.BackupInventory.prototype.clearLastLiveBackup = function() {
+  this.setLastLiveBackup(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsdaemon.BackupInventory.prototype.hasLastLiveBackup = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp last_backup = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.wsdaemon.BackupInventory.prototype.getLastBackup = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
+
+
+/** @param {?proto.google.protobuf.Timestamp|undefined} value */
+proto.wsdaemon.BackupInventory.prototype.setLastBackup = function(value) {
+  jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ */
+proto.wsdaemon.BackupInventory.prototype.clearLastBackup = function() {
+  this.setLastBackup(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsdaemon.BackupInventory.prototype.hasLastBackup = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional int64 last_backup_size = 4;
+ * @return {number}
+ */
+proto.wsdaemon.BackupInventory.prototype.getLastBackupSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/** @param {number} value */
+proto.wsdaemon.BackupInventory.prototype.setLastBackupSize = function(value) {
+  jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsdaemon.ResourceInventory.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.ResourceInventory.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsdaemon.ResourceInventory} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.ResourceInventory.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    cpuGoverned: jspb.Message.getFieldWithDefault(msg, 1, false),
+    cpuLimit: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    cpuLoad: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    cpuBudgetSpent: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    diskUsage: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsdaemon.ResourceInventory}
+ */
+proto.wsdaemon.ResourceInventory.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsdaemon.ResourceInventory;
+  return proto.wsdaemon.ResourceInventory.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsdaemon.ResourceInventory} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsdaemon.ResourceInventory}
+ */
+proto.wsdaemon.ResourceInventory.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setCpuGoverned(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCpuLimit(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCpuLoad(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCpuBudgetSpent(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setDiskUsage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsdaemon.ResourceInventory.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsdaemon.ResourceInventory.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsdaemon.ResourceInventory} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.ResourceInventory.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCpuGoverned();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getCpuLimit();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getCpuLoad();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getCpuBudgetSpent();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+  f = message.getDiskUsage();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool cpu_governed = 1;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.wsdaemon.ResourceInventory.prototype.getCpuGoverned = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 1, false));
+};
+
+
+/** @param {boolean} value */
+proto.wsdaemon.ResourceInventory.prototype.setCpuGoverned = function(value) {
+  jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional int64 cpu_limit = 2;
+ * @return {number}
+ */
+proto.wsdaemon.ResourceInventory.prototype.getCpuLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/** @param {number} value */
+proto.wsdaemon.ResourceInventory.prototype.setCpuLimit = function(value) {
+  jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int64 cpu_load = 3;
+ * @return {number}
+ */
+proto.wsdaemon.ResourceInventory.prototype.getCpuLoad = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/** @param {number} value */
+proto.wsdaemon.ResourceInventory.prototype.setCpuLoad = function(value) {
+  jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int64 cpu_budget_spent = 4;
+ * @return {number}
+ */
+proto.wsdaemon.ResourceInventory.prototype.getCpuBudgetSpent = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/** @param {number} value */
+proto.wsdaemon.ResourceInventory.prototype.setCpuBudgetSpent = function(value) {
+  jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional int64 disk_usage = 5;
+ * @return {number}
+ */
+proto.wsdaemon.ResourceInventory.prototype.getDiskUsage = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/** @param {number} value */
+proto.wsdaemon.ResourceInventory.prototype.setDiskUsage = function(value) {
+  jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsdaemon.ContainerInventory.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.ContainerInventory.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsdaemon.ContainerInventory} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.ContainerInventory.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    cgroupPath: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsdaemon.ContainerInventory}
+ */
+proto.wsdaemon.ContainerInventory.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsdaemon.ContainerInventory;
+  return proto.wsdaemon.ContainerInventory.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsdaemon.ContainerInventory} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsdaemon.ContainerInventory}
+ */
+proto.wsdaemon.ContainerInventory.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCgroupPath(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsdaemon.ContainerInventory.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsdaemon.ContainerInventory.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsdaemon.ContainerInventory} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.ContainerInventory.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getCgroupPath();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsdaemon.ContainerInventory.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.wsdaemon.ContainerInventory.prototype.setId = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string cgroup_path = 2;
+ * @return {string}
+ */
+proto.wsdaemon.ContainerInventory.prototype.getCgroupPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.wsdaemon.ContainerInventory.prototype.setCgroupPath = function(value) {
+  jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
 /**
  * @enum {number}
  */
@@ -9,6 +9,30 @@
 'use strict';
 var grpc = require('grpc');
 var workspace_pb = require('./workspace_pb.js');
+var content$service$api_initializer_pb = require('@gitpod/content-service/lib');
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+
+function serialize_iws_BackupStatusRequest(arg) {
+  if (!(arg instanceof workspace_pb.BackupStatusRequest)) {
+    throw new Error('Expected argument of type iws.BackupStatusRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_iws_BackupStatusRequest(buffer_arg) {
+  return workspace_pb.BackupStatusRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_iws_BackupStatusResponse(arg) {
+  if (!(arg instanceof workspace_pb.BackupStatusResponse)) {
+    throw new Error('Expected argument of type iws.BackupStatusResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_iws_BackupStatusResponse(buffer_arg) {
+  return workspace_pb.BackupStatusResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
 
 function serialize_iws_MountProcRequest(arg) {
   if (!(arg instanceof workspace_pb.MountProcRequest)) {
@@ -76,6 +100,28 @@ function deserialize_iws_TeardownResponse(buffer_arg) {
   return workspace_pb.TeardownResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_iws_UpdateGitStatusRequest(arg) {
+  if (!(arg instanceof workspace_pb.UpdateGitStatusRequest)) {
+    throw new Error('Expected argument of type iws.UpdateGitStatusRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_iws_UpdateGitStatusRequest(buffer_arg) {
+  return workspace_pb.UpdateGitStatusRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_iws_UpdateGitStatusResponse(arg) {
+  if (!(arg instanceof workspace_pb.UpdateGitStatusResponse)) {
+    throw new Error('Expected argument of type iws.UpdateGitStatusResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_iws_UpdateGitStatusResponse(buffer_arg) {
+  return workspace_pb.UpdateGitStatusResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_iws_WriteIDMappingRequest(arg) {
   if (!(arg instanceof workspace_pb.WriteIDMappingRequest)) {
     throw new Error('Expected argument of type iws.WriteIDMappingRequest');
@@ -159,3 +205,34 @@ teardown: {
 };
 
 exports.InWorkspaceServiceClient = grpc.makeGenericClientConstructor(InWorkspaceServiceService);
+// InWorkspaceStatusService lets a workspace learn about and report on its own state. Contrary to the InWorkspaceService,
+// ws-daemon offers it to every workspace, on the status.sock in `/.workspace-status`.
+var InWorkspaceStatusServiceService = exports.InWorkspaceStatusServiceService = {
+  // BackupStatus provides information about the last backup of the workspace content that was uploaded to remote storage.
+backupStatus: {
+    path: '/iws.InWorkspaceStatusService/BackupStatus',
+    requestStream: false,
+    responseStream: false,
+    requestType: workspace_pb.BackupStatusRequest,
+    responseType: workspace_pb.BackupStatusResponse,
+    requestSerialize: serialize_iws_BackupStatusRequest,
+    requestDeserialize: deserialize_iws_BackupStatusRequest,
+    responseSerialize: serialize_iws_BackupStatusResponse,
+    responseDeserialize: deserialize_iws_BackupStatusResponse,
+  },
+  // UpdateGitStatus reports the current state of the Git repo in the workspace. ws-daemon passes it on
+// as lifecycle event of the workspace, s.t. the workspace status reflects it while the workspace is running.
+updateGitStatus: {
+    path: '/iws.InWorkspaceStatusService/UpdateGitStatus',
+    requestStream: false,
+    responseStream: false,
+    requestType: workspace_pb.UpdateGitStatusRequest,
+    responseType: workspace_pb.UpdateGitStatusResponse,
+    requestSerialize: serialize_iws_UpdateGitStatusRequest,
+    requestDeserialize: deserialize_iws_UpdateGitStatusRequest,
+    responseSerialize: serialize_iws_UpdateGitStatusResponse,
+    responseDeserialize: deserialize_iws_UpdateGitStatusResponse,
+  },
+};
+
+exports.InWorkspaceStatusServiceClient = grpc.makeGenericClientConstructor(InWorkspaceStatusServiceService);
@@ -8,6 +8,8 @@
 // file: workspace.proto
 
 import * as jspb from "google-protobuf";
+import * as content_service_api_initializer_pb from "@gitpod/content-service/lib";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
 
 export class PrepareForUserNSRequest extends jspb.Message {
   serializeBinary(): Uint8Array;
@@ -199,3 +201,83 @@ export namespace TeardownResponse {
   }
 }
 
+export class BackupStatusRequest extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BackupStatusRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: BackupStatusRequest): BackupStatusRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: BackupStatusRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BackupStatusRequest;
+  static deserializeBinaryFromReader(message: BackupStatusRequest, reader: jspb.BinaryReader): BackupStatusRequest;
+}
+
+export namespace BackupStatusRequest {
+  export type AsObject = {
+  }
+}
+
+export class BackupStatusResponse extends jspb.Message {
+  hasLastBackup(): boolean;
+  clearLastBackup(): void;
+  getLastBackup(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setLastBackup(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getLastBackupSize(): number;
+  setLastBackupSize(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BackupStatusResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: BackupStatusResponse): BackupStatusResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: BackupStatusResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BackupStatusResponse;
+  static deserializeBinaryFromReader(message: BackupStatusResponse, reader: jspb.BinaryReader): BackupStatusResponse;
+}
+
+export namespace BackupStatusResponse {
+  export type AsObject = {
+    lastBackup?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    lastBackupSize: number,
+  }
+}
+
+export class UpdateGitStatusRequest extends jspb.Message {
+  hasRepo(): boolean;
+  clearRepo(): void;
+  getRepo(): content_service_api_initializer_pb.GitStatus | undefined;
+  setRepo(value?: content_service_api_initializer_pb.GitStatus): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UpdateGitStatusRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: UpdateGitStatusRequest): UpdateGitStatusRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: UpdateGitStatusRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UpdateGitStatusRequest;
+  static deserializeBinaryFromReader(message: UpdateGitStatusRequest, reader: jspb.BinaryReader): UpdateGitStatusRequest;
+}
+
+export namespace UpdateGitStatusRequest {
+  export type AsObject = {
+    repo?: content_service_api_initializer_pb.GitStatus.AsObject,
+  }
+}
+
+export class UpdateGitStatusResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UpdateGitStatusResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: UpdateGitStatusResponse): UpdateGitStatusResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: UpdateGitStatusResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UpdateGitStatusResponse;
+  static deserializeBinaryFromReader(message: UpdateGitStatusResponse, reader: jspb.BinaryReader): UpdateGitStatusResponse;
+}
+
+export namespace UpdateGitStatusResponse {
+  export type AsObject = {
+  }
+}
+
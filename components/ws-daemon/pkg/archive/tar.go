@@ -22,6 +22,7 @@ type buildTarbalConfig struct {
 	MaxSizeBytes int64
 	UIDMaps      []idtools.IDMap
 	GIDMaps      []idtools.IDMap
+	Excludes     []string
 }
 
 // BuildTarbalOption configures the tarbal creation
@@ -69,6 +70,13 @@ func WithGIDMapping(mappings []IDMapping) BuildTarbalOption {
 	}
 }
 
+// WithExcludes excludes all files matching the patterns from the tarbal. Patterns are relative to the source directory.
+func WithExcludes(patterns ...string) BuildTarbalOption {
+	return func(o *buildTarbalConfig) {
+		o.Excludes = append(o.Excludes, patterns...)
+	}
+}
+
 // BuildTarbal creates an OCI compatible tar file dst from the folder src, expecting the overlay whiteout format
 func BuildTarbal(ctx context.Context, src string, dst string, opts ...BuildTarbalOption) (err error) {
 	var cfg buildTarbalConfig
@@ -87,11 +95,12 @@ func BuildTarbal(ctx context.Context, src string, dst string, opts ...BuildTarba
 	}
 
 	tarout, err := archive.TarWithOptions(src, &archive.TarOptions{
-		Compression:    archive.Uncompressed,
-		WhiteoutFormat: archive.OverlayWhiteoutFormat,
-		InUserNS:       true,
-		UIDMaps:        cfg.UIDMaps,
-		GIDMaps:        cfg.GIDMaps,
+		Compression:     archive.Uncompressed,
+		WhiteoutFormat:  archive.OverlayWhiteoutFormat,
+		InUserNS:        true,
+		UIDMaps:         cfg.UIDMaps,
+		GIDMaps:         cfg.GIDMaps,
+		ExcludePatterns: cfg.Excludes,
 	})
 	if err != nil {
 		return xerrors.Errorf("cannot create tar: %w", err)
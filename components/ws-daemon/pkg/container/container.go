@@ -74,3 +74,10 @@ type ID string
 type OptsContainerRootfs struct {
 	Unmapped bool
 }
+
+// ImagePruner is implemented by container runtimes which can remove images that are no longer in use
+type ImagePruner interface {
+	// PruneImages removes all images which are not used by any container and returns
+	// the names of the images that were removed.
+	PruneImages(ctx context.Context) (removed []string, err error)
+}
@@ -17,10 +17,12 @@ import (
 	"github.com/containerd/containerd/api/types"
 	"github.com/containerd/containerd/containers"
 	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
 	"github.com/containerd/typeurl"
 	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/common-go/tracing"
+	"github.com/opencontainers/go-digest"
 	ocispecs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/opentracing/opentracing-go"
 	"golang.org/x/xerrors"
@@ -473,6 +475,72 @@ func (s *Containerd) ContainerPID(ctx context.Context, id ID) (pid uint64, err e
 	return uint64(info.PID), nil
 }
 
+// PruneImages removes all images which are not used by any container
+func (s *Containerd) PruneImages(ctx context.Context) (removed []string, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PruneImages")
+	defer tracing.FinishSpan(span, &err)
+
+	cntrs, err := s.Client.ContainerService().List(ctx)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot list containers: %w", err)
+	}
+	imgs, err := s.Client.ImageService().List(ctx)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot list images: %w", err)
+	}
+
+	for _, name := range unusedImages(cntrs, imgs) {
+		err := s.Client.ImageService().Delete(ctx, name, images.SynchronousDelete())
+		if errdefs.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			log.WithError(err).WithField("image", name).Warn("cannot remove unused image")
+			continue
+		}
+		removed = append(removed, name)
+	}
+	span.LogKV("removed", len(removed))
+
+	return removed, nil
+}
+
+// unusedImages lists the names of all images which are not used by any container. The same image
+// can have several names, e.g. a tag and a digest reference. Containers refer to one of them only,
+// hence we consider an image in use if any of its names is.
+func unusedImages(cntrs []containers.Container, imgs []images.Image) (unused []string) {
+	var (
+		inUse       = make(map[string]struct{}, len(cntrs))
+		targetInUse = make(map[digest.Digest]struct{}, len(cntrs))
+	)
+	for _, c := range cntrs {
+		inUse[c.Image] = struct{}{}
+		// digest references name the image's target themselves
+		if i := strings.LastIndex(c.Image, "@"); i >= 0 {
+			if dgst, err := digest.Parse(c.Image[i+1:]); err == nil {
+				targetInUse[dgst] = struct{}{}
+			}
+		}
+	}
+	for _, img := range imgs {
+		if _, ok := inUse[img.Name]; ok {
+			targetInUse[img.Target.Digest] = struct{}{}
+		}
+	}
+
+	for _, img := range imgs {
+		if _, ok := inUse[img.Name]; ok {
+			continue
+		}
+		if _, ok := targetInUse[img.Target.Digest]; ok {
+			continue
+		}
+		unused = append(unused, img.Name)
+	}
+	return unused
+}
+
 // ExtractCGroupPathFromContainer retrieves the CGroupPath from the linux section
 // in a container's OCI spec.
 func ExtractCGroupPathFromContainer(container containers.Container) (cgroupPath string, err error) {
@@ -0,0 +1,66 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package container
+
+import (
+	"testing"
+
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/images"
+	"github.com/google/go-cmp/cmp"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+func TestUnusedImages(t *testing.T) {
+	const (
+		used   = digest.Digest("sha256:1111111111111111111111111111111111111111111111111111111111111111")
+		unused = digest.Digest("sha256:2222222222222222222222222222222222222222222222222222222222222222")
+	)
+	image := func(name string, target digest.Digest) images.Image {
+		return images.Image{Name: name, Target: ocispec.Descriptor{Digest: target}}
+	}
+	imgs := []images.Image{
+		image("docker.io/library/alpine:latest", used),
+		image("docker.io/library/alpine@"+used.String(), used),
+		image(used.String(), used),
+		image("docker.io/library/ubuntu:latest", unused),
+		image("docker.io/library/ubuntu@"+unused.String(), unused),
+	}
+
+	tests := []struct {
+		Name        string
+		Containers  []containers.Container
+		Expectation []string
+	}{
+		{
+			Name:        "no containers",
+			Expectation: []string{"docker.io/library/alpine:latest", "docker.io/library/alpine@" + used.String(), used.String(), "docker.io/library/ubuntu:latest", "docker.io/library/ubuntu@" + unused.String()},
+		},
+		{
+			Name:        "tag reference in use",
+			Containers:  []containers.Container{{Image: "docker.io/library/alpine:latest"}},
+			Expectation: []string{"docker.io/library/ubuntu:latest", "docker.io/library/ubuntu@" + unused.String()},
+		},
+		{
+			Name:        "digest reference in use",
+			Containers:  []containers.Container{{Image: "docker.io/library/alpine@" + used.String()}},
+			Expectation: []string{"docker.io/library/ubuntu:latest", "docker.io/library/ubuntu@" + unused.String()},
+		},
+		{
+			Name:        "unknown digest reference in use",
+			Containers:  []containers.Container{{Image: "eu.gcr.io/gitpod/alpine@" + used.String()}},
+			Expectation: []string{"docker.io/library/ubuntu:latest", "docker.io/library/ubuntu@" + unused.String()},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := unusedImages(test.Containers, imgs)
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected unused images (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
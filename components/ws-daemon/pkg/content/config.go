@@ -40,8 +40,13 @@ type Config struct {
 		// Detaults to 3
 		Attempts int `json:"backupAttempts"`
 
-		// Period is the time between regular workspace backups
+		// Period is the time between regular workspace backups. If zero, workspaces are
+		// backed up only when they're disposed of.
 		Period util.Duration `json:"period"`
+
+		// PeriodicConcurrency limits how many periodic backups we upload at the same time.
+		// Defaults to 1.
+		PeriodicConcurrency int `json:"periodicConcurrency,omitempty"`
 	} `json:"backup,omitempty"`
 
 	// FullWorkspaceBackup configures the FWB behaviour
@@ -0,0 +1,137 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package content
+
+import (
+	"sync"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/golang/protobuf/ptypes"
+)
+
+const (
+	// eventSubscriberBuffer is the number of events we buffer per subscriber before we start dropping them
+	eventSubscriberBuffer = 32
+)
+
+// eventBroker distributes workspace lifecycle events to their subscribers
+type eventBroker struct {
+	mu   sync.Mutex
+	subs map[string]map[chan *api.WorkspaceLifecycleEvent]struct{}
+	last map[string]*api.WorkspaceLifecycleEvent
+	// lastGitStatus is kept apart from the last content event, s.t. new subscribers learn about both
+	lastGitStatus map[string]*api.WorkspaceLifecycleEvent
+}
+
+func newEventBroker() *eventBroker {
+	return &eventBroker{
+		subs:          make(map[string]map[chan *api.WorkspaceLifecycleEvent]struct{}),
+		last:          make(map[string]*api.WorkspaceLifecycleEvent),
+		lastGitStatus: make(map[string]*api.WorkspaceLifecycleEvent),
+	}
+}
+
+// Subscribe listens for events of a workspace. The last event published for that workspace and its last
+// Git status, if any, are delivered first. The channel is closed when the workspace is disposed of or cancel is called.
+func (b *eventBroker) Subscribe(id string) (evts <-chan *api.WorkspaceLifecycleEvent, cancel func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := make(chan *api.WorkspaceLifecycleEvent, eventSubscriberBuffer)
+	if last, ok := b.last[id]; ok {
+		c <- last
+	}
+	if last, ok := b.lastGitStatus[id]; ok {
+		c <- last
+	}
+	if _, ok := b.subs[id]; !ok {
+		b.subs[id] = make(map[chan *api.WorkspaceLifecycleEvent]struct{})
+	}
+	b.subs[id][c] = struct{}{}
+
+	return c, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		if _, ok := b.subs[id][c]; !ok {
+			// already closed
+			return
+		}
+		delete(b.subs[id], c)
+		close(c)
+	}
+}
+
+// Publish sends an event to all subscribers of its workspace. Publish never blocks: if a subscriber
+// cannot keep up, it misses events.
+func (b *eventBroker) Publish(evt *api.WorkspaceLifecycleEvent) {
+	if evt.Time == nil {
+		evt.Time = ptypes.TimestampNow()
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := evt.Payload.(*api.WorkspaceLifecycleEvent_GitStatus); ok {
+		b.lastGitStatus[evt.Id] = evt
+	} else {
+		b.last[evt.Id] = evt
+	}
+	for c := range b.subs[evt.Id] {
+		select {
+		case c <- evt:
+		default:
+			log.WithFields(log.OWI("", "", evt.Id)).Debug("dropped workspace lifecycle event - subscriber is too slow")
+		}
+	}
+}
+
+// Close ends all subscriptions of a workspace and forgets its events
+func (b *eventBroker) Close(id string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for c := range b.subs[id] {
+		close(c)
+	}
+	delete(b.subs, id)
+	delete(b.last, id)
+	delete(b.lastGitStatus, id)
+}
+
+// PublishGitStatus publishes the state of a workspace's Git repo
+func (b *eventBroker) PublishGitStatus(id string, repo *csapi.GitStatus) {
+	b.Publish(&api.WorkspaceLifecycleEvent{
+		Id:      id,
+		Payload: &api.WorkspaceLifecycleEvent_GitStatus{GitStatus: repo},
+	})
+}
+
+func (s *WorkspaceService) publishInitProgress(id string, p *api.InitializerProgress) {
+	s.events.Publish(&api.WorkspaceLifecycleEvent{
+		Id:      id,
+		Payload: &api.WorkspaceLifecycleEvent_Initializer{Initializer: p},
+	})
+}
+
+func (s *WorkspaceService) publishBackupProgress(id string, p *api.BackupProgress) {
+	s.events.Publish(&api.WorkspaceLifecycleEvent{
+		Id:      id,
+		Payload: &api.WorkspaceLifecycleEvent_Backup{Backup: p},
+	})
+}
+
+func (s *WorkspaceService) publishError(id, operation string, err error, willRetry bool) {
+	s.events.Publish(&api.WorkspaceLifecycleEvent{
+		Id: id,
+		Payload: &api.WorkspaceLifecycleEvent_Error{Error: &api.ContentError{
+			Operation: operation,
+			Message:   err.Error(),
+			WillRetry: willRetry,
+		}},
+	})
+}
@@ -5,21 +5,25 @@
 package content
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/common-go/tracing"
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	wsinit "github.com/gitpod-io/gitpod/content-service/pkg/initializer"
 	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+	"github.com/gitpod-io/gitpod/ws-daemon/api"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/opencontainers/runtime-spec/specs-go"
@@ -39,6 +43,9 @@ type RunInitializerOpts struct {
 	GID uint32
 
 	OWI map[string]interface{}
+
+	// Progress is called whenever the initializer reports progress
+	Progress func(p *api.InitializerProgress)
 }
 
 func collectRemoteContent(ctx context.Context, rs storage.DirectAccess, ps storage.PresignedAccess, workspaceOwner string, initializer *csapi.WorkspaceInitializer) (rc map[string]storage.DownloadInfo, err error) {
@@ -211,10 +218,19 @@ func RunInitializer(ctx context.Context, destination string, initializer *csapi.
 
 	cmd = exec.Command("runc", "--root", "state", "--debug", "--log-format", "json", "run", "gogogo")
 	cmd.Dir = tmpdir
-	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	cmd.Stdin = os.Stdin
-	err = cmd.Run()
+	// the initializer reports its progress on stdout - everything else we just pass along
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	err = cmd.Start()
+	if err != nil {
+		return err
+	}
+	forwardInitializerOutput(stdout, os.Stdout, opts.Progress)
+	err = cmd.Wait()
 	if err != nil {
 		return err
 	}
@@ -222,6 +238,53 @@ func RunInitializer(ctx context.Context, destination string, initializer *csapi.
 	return nil
 }
 
+// maxInitializerOutputLine is the longest line of initializer output we can process
+const maxInitializerOutputLine = 1024 * 1024
+
+// forwardInitializerOutput reads the initializer's output line by line, reports all progress messages
+// and forwards everything else to out. It consumes in until EOF s.t. the initializer never blocks on its output.
+func forwardInitializerOutput(in io.Reader, out io.Writer, progress func(p *api.InitializerProgress)) {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxInitializerOutputLine)
+	defer func() {
+		if err := scanner.Err(); err != nil {
+			log.WithError(err).Warn("cannot read initializer output - discarding the rest")
+			_, _ = io.Copy(ioutil.Discard, in)
+		}
+	}()
+	for scanner.Scan() {
+		line := scanner.Bytes()
+
+		var msg msgInitOutput
+		if err := json.Unmarshal(line, &msg); err == nil && msg.InitProgress != nil {
+			if progress != nil {
+				progress(&api.InitializerProgress{
+					Phase:           msg.InitProgress.Phase,
+					BytesDownloaded: msg.InitProgress.BytesDownloaded,
+					BytesTotal:      msg.InitProgress.BytesTotal,
+				})
+			}
+			continue
+		}
+
+		out.Write(line)
+		out.Write([]byte{'\n'})
+	}
+}
+
+// reportInitProgress is used by the initializer child to tell ws-daemon about its progress
+func reportInitProgress(phase string, downloaded, total int64) {
+	msg, err := json.Marshal(msgInitOutput{InitProgress: &msgInitProgress{
+		Phase:           phase,
+		BytesDownloaded: downloaded,
+		BytesTotal:      total,
+	}})
+	if err != nil {
+		return
+	}
+	fmt.Fprintln(os.Stdout, string(msg))
+}
+
 // RunInitializerChild is the function that's exepcted to run when we call `/proc/self/exe content-initializer`
 func RunInitializerChild() (err error) {
 	fc, err := ioutil.ReadFile("/content.json")
@@ -257,6 +320,7 @@ func RunInitializerChild() (err error) {
 	if err != nil {
 		return err
 	}
+	initializer = &progressInitializer{Delegate: initializer, Phase: initializerPhase(&req)}
 
 	initSource, err := wsinit.InitializeWorkspace(ctx, "/dst", rs,
 		wsinit.WithInitializer(initializer),
@@ -303,9 +367,16 @@ func (rs *remoteContentStorage) Download(ctx context.Context, destination string
 	}
 	defer resp.Body.Close()
 
+	body := &downloadProgressReader{
+		R:     resp.Body,
+		Phase: fmt.Sprintf("downloading %s", name),
+		Total: resp.ContentLength,
+	}
+	defer body.report()
+
 	tarcmd := exec.Command("tar", "x")
 	tarcmd.Dir = destination
-	tarcmd.Stdin = resp.Body
+	tarcmd.Stdin = body
 
 	msg, err := tarcmd.CombinedOutput()
 	if err != nil {
@@ -345,6 +416,73 @@ func (rs *remoteContentStorage) SnapshotObject(name string) string {
 	return ""
 }
 
+// progressInitializer reports its phase before running the actual initializer
+type progressInitializer struct {
+	Delegate wsinit.Initializer
+	Phase    string
+}
+
+// Run reports the phase and runs the delegate
+func (p *progressInitializer) Run(ctx context.Context) (csapi.WorkspaceInitSource, error) {
+	reportInitProgress(p.Phase, 0, 0)
+	return p.Delegate.Run(ctx)
+}
+
+// initializerPhase names the phase an initializer represents
+func initializerPhase(req *csapi.WorkspaceInitializer) string {
+	switch {
+	case req.GetGit() != nil:
+		return "cloning"
+	case req.GetSnapshot() != nil:
+		return "restoring snapshot"
+	case req.GetPrebuild() != nil:
+		return "restoring prebuild"
+	default:
+		return "initializing"
+	}
+}
+
+// downloadProgressReader reports download progress at most once per downloadProgressInterval
+type downloadProgressReader struct {
+	R     io.Reader
+	Phase string
+	Total int64
+
+	read       int64
+	lastReport time.Time
+}
+
+const downloadProgressInterval = 1 * time.Second
+
+func (d *downloadProgressReader) Read(b []byte) (n int, err error) {
+	n, err = d.R.Read(b)
+	d.read += int64(n)
+	if time.Since(d.lastReport) > downloadProgressInterval {
+		d.report()
+	}
+	return
+}
+
+func (d *downloadProgressReader) report() {
+	total := d.Total
+	if total < 0 {
+		total = 0
+	}
+	reportInitProgress(d.Phase, d.read, total)
+	d.lastReport = time.Now()
+}
+
+// msgInitOutput is a line of output of the initializer which ws-daemon interprets
+type msgInitOutput struct {
+	InitProgress *msgInitProgress `json:"initProgress,omitempty"`
+}
+
+type msgInitProgress struct {
+	Phase           string `json:"phase"`
+	BytesDownloaded int64  `json:"bytesDownloaded"`
+	BytesTotal      int64  `json:"bytesTotal"`
+}
+
 type msgInitContent struct {
 	Destination   string
 	RemoteContent map[string]storage.DownloadInfo
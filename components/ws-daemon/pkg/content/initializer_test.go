@@ -0,0 +1,68 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package content
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/ws-daemon/api"
+)
+
+func TestForwardInitializerOutput(t *testing.T) {
+	tests := []struct {
+		Name           string
+		Output         string
+		ExpectedOut    string
+		ExpectedPhases []string
+	}{
+		{
+			Name:           "progress and output",
+			Output:         "cloning\n{\"initProgress\":{\"phase\":\"cloning\"}}\ndone\n",
+			ExpectedOut:    "cloning\ndone\n",
+			ExpectedPhases: []string{"cloning"},
+		},
+		{
+			Name:        "line exceeds the buffer",
+			Output:      "before\n" + strings.Repeat("x", maxInitializerOutputLine+1) + "\n{\"initProgress\":{\"phase\":\"cloning\"}}\n",
+			ExpectedOut: "before\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var (
+				in, w  = io.Pipe()
+				out    bytes.Buffer
+				phases []string
+				done   = make(chan struct{})
+			)
+			go func() {
+				// the pipe blocks until everything was read
+				_, _ = io.WriteString(w, test.Output)
+				w.Close()
+				close(done)
+			}()
+
+			forwardInitializerOutput(in, &out, func(p *api.InitializerProgress) {
+				phases = append(phases, p.Phase)
+			})
+			select {
+			case <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatal("initializer output was not consumed")
+			}
+
+			if out.String() != test.ExpectedOut {
+				t.Errorf("unexpected output: expected %q, got %q", test.ExpectedOut, out.String())
+			}
+			if strings.Join(phases, ",") != strings.Join(test.ExpectedPhases, ",") {
+				t.Errorf("unexpected phases: expected %v, got %v", test.ExpectedPhases, phases)
+			}
+		})
+	}
+}
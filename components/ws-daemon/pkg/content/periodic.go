@@ -0,0 +1,207 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package content
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	wsinit "github.com/gitpod-io/gitpod/content-service/pkg/initializer"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/internal/session"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// contentFingerprint is a cheap approximation of the state of a workspace's content.
+// If the fingerprint hasn't changed, we assume the content hasn't changed either.
+type contentFingerprint struct {
+	Files   int64
+	Size    int64
+	ModTime time.Time
+}
+
+// startPeriodicBackups regularly uploads the content of all ready workspaces until the context is canceled.
+// This function is intended to run as Go routine.
+func (s *WorkspaceService) startPeriodicBackups(ctx context.Context, period time.Duration) {
+	log.WithField("period", period.String()).Info("starting periodic workspace backups")
+
+	t := time.NewTicker(period)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			s.backupReadyWorkspaces(ctx)
+		case <-ctx.Done():
+			log.Debug("stopping periodic workspace backups")
+			return
+		}
+	}
+}
+
+// backupReadyWorkspaces uploads the content of all ready workspaces whose content has changed since their last periodic backup.
+// At most Backup.PeriodicConcurrency uploads run at the same time. This function returns once all backups are done.
+func (s *WorkspaceService) backupReadyWorkspaces(ctx context.Context) {
+	concurrency := s.config.Backup.PeriodicConcurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	var (
+		wg    sync.WaitGroup
+		slots = make(chan struct{}, concurrency)
+		known = make(map[string]struct{})
+	)
+	for _, sess := range s.store.List() {
+		known[sess.InstanceID] = struct{}{}
+
+		// Full workspace backup workspaces maintain live backups on the node already. Uploading those
+		// would not help because the final backup is what determines the content of the next start.
+		if sess.FullWorkspaceBackup || !sess.IsReady() {
+			continue
+		}
+
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		}
+
+		wg.Add(1)
+		go func(sess *session.Workspace) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			err := s.periodicBackup(ctx, sess)
+			if err != nil {
+				log.WithError(err).WithFields(sess.OWI()).Warn("periodic backup failed")
+			}
+		}(sess)
+	}
+	wg.Wait()
+
+	// forget about the fingerprints of workspaces which are gone
+	s.fingerprintMu.Lock()
+	defer s.fingerprintMu.Unlock()
+	for id := range s.backupFingerprints {
+		if _, ok := known[id]; !ok {
+			delete(s.backupFingerprints, id)
+		}
+	}
+}
+
+// periodicBackup uploads the content of a running workspace, unless its content hasn't changed since the last periodic backup
+func (s *WorkspaceService) periodicBackup(ctx context.Context, sess *session.Workspace) (err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "periodicBackup")
+	tracing.ApplyOWI(span, sess.OWI())
+	defer tracing.FinishSpan(span, &err)
+
+	unlock := sess.LockBackup()
+	defer unlock()
+
+	// the workspace might have started disposal while we were waiting for the lock
+	if !sess.IsReady() {
+		return nil
+	}
+
+	fp, err := fingerprintContent(sess.Location)
+	if err != nil {
+		return xerrors.Errorf("cannot fingerprint workspace content: %w", err)
+	}
+	s.fingerprintMu.Lock()
+	last, ok := s.backupFingerprints[sess.InstanceID]
+	s.fingerprintMu.Unlock()
+	if ok && last == fp {
+		log.WithFields(sess.OWI()).Debug("workspace content did not change - skipping periodic backup")
+		span.LogKV("skipped", "unchanged")
+		return nil
+	}
+
+	size, err := s.uploadWorkspaceContent(ctx, sess, storage.DefaultBackup, storage.DefaultBackupManifest, true)
+	if err != nil {
+		return err
+	}
+	recordBackup(sess, storage.DefaultBackup, size)
+	s.fingerprintMu.Lock()
+	s.backupFingerprints[sess.InstanceID] = fp
+	s.fingerprintMu.Unlock()
+	log.WithFields(sess.OWI()).WithField("files", fp.Files).WithField("size", fp.Size).Info("uploaded periodic backup")
+
+	if bkp := sess.GetLastBackup(); bkp != nil {
+		err = s.annotateLastBackup(sess.InstanceID, bkp.Time)
+		if err != nil {
+			log.WithError(err).WithFields(sess.OWI()).Warn("cannot annotate workspace pod with last backup")
+		}
+	}
+
+	return nil
+}
+
+// annotateLastBackup marks the workspace pod with the time of its last backup so that ws-manager can report it
+func (s *WorkspaceService) annotateLastBackup(instanceID string, t time.Time) error {
+	if s.clientset == nil {
+		return nil
+	}
+
+	client := s.clientset.CoreV1().Pods(s.kubernetesNamespace)
+	pods, err := client.List(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", wsk8s.WorkspaceIDLabel, instanceID),
+	})
+	if err != nil {
+		return xerrors.Errorf("cannot find workspace pod: %w", err)
+	}
+
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{"%s":"%s"}}}`, wsk8s.LastBackupAnnotation, t.UTC().Format(time.RFC3339Nano))
+	for _, pod := range pods.Items {
+		_, err = client.Patch(pod.Name, types.MergePatchType, []byte(patch))
+		if err != nil {
+			return xerrors.Errorf("cannot patch workspace pod %s: %w", pod.Name, err)
+		}
+	}
+	return nil
+}
+
+// fingerprintContent computes the content fingerprint of a workspace. The workspace ready file
+// is not part of the fingerprint as it's not part of a periodic backup either.
+func fingerprintContent(loc string) (res contentFingerprint, err error) {
+	var (
+		readyFile = filepath.Join(loc, wsinit.WorkspaceReadyFile)
+		readyDir  = filepath.Dir(readyFile)
+	)
+	err = filepath.Walk(loc, func(path string, info os.FileInfo, err error) error {
+		if os.IsNotExist(err) {
+			// files may come and go while we walk the workspace
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if path == readyFile {
+			return nil
+		}
+
+		res.Files++
+		if info.Mode().IsRegular() {
+			res.Size += info.Size()
+		}
+		// directory modification times change when entries are renamed or removed.
+		// Writing the ready file changes the modification time of its directory, too.
+		if mt := info.ModTime(); mt.After(res.ModTime) && path != readyDir {
+			res.ModTime = mt
+		}
+		return nil
+	})
+	return
+}
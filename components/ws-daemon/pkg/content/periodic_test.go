@@ -0,0 +1,124 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package content
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	wsinit "github.com/gitpod-io/gitpod/content-service/pkg/initializer"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakek8s "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestFingerprintContent(t *testing.T) {
+	tests := []struct {
+		Name    string
+		Change  func(loc string) error
+		Changed bool
+	}{
+		{
+			Name:   "no change",
+			Change: func(loc string) error { return nil },
+		},
+		{
+			Name:    "file added",
+			Change:  func(loc string) error { return ioutil.WriteFile(filepath.Join(loc, "new"), []byte("new"), 0644) },
+			Changed: true,
+		},
+		{
+			Name: "file grown",
+			Change: func(loc string) error {
+				return ioutil.WriteFile(filepath.Join(loc, "src", "main.go"), []byte("package main\n\n"), 0644)
+			},
+			Changed: true,
+		},
+		{
+			Name:    "file removed",
+			Change:  func(loc string) error { return os.Remove(filepath.Join(loc, "src", "main.go")) },
+			Changed: true,
+		},
+		{
+			Name: "ready file written",
+			Change: func(loc string) error {
+				return ioutil.WriteFile(filepath.Join(loc, wsinit.WorkspaceReadyFile), []byte("{}"), 0644)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			loc, err := ioutil.TempDir("", "fingerprint")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(loc)
+			for _, dir := range []string{"src", filepath.Dir(wsinit.WorkspaceReadyFile)} {
+				err = os.MkdirAll(filepath.Join(loc, dir), 0755)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			err = ioutil.WriteFile(filepath.Join(loc, "src", "main.go"), []byte("package main"), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// make sure changes result in a different modification time
+			past := time.Now().Add(-time.Hour)
+			for _, fn := range []string{filepath.Join(loc, "src", "main.go"), filepath.Join(loc, "src"), filepath.Join(loc, filepath.Dir(wsinit.WorkspaceReadyFile)), loc} {
+				err = os.Chtimes(fn, past, past)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			before, err := fingerprintContent(loc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = test.Change(loc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			after, err := fingerprintContent(loc)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if changed := before != after; changed != test.Changed {
+				t.Errorf("unexpected fingerprint change: expected %v, got %v (before: %+v, after: %+v)", test.Changed, changed, before, after)
+			}
+		})
+	}
+}
+
+func TestAnnotateLastBackup(t *testing.T) {
+	clientset := fakek8s.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ws-foobar",
+			Namespace: "default",
+			Labels:    map[string]string{wsk8s.WorkspaceIDLabel: "foobar"},
+		},
+	})
+	svc := &WorkspaceService{clientset: clientset, kubernetesNamespace: "default"}
+
+	lastBackup := time.Date(2020, 11, 5, 10, 30, 0, 0, time.UTC)
+	err := svc.annotateLastBackup("foobar", lastBackup)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pod, err := clientset.CoreV1().Pods("default").Get("ws-foobar", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if act, exp := pod.Annotations[wsk8s.LastBackupAnnotation], lastBackup.Format(time.RFC3339Nano); act != exp {
+		t.Errorf("unexpected last backup annotation: expected %q, got %q", exp, act)
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package content
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/internal/session"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/iws"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// maxPrebuildReportSize is the size up to which we read a prebuild report from the workspace
+	maxPrebuildReportSize = 1 << 20
+	// maxPrebuildLogSize is the size of a prebuild task log we upload. Of longer logs we upload the end only.
+	maxPrebuildLogSize = 32 << 20
+	// maxPrebuildTasks is the number of tasks we upload logs for
+	maxPrebuildTasks = 100
+)
+
+// uploadPrebuildReport uploads the prebuild report and task logs the workspace holds next to its snapshot.
+// The report is written by supervisor, hence by the workspace user - we don't trust it any further than
+// we trust the workspace content.
+func (s *WorkspaceService) uploadPrebuildReport(ctx context.Context, sess *session.Workspace, rs storage.DirectAccess, snapshot string) (err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "uploadPrebuildReport")
+	defer tracing.FinishSpan(span, &err)
+
+	loc := sess.Location
+	if sess.FullWorkspaceBackup {
+		lb, ok := sess.NonPersistentAttrs[session.AttrLiveBackup].(*iws.LiveWorkspaceBackup)
+		if lb == nil || !ok {
+			return xerrors.Errorf("workspace has no live backup configured")
+		}
+		loc, err = lb.Latest()
+		if err != nil {
+			return xerrors.Errorf("no live backup available: %w", err)
+		}
+	}
+
+	f, err := openInWorkspace(loc, csapi.PrebuildReportFile)
+	if os.IsNotExist(err) {
+		log.WithFields(sess.OWI()).Debug("prebuild has no report")
+		return nil
+	}
+	if err != nil {
+		return xerrors.Errorf("cannot open prebuild report: %w", err)
+	}
+	var report csapi.PrebuildReport
+	err = json.NewDecoder(io.LimitReader(f, maxPrebuildReportSize)).Decode(&report)
+	f.Close()
+	if err != nil {
+		return xerrors.Errorf("cannot read prebuild report: %w", err)
+	}
+	if len(report.Tasks) > maxPrebuildTasks {
+		report.Tasks = report.Tasks[:maxPrebuildTasks]
+	}
+
+	reportDir := filepath.Dir(csapi.PrebuildReportFile)
+	for i, t := range report.Tasks {
+		if t.LogFile == "" {
+			continue
+		}
+		// the log file lives next to the report - we don't follow it anywhere else
+		if t.LogFile != filepath.Base(t.LogFile) || t.LogFile == ".." {
+			log.WithFields(sess.OWI()).WithField("task", t.ID).Warn("ignoring prebuild task log outside of the report directory")
+			report.Tasks[i].LogFile = ""
+			continue
+		}
+		// the task ID becomes part of the log's object name
+		if t.ID == "" || strings.ContainsAny(t.ID, "/\\") || strings.Contains(t.ID, "..") {
+			log.WithFields(sess.OWI()).WithField("task", t.ID).Warn("ignoring prebuild task log with invalid task ID")
+			report.Tasks[i].LogFile = ""
+			continue
+		}
+
+		err = s.uploadPrebuildLog(ctx, rs, loc, filepath.Join(reportDir, t.LogFile), t.ID)
+		if err != nil {
+			log.WithError(err).WithFields(sess.OWI()).WithField("task", t.ID).Warn("cannot upload prebuild task log")
+			report.Tasks[i].LogFile = ""
+		}
+	}
+
+	report.Snapshot = snapshot
+	fc, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	tmpf, err := ioutil.TempFile(s.config.TmpDir, fmt.Sprintf("prebuild-report-%s-*.json", sess.InstanceID))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpf.Name())
+	_, err = tmpf.Write(fc)
+	tmpf.Close()
+	if err != nil {
+		return err
+	}
+	_, _, err = rs.Upload(ctx, tmpf.Name(), storage.PrebuildReport, storage.WithContentType(csapi.ContentTypePrebuildReport))
+	if err != nil {
+		return xerrors.Errorf("cannot upload prebuild report: %w", err)
+	}
+	return nil
+}
+
+func (s *WorkspaceService) uploadPrebuildLog(ctx context.Context, rs storage.DirectAccess, loc, logFile, taskID string) error {
+	f, err := openInWorkspace(loc, logFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	stat, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if stat.Size() > maxPrebuildLogSize {
+		_, err = f.Seek(stat.Size()-maxPrebuildLogSize, io.SeekStart)
+		if err != nil {
+			return err
+		}
+	}
+
+	// we copy the log first so that the workspace cannot change it while we upload it
+	tmpf, err := ioutil.TempFile(s.config.TmpDir, "prebuild-log-*.txt")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpf.Name())
+	_, err = io.Copy(tmpf, io.LimitReader(f, maxPrebuildLogSize))
+	tmpf.Close()
+	if err != nil {
+		return err
+	}
+
+	_, _, err = rs.Upload(ctx, tmpf.Name(), storage.PrebuildLog(taskID), storage.WithContentType("text/plain; charset=utf-8"))
+	return err
+}
+
+// openInWorkspace opens a regular file in the workspace content without following symlinks out of it
+func openInWorkspace(root, name string) (*os.File, error) {
+	root, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return nil, err
+	}
+	fn, err := filepath.EvalSymlinks(filepath.Join(root, name))
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(fn, root+string(filepath.Separator)) {
+		return nil, xerrors.Errorf("%s is not within the workspace", name)
+	}
+
+	f, err := os.OpenFile(fn, os.O_RDONLY|syscall.O_NOFOLLOW, 0)
+	if err != nil {
+		return nil, err
+	}
+	stat, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if !stat.Mode().IsRegular() {
+		f.Close()
+		return nil, xerrors.Errorf("%s is not a regular file", name)
+	}
+	return f, nil
+}
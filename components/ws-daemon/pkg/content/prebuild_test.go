@@ -0,0 +1,109 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package content
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestOpenInWorkspace(t *testing.T) {
+	outside, err := ioutil.TempDir("", "outside")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(outside)
+	err = ioutil.WriteFile(filepath.Join(outside, "secret"), []byte("secret"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Name   string
+		Setup  func(loc string) error
+		File   string
+		Expect bool
+	}{
+		{
+			Name: "regular file",
+			Setup: func(loc string) error {
+				return ioutil.WriteFile(filepath.Join(loc, ".gitpod", "prebuild-log-0"), []byte("log"), 0644)
+			},
+			File:   ".gitpod/prebuild-log-0",
+			Expect: true,
+		},
+		{
+			Name: "symlink within workspace",
+			Setup: func(loc string) error {
+				return os.Symlink(filepath.Join(loc, "log"), filepath.Join(loc, ".gitpod", "prebuild-log-0"))
+			},
+			File:   ".gitpod/prebuild-log-0",
+			Expect: true,
+		},
+		{
+			Name: "symlinked file outside workspace",
+			Setup: func(loc string) error {
+				return os.Symlink(filepath.Join(outside, "secret"), filepath.Join(loc, ".gitpod", "prebuild-log-0"))
+			},
+			File: ".gitpod/prebuild-log-0",
+		},
+		{
+			Name: "symlinked directory outside workspace",
+			Setup: func(loc string) error {
+				err := os.RemoveAll(filepath.Join(loc, ".gitpod"))
+				if err != nil {
+					return err
+				}
+				return os.Symlink(outside, filepath.Join(loc, ".gitpod"))
+			},
+			File: ".gitpod/secret",
+		},
+		{
+			Name:  "relative path outside workspace",
+			Setup: func(loc string) error { return nil },
+			File:  "../" + filepath.Base(outside) + "/secret",
+		},
+		{
+			Name:  "directory",
+			Setup: func(loc string) error { return nil },
+			File:  ".gitpod",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			loc, err := ioutil.TempDir("", "workspace")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(loc)
+			err = os.MkdirAll(filepath.Join(loc, ".gitpod"), 0755)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = ioutil.WriteFile(filepath.Join(loc, "log"), []byte("log"), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = test.Setup(loc)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := openInWorkspace(loc, test.File)
+			if f != nil {
+				f.Close()
+			}
+			if test.Expect && err != nil {
+				t.Errorf("expected to open %s: %v", test.File, err)
+			}
+			if !test.Expect && err == nil {
+				t.Errorf("expected not to open %s", test.File)
+			}
+		})
+	}
+}
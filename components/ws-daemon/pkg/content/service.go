@@ -12,6 +12,7 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"sync"
 	"syscall"
 	"time"
 
@@ -35,6 +36,7 @@ import (
 	"golang.org/x/xerrors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"k8s.io/client-go/kubernetes"
 )
 
 // WorkspaceService implements the InitService and WorkspaceService
@@ -46,13 +48,19 @@ type WorkspaceService struct {
 	stopService context.CancelFunc
 	sandboxes   quota.SandboxProvider
 	runtime     container.Runtime
+
+	kubernetesNamespace string
+	clientset           kubernetes.Interface
+	backupFingerprints  map[string]contentFingerprint
+	fingerprintMu       sync.Mutex
+	events              *eventBroker
 }
 
 // WorkspaceExistenceCheck is a check that can determine if a workspace container currently exists on this node.
 type WorkspaceExistenceCheck func(instanceID string) bool
 
 // NewWorkspaceService creates a new workspce initialization service, starts housekeeping and the Prometheus integration
-func NewWorkspaceService(ctx context.Context, cfg Config, kubernetesNamespace string, runtime container.Runtime, wec WorkspaceExistenceCheck, uidmapper *iws.Uidmapper, reg prometheus.Registerer) (res *WorkspaceService, err error) {
+func NewWorkspaceService(ctx context.Context, cfg Config, kubernetesNamespace string, clientset kubernetes.Interface, runtime container.Runtime, wec WorkspaceExistenceCheck, uidmapper *iws.Uidmapper, reg prometheus.Registerer) (res *WorkspaceService, err error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "NewWorkspaceService")
 	defer tracing.FinishSpan(span, &err)
 
@@ -63,7 +71,8 @@ func NewWorkspaceService(ctx context.Context, cfg Config, kubernetesNamespace st
 	}
 
 	// read all session json files
-	store, err := session.NewStore(ctx, cfg.WorkingArea, workspaceLifecycleHooks(cfg, kubernetesNamespace, wec, uidmapper))
+	events := newEventBroker()
+	store, err := session.NewStore(ctx, cfg.WorkingArea, workspaceLifecycleHooks(cfg, kubernetesNamespace, wec, uidmapper, events.PublishGitStatus))
 	if err != nil {
 		return nil, xerrors.Errorf("cannot create session store: %w", err)
 	}
@@ -79,6 +88,11 @@ func NewWorkspaceService(ctx context.Context, cfg Config, kubernetesNamespace st
 		ctx:         ctx,
 		stopService: stopService,
 		runtime:     runtime,
+
+		kubernetesNamespace: kubernetesNamespace,
+		clientset:           clientset,
+		backupFingerprints:  make(map[string]contentFingerprint),
+		events:              events,
 	}, nil
 }
 
@@ -106,9 +120,18 @@ func registerWorkingAreaDiskspaceGauge(workingArea string, reg prometheus.Regist
 // Start starts this workspace service and returns when the service gets stopped.
 // This function is intended to run as Go routine.
 func (s *WorkspaceService) Start() {
+	if s.config.Backup.Period > 0 {
+		go s.startPeriodicBackups(s.ctx, time.Duration(s.config.Backup.Period))
+	}
+
 	s.store.StartHousekeeping(s.ctx, 5*time.Minute)
 }
 
+// Workspaces returns all workspaces currently managed by this service
+func (s *WorkspaceService) Workspaces() []*session.Workspace {
+	return s.store.List()
+}
+
 // InitWorkspace intialises a new workspace folder in the working area
 func (s *WorkspaceService) InitWorkspace(ctx context.Context, req *api.InitWorkspaceRequest) (resp *api.InitWorkspaceResponse, err error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "InitWorkspace")
@@ -194,9 +217,11 @@ func (s *WorkspaceService) InitWorkspace(ctx context.Context, req *api.InitWorks
 			return nil, status.Error(codes.Internal, "no presigned storage available")
 		}
 
+		s.publishInitProgress(req.Id, &api.InitializerProgress{Phase: "collecting remote content"})
 		remoteContent, err := collectRemoteContent(ctx, rs, ps, workspace.Owner, req.Initializer)
 		if err != nil {
 			log.WithError(err).Error("cannot collect remote content")
+			s.publishError(req.Id, "initialize", err, false)
 			return nil, status.Error(codes.Internal, "remote content error")
 		}
 
@@ -211,6 +236,9 @@ func (s *WorkspaceService) InitWorkspace(ctx context.Context, req *api.InitWorks
 			Args:    s.config.Initializer.Args,
 			UID:     wsinit.GitpodUID,
 			GID:     wsinit.GitpodGID,
+			Progress: func(p *api.InitializerProgress) {
+				s.publishInitProgress(req.Id, p)
+			},
 		}
 		if req.UserNamespaced {
 			// This is a bit of a hack as it makes hard assumptions about the nature of the UID mapping.
@@ -224,6 +252,7 @@ func (s *WorkspaceService) InitWorkspace(ctx context.Context, req *api.InitWorks
 		err = RunInitializer(ctx, workspace.Location, req.Initializer, remoteContent, opts)
 		if err != nil {
 			log.WithError(err).WithField("workspaceId", req.Id).Error("cannot initialize workspace")
+			s.publishError(req.Id, "initialize", err, false)
 			return nil, status.Error(codes.Internal, fmt.Sprintf("cannot initialize workspace: %s", err.Error()))
 		}
 	}
@@ -234,6 +263,7 @@ func (s *WorkspaceService) InitWorkspace(ctx context.Context, req *api.InitWorks
 		log.WithError(err).WithField("workspaceId", req.Id).Error("cannot initialize workspace")
 		return nil, status.Error(codes.Internal, fmt.Sprintf("cannot finish workspace init: %v", err))
 	}
+	s.publishInitProgress(req.Id, &api.InitializerProgress{Phase: "done", Done: true})
 
 	return &api.InitWorkspaceResponse{}, nil
 }
@@ -259,6 +289,7 @@ func (s *WorkspaceService) creator(req *api.InitWorkspaceRequest, upperdir strin
 			UserNamespaced:   req.UserNamespaced,
 			ServiceLocDaemon: filepath.Join(s.config.WorkingArea, req.Id+"-daemon"),
 			ServiceLocNode:   filepath.Join(s.config.WorkingAreaNode, req.Id+"-daemon"),
+			ServiceLocStatus: filepath.Join(s.config.WorkingArea, req.Id+"-status"),
 		}, nil
 	}
 }
@@ -368,11 +399,15 @@ func (s *WorkspaceService) DisposeWorkspace(ctx context.Context, req *api.Dispos
 			backupName = fmt.Sprintf(storage.FmtFullWorkspaceBackup, time.Now().UnixNano())
 		}
 
-		err = s.uploadWorkspaceContent(ctx, sess, backupName, mfName)
+		// make sure we're not racing a periodic backup which might overwrite this final one
+		unlock := sess.LockBackup()
+		size, err := s.uploadWorkspaceContent(ctx, sess, backupName, mfName, false)
+		unlock()
 		if err != nil {
 			log.WithError(err).WithFields(sess.OWI()).Error("final backup failed")
 			return nil, status.Error(codes.DataLoss, "final backup failed")
 		}
+		recordBackup(sess, backupName, size)
 	}
 
 	// Update the git status prior to deleting the workspace
@@ -404,10 +439,15 @@ func (s *WorkspaceService) DisposeWorkspace(ctx context.Context, req *api.Dispos
 		return nil, status.Error(codes.Internal, "cannot delete workspace from store")
 	}
 
+	// the workspace is gone - there won't be any more events
+	s.events.Close(req.Id)
+
 	return resp, nil
 }
 
-func (s *WorkspaceService) uploadWorkspaceContent(ctx context.Context, sess *session.Workspace, backupName, mfName string) (err error) {
+// uploadWorkspaceContent archives the workspace content and uploads it to remote storage. If inFlight is true, the workspace
+// is still in use and we must not modify its content.
+func (s *WorkspaceService) uploadWorkspaceContent(ctx context.Context, sess *session.Workspace, backupName, mfName string, inFlight bool) (size int64, err error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "uploadWorkspaceContent")
 	defer tracing.FinishSpan(span, &err)
 
@@ -419,25 +459,27 @@ func (s *WorkspaceService) uploadWorkspaceContent(ctx context.Context, sess *ses
 	if sess.FullWorkspaceBackup {
 		lb, ok := sess.NonPersistentAttrs[session.AttrLiveBackup].(*iws.LiveWorkspaceBackup)
 		if lb == nil || !ok {
-			return xerrors.Errorf("workspace has no live backup configured")
+			return 0, xerrors.Errorf("workspace has no live backup configured")
 		}
 
 		loc, err = lb.Latest()
 		if err != nil {
-			return xerrors.Errorf("no live backup available: %w", err)
+			return 0, xerrors.Errorf("no live backup available: %w", err)
 		}
 
 		err = json.Unmarshal(sess.ContentManifest, &mf)
 		if err != nil {
-			return xerrors.Errorf("cannot unmarshal original content manifest: %w", err)
+			return 0, xerrors.Errorf("cannot unmarshal original content manifest: %w", err)
 		}
 	}
 
-	err = os.Remove(filepath.Join(loc, wsinit.WorkspaceReadyFile))
-	if err != nil && !os.IsNotExist(err) {
-		// We'll still upload the backup, well aware that the UX during restart will be broken.
-		// But it's better to have a backup with all files (albeit one too many), than having no backup at all.
-		log.WithError(err).WithFields(sess.OWI()).Warn("cannot remove workspace ready file")
+	if !inFlight {
+		err = os.Remove(filepath.Join(loc, wsinit.WorkspaceReadyFile))
+		if err != nil && !os.IsNotExist(err) {
+			// We'll still upload the backup, well aware that the UX during restart will be broken.
+			// But it's better to have a backup with all files (albeit one too many), than having no backup at all.
+			log.WithError(err).WithFields(sess.OWI()).Warn("cannot remove workspace ready file")
+		}
 	}
 
 	if s.config.Storage.BackupTrail.Enabled && !sess.FullWorkspaceBackup {
@@ -446,15 +488,16 @@ func (s *WorkspaceService) uploadWorkspaceContent(ctx context.Context, sess *ses
 
 	rs, ok := sess.NonPersistentAttrs[session.AttrRemoteStorage].(storage.DirectAccess)
 	if rs == nil || !ok {
-		return xerrors.Errorf("no remote storage configured")
+		return 0, xerrors.Errorf("no remote storage configured")
 	}
 
 	var (
 		tmpf       *os.File
 		tmpfSize   int64
 		tmpfDigest digest.Digest
+		final      = !inFlight
 	)
-	err = retryIfErr(ctx, s.config.Backup.Attempts, log.WithFields(sess.OWI()).WithField("op", "create archive"), func(ctx context.Context) (err error) {
+	err = retryIfErr(ctx, s.config.Backup.Attempts, log.WithFields(sess.OWI()).WithField("op", "create archive"), s.reportingBackupOp(sess, final, "archiving", func(ctx context.Context) (err error) {
 		tmpf, err = ioutil.TempFile(s.config.TmpDir, fmt.Sprintf("wsbkp-%s-*.tar", sess.InstanceID))
 		if err != nil {
 			return
@@ -477,6 +520,10 @@ func (s *WorkspaceService) uploadWorkspaceContent(ctx context.Context, sess *ses
 				archive.WithGIDMapping(mappings),
 			)
 		}
+		if inFlight {
+			// the workspace is still running and relies on its ready file - we just must not have it in the backup
+			opts = append(opts, archive.WithExcludes(wsinit.WorkspaceReadyFile))
+		}
 
 		err = archive.BuildTarbal(ctx, loc, tmpf.Name(), opts...)
 		if err != nil {
@@ -497,9 +544,9 @@ func (s *WorkspaceService) uploadWorkspaceContent(ctx context.Context, sess *ses
 		log.WithField("size", tmpfSize).WithFields(sess.OWI()).Debug("created temp file for workspace backup upload")
 
 		return
-	})
+	}))
 	if err != nil {
-		return xerrors.Errorf("cannot create archive: %w", err)
+		return 0, xerrors.Errorf("cannot create archive: %w", err)
 	}
 	defer func() {
 		if err == nil && tmpf != nil {
@@ -513,7 +560,9 @@ func (s *WorkspaceService) uploadWorkspaceContent(ctx context.Context, sess *ses
 		layerBucket string
 		layerObject string
 	)
-	err = retryIfErr(ctx, s.config.Backup.Attempts, log.WithFields(sess.OWI()).WithField("op", "upload layer"), func(ctx context.Context) (err error) {
+	var uploadAttempt int32
+	err = retryIfErr(ctx, s.config.Backup.Attempts, log.WithFields(sess.OWI()).WithField("op", "upload layer"), s.reportingBackupOp(sess, final, "uploading", func(ctx context.Context) (err error) {
+		uploadAttempt++
 		layerUploadOpts := opts
 		if sess.FullWorkspaceBackup {
 			// we deliberately ignore the other opload options here as FWB workspace trailing doesn't make sense
@@ -526,15 +575,17 @@ func (s *WorkspaceService) uploadWorkspaceContent(ctx context.Context, sess *ses
 			}
 		}
 
+		layerUploadOpts = append(layerUploadOpts, storage.WithProgress(s.uploadProgressReporter(sess, final, uploadAttempt)))
+
 		layerBucket, layerObject, err = rs.Upload(ctx, tmpf.Name(), backupName, layerUploadOpts...)
 		if err != nil {
 			return
 		}
 
 		return
-	})
+	}))
 	if err != nil {
-		return xerrors.Errorf("cannot upload workspace content: %w", err)
+		return 0, xerrors.Errorf("cannot upload workspace content: %w", err)
 	}
 
 	err = retryIfErr(ctx, s.config.Backup.Attempts, log.WithFields(sess.OWI()).WithField("op", "upload manifest"), func(ctx context.Context) (err error) {
@@ -587,10 +638,75 @@ func (s *WorkspaceService) uploadWorkspaceContent(ctx context.Context, sess *ses
 		return nil
 	})
 	if err != nil {
-		return xerrors.Errorf("cannot upload workspace content manifest: %w", err)
+		return 0, xerrors.Errorf("cannot upload workspace content manifest: %w", err)
 	}
 
-	return nil
+	s.publishBackupProgress(sess.InstanceID, &api.BackupProgress{
+		Phase:         "done",
+		BytesUploaded: tmpfSize,
+		BytesTotal:    tmpfSize,
+		Final:         final,
+		Done:          true,
+	})
+
+	return tmpfSize, nil
+}
+
+// recordBackup remembers a backup as the last one of the workspace. Snapshots are no backups and must not be recorded.
+func recordBackup(sess *session.Workspace, name string, size int64) {
+	err := sess.SetLastBackup(&session.BackupInfo{
+		Name: name,
+		Time: time.Now(),
+		Size: size,
+	})
+	if err != nil {
+		log.WithError(err).WithFields(sess.OWI()).Warn("cannot persist last backup")
+	}
+}
+
+// reportingBackupOp wraps a backup operation so that each attempt and each failure is published as lifecycle event
+func (s *WorkspaceService) reportingBackupOp(sess *session.Workspace, final bool, phase string, op func(ctx context.Context) error) func(ctx context.Context) error {
+	var attempt int32
+	return func(ctx context.Context) error {
+		attempt++
+		s.publishBackupProgress(sess.InstanceID, &api.BackupProgress{Phase: phase, Attempt: attempt, Final: final})
+
+		err := op(ctx)
+		if err != nil {
+			willRetry := int(attempt) < s.config.Backup.Attempts && ctx.Err() == nil
+			s.publishError(sess.InstanceID, "backup", xerrors.Errorf("%s: %w", phase, err), willRetry)
+		}
+		return err
+	}
+}
+
+// uploadProgressReporter publishes the upload progress whenever another percent of the upload is complete
+func (s *WorkspaceService) uploadProgressReporter(sess *session.Workspace, final bool, attempt int32) func(uploaded, total int64) {
+	var (
+		mu      sync.Mutex
+		lastPct int64 = -1
+	)
+	return func(uploaded, total int64) {
+		if total <= 0 {
+			return
+		}
+		pct := uploaded * 100 / total
+
+		mu.Lock()
+		defer mu.Unlock()
+		if pct <= lastPct {
+			return
+		}
+		lastPct = pct
+
+		s.publishBackupProgress(sess.InstanceID, &api.BackupProgress{
+			Phase:         "uploading",
+			BytesUploaded: uploaded,
+			BytesTotal:    total,
+			Attempt:       attempt,
+			Final:         final,
+		})
+	}
 }
 
 func retryIfErr(ctx context.Context, attempts int, log *logrus.Entry, op func(ctx context.Context) error) (err error) {
@@ -657,6 +773,34 @@ func (s *WorkspaceService) WaitForInit(ctx context.Context, req *api.WaitForInit
 	return &api.WaitForInitResponse{}, nil
 }
 
+// WatchWorkspaceEvents streams the lifecycle events of a workspace's content. Callers may subscribe before
+// the workspace is initialized, e.g. to observe initialization from the start.
+func (s *WorkspaceService) WatchWorkspaceEvents(req *api.WatchWorkspaceEventsRequest, srv api.WorkspaceContentService_WatchWorkspaceEventsServer) error {
+	if req.Id == "" {
+		return status.Error(codes.InvalidArgument, "ID is required")
+	}
+
+	evts, cancel := s.events.Subscribe(req.Id)
+	defer cancel()
+
+	for {
+		select {
+		case evt, ok := <-evts:
+			if !ok {
+				return nil
+			}
+			err := srv.Send(evt)
+			if err != nil {
+				return err
+			}
+		case <-srv.Context().Done():
+			return nil
+		case <-s.ctx.Done():
+			return status.Error(codes.Unavailable, "service is shutting down")
+		}
+	}
+}
+
 // TakeSnapshot creates a backup/snapshot of a workspace
 func (s *WorkspaceService) TakeSnapshot(ctx context.Context, req *api.TakeSnapshotRequest) (res *api.TakeSnapshotResponse, err error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "TakeSnapshot")
@@ -702,12 +846,21 @@ func (s *WorkspaceService) TakeSnapshot(ctx context.Context, req *api.TakeSnapsh
 		snapshotName = rs.Qualify(backupName)
 	}
 
-	err = s.uploadWorkspaceContent(ctx, sess, backupName, mfName)
+	_, err = s.uploadWorkspaceContent(ctx, sess, backupName, mfName, false)
 	if err != nil {
 		log.WithError(err).WithField("workspaceId", req.Id).Error("snapshot upload failed")
 		return nil, status.Error(codes.Internal, "cannot upload snapshot")
 	}
 
+	if req.Prebuild {
+		// the report is meant for debugging prebuilds - failing to upload it must not cost us the prebuild
+		err = s.uploadPrebuildReport(ctx, sess, rs, snapshotName)
+		if err != nil {
+			log.WithError(err).WithFields(sess.OWI()).Warn("cannot upload prebuild report")
+			err = nil
+		}
+	}
+
 	return &api.TakeSnapshotResponse{
 		Url: snapshotName,
 	}, nil
@@ -752,7 +905,7 @@ func (c *cannotCancelContext) Value(key interface{}) interface{} {
 	return c.Delegate.Value(key)
 }
 
-func workspaceLifecycleHooks(cfg Config, kubernetesNamespace string, workspaceExistenceCheck WorkspaceExistenceCheck, uidmapper *iws.Uidmapper) map[session.WorkspaceState][]session.WorkspaceLivecycleHook {
+func workspaceLifecycleHooks(cfg Config, kubernetesNamespace string, workspaceExistenceCheck WorkspaceExistenceCheck, uidmapper *iws.Uidmapper, gitStatus iws.GitStatusReporter) map[session.WorkspaceState][]session.WorkspaceLivecycleHook {
 	var setupWorkspace session.WorkspaceLivecycleHook = func(ctx context.Context, ws *session.Workspace) error {
 		if _, ok := ws.NonPersistentAttrs[session.AttrRemoteStorage]; !ok {
 			remoteStorage, err := storage.NewDirectAccess(&cfg.Storage)
@@ -808,7 +961,7 @@ func workspaceLifecycleHooks(cfg Config, kubernetesNamespace string, workspaceEx
 	}
 
 	return map[session.WorkspaceState][]session.WorkspaceLivecycleHook{
-		session.WorkspaceInitializing: {setupWorkspace, iws.ServeWorkspace(uidmapper)},
+		session.WorkspaceInitializing: {setupWorkspace, iws.ServeWorkspace(uidmapper, gitStatus)},
 		session.WorkspaceReady:        {setupWorkspace, startLiveBackup},
 		session.WorkspaceDisposing:    {iws.StopServingWorkspace},
 	}
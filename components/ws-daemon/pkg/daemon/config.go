@@ -27,6 +27,12 @@ type Config struct {
 		Path    string `json:"path"`
 	} `json:"readiness"`
 
+	Inventory struct {
+		// Addr is the address the read-only HTTP workspace inventory is served on.
+		// If empty, the inventory is only available via gRPC.
+		Addr string `json:"addr"`
+	} `json:"inventory"`
+
 	Content        content.Config      `json:"content"`
 	Uidmapper      iws.UidmapperConfig `json:"uidmapper"`
 	Resources      resources.Config    `json:"resources"`
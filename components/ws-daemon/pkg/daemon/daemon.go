@@ -6,8 +6,12 @@ package daemon
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/ws-daemon/api"
@@ -18,9 +22,11 @@ import (
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/hosts"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/iws"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/resources"
+	wsmanapi "github.com/gitpod-io/gitpod/ws-manager/api"
 	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/xerrors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -49,8 +55,9 @@ func NewDaemon(config Config, reg prometheus.Registerer) (*Daemon, error) {
 	if nodename == "" {
 		return nil, xerrors.Errorf("NODENAME env var isn't set")
 	}
+	governer := resources.NewDispatchListener(&config.Resources, reg)
 	dsptch, err := dispatch.NewDispatch(containerRuntime, clientset, config.Runtime.KubernetesNamespace, nodename,
-		resources.NewDispatchListener(&config.Resources, reg),
+		governer,
 		&Containerd4214Workaround{},
 	)
 	if err != nil {
@@ -61,6 +68,7 @@ func NewDaemon(config Config, reg prometheus.Registerer) (*Daemon, error) {
 		context.Background(),
 		config.Content,
 		config.Runtime.KubernetesNamespace,
+		clientset,
 		containerRuntime,
 		dsptch.WorkspaceExistsOnNode,
 		&iws.Uidmapper{Config: config.Uidmapper, Runtime: containerRuntime},
@@ -70,7 +78,20 @@ func NewDaemon(config Config, reg prometheus.Registerer) (*Daemon, error) {
 		return nil, xerrors.Errorf("cannot create content service: %w", err)
 	}
 
-	dsk := diskguard.FromConfig(config.DiskSpaceGuard, clientset, nodename)
+	inventory := &InventoryService{
+		Nodename:  nodename,
+		Content:   contentService,
+		Dispatch:  dsptch,
+		Resources: governer,
+	}
+	dskResponses, err := newDiskguardResponses(config.DiskSpaceGuard, containerRuntime, inventory)
+	if err != nil {
+		return nil, err
+	}
+	dsk, err := diskguard.FromConfig(config.DiskSpaceGuard, clientset, nodename, config.Runtime.KubernetesNamespace, dskResponses, reg)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot create disk guard: %w", err)
+	}
 
 	hsts, err := hosts.FromConfig(config.Hosts, clientset, config.Runtime.KubernetesNamespace)
 	if err != nil {
@@ -84,9 +105,75 @@ func NewDaemon(config Config, reg prometheus.Registerer) (*Daemon, error) {
 		content:    contentService,
 		diskGuards: dsk,
 		hosts:      hsts,
+		inventory:  inventory,
 	}, nil
 }
 
+func newDiskguardResponses(cfg diskguard.Config, rt container.Runtime, inventory *InventoryService) (res diskguard.Responses, err error) {
+	if !cfg.Enabled {
+		return
+	}
+
+	if cfg.GC.PruneImages {
+		pruner, ok := rt.(container.ImagePruner)
+		if !ok {
+			return res, xerrors.Errorf("container runtime cannot prune images")
+		}
+		res.Collectors = append(res.Collectors, &diskguard.ImageCollector{Pruner: pruner})
+	}
+	for _, c := range cfg.GC.Caches {
+		res.Collectors = append(res.Collectors, &diskguard.CacheCollector{Path: c.Path, MinAge: time.Duration(c.MinAge)})
+	}
+
+	if cfg.Eviction.WSManagerAddr != "" {
+		// we don't block here: ws-manager might not be available yet, and we only need it under disk pressure
+		creds, err := wsmanagerCredentials(cfg)
+		if err != nil {
+			return res, err
+		}
+		conn, err := grpc.Dial(cfg.Eviction.WSManagerAddr, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return res, xerrors.Errorf("cannot connect to ws-manager: %w", err)
+		}
+		res.Evicter = &diskguard.WorkspaceEvicter{
+			Candidates: func(ctx context.Context) ([]diskguard.EvictionCandidate, error) {
+				return inventory.evictionCandidates(ctx, cfg.Eviction.MaxIdleCPULoad)
+			},
+			Manager:     wsmanapi.NewWorkspaceManagerClient(conn),
+			MaxPerRound: cfg.Eviction.MaxPerInterval,
+		}
+	}
+
+	return res, nil
+}
+
+// wsmanagerCredentials loads the TLS config we use to connect to ws-manager for evicting workspaces
+func wsmanagerCredentials(cfg diskguard.Config) (credentials.TransportCredentials, error) {
+	tlscfg := cfg.Eviction.TLS
+	if tlscfg.Authority == "" || tlscfg.Certificate == "" || tlscfg.PrivateKey == "" {
+		return nil, xerrors.Errorf("eviction requires a TLS config to connect to ws-manager")
+	}
+
+	rootCA, err := ioutil.ReadFile(tlscfg.Authority)
+	if err != nil {
+		return nil, xerrors.Errorf("could not read ca certificate: %w", err)
+	}
+	certPool := x509.NewCertPool()
+	if ok := certPool.AppendCertsFromPEM(rootCA); !ok {
+		return nil, xerrors.Errorf("failed to append ca certs")
+	}
+
+	certificate, err := tls.LoadX509KeyPair(tlscfg.Certificate, tlscfg.PrivateKey)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot load ws-manager client certs: %w", err)
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      certPool,
+	}), nil
+}
+
 func newClientSet(kubeconfig string) (res *kubernetes.Clientset, err error) {
 	defer func() {
 		if err != nil {
@@ -117,6 +204,7 @@ type Daemon struct {
 	content    *content.WorkspaceService
 	diskGuards []*diskguard.Guard
 	hosts      hosts.Controller
+	inventory  *InventoryService
 }
 
 // Start runs all parts of the daemon until stop is called
@@ -137,6 +225,9 @@ func (d *Daemon) Start() error {
 	if d.Config.ReadinessSignal.Enabled {
 		go d.startReadinessSignal()
 	}
+	if d.Config.Inventory.Addr != "" {
+		go d.startInventoryHTTP()
+	}
 
 	return nil
 }
@@ -144,6 +235,7 @@ func (d *Daemon) Start() error {
 // Register registers all gRPC services provided by this daemon
 func (d *Daemon) Register(srv *grpc.Server) {
 	api.RegisterWorkspaceContentServiceServer(srv, d.content)
+	api.RegisterInventoryServiceServer(srv, d.inventory)
 }
 
 func (d *Daemon) startReadinessSignal() {
@@ -168,6 +260,16 @@ func (d *Daemon) startReadinessSignal() {
 	}
 }
 
+func (d *Daemon) startInventoryHTTP() {
+	mux := http.NewServeMux()
+	mux.Handle("/workspaces", d.inventory)
+	log.WithField("addr", d.Config.Inventory.Addr).Info("started workspace inventory endpoint")
+	err := http.ListenAndServe(d.Config.Inventory.Addr, mux)
+	if err != nil {
+		log.WithError(err).Error("cannot start workspace inventory endpoint")
+	}
+}
+
 // Stop gracefully shuts down the daemon. Once stopped, it
 // cannot be started again.
 func (d *Daemon) Stop() error {
@@ -0,0 +1,245 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package daemon
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/diskguard"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/internal/session"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/iws"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/resources"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// diskUsageTTL is how long we reuse the disk usage of a workspace. Walking a workspace is expensive
+// and the inventory is requested by gpctl and the disk guard alike.
+const diskUsageTTL = 1 * time.Minute
+
+// WorkspaceLister lists the workspaces managed by this daemon, e.g. the content.WorkspaceService
+type WorkspaceLister interface {
+	Workspaces() []*session.Workspace
+}
+
+// InventoryService provides read-only insight into the workspaces managed by this daemon
+type InventoryService struct {
+	Nodename  string
+	Content   WorkspaceLister
+	Dispatch  *dispatch.Dispatch
+	Resources *resources.DispatchListener
+
+	diskUsage   map[string]diskUsageSample
+	diskUsageMu sync.Mutex
+}
+
+type diskUsageSample struct {
+	Size    int64
+	Sampled time.Time
+}
+
+// ListWorkspaces lists all workspaces this daemon currently knows about on its node
+func (is *InventoryService) ListWorkspaces(ctx context.Context, req *api.ListWorkspacesRequest) (*api.ListWorkspacesResponse, error) {
+	wss := is.Content.Workspaces()
+	is.forgetDiskUsage(wss)
+	res := &api.ListWorkspacesResponse{
+		Node:       is.Nodename,
+		Workspaces: make([]*api.WorkspaceInventoryEntry, 0, len(wss)),
+	}
+	for _, ws := range wss {
+		res.Workspaces = append(res.Workspaces, is.describe(ctx, ws))
+	}
+	return res, nil
+}
+
+func (is *InventoryService) describe(ctx context.Context, ws *session.Workspace) *api.WorkspaceInventoryEntry {
+	res := &api.WorkspaceInventoryEntry{
+		Id: ws.InstanceID,
+		Metadata: &api.WorkspaceMetadata{
+			Owner:  ws.Owner,
+			MetaId: ws.WorkspaceID,
+		},
+		State:               string(ws.State()),
+		FullWorkspaceBackup: ws.FullWorkspaceBackup,
+		CreatedAt:           toTimestamp(&ws.CreatedAt),
+		Backup:              &api.BackupInventory{},
+		Resources:           &api.ResourceInventory{},
+	}
+
+	if lb, ok := ws.NonPersistentAttrs[session.AttrLiveBackup].(*iws.LiveWorkspaceBackup); ok {
+		status := lb.Status()
+		res.Backup.LiveBackupRunning = status.Running
+		res.Backup.LastLiveBackup = toTimestamp(status.LastBackup)
+	}
+	if bkp := ws.GetLastBackup(); bkp != nil {
+		res.Backup.LastBackup = toTimestamp(&bkp.Time)
+		res.Backup.LastBackupSize = bkp.Size
+	}
+
+	loc := ws.Location
+	if ws.FullWorkspaceBackup {
+		loc = ws.UpperdirLocation
+	}
+	if loc != "" {
+		usage, err := is.cachedDiskUsage(ws.InstanceID, loc)
+		if err != nil {
+			log.WithError(err).WithFields(ws.OWI()).Warn("cannot compute workspace disk usage")
+		}
+		res.Resources.DiskUsage = usage
+	}
+
+	if is.Dispatch == nil {
+		return res
+	}
+	dws := is.Dispatch.Workspace(ws.InstanceID)
+	if dws == nil {
+		return res
+	}
+	res.Container = &api.ContainerInventory{
+		Id: string(dws.ContainerID),
+	}
+	cgroupPath, err := is.Dispatch.Runtime.ContainerCGroupPath(ctx, dws.ContainerID)
+	if err != nil {
+		log.WithError(err).WithFields(ws.OWI()).Warn("cannot get workspace container cgroup path")
+	}
+	res.Container.CgroupPath = cgroupPath
+
+	if is.Resources == nil {
+		return res
+	}
+	if gov := is.Resources.Governer(dws.ContainerID); gov != nil {
+		cpu := gov.CPUStatus()
+		res.Resources.CpuGoverned = true
+		res.Resources.CpuLimit = cpu.Limit
+		res.Resources.CpuLoad = cpu.Load
+		res.Resources.CpuBudgetSpent = cpu.BudgetSpent
+	}
+
+	return res
+}
+
+// evictionCandidates lists all ready workspaces with a container. A workspace is considered idle
+// if its CPU load is governed and at most maxIdleLoad.
+func (is *InventoryService) evictionCandidates(ctx context.Context, maxIdleLoad int64) ([]diskguard.EvictionCandidate, error) {
+	wss := is.Content.Workspaces()
+	is.forgetDiskUsage(wss)
+	res := make([]diskguard.EvictionCandidate, 0, len(wss))
+	for _, ws := range wss {
+		if ws.State() != session.WorkspaceReady {
+			continue
+		}
+
+		entry := is.describe(ctx, ws)
+		if entry.Container == nil {
+			continue
+		}
+		res = append(res, diskguard.EvictionCandidate{
+			InstanceID: entry.Id,
+			DiskUsage:  entry.Resources.DiskUsage,
+			Idle:       entry.Resources.CpuGoverned && entry.Resources.CpuLoad <= maxIdleLoad,
+		})
+	}
+	return res, nil
+}
+
+// ServeHTTP serves the workspace inventory as JSON
+func (is *InventoryService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	resp, err := is.ListWorkspaces(r.Context(), &api.ListWorkspacesRequest{})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = (&jsonpb.Marshaler{Indent: "  "}).Marshal(w, resp)
+	if err != nil {
+		log.WithError(err).Warn("cannot serve workspace inventory")
+	}
+}
+
+func toTimestamp(t *time.Time) *timestamp.Timestamp {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+
+	res, err := ptypes.TimestampProto(*t)
+	if err != nil {
+		return nil
+	}
+	return res
+}
+
+// cachedDiskUsage returns the disk usage of a workspace, walking its content at most once per diskUsageTTL
+func (is *InventoryService) cachedDiskUsage(instanceID, loc string) (int64, error) {
+	is.diskUsageMu.Lock()
+	c, ok := is.diskUsage[instanceID]
+	is.diskUsageMu.Unlock()
+	if ok && time.Since(c.Sampled) < diskUsageTTL {
+		return c.Size, nil
+	}
+
+	// we must not hold the lock while walking the workspace - that can take a while and would
+	// block the inventory of all other workspaces.
+	size, err := diskUsage(loc)
+	if err != nil {
+		return size, err
+	}
+
+	is.diskUsageMu.Lock()
+	defer is.diskUsageMu.Unlock()
+	if is.diskUsage == nil {
+		is.diskUsage = make(map[string]diskUsageSample)
+	}
+	is.diskUsage[instanceID] = diskUsageSample{Size: size, Sampled: time.Now()}
+	return size, nil
+}
+
+// forgetDiskUsage drops the cached disk usage of workspaces which are gone
+func (is *InventoryService) forgetDiskUsage(wss []*session.Workspace) {
+	is.diskUsageMu.Lock()
+	defer is.diskUsageMu.Unlock()
+
+	known := make(map[string]struct{}, len(wss))
+	for _, ws := range wss {
+		known[ws.InstanceID] = struct{}{}
+	}
+	for id := range is.diskUsage {
+		if _, ok := known[id]; !ok {
+			delete(is.diskUsage, id)
+		}
+	}
+}
+
+// diskUsage returns the apparent size of all regular files below loc
+func diskUsage(loc string) (size int64, err error) {
+	err = filepath.Walk(loc, func(path string, info os.FileInfo, err error) error {
+		if os.IsNotExist(err) {
+			// files may come and go while we walk the workspace
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package daemon
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/internal/session"
+)
+
+type fixedWorkspaces []*session.Workspace
+
+func (f fixedWorkspaces) Workspaces() []*session.Workspace { return f }
+
+func TestDiskUsage(t *testing.T) {
+	loc := writeWorkspace(t, map[string]string{
+		"README.md":   "hello",
+		"src/main.go": "package main",
+	})
+	defer os.RemoveAll(loc)
+	err := os.Symlink(filepath.Join(loc, "README.md"), filepath.Join(loc, "link"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	size, err := diskUsage(loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := int64(len("hello") + len("package main")); size != exp {
+		t.Errorf("unexpected disk usage: expected %d, got %d", exp, size)
+	}
+}
+
+func TestListWorkspacesCachesDiskUsage(t *testing.T) {
+	loc := writeWorkspace(t, map[string]string{"README.md": "hello"})
+	defer os.RemoveAll(loc)
+
+	ws := &session.Workspace{InstanceID: "foobar", WorkspaceID: "foo", Owner: "owner", Location: loc}
+	is := &InventoryService{Nodename: "node", Content: fixedWorkspaces{ws}}
+	listDiskUsage := func() int64 {
+		resp, err := is.ListWorkspaces(context.Background(), &api.ListWorkspacesRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Workspaces) != 1 {
+			t.Fatalf("expected one workspace, got %d", len(resp.Workspaces))
+		}
+		if entry := resp.Workspaces[0]; entry.Id != "foobar" || entry.Metadata.Owner != "owner" || entry.Metadata.MetaId != "foo" {
+			t.Errorf("unexpected workspace: %v", entry)
+		}
+		return resp.Workspaces[0].Resources.DiskUsage
+	}
+
+	if act := listDiskUsage(); act != 5 {
+		t.Fatalf("unexpected disk usage: expected 5, got %d", act)
+	}
+	err := ioutil.WriteFile(filepath.Join(loc, "main.go"), []byte("package main"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if act := listDiskUsage(); act != 5 {
+		t.Errorf("expected the cached disk usage 5, got %d", act)
+	}
+
+	is.diskUsage["foobar"] = diskUsageSample{Size: 5, Sampled: time.Now().Add(-diskUsageTTL)}
+	if act := listDiskUsage(); act != 17 {
+		t.Errorf("expected the disk usage to be updated once the cache expired: expected 17, got %d", act)
+	}
+
+	is.Content = fixedWorkspaces{}
+	_, err = is.ListWorkspaces(context.Background(), &api.ListWorkspacesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(is.diskUsage) != 0 {
+		t.Errorf("expected the disk usage of gone workspaces to be forgotten, got %v", is.diskUsage)
+	}
+}
+
+func TestInventoryServeHTTP(t *testing.T) {
+	is := &InventoryService{
+		Nodename: "node",
+		Content:  fixedWorkspaces{{InstanceID: "foobar", Owner: "owner"}},
+	}
+
+	rec := httptest.NewRecorder()
+	is.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/workspaces", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d", rec.Code)
+	}
+	if body := rec.Body.String(); !strings.Contains(body, `"id": "foobar"`) || !strings.Contains(body, `"node": "node"`) {
+		t.Errorf("unexpected inventory: %s", body)
+	}
+
+	rec = httptest.NewRecorder()
+	is.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/workspaces", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected POST to be rejected, got %d", rec.Code)
+	}
+}
+
+func writeWorkspace(t *testing.T, files map[string]string) string {
+	loc, err := ioutil.TempDir("", "inventory")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for fn, content := range files {
+		fn = filepath.Join(loc, fn)
+		err = os.MkdirAll(filepath.Dir(fn), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(fn, []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return loc
+}
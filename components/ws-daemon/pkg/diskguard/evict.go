@@ -0,0 +1,96 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package diskguard
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	wsmanapi "github.com/gitpod-io/gitpod/ws-manager/api"
+	"golang.org/x/xerrors"
+)
+
+// stopGracePeriod is the time we wait for a workspace we asked to stop before we try again
+const stopGracePeriod = 15 * time.Minute
+
+// EvictionCandidate is a workspace which might be stopped to free disk space
+type EvictionCandidate struct {
+	InstanceID string
+	DiskUsage  int64
+	Idle       bool
+}
+
+// WorkspaceEvicter stops the largest idle workspaces on a node
+type WorkspaceEvicter struct {
+	// Candidates lists the workspaces currently running on this node
+	Candidates func(ctx context.Context) ([]EvictionCandidate, error)
+	Manager    wsmanapi.WorkspaceManagerClient
+
+	// MaxPerRound is the maximum number of workspaces stopped per call to Evict
+	MaxPerRound int
+
+	mu      sync.Mutex
+	stopped map[string]time.Time
+}
+
+// Evict stops up to MaxPerRound idle workspaces, largest first. It returns the instance IDs of the
+// workspaces it asked ws-manager to stop.
+func (e *WorkspaceEvicter) Evict(ctx context.Context) (stopped []string, err error) {
+	cs, err := e.Candidates(ctx)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot list eviction candidates: %w", err)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.stopped == nil {
+		e.stopped = make(map[string]time.Time)
+	}
+	for id, t := range e.stopped {
+		if time.Since(t) > stopGracePeriod {
+			delete(e.stopped, id)
+		}
+	}
+
+	idle := make([]EvictionCandidate, 0, len(cs))
+	for _, c := range cs {
+		if !c.Idle {
+			continue
+		}
+		if _, ok := e.stopped[c.InstanceID]; ok {
+			// we've asked ws-manager to stop this workspace already - give it time to do so
+			continue
+		}
+		idle = append(idle, c)
+	}
+	sort.Slice(idle, func(i, j int) bool { return idle[i].DiskUsage > idle[j].DiskUsage })
+
+	max := e.MaxPerRound
+	if max <= 0 {
+		max = 1
+	}
+	for _, c := range idle {
+		if len(stopped) >= max {
+			break
+		}
+
+		_, err = e.Manager.StopWorkspace(ctx, &wsmanapi.StopWorkspaceRequest{
+			Id:     c.InstanceID,
+			Policy: wsmanapi.StopWorkspacePolicy_NORMALLY,
+		})
+		if err != nil {
+			return stopped, xerrors.Errorf("cannot stop workspace %s: %w", c.InstanceID, err)
+		}
+		log.WithField("instanceId", c.InstanceID).WithField("diskUsage", c.DiskUsage).Info("stopped idle workspace due to disk pressure")
+
+		e.stopped[c.InstanceID] = time.Now()
+		stopped = append(stopped, c.InstanceID)
+	}
+
+	return stopped, nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package diskguard
+
+import (
+	"context"
+	"testing"
+
+	wsmanapi "github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+)
+
+type fakeManager struct {
+	wsmanapi.WorkspaceManagerClient
+	Stopped []string
+}
+
+func (m *fakeManager) StopWorkspace(ctx context.Context, req *wsmanapi.StopWorkspaceRequest, opts ...grpc.CallOption) (*wsmanapi.StopWorkspaceResponse, error) {
+	m.Stopped = append(m.Stopped, req.Id)
+	return &wsmanapi.StopWorkspaceResponse{}, nil
+}
+
+func TestEvict(t *testing.T) {
+	candidates := []EvictionCandidate{
+		{InstanceID: "small-idle", DiskUsage: 10, Idle: true},
+		{InstanceID: "large-busy", DiskUsage: 1000, Idle: false},
+		{InstanceID: "large-idle", DiskUsage: 500, Idle: true},
+		{InstanceID: "medium-idle", DiskUsage: 100, Idle: true},
+	}
+	tests := []struct {
+		Name        string
+		MaxPerRound int
+		Rounds      int
+		Expectation []string
+	}{
+		{"default limit", 0, 1, []string{"large-idle"}},
+		{"two per round", 2, 1, []string{"large-idle", "medium-idle"}},
+		{"no repeated stops", 2, 2, []string{"large-idle", "medium-idle", "small-idle"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mgr := &fakeManager{}
+			e := &WorkspaceEvicter{
+				Candidates:  func(ctx context.Context) ([]EvictionCandidate, error) { return candidates, nil },
+				Manager:     mgr,
+				MaxPerRound: test.MaxPerRound,
+			}
+			for i := 0; i < test.Rounds; i++ {
+				_, err := e.Evict(context.Background())
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			if diff := cmp.Diff(test.Expectation, mgr.Stopped); diff != "" {
+				t.Errorf("unexpected stopped workspaces (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
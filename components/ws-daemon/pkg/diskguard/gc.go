@@ -0,0 +1,100 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package diskguard
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/container"
+	"golang.org/x/xerrors"
+)
+
+// GarbageCollector frees disk space when a guarded location runs low
+type GarbageCollector interface {
+	// Name identifies the collector in events and metrics
+	Name() string
+
+	// CollectGarbage removes whatever this collector deems unnecessary
+	CollectGarbage(ctx context.Context) error
+}
+
+// ImageCollector removes container images which are not in use by any container
+type ImageCollector struct {
+	Pruner container.ImagePruner
+}
+
+// Name identifies the collector in events and metrics
+func (c *ImageCollector) Name() string { return "images" }
+
+// CollectGarbage removes all unused images
+func (c *ImageCollector) CollectGarbage(ctx context.Context) error {
+	removed, err := c.Pruner.PruneImages(ctx)
+	if err != nil {
+		return xerrors.Errorf("cannot prune images: %w", err)
+	}
+	log.WithField("removed", removed).Info("pruned unused container images")
+	return nil
+}
+
+// CacheConfig configures a cache directory we can remove entries from
+type CacheConfig struct {
+	// Path is the cache directory. Each entry of this directory is considered a cache entry.
+	Path string `json:"path"`
+
+	// MinAge is the time an entry must have gone unmodified before we remove it
+	MinAge util.Duration `json:"minAge"`
+}
+
+// CacheCollector removes the least recently modified entries of a cache directory
+type CacheCollector struct {
+	Path   string
+	MinAge time.Duration
+}
+
+// Name identifies the collector in events and metrics
+func (c *CacheCollector) Name() string { return "cache" }
+
+// CollectGarbage removes all cache entries older than MinAge, oldest first
+func (c *CacheCollector) CollectGarbage(ctx context.Context) error {
+	entries, err := ioutil.ReadDir(c.Path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return xerrors.Errorf("cannot list cache %s: %w", c.Path, err)
+	}
+
+	// oldest first, so that we free the least recently used entries should we get cancelled
+	sort.Slice(entries, func(i, j int) bool { return entries[i].ModTime().Before(entries[j].ModTime()) })
+
+	threshold := time.Now().Add(-c.MinAge)
+	var removed int
+	for _, e := range entries {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if e.ModTime().After(threshold) {
+			break
+		}
+
+		loc := filepath.Join(c.Path, e.Name())
+		err := os.RemoveAll(loc)
+		if err != nil {
+			log.WithError(err).WithField("loc", loc).Warn("cannot remove cache entry")
+			continue
+		}
+		removed++
+	}
+	log.WithField("path", c.Path).WithField("removed", removed).Info("removed stale cache entries")
+
+	return nil
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package diskguard
+
+import (
+	"context"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/xerrors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakek8s "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCacheCollector(t *testing.T) {
+	cache, err := ioutil.TempDir("", "cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(cache)
+
+	entries := map[string]time.Duration{
+		"fresh":     0,
+		"old":       2 * time.Hour,
+		"very-old":  24 * time.Hour,
+		"old-dir/a": 2 * time.Hour,
+	}
+	for name, age := range entries {
+		fn := filepath.Join(cache, name)
+		err = os.MkdirAll(filepath.Dir(fn), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(fn, []byte(name), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mt := time.Now().Add(-age)
+		err = os.Chtimes(fn, mt, mt)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	old := time.Now().Add(-2 * time.Hour)
+	err = os.Chtimes(filepath.Join(cache, "old-dir"), old, old)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gc := &CacheCollector{Path: cache, MinAge: time.Hour}
+	err = gc.CollectGarbage(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	remaining, err := ioutil.ReadDir(cache)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, e := range remaining {
+		names = append(names, e.Name())
+	}
+	sort.Strings(names)
+	if diff := cmp.Diff([]string{"fresh"}, names); diff != "" {
+		t.Errorf("unexpected cache entries (-want +got):\n%s", diff)
+	}
+}
+
+func TestCacheCollectorMissingCache(t *testing.T) {
+	gc := &CacheCollector{Path: "/this/cache/does/not/exist", MinAge: time.Hour}
+	err := gc.CollectGarbage(context.Background())
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+type fakePruner struct {
+	Calls int
+	Err   error
+}
+
+func (p *fakePruner) PruneImages(ctx context.Context) ([]string, error) {
+	p.Calls++
+	return nil, p.Err
+}
+
+func TestImageCollector(t *testing.T) {
+	pruner := &fakePruner{}
+	gc := &ImageCollector{Pruner: pruner}
+	err := gc.CollectGarbage(context.Background())
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if pruner.Calls != 1 {
+		t.Errorf("expected images to be pruned once, got %d", pruner.Calls)
+	}
+
+	pruner.Err = xerrors.Errorf("containerd is gone")
+	err = gc.CollectGarbage(context.Background())
+	if !xerrors.Is(err, pruner.Err) {
+		t.Errorf("expected the pruner's error, got %v", err)
+	}
+}
+
+type countingCollector struct {
+	Calls int
+}
+
+func (c *countingCollector) Name() string { return "counting" }
+
+func (c *countingCollector) CollectGarbage(ctx context.Context) error {
+	c.Calls++
+	return nil
+}
+
+func TestGuardCollectsGarbage(t *testing.T) {
+	const (
+		never  = 0
+		always = math.MaxUint64
+	)
+	tests := []struct {
+		Name               string
+		WarningBytesAvail  uint64
+		CriticalBytesAvail uint64
+		ExpectGC           bool
+	}{
+		{"below warning threshold", always, never, true},
+		{"above warning threshold", 1, never, false},
+		{"warning stage disabled", never, never, false},
+		{"warning stage disabled below critical threshold", never, always, false},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var (
+				collector = &countingCollector{}
+				clientset = fakek8s.NewSimpleClientset(&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{Name: "node", UID: "node-uid", Labels: map[string]string{}},
+				})
+			)
+			metrics, err := newMetrics(prometheus.NewRegistry())
+			if err != nil {
+				t.Fatal(err)
+			}
+			g := &Guard{
+				Path:               os.TempDir(),
+				WarningBytesAvail:  test.WarningBytesAvail,
+				CriticalBytesAvail: test.CriticalBytesAvail,
+				Clientset:          clientset,
+				Nodename:           "node",
+				Namespace:          "default",
+				Responses:          Responses{Collectors: []GarbageCollector{collector}},
+				metrics:            metrics,
+			}
+			g.check(context.Background())
+
+			if gc := collector.Calls > 0; gc != test.ExpectGC {
+				t.Fatalf("unexpected garbage collection: expected %v, got %v", test.ExpectGC, gc)
+			}
+			if !test.ExpectGC {
+				return
+			}
+
+			evts, err := clientset.CoreV1().Events("default").List(metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(evts.Items) != 1 {
+				t.Fatalf("expected one event, got %d", len(evts.Items))
+			}
+			if ref := evts.Items[0].InvolvedObject; ref.Kind != "Node" || ref.Name != "node" || ref.UID != "node-uid" {
+				t.Errorf("unexpected involved object: %+v", ref)
+			}
+		})
+	}
+}
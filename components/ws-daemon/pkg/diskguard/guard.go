@@ -5,13 +5,17 @@
 package diskguard
 
 import (
+	"context"
 	"fmt"
 	"syscall"
 	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/util/retry"
 )
@@ -20,6 +24,9 @@ const (
 	// LabelDiskPressure is set on a node if any of the guarded disks have
 	// too little space available.
 	LabelDiskPressure = "gitpod.io/diskPressure"
+
+	// eventSource is the component name we use when recording node events
+	eventSource = "ws-daemon"
 )
 
 // Config configures the disk guard
@@ -29,59 +36,191 @@ type Config struct {
 	Locations []struct {
 		Path          string `json:"path"`
 		MinBytesAvail uint64 `json:"minBytesAvail"`
+
+		// WarningBytesAvail is the free space below which we garbage collect caches.
+		// If zero, we don't collect garbage for this location.
+		WarningBytesAvail uint64 `json:"warningBytesAvail,omitempty"`
+
+		// CriticalBytesAvail is the free space below which we stop idle workspaces.
+		// If zero, we don't stop workspaces for this location.
+		CriticalBytesAvail uint64 `json:"criticalBytesAvail,omitempty"`
 	} `json:"locations"`
+
+	// GC configures how we free space once a location falls below its warning threshold
+	GC struct {
+		// PruneImages removes all container images which are not used by any container
+		PruneImages bool `json:"pruneImages"`
+
+		// Caches are cache directories, e.g. a blobspace, whose least recently used entries we remove
+		Caches []CacheConfig `json:"caches,omitempty"`
+	} `json:"gc"`
+
+	// Eviction configures how we stop workspaces once a location falls below its critical threshold
+	Eviction struct {
+		// WSManagerAddr is the address of the ws-manager we ask to stop workspaces.
+		// If empty, we don't stop workspaces.
+		WSManagerAddr string `json:"wsmanagerAddr"`
+
+		// TLS is the certificate/key config to connect to ws-manager. It's required if WSManagerAddr is set:
+		// we'd rather not evict workspaces than talk to ws-manager unsecured.
+		TLS struct {
+			// Authority is the root certificate that was used to sign the ws-manager certificate
+			Authority string `json:"ca"`
+			// Certificate is the crt file, the actual certificate
+			Certificate string `json:"crt"`
+			// PrivateKey is the private key in order to use the certificate
+			PrivateKey string `json:"key"`
+		} `json:"tls"`
+
+		// MaxIdleCPULoad is the CPU load (in jiffies per sampling period) up to which we consider a workspace idle
+		MaxIdleCPULoad int64 `json:"maxIdleCPULoad"`
+
+		// MaxPerInterval limits how many workspaces we stop per check interval. Defaults to 1.
+		MaxPerInterval int `json:"maxPerInterval,omitempty"`
+	} `json:"eviction"`
+}
+
+// Responses are the actions a guard takes when disk space runs low
+type Responses struct {
+	// Collectors free space once a location falls below its warning threshold
+	Collectors []GarbageCollector
+
+	// Evicter stops workspaces once a location falls below its critical threshold
+	Evicter *WorkspaceEvicter
 }
 
 // FromConfig produces a set of disk space guards from the configuration
-func FromConfig(cfg Config, clientset kubernetes.Interface, nodeName string) []*Guard {
+func FromConfig(cfg Config, clientset kubernetes.Interface, nodeName, namespace string, responses Responses, reg prometheus.Registerer) ([]*Guard, error) {
 	if !cfg.Enabled {
-		return nil
+		return nil, nil
+	}
+
+	metrics, err := newMetrics(reg)
+	if err != nil {
+		return nil, err
 	}
 
 	res := make([]*Guard, len(cfg.Locations))
 	for i, loc := range cfg.Locations {
 		res[i] = &Guard{
-			Path:          loc.Path,
-			MinBytesAvail: loc.MinBytesAvail,
-			Interval:      time.Duration(cfg.Interval),
-			Clientset:     clientset,
-			Nodename:      nodeName,
+			Path:               loc.Path,
+			MinBytesAvail:      loc.MinBytesAvail,
+			WarningBytesAvail:  loc.WarningBytesAvail,
+			CriticalBytesAvail: loc.CriticalBytesAvail,
+			Interval:           time.Duration(cfg.Interval),
+			Clientset:          clientset,
+			Nodename:           nodeName,
+			Namespace:          namespace,
+			Responses:          responses,
+
+			metrics: metrics,
 		}
 	}
 
-	return res
+	return res, nil
 }
 
 // Guard regularly checks how much free space is left on a path/disk.
 // If the percentage of used space goes above a certain threshold,
 // we'll label the node accordingly - and remove the label once that condition
 // subsides.
+//
+// Below the warning threshold the guard collects garbage, below the critical
+// threshold it additionally stops the largest idle workspaces on the node.
+// Each of those actions is recorded as event on the node.
 type Guard struct {
-	Path          string
-	MinBytesAvail uint64
-	Interval      time.Duration
-	Clientset     kubernetes.Interface
-	Nodename      string
+	Path               string
+	MinBytesAvail      uint64
+	WarningBytesAvail  uint64
+	CriticalBytesAvail uint64
+	Interval           time.Duration
+	Clientset          kubernetes.Interface
+	Nodename           string
+	Namespace          string
+	Responses          Responses
+
+	metrics *metrics
+	nodeUID types.UID
 }
 
 // Start starts the disk guard
 func (g *Guard) Start() {
 	t := time.NewTicker(g.Interval)
+	defer t.Stop()
 	for {
-		bvail, err := getAvailableBytes(g.Path)
+		g.check(context.Background())
+		<-t.C
+	}
+}
+
+func (g *Guard) check(ctx context.Context) {
+	bvail, err := getAvailableBytes(g.Path)
+	if err != nil {
+		log.WithError(err).WithField("path", g.Path).Error("cannot check how much space is available")
+		return
+	}
+	log.WithField("bvail", bvail).WithField("minBytesAvail", g.MinBytesAvail).Debug("checked for available disk space")
+	g.metrics.BytesAvailable.WithLabelValues(g.Path).Set(float64(bvail))
+
+	addLabel := bvail <= g.MinBytesAvail
+	err = g.setLabel(LabelDiskPressure, addLabel)
+	if err != nil {
+		log.WithError(err).Error("cannot update node label")
+	}
+
+	// below the warning threshold we collect garbage, below the critical one we stop idle workspaces.
+	// A threshold of zero disables its stage.
+	var (
+		collect = g.WarningBytesAvail != 0 && bvail <= g.WarningBytesAvail
+		evict   = g.CriticalBytesAvail != 0 && bvail <= g.CriticalBytesAvail && g.Responses.Evicter != nil
+	)
+	if collect {
+		bvail = g.collectGarbage(ctx, bvail)
+	}
+	if evict && bvail <= g.CriticalBytesAvail {
+		g.evictWorkspaces(ctx, bvail)
+	}
+}
+
+// collectGarbage runs all garbage collectors and returns the space available afterwards
+func (g *Guard) collectGarbage(ctx context.Context, bvail uint64) uint64 {
+	for _, gc := range g.Responses.Collectors {
+		err := gc.CollectGarbage(ctx)
 		if err != nil {
-			log.WithError(err).WithField("path", g.Path).Error("cannot check how much space is available")
+			log.WithError(err).WithField("path", g.Path).WithField("collector", gc.Name()).Warn("cannot collect garbage")
+			g.metrics.recordAction(g.Path, "gc_"+gc.Name(), err)
+			g.recordEvent(corev1.EventTypeWarning, "DiskPressureGCFailed", fmt.Sprintf("%s garbage collection failed while %s has %s available: %v", gc.Name(), g.Path, formatBytes(bvail), err))
 			continue
 		}
-		log.WithField("bvail", bvail).WithField("minBytesAvail", g.MinBytesAvail).Debug("checked for available disk space")
 
-		addLabel := bvail <= g.MinBytesAvail
-		err = g.setLabel(LabelDiskPressure, addLabel)
-		if err != nil {
-			log.WithError(err).Error("cannot update node label")
-		}
+		g.metrics.recordAction(g.Path, "gc_"+gc.Name(), nil)
+		g.recordEvent(corev1.EventTypeNormal, "DiskPressureGC", fmt.Sprintf("collected %s garbage because %s has only %s available", gc.Name(), g.Path, formatBytes(bvail)))
+	}
+	if len(g.Responses.Collectors) == 0 {
+		return bvail
+	}
 
-		<-t.C
+	// garbage collection might have gotten us out of trouble already
+	after, err := getAvailableBytes(g.Path)
+	if err != nil {
+		log.WithError(err).WithField("path", g.Path).Error("cannot check how much space is available")
+		return bvail
+	}
+	g.metrics.BytesAvailable.WithLabelValues(g.Path).Set(float64(after))
+	return after
+}
+
+// evictWorkspaces stops idle workspaces
+func (g *Guard) evictWorkspaces(ctx context.Context, bvail uint64) {
+	stopped, err := g.Responses.Evicter.Evict(ctx)
+	for _, id := range stopped {
+		g.metrics.recordAction(g.Path, "stop_workspace", nil)
+		g.recordEvent(corev1.EventTypeWarning, "DiskPressureWorkspaceStopped", fmt.Sprintf("stopped idle workspace %s because %s has only %s available", id, g.Path, formatBytes(bvail)))
+	}
+	if err != nil {
+		log.WithError(err).WithField("path", g.Path).Warn("cannot stop idle workspaces")
+		g.metrics.recordAction(g.Path, "stop_workspace", err)
+		g.recordEvent(corev1.EventTypeWarning, "DiskPressureEvictionFailed", fmt.Sprintf("cannot stop idle workspaces while %s has only %s available: %v", g.Path, formatBytes(bvail), err))
 	}
 }
 
@@ -113,6 +252,44 @@ func (g *Guard) setLabel(label string, add bool) error {
 	})
 }
 
+// recordEvent records a Kubernetes event on the node this guard runs on
+func (g *Guard) recordEvent(tpe, reason, message string) {
+	if g.nodeUID == "" {
+		// events refer to the node by its UID, too - otherwise they don't show up for the node
+		node, err := g.Clientset.CoreV1().Nodes().Get(g.Nodename, metav1.GetOptions{})
+		if err != nil {
+			log.WithError(err).WithField("node", g.Nodename).WithField("reason", reason).Warn("cannot record node event")
+			return
+		}
+		g.nodeUID = node.UID
+	}
+
+	timestamp := time.Now().UTC()
+	_, err := g.Clientset.CoreV1().Events(g.Namespace).Create(&corev1.Event{
+		Count:          1,
+		Message:        message,
+		Reason:         reason,
+		LastTimestamp:  metav1.NewTime(timestamp),
+		FirstTimestamp: metav1.NewTime(timestamp),
+		Type:           tpe,
+		Source: corev1.EventSource{
+			Component: eventSource,
+			Host:      g.Nodename,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind: "Node",
+			Name: g.Nodename,
+			UID:  g.nodeUID,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: g.Nodename + "-",
+		},
+	})
+	if err != nil {
+		log.WithError(err).WithField("node", g.Nodename).WithField("reason", reason).Warn("cannot record node event")
+	}
+}
+
 func getAvailableBytes(path string) (bvail uint64, err error) {
 	var stat syscall.Statfs_t
 	err = syscall.Statfs(path, &stat)
@@ -123,3 +300,16 @@ func getAvailableBytes(path string) (bvail uint64, err error) {
 	bvail = stat.Bavail * uint64(stat.Bsize)
 	return
 }
+
+func formatBytes(b uint64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+	div, exp := uint64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package diskguard
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/xerrors"
+)
+
+const (
+	metricsNamespace = "wsdaemon"
+	metricsSubsystem = "diskguard"
+)
+
+type metrics struct {
+	BytesAvailable *prometheus.GaugeVec
+	Actions        *prometheus.CounterVec
+}
+
+func newMetrics(reg prometheus.Registerer) (*metrics, error) {
+	m := &metrics{
+		BytesAvailable: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "bytes_available",
+			Help:      "Bytes available on the guarded location",
+		}, []string{"path"}),
+		Actions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "actions_total",
+			Help:      "Actions taken in response to disk pressure",
+		}, []string{"path", "action", "outcome"}),
+	}
+
+	for _, c := range []prometheus.Collector{m.BytesAvailable, m.Actions} {
+		err := reg.Register(c)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot register diskguard metrics: %w", err)
+		}
+	}
+	return m, nil
+}
+
+func (m *metrics) recordAction(path, action string, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "failure"
+	}
+	m.Actions.WithLabelValues(path, action, outcome).Inc()
+}
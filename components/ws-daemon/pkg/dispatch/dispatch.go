@@ -166,6 +166,19 @@ func (d *Dispatch) WorkspaceExistsOnNode(instanceID string) (ok bool) {
 	return
 }
 
+// Workspace returns the workspace with the given instance ID if this dispatch knows about it and has seen its container.
+// Otherwise this function returns nil.
+func (d *Dispatch) Workspace(instanceID string) *Workspace {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	state, ok := d.ctxs[instanceID]
+	if !ok || !state.SeenContainer {
+		return nil
+	}
+	return state.Workspace
+}
+
 func (d *Dispatch) handlePodUpdate(oldPod, newPod *corev1.Pod) {
 	workspaceID, ok := newPod.Labels[wsk8s.MetaIDLabel]
 	if !ok {
@@ -6,7 +6,9 @@ package hosts
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/gitpod-io/gitpod/common-go/util"
 	"golang.org/x/xerrors"
 	"k8s.io/client-go/kubernetes"
 )
@@ -17,16 +19,47 @@ type Config struct {
 	NodeHostsFile string            `json:"nodeHostsFile"`
 	FromNodeIPs   map[string]string `json:"fromPodNodeIP"`
 	FixedHosts    map[string][]Host `json:"fixedHosts"`
+	FromEndpoints map[string]string `json:"fromEndpoints,omitempty"`
+	FromDNS       []DNSConfig       `json:"fromDNS,omitempty"`
 	ServiceProxy  struct {
 		Enabled     bool `json:"enabled,omitempty"`
 		PortMapping []struct {
-			Selector  string `json:"selector"`
-			Alias     string `json:"alias"`
-			ProxyPort int    `json:"proxyPort"`
+			// Source determines where the proxy targets come from. Can be "service" (default), "endpoints" or "dns".
+			Source    SourceType `json:"source,omitempty"`
+			Selector  string     `json:"selector"`
+			Alias     string     `json:"alias"`
+			ProxyPort int        `json:"proxyPort"`
+
+			// DNSName is the name we resolve for the "dns" source
+			DNSName string `json:"dnsName,omitempty"`
+			// TargetPort is the port we proxy to for the "endpoints" and "dns" sources.
+			// Endpoints default to all their TCP ports, DNS targets require a port.
+			TargetPort int `json:"targetPort,omitempty"`
+			// Interval is the time between DNS resolutions for the "dns" source
+			Interval util.Duration `json:"interval,omitempty"`
 		} `json:"mapping"`
 	} `json:"serviceProxy,omitempty"`
 }
 
+// DNSConfig configures a host source which periodically resolves a DNS name
+type DNSConfig struct {
+	DNSName  string        `json:"dnsName"`
+	Alias    string        `json:"alias"`
+	Interval util.Duration `json:"interval,omitempty"`
+}
+
+// SourceType names a kind of host source
+type SourceType string
+
+const (
+	// SourceService produces hosts from the cluster IPs of services
+	SourceService SourceType = "service"
+	// SourceEndpoints produces hosts from the ready addresses of endpoints
+	SourceEndpoints SourceType = "endpoints"
+	// SourceDNS produces hosts by periodically resolving a DNS name
+	SourceDNS SourceType = "dns"
+)
+
 // FromConfig produces a hosts controller from configuration.
 func FromConfig(cfg Config, clientset kubernetes.Interface, kubernetesNamespace string) (res Controller, err error) {
 	if !cfg.Enabled {
@@ -36,13 +69,41 @@ func FromConfig(cfg Config, clientset kubernetes.Interface, kubernetesNamespace
 	if cfg.ServiceProxy.Enabled {
 		provider := make(map[string]HostSource)
 		for _, portcfg := range cfg.ServiceProxy.PortMapping {
-			provider[fmt.Sprintf(":%d", portcfg.ProxyPort)] = &ServiceClusterIPSource{
-				ID:        portcfg.Alias,
-				Clientset: clientset,
-				Namespace: kubernetesNamespace,
-				Selector:  portcfg.Selector,
-				Alias:     portcfg.Alias,
+			var src HostSource
+			switch portcfg.Source {
+			case "", SourceService:
+				src = &ServiceClusterIPSource{
+					ID:        portcfg.Alias,
+					Clientset: clientset,
+					Namespace: kubernetesNamespace,
+					Selector:  portcfg.Selector,
+					Alias:     portcfg.Alias,
+				}
+			case SourceEndpoints:
+				src = &EndpointsSource{
+					ID:        portcfg.Alias,
+					Clientset: clientset,
+					Namespace: kubernetesNamespace,
+					Selector:  portcfg.Selector,
+					Alias:     portcfg.Alias,
+					WithPort:  true,
+					Port:      int32(portcfg.TargetPort),
+				}
+			case SourceDNS:
+				if portcfg.TargetPort == 0 {
+					return nil, xerrors.Errorf("DNS source %s needs a target port", portcfg.Alias)
+				}
+				src = &DNSSource{
+					ID:       portcfg.Alias,
+					DNSName:  portcfg.DNSName,
+					Alias:    portcfg.Alias,
+					Interval: time.Duration(portcfg.Interval),
+					Port:     portcfg.TargetPort,
+				}
+			default:
+				return nil, xerrors.Errorf("unknown host source type %s for %s", portcfg.Source, portcfg.Alias)
 			}
+			provider[fmt.Sprintf(":%d", portcfg.ProxyPort)] = src
 		}
 
 		hg, err := NewProxyingController(kubernetesNamespace, cfg.NodeHostsFile, provider)
@@ -65,6 +126,23 @@ func FromConfig(cfg Config, clientset kubernetes.Interface, kubernetesNamespace
 	for alias, entry := range cfg.FixedHosts {
 		provider = append(provider, NewFixedIPSource(alias, entry))
 	}
+	for src, alias := range cfg.FromEndpoints {
+		provider = append(provider, &EndpointsSource{
+			ID:        alias,
+			Clientset: clientset,
+			Namespace: kubernetesNamespace,
+			Selector:  src,
+			Alias:     alias,
+		})
+	}
+	for _, dns := range cfg.FromDNS {
+		provider = append(provider, &DNSSource{
+			ID:       dns.Alias,
+			DNSName:  dns.DNSName,
+			Alias:    dns.Alias,
+			Interval: time.Duration(dns.Interval),
+		})
+	}
 	hg, err := NewDirectController(kubernetesNamespace, cfg.NodeHostsFile, provider...)
 	if err != nil {
 		return nil, xerrors.Errorf("cannot create hosts controller: %w", err)
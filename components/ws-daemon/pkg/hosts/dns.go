@@ -0,0 +1,138 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package hosts
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+)
+
+const (
+	// defaultDNSInterval is the time between two DNS resolutions if none is configured
+	defaultDNSInterval = 30 * time.Second
+
+	// dnsTimeout is the maximum time a single DNS resolution may take
+	dnsTimeout = 10 * time.Second
+)
+
+// Resolver resolves a DNS name to its addresses. net.DefaultResolver implements this interface.
+type Resolver interface {
+	LookupHost(ctx context.Context, host string) (addrs []string, err error)
+}
+
+// DNSSource periodically resolves an external DNS name and reports its addresses as host source.
+// This is useful for split-horizon setups where the node resolves names differently than the workspaces.
+type DNSSource struct {
+	ID       string
+	DNSName  string
+	Alias    string
+	Interval time.Duration
+	Resolver Resolver
+
+	// Port is added to the host addresses if not zero, which makes this source suitable
+	// for the proxying controller.
+	Port int
+
+	close chan struct{}
+	src   chan []Host
+	last  []string
+}
+
+// Name returns the ID of this source
+func (s *DNSSource) Name() string {
+	return s.ID
+}
+
+// Start starts this source
+func (s *DNSSource) Start() error {
+	s.close = make(chan struct{})
+	s.src = make(chan []Host)
+	if s.Resolver == nil {
+		s.Resolver = net.DefaultResolver
+	}
+	interval := s.Interval
+	if interval == 0 {
+		interval = defaultDNSInterval
+	}
+
+	go func() {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+
+		for {
+			s.resolve()
+
+			select {
+			case <-t.C:
+			case <-s.close:
+				log.WithField("dnsName", s.DNSName).Info("DNS source shutting down")
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (s *DNSSource) resolve() {
+	ctx, cancel := context.WithTimeout(context.Background(), dnsTimeout)
+	defer cancel()
+
+	addrs, err := s.Resolver.LookupHost(ctx, s.DNSName)
+	if err != nil {
+		// we keep the previously published hosts - a failing DNS server is no reason to break resolution on the node
+		log.WithField("name", s.ID).WithField("dnsName", s.DNSName).WithError(err).Warn("cannot resolve DNS name")
+		return
+	}
+	sort.Strings(addrs)
+	if stringsEqual(addrs, s.last) {
+		return
+	}
+	s.last = addrs
+
+	hosts := make([]Host, len(addrs))
+	for i, addr := range addrs {
+		if s.Port != 0 {
+			addr = net.JoinHostPort(addr, strconv.Itoa(s.Port))
+		}
+		hosts[i] = Host{
+			Addr: addr,
+			Name: s.Alias,
+		}
+	}
+	log.WithField("name", s.ID).WithField("hosts", hosts).Debug("update hosts")
+
+	select {
+	case s.src <- hosts:
+	case <-s.close:
+	}
+}
+
+// Stop stops this source from providing updates
+func (s *DNSSource) Stop() {
+	close(s.close)
+}
+
+// Source returns this source's channel
+func (s *DNSSource) Source() <-chan []Host {
+	return s.src
+}
+
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
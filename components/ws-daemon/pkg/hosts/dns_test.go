@@ -0,0 +1,56 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package hosts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type fakeResolver map[string][]string
+
+func (r fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	return r[host], nil
+}
+
+func TestDNSSource(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Port        int
+		Expectation []Host
+	}{
+		{"plain", 0, []Host{{Addr: "10.0.0.1", Name: "alias"}, {Addr: "10.0.0.2", Name: "alias"}}},
+		{"with port", 443, []Host{{Addr: "10.0.0.1:443", Name: "alias"}, {Addr: "10.0.0.2:443", Name: "alias"}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			src := &DNSSource{
+				ID:       "test",
+				DNSName:  "registry.example.com",
+				Alias:    "alias",
+				Port:     test.Port,
+				Resolver: fakeResolver{"registry.example.com": {"10.0.0.2", "10.0.0.1"}},
+			}
+			err := src.Start()
+			if err != nil {
+				t.Fatalf("cannot start source: %v", err)
+			}
+			defer src.Stop()
+
+			select {
+			case act := <-src.Source():
+				if diff := cmp.Diff(test.Expectation, act); diff != "" {
+					t.Errorf("unexpected hosts (-want +got):\n%s", diff)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("source did not publish hosts")
+			}
+		})
+	}
+}
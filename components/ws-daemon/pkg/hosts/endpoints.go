@@ -0,0 +1,179 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package hosts
+
+import (
+	"net"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"golang.org/x/xerrors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+)
+
+// EndpointsSource reports the ready addresses of Kubernetes endpoints as host source.
+// This is useful for headless services which have multiple backends but no cluster IP.
+//
+// If WithPort is true the hosts carry the endpoint port, which makes this source suitable
+// for the proxying controller. Otherwise it produces plain IP addresses for the hosts file.
+//
+// We watch Endpoints rather than EndpointSlices: the Kubernetes API we build against offers
+// EndpointSlices as alpha only, and the services we source hosts from have far fewer backends
+// than the 1000 addresses after which Endpoints get truncated.
+type EndpointsSource struct {
+	ID        string
+	Clientset kubernetes.Interface
+	Namespace string
+	Selector  string
+	Alias     string
+
+	// WithPort adds the endpoint port to the host addresses
+	WithPort bool
+	// Port restricts the endpoint ports we report. If zero, we report all TCP ports.
+	Port int32
+
+	hosts map[string][]string
+	close chan struct{}
+	src   chan []Host
+}
+
+// Name returns the ID of this source
+func (s *EndpointsSource) Name() string {
+	return s.ID
+}
+
+// Start starts this source
+func (s *EndpointsSource) Start() error {
+	s.close = make(chan struct{})
+	s.src = make(chan []Host)
+	s.hosts = make(map[string][]string)
+
+	opts := metav1.ListOptions{
+		LabelSelector: s.Selector,
+	}
+	eps, err := s.Clientset.CoreV1().Endpoints(s.Namespace).List(opts)
+	if err != nil {
+		return xerrors.Errorf("cannot list endpoints: %w", err)
+	}
+	for i := range eps.Items {
+		ep := &eps.Items[i]
+		s.hosts[ep.Name] = s.addresses(ep)
+	}
+
+	wtch, err := s.Clientset.CoreV1().Endpoints(s.Namespace).Watch(opts)
+	if err != nil {
+		return xerrors.Errorf("cannot watch endpoints: %w", err)
+	}
+	go func() {
+		// we publish from here rather than from Start, because nobody listens on the source channel before Start returns
+		s.publish()
+
+		for {
+		evtloop:
+			for {
+				select {
+				case evt := <-wtch.ResultChan():
+					switch evt.Type {
+					case watch.Added, watch.Modified:
+						ep, ok := evt.Object.(*corev1.Endpoints)
+						if !ok {
+							continue
+						}
+						s.hosts[ep.Name] = s.addresses(ep)
+						s.publish()
+					case watch.Deleted:
+						ep, ok := evt.Object.(*corev1.Endpoints)
+						if !ok {
+							continue
+						}
+						delete(s.hosts, ep.Name)
+						s.publish()
+					case "", watch.Error:
+						break evtloop
+					}
+				case <-s.close:
+					wtch.Stop()
+					log.WithField("selector", s.Selector).Info("endpoints source shutting down")
+					return
+				}
+			}
+
+			for {
+				log.WithField("name", s.ID).Warn("Kubernetes endpoints host source lost Kubernetes connection - reconnecting")
+				time.Sleep(10 * time.Second)
+
+				wtch, err = s.Clientset.CoreV1().Endpoints(s.Namespace).Watch(opts)
+				if err != nil {
+					log.WithField("name", s.ID).WithError(err).Warn("cannot watch endpoints")
+					continue
+				}
+				break
+			}
+		}
+	}()
+
+	return nil
+}
+
+// addresses returns the ready addresses of an endpoints object
+func (s *EndpointsSource) addresses(ep *corev1.Endpoints) []string {
+	var res []string
+	for _, subset := range ep.Subsets {
+		for _, addr := range subset.Addresses {
+			if !s.WithPort {
+				res = append(res, addr.IP)
+				continue
+			}
+
+			for _, prt := range subset.Ports {
+				if prt.Protocol != corev1.ProtocolTCP {
+					continue
+				}
+				if s.Port != 0 && prt.Port != s.Port {
+					continue
+				}
+				res = append(res, net.JoinHostPort(addr.IP, strconv.Itoa(int(prt.Port))))
+			}
+		}
+	}
+	return res
+}
+
+// Stop stops this source from providing updates
+func (s *EndpointsSource) Stop() {
+	close(s.close)
+}
+
+func (s *EndpointsSource) publish() {
+	ips := make(map[string]struct{})
+	for _, addrs := range s.hosts {
+		for _, addr := range addrs {
+			ips[addr] = struct{}{}
+		}
+	}
+
+	hosts := make([]Host, 0, len(ips))
+	for ip := range ips {
+		hosts = append(hosts, Host{
+			Addr: ip,
+			Name: s.Alias,
+		})
+	}
+	// we sort the hosts so that the proxy's round robin order is stable across updates
+	sort.Slice(hosts, func(i, j int) bool { return hosts[i].Addr < hosts[j].Addr })
+	log.WithField("name", s.ID).WithField("hosts", hosts).Debug("update hosts")
+
+	s.src <- hosts
+}
+
+// Source returns this source's channel
+func (s *EndpointsSource) Source() <-chan []Host {
+	return s.src
+}
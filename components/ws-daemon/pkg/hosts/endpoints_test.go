@@ -0,0 +1,152 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package hosts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	fakek8s "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestEndpointsSource(t *testing.T) {
+	endpoints := func(name string, ips ...string) *corev1.Endpoints {
+		addrs := make([]corev1.EndpointAddress, len(ips))
+		for i, ip := range ips {
+			addrs[i] = corev1.EndpointAddress{IP: ip}
+		}
+		return &corev1.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"component": "registry-facade"}},
+			Subsets: []corev1.EndpointSubset{{
+				Addresses: addrs,
+				Ports: []corev1.EndpointPort{
+					{Name: "https", Port: 443, Protocol: corev1.ProtocolTCP},
+					{Name: "dns", Port: 53, Protocol: corev1.ProtocolUDP},
+				},
+			}},
+		}
+	}
+	hosts := func(addrs ...string) []Host {
+		res := make([]Host, len(addrs))
+		for i, addr := range addrs {
+			res[i] = Host{Addr: addr, Name: "alias"}
+		}
+		return res
+	}
+
+	tests := []struct {
+		Name        string
+		WithPort    bool
+		Initial     []*corev1.Endpoints
+		Change      func(client kubernetes.Interface) error
+		Initially   []Host
+		Expectation []Host
+	}{
+		{
+			Name:      "add",
+			Initial:   []*corev1.Endpoints{endpoints("a", "10.0.0.2")},
+			Initially: hosts("10.0.0.2"),
+			Change: func(client kubernetes.Interface) error {
+				_, err := client.CoreV1().Endpoints("default").Create(endpoints("b", "10.0.0.1"))
+				return err
+			},
+			Expectation: hosts("10.0.0.1", "10.0.0.2"),
+		},
+		{
+			Name:      "update",
+			Initial:   []*corev1.Endpoints{endpoints("a", "10.0.0.1")},
+			Initially: hosts("10.0.0.1"),
+			Change: func(client kubernetes.Interface) error {
+				_, err := client.CoreV1().Endpoints("default").Update(endpoints("a", "10.0.0.3", "10.0.0.2"))
+				return err
+			},
+			Expectation: hosts("10.0.0.2", "10.0.0.3"),
+		},
+		{
+			Name:      "delete",
+			Initial:   []*corev1.Endpoints{endpoints("a", "10.0.0.1"), endpoints("b", "10.0.0.2")},
+			Initially: hosts("10.0.0.1", "10.0.0.2"),
+			Change: func(client kubernetes.Interface) error {
+				return client.CoreV1().Endpoints("default").Delete("a", &metav1.DeleteOptions{})
+			},
+			Expectation: hosts("10.0.0.2"),
+		},
+		{
+			Name:      "empty subset",
+			Initial:   []*corev1.Endpoints{endpoints("a", "10.0.0.1")},
+			Initially: hosts("10.0.0.1"),
+			Change: func(client kubernetes.Interface) error {
+				ep := endpoints("a")
+				ep.Subsets = nil
+				_, err := client.CoreV1().Endpoints("default").Update(ep)
+				return err
+			},
+			Expectation: hosts(),
+		},
+		{
+			Name:      "with port",
+			WithPort:  true,
+			Initial:   []*corev1.Endpoints{endpoints("a", "10.0.0.1")},
+			Initially: hosts("10.0.0.1:443"),
+			Change: func(client kubernetes.Interface) error {
+				_, err := client.CoreV1().Endpoints("default").Create(endpoints("b", "10.0.0.2"))
+				return err
+			},
+			Expectation: hosts("10.0.0.1:443", "10.0.0.2:443"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			client := fakek8s.NewSimpleClientset()
+			for _, ep := range test.Initial {
+				_, err := client.CoreV1().Endpoints("default").Create(ep)
+				if err != nil {
+					t.Fatalf("cannot create initial endpoints; this is a bug in the unit test itself: %v", err)
+				}
+			}
+
+			src := &EndpointsSource{
+				ID:        "test",
+				Clientset: client,
+				Namespace: "default",
+				Selector:  "component=registry-facade",
+				Alias:     "alias",
+				WithPort:  test.WithPort,
+			}
+			err := src.Start()
+			if err != nil {
+				t.Fatalf("cannot start source: %v", err)
+			}
+			defer src.Stop()
+
+			receive := func() []Host {
+				select {
+				case act := <-src.Source():
+					return act
+				case <-time.After(5 * time.Second):
+					t.Fatal("source did not publish hosts")
+					return nil
+				}
+			}
+
+			if diff := cmp.Diff(test.Initially, receive()); diff != "" {
+				t.Errorf("unexpected initial hosts (-want +got):\n%s", diff)
+			}
+
+			err = test.Change(client)
+			if err != nil {
+				t.Fatalf("cannot change endpoints; this is a bug in the unit test itself: %v", err)
+			}
+			if diff := cmp.Diff(test.Expectation, receive()); diff != "" {
+				t.Errorf("unexpected hosts (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -73,12 +73,19 @@ func (g *ProxyingController) Start() {
 						Port:  port,
 					}
 
-					hostsFileEntries := make([]Host, len(inc))
+					// a source may produce multiple targets for the same name - the proxy balances across
+					// them, hence the hosts file needs a single entry per name only.
+					var hostsFileEntries []Host
+					names := make(map[string]struct{}, len(inc))
 					for i := range inc {
-						hostsFileEntries[i] = Host{
+						if _, exists := names[inc[i].Name]; exists {
+							continue
+						}
+						names[inc[i].Name] = struct{}{}
+						hostsFileEntries = append(hostsFileEntries, Host{
 							Addr: "127.0.0.1",
 							Name: inc[i].Name,
-						}
+						})
 					}
 					hostsFileUpdates <- hostUpdate{src.Name(), hostsFileEntries}
 				case <-g.stop:
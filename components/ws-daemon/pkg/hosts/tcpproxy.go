@@ -5,8 +5,8 @@
 package hosts
 
 import (
-	"math/rand"
 	"net"
+	"sort"
 	"sync"
 	"time"
 
@@ -14,11 +14,21 @@ import (
 	"github.com/google/tcpproxy"
 )
 
-// tcpProxy can proxy TCP connections to a remote target randomly choosen from a list of candidates
+const (
+	// targetFailureCooldown is the time we avoid a target after dialing it failed
+	targetFailureCooldown = 30 * time.Second
+)
+
+// tcpProxy can proxy TCP connections to a remote target. The proxy balances connections
+// across all target candidates in a round robin fashion and avoids targets which recently
+// could not be dialed.
 type tcpProxy struct {
 	Name string
 
 	targets  map[string]tcpproxy.Target
+	order    []string
+	next     int
+	failed   map[string]time.Time
 	mu       sync.RWMutex
 	listener net.Listener
 }
@@ -53,20 +63,44 @@ func (p *tcpProxy) serveConn(c net.Conn) {
 }
 
 func (p *tcpProxy) findTarget() tcpproxy.Target {
-	p.mu.RLock()
-	defer p.mu.RUnlock()
+	p.mu.Lock()
+	defer p.mu.Unlock()
 
-	if len(p.targets) == 0 {
+	if len(p.order) == 0 {
 		return nil
 	}
 
-	var keys = make([]string, len(p.targets))
-	var i = 0
-	for k := range p.targets {
-		keys[i] = k
-		i++
+	// we try all targets once, starting with the next in line, and skip those which failed recently.
+	// If all targets failed recently, we'd rather try again than refuse the connection.
+	now := time.Now()
+	start := p.next % len(p.order)
+	for i := 0; i < len(p.order); i++ {
+		idx := (start + i) % len(p.order)
+		t := p.order[idx]
+		if failedAt, ok := p.failed[t]; ok && now.Sub(failedAt) < targetFailureCooldown {
+			continue
+		}
+
+		p.next = idx + 1
+		return p.targets[t]
 	}
-	return p.targets[keys[rand.Intn(len(p.targets))]]
+
+	p.next = start + 1
+	return p.targets[p.order[start]]
+}
+
+// markFailed records that dialing a target failed
+func (p *tcpProxy) markFailed(target string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.targets[target]; !ok {
+		return
+	}
+	if p.failed == nil {
+		p.failed = make(map[string]time.Time)
+	}
+	p.failed[target] = time.Now()
 }
 
 // UpdateTargets updates the list of available target candidates
@@ -88,15 +122,15 @@ func (p *tcpProxy) UpdateTargets(targets []string) {
 			continue
 		}
 
+		t := t
 		p.targets[t] = &tcpproxy.DialProxy{
 			Addr:        t,
 			DialTimeout: 1 * time.Minute, // the docs are a lie: DialTimeout defaults to "disabled" (cmp. https://github.com/inetaf/tcpproxy/issues/28)
 			OnDialError: func(src net.Conn, err error) {
-				destAddr := t
-				log.WithField("src-addr", src.RemoteAddr().String()).WithField("dest-addr", destAddr).WithError(err).Error("cannot dial target")
+				log.WithField("src-addr", src.RemoteAddr().String()).WithField("dest-addr", t).WithError(err).Error("cannot dial target")
 				src.Close()
 
-				// TODO: auto-remove target after some TTL was exceeded
+				p.markFailed(t)
 			},
 		}
 		log.WithField("dest-addr", t).Debug("added target")
@@ -104,8 +138,15 @@ func (p *tcpProxy) UpdateTargets(targets []string) {
 
 	for t := range goner {
 		delete(p.targets, t)
+		delete(p.failed, t)
 		log.WithField("dest-addr", t).Debug("removed target")
 	}
+
+	p.order = make([]string, 0, len(p.targets))
+	for t := range p.targets {
+		p.order = append(p.order, t)
+	}
+	sort.Strings(p.order)
 }
 
 // Close stops this proxy
@@ -0,0 +1,51 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package hosts
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/tcpproxy"
+)
+
+func TestFindTarget(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Targets     []string
+		Failed      []string
+		Picks       int
+		Expectation []string
+	}{
+		{"no targets", nil, nil, 1, []string{""}},
+		{"round robin", []string{"b:1", "a:1", "c:1"}, nil, 4, []string{"a:1", "b:1", "c:1", "a:1"}},
+		{"skip failed", []string{"a:1", "b:1", "c:1"}, []string{"b:1"}, 3, []string{"a:1", "c:1", "a:1"}},
+		{"all failed", []string{"a:1", "b:1"}, []string{"a:1", "b:1"}, 3, []string{"a:1", "b:1", "a:1"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			p := &tcpProxy{Name: test.Name}
+			p.UpdateTargets(test.Targets)
+			for _, f := range test.Failed {
+				p.markFailed(f)
+			}
+
+			var act []string
+			for i := 0; i < test.Picks; i++ {
+				tgt := p.findTarget()
+				if tgt == nil {
+					act = append(act, "")
+					continue
+				}
+				act = append(act, tgt.(*tcpproxy.DialProxy).Addr)
+			}
+
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected targets (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
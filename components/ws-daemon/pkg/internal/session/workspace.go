@@ -69,9 +69,11 @@ type Workspace struct {
 	LastGitStatus       *csapi.GitStatus `json:"lastGitStatus"`
 	FullWorkspaceBackup bool             `json:"fullWorkspaceBackup"`
 	ContentManifest     []byte           `json:"contentManifest"`
+	LastBackup          *BackupInfo      `json:"lastBackup,omitempty"`
 
 	ServiceLocNode   string `json:"serviceLocNode"`
 	ServiceLocDaemon string `json:"serviceLocDaemon"`
+	ServiceLocStatus string `json:"serviceLocStatus"`
 	UserNamespaced   bool   `json:"userNamespaced"`
 
 	NonPersistentAttrs map[string]interface{} `json:"-"`
@@ -80,6 +82,14 @@ type Workspace struct {
 	state              WorkspaceState
 	stateLock          sync.RWMutex
 	operatingCondition *sync.Cond
+	backupLock         sync.Mutex
+}
+
+// BackupInfo describes a backup that was uploaded to remote storage
+type BackupInfo struct {
+	Name string    `json:"name"`
+	Time time.Time `json:"time"`
+	Size int64     `json:"size"`
 }
 
 // OWI produces the owner, workspace, instance log metadata from the information
@@ -228,6 +238,13 @@ func (s *Workspace) Dispose(ctx context.Context) (err error) {
 	return nil
 }
 
+// State returns the current lifecycle state of the workspace
+func (s *Workspace) State() WorkspaceState {
+	s.stateLock.RLock()
+	defer s.stateLock.RUnlock()
+	return s.state
+}
+
 // IsReady returns true if the workspace is in the ready state
 func (s *Workspace) IsReady() bool {
 	s.stateLock.RLock()
@@ -253,6 +270,29 @@ func (s *Workspace) SetGitStatus(status *csapi.GitStatus) error {
 	return s.persist()
 }
 
+// SetLastBackup records the last successful backup and persists the change
+func (s *Workspace) SetLastBackup(info *BackupInfo) error {
+	s.stateLock.Lock()
+	s.LastBackup = info
+	s.stateLock.Unlock()
+
+	return s.persist()
+}
+
+// GetLastBackup returns the last successful backup or nil if there was none
+func (s *Workspace) GetLastBackup() *BackupInfo {
+	s.stateLock.RLock()
+	defer s.stateLock.RUnlock()
+	return s.LastBackup
+}
+
+// LockBackup ensures that only one backup of this workspace is uploaded at a time, e.g. so that a periodic
+// backup cannot overwrite the final backup. Callers must call the returned function once their backup is done.
+func (s *Workspace) LockBackup() (unlock func()) {
+	s.backupLock.Lock()
+	return s.backupLock.Unlock
+}
+
 // UpdateGitStatus attempts to update the LastGitStatus from the workspace's local working copy.
 // This method only works for legacy workspaces, not for full workspace backup ones.
 // we cannot compute the git status for a full workspace backup workspace ourselves as we only have
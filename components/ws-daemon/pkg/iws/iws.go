@@ -16,9 +16,11 @@ import (
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/common-go/tracing"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/ws-daemon/api"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/container"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/internal/session"
@@ -70,27 +72,56 @@ var (
 	}
 )
 
-// ServeWorkspace establishes the IWS server for a workspace
-func ServeWorkspace(uidmapper *Uidmapper) func(ctx context.Context, ws *session.Workspace) error {
-	return func(ctx context.Context, ws *session.Workspace) (err error) {
-		if !ws.FullWorkspaceBackup && !ws.UserNamespaced {
-			return nil
-		}
+// GitStatusReporter passes on the state of a workspace's Git repo as reported from within the workspace
+type GitStatusReporter func(instanceID string, repo *csapi.GitStatus)
 
+// ServeWorkspace establishes the IWS servers for a workspace. Every workspace gets the status server,
+// only user-namespaced and full workspace backup workspaces get the in-workspace service.
+func ServeWorkspace(uidmapper *Uidmapper, gitStatus GitStatusReporter) func(ctx context.Context, ws *session.Workspace) error {
+	return func(ctx context.Context, ws *session.Workspace) (err error) {
 		span, ctx := opentracing.StartSpanFromContext(ctx, "iws.ServeWorkspace")
 		defer tracing.FinishSpan(span, &err)
 
-		helper := &InWorkspaceServiceServer{
-			Uidmapper: uidmapper,
-			Session:   ws,
+		var stop []func()
+		if ws.ServiceLocStatus != "" {
+			status := &InWorkspaceStatusServer{
+				Session:   ws,
+				GitStatus: gitStatus,
+			}
+			err = status.Start()
+			if err != nil {
+				return xerrors.Errorf("cannot start in-workspace status server: %w", err)
+			}
+			stop = append(stop, status.Stop)
+		} else {
+			// workspaces started by a former ws-daemon version have no status location
+			log.WithFields(ws.OWI()).Warn("workspace has no status location - not serving the in-workspace status service")
 		}
-		err = helper.Start()
-		if err != nil {
-			return xerrors.Errorf("cannot start in-workspace-helper server: %w", err)
+
+		if ws.FullWorkspaceBackup || ws.UserNamespaced {
+			helper := &InWorkspaceServiceServer{
+				Uidmapper: uidmapper,
+				Session:   ws,
+			}
+			err = helper.Start()
+			if err != nil {
+				for _, s := range stop {
+					s()
+				}
+				return xerrors.Errorf("cannot start in-workspace-helper server: %w", err)
+			}
+			stop = append(stop, helper.Stop)
+		}
+		if len(stop) == 0 {
+			return nil
 		}
 
 		log.WithFields(ws.OWI()).Info("established IWS server")
-		ws.NonPersistentAttrs[session.AttrWorkspaceServer] = helper.Stop
+		ws.NonPersistentAttrs[session.AttrWorkspaceServer] = context.CancelFunc(func() {
+			for _, s := range stop {
+				s()
+			}
+		})
 
 		return nil
 	}
@@ -124,25 +155,9 @@ type InWorkspaceServiceServer struct {
 
 // Start creates the syscall socket the IWS server listens on, and starts the gRPC server on it
 func (wbs *InWorkspaceServiceServer) Start() error {
-	// It's possible that the kubelet hasn't create the ServiceLocDaemon directory yet.
-	err := os.MkdirAll(wbs.Session.ServiceLocDaemon, 0755)
-	if err != nil && !os.IsExist(err) {
-		return xerrors.Errorf("cannot create ServiceLocDaemon: %w", err)
-	}
-
-	socketFN := filepath.Join(wbs.Session.ServiceLocDaemon, "daemon.sock")
-	if _, err := os.Stat(socketFN); err == nil {
-		// a former ws-daemon instance left their sockets laying around.
-		// Let's clean up after them.
-		_ = os.Remove(socketFN)
-	}
-	sckt, err := net.Listen("unix", socketFN)
-	if err != nil {
-		return xerrors.Errorf("cannot create IWS socket: %w", err)
-	}
-	err = os.Chmod(socketFN, 0777)
+	sckt, err := listenOnWorkspaceSocket(wbs.Session.ServiceLocDaemon, "daemon.sock")
 	if err != nil {
-		return xerrors.Errorf("cannot chmod IWS socket: %w", err)
+		return err
 	}
 
 	limits := ratelimitingInterceptor{
@@ -159,6 +174,7 @@ func (wbs *InWorkspaceServiceServer) Start() error {
 
 	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(limits.UnaryInterceptor()))
 	api.RegisterInWorkspaceServiceServer(srv, wbs)
+	wbs.srv, wbs.sckt = srv, sckt
 	go func() {
 		err := srv.Serve(sckt)
 		if err != nil {
@@ -168,6 +184,32 @@ func (wbs *InWorkspaceServiceServer) Start() error {
 	return nil
 }
 
+// listenOnWorkspaceSocket creates a unix socket in a directory the workspace has mounted
+func listenOnWorkspaceSocket(dir, name string) (net.Listener, error) {
+	// It's possible that the kubelet hasn't create the directory yet.
+	err := os.MkdirAll(dir, 0755)
+	if err != nil && !os.IsExist(err) {
+		return nil, xerrors.Errorf("cannot create %s: %w", dir, err)
+	}
+
+	socketFN := filepath.Join(dir, name)
+	if _, err := os.Stat(socketFN); err == nil {
+		// a former ws-daemon instance left their sockets laying around.
+		// Let's clean up after them.
+		_ = os.Remove(socketFN)
+	}
+	sckt, err := net.Listen("unix", socketFN)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot create IWS socket: %w", err)
+	}
+	err = os.Chmod(socketFN, 0777)
+	if err != nil {
+		sckt.Close()
+		return nil, xerrors.Errorf("cannot chmod IWS socket: %w", err)
+	}
+	return sckt, nil
+}
+
 // Stop stops the service and closes the socket
 func (wbs *InWorkspaceServiceServer) Stop() {
 	defer wbs.sckt.Close()
@@ -376,6 +418,37 @@ func (wbs *InWorkspaceServiceServer) Teardown(ctx context.Context, req *api.Tear
 	return &api.TeardownResponse{Success: success}, nil
 }
 
+const (
+	// maxGitStatusEntries is the number of files or commits we pass on per category. The content service
+	// reports the first 100 followed by a summary line.
+	maxGitStatusEntries = 101
+	// maxGitStatusEntryLen is the length of a file name, branch or commit message we pass on
+	maxGitStatusEntryLen = 512
+)
+
+func limitGitStatusEntries(entries []string) []string {
+	if len(entries) > maxGitStatusEntries {
+		entries = entries[:maxGitStatusEntries]
+	}
+	res := make([]string, len(entries))
+	for i, e := range entries {
+		res[i] = limitGitStatusEntry(e)
+	}
+	return res
+}
+
+// limitGitStatusEntry truncates an entry to at most maxGitStatusEntryLen bytes without splitting a character
+func limitGitStatusEntry(entry string) string {
+	if len(entry) <= maxGitStatusEntryLen {
+		return entry
+	}
+	end := maxGitStatusEntryLen
+	for end > 0 && !utf8.RuneStart(entry[end]) {
+		end--
+	}
+	return entry[:end]
+}
+
 func (wbs *InWorkspaceServiceServer) performLiveBackup() error {
 	if !wbs.Session.FullWorkspaceBackup {
 		return nil
@@ -0,0 +1,109 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package iws
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/internal/session"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+)
+
+func TestLimitGitStatusEntry(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Entry       string
+		Expectation string
+	}{
+		{"short entry", "main", "main"},
+		{"ascii", strings.Repeat("a", maxGitStatusEntryLen+10), strings.Repeat("a", maxGitStatusEntryLen)},
+		{"multi-byte character at the limit", strings.Repeat("a", maxGitStatusEntryLen-1) + "ä", strings.Repeat("a", maxGitStatusEntryLen-1)},
+		{"multi-byte characters only", strings.Repeat("€", maxGitStatusEntryLen), strings.Repeat("€", maxGitStatusEntryLen/3)},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := limitGitStatusEntry(test.Entry)
+			if act != test.Expectation {
+				t.Errorf("unexpected entry: expected %d bytes, got %d bytes", len(test.Expectation), len(act))
+			}
+			if !utf8.ValidString(act) {
+				t.Errorf("entry is not valid UTF-8: %q", act)
+			}
+		})
+	}
+}
+
+func TestServeRegularWorkspace(t *testing.T) {
+	loc, err := ioutil.TempDir("", "iws")
+	if err != nil {
+		t.Fatalf("cannot create temp dir: %v", err)
+	}
+	defer os.RemoveAll(loc)
+
+	var (
+		ws = &session.Workspace{
+			InstanceID:         "foobar",
+			ServiceLocDaemon:   filepath.Join(loc, "foobar-daemon"),
+			ServiceLocStatus:   filepath.Join(loc, "foobar-status"),
+			NonPersistentAttrs: make(map[string]interface{}),
+		}
+		reports = make(chan *csapi.GitStatus, 1)
+		repo    = &csapi.GitStatus{Branch: "main", UncommitedFiles: []string{"README.md"}, TotalUncommitedFiles: 1}
+	)
+	err = ServeWorkspace(nil, func(instanceID string, repo *csapi.GitStatus) {
+		if instanceID != ws.InstanceID {
+			t.Errorf("unexpected instance ID: %s", instanceID)
+		}
+		reports <- repo
+	})(context.Background(), ws)
+	if err != nil {
+		t.Fatalf("cannot serve workspace: %v", err)
+	}
+	defer StopServingWorkspace(context.Background(), ws)
+
+	if _, err := os.Stat(filepath.Join(ws.ServiceLocDaemon, "daemon.sock")); !os.IsNotExist(err) {
+		t.Errorf("regular workspace must not get the in-workspace service: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, "unix://"+filepath.Join(ws.ServiceLocStatus, "status.sock"), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("cannot connect to status service: %v", err)
+	}
+	defer conn.Close()
+	client := api.NewInWorkspaceStatusServiceClient(conn)
+
+	_, err = client.UpdateGitStatus(ctx, &api.UpdateGitStatusRequest{Repo: repo})
+	if err != nil {
+		t.Fatalf("cannot update Git status: %v", err)
+	}
+	select {
+	case act := <-reports:
+		if !proto.Equal(repo, act) {
+			t.Errorf("unexpected Git status: %v", act)
+		}
+	case <-ctx.Done():
+		t.Fatal("Git status was not reported")
+	}
+
+	bkp, err := client.BackupStatus(ctx, &api.BackupStatusRequest{})
+	if err != nil {
+		t.Fatalf("cannot get backup status: %v", err)
+	}
+	if bkp.LastBackup != nil {
+		t.Errorf("unexpected last backup: %v", bkp.LastBackup)
+	}
+}
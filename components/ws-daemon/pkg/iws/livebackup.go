@@ -40,9 +40,17 @@ type LiveWorkspaceBackup struct {
 
 	stop         chan struct{}
 	closeOnce    sync.Once
+	mu           sync.RWMutex
+	running      bool
 	lastFSBackup *time.Time
 }
 
+// LiveBackupStatus describes the state of a live workspace backup
+type LiveBackupStatus struct {
+	Running    bool
+	LastBackup *time.Time
+}
+
 // Start starts listening for FS changes and triggers backups accordingly
 func (l *LiveWorkspaceBackup) Start() (err error) {
 	if l == nil {
@@ -54,7 +62,17 @@ func (l *LiveWorkspaceBackup) Start() (err error) {
 	rbTicker := time.NewTicker(regularBackupInterval)
 	defer rbTicker.Stop()
 
+	l.mu.Lock()
+	l.running = true
+	l.mu.Unlock()
+
 	go func() {
+		defer func() {
+			l.mu.Lock()
+			l.running = false
+			l.mu.Unlock()
+		}()
+
 		for {
 			select {
 			case <-gcTicker.C:
@@ -110,9 +128,28 @@ func (l *LiveWorkspaceBackup) Backup() (dest string, err error) {
 	}
 	os.RemoveAll(filepath.Join(dest, "tmp"))
 
+	now := time.Now()
+	l.mu.Lock()
+	l.lastFSBackup = &now
+	l.mu.Unlock()
+
 	return
 }
 
+// Status returns the current state of this live backup
+func (l *LiveWorkspaceBackup) Status() LiveBackupStatus {
+	if l == nil {
+		return LiveBackupStatus{}
+	}
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return LiveBackupStatus{
+		Running:    l.running,
+		LastBackup: l.lastFSBackup,
+	}
+}
+
 // garbageCollect reduces the number of live backups to a fixed maximum
 func (l *LiveWorkspaceBackup) garbageCollect() (err error) {
 	if l == nil {
@@ -0,0 +1,107 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package iws
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/internal/session"
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// InWorkspaceStatusServer implements the workspace facing status services. Contrary to the InWorkspaceServiceServer
+// it's offered to every workspace.
+type InWorkspaceStatusServer struct {
+	Session   *session.Workspace
+	GitStatus GitStatusReporter
+
+	srv  *grpc.Server
+	sckt io.Closer
+}
+
+// Start creates the status socket in the workspace and starts the gRPC server on it
+func (wss *InWorkspaceStatusServer) Start() error {
+	sckt, err := listenOnWorkspaceSocket(wss.Session.ServiceLocStatus, "status.sock")
+	if err != nil {
+		return err
+	}
+
+	limits := ratelimitingInterceptor{
+		"/iws.InWorkspaceStatusService/BackupStatus": ratelimit{
+			Limiter: rate.NewLimiter(rate.Every(time.Second), 5),
+		},
+		"/iws.InWorkspaceStatusService/UpdateGitStatus": ratelimit{
+			Limiter: rate.NewLimiter(rate.Every(5*time.Second), 3),
+		},
+	}
+
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(limits.UnaryInterceptor()))
+	api.RegisterInWorkspaceStatusServiceServer(srv, wss)
+	wss.srv, wss.sckt = srv, sckt
+	go func() {
+		err := srv.Serve(sckt)
+		if err != nil {
+			log.WithError(err).WithFields(wss.Session.OWI()).Error("IWS status server failed")
+		}
+	}()
+	return nil
+}
+
+// Stop stops the service and closes the socket
+func (wss *InWorkspaceStatusServer) Stop() {
+	defer wss.sckt.Close()
+	wss.srv.GracefulStop()
+}
+
+// BackupStatus provides information about the last backup of the workspace content
+func (wss *InWorkspaceStatusServer) BackupStatus(ctx context.Context, req *api.BackupStatusRequest) (*api.BackupStatusResponse, error) {
+	bkp := wss.Session.GetLastBackup()
+	if bkp == nil {
+		return &api.BackupStatusResponse{}, nil
+	}
+
+	lastBackup, err := ptypes.TimestampProto(bkp.Time)
+	if err != nil {
+		log.WithError(err).WithFields(wss.Session.OWI()).Warn("cannot convert last backup time")
+		return nil, status.Error(codes.Internal, "cannot convert last backup time")
+	}
+	return &api.BackupStatusResponse{
+		LastBackup:     lastBackup,
+		LastBackupSize: bkp.Size,
+	}, nil
+}
+
+// UpdateGitStatus passes on the state of the workspace's Git repo
+func (wss *InWorkspaceStatusServer) UpdateGitStatus(ctx context.Context, req *api.UpdateGitStatusRequest) (*api.UpdateGitStatusResponse, error) {
+	if wss.GitStatus == nil {
+		return nil, status.Error(codes.Unimplemented, "Git status reporting is not supported")
+	}
+
+	repo := req.Repo
+	if repo != nil {
+		// this is user controlled input which ends up in the workspace status - we make sure it's as small as we'd produce it
+		repo = &csapi.GitStatus{
+			Branch:               limitGitStatusEntry(repo.Branch),
+			LatestCommit:         limitGitStatusEntry(repo.LatestCommit),
+			UncommitedFiles:      limitGitStatusEntries(repo.UncommitedFiles),
+			TotalUncommitedFiles: repo.TotalUncommitedFiles,
+			UntrackedFiles:       limitGitStatusEntries(repo.UntrackedFiles),
+			TotalUntrackedFiles:  repo.TotalUntrackedFiles,
+			UnpushedCommits:      limitGitStatusEntries(repo.UnpushedCommits),
+			TotalUnpushedCommits: repo.TotalUnpushedCommits,
+		}
+	}
+	wss.GitStatus(wss.Session.InstanceID, repo)
+	return &api.UpdateGitStatusResponse{}, nil
+}
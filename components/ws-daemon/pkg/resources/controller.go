@@ -41,6 +41,8 @@ type Controller struct {
 	cpuLimiter         ResourceLimiter
 	cpuLimiterOverride ResourceLimiter
 	cpuLoad            int64
+	cpuLimit           int64
+	cpuBudgetSpent     int64
 	cpuPrevAcct        int64
 	cpuExpenditures    *ring.Ring
 	cfsController      cfsController
@@ -233,6 +235,9 @@ func (gov *Controller) controlCPU() {
 		//   1000 micro-jiffies/sec are 1 milli-jiffie/sec
 		//     10 milli-jiffies/sec are 1 jiffie/sec (because 100 jiffie/sec CPU capactity * 10 milli-jiffie/sec make 1000 milliseconds)
 		load := diff / (1000 * 1000 * 10)
+		gov.mu.Lock()
+		gov.cpuLoad = load
+		gov.mu.Unlock()
 
 		// load is the jiffies we've spent this sampling period. Add it to the expenditure sampling buffer
 		// and compute the budget we have left.
@@ -249,13 +254,15 @@ func (gov *Controller) controlCPU() {
 
 	// newLimit is expressed in jiffies/sec
 	var newLimit int64
-	gov.mu.RLock()
+	gov.mu.Lock()
 	if gov.cpuLimiterOverride != nil {
 		newLimit = gov.cpuLimiterOverride.Limit(bdgtSpent)
 	} else {
 		newLimit = gov.cpuLimiter.Limit(bdgtSpent)
 	}
-	gov.mu.RUnlock()
+	gov.cpuLimit = newLimit
+	gov.cpuBudgetSpent = bdgtSpent
+	gov.mu.Unlock()
 
 	_, err = gov.enforceCPULimit(newLimit)
 	if xerrors.Is(err, os.ErrNotExist) {
@@ -282,6 +289,28 @@ func (gov *Controller) SetFixedCPULimit(jiffiesPerSec int64) {
 	}
 }
 
+// CPUStatus describes the CPU use and limit of a governed container
+type CPUStatus struct {
+	// Limit is the CPU limit last enforced in jiffies/sec
+	Limit int64
+	// Load is the CPU time consumed during the last sampling period in jiffies
+	Load int64
+	// BudgetSpent is the CPU time consumed during the current control period in jiffies
+	BudgetSpent int64
+}
+
+// CPUStatus returns the CPU use and limit last observed by this controller
+func (gov *Controller) CPUStatus() CPUStatus {
+	gov.mu.RLock()
+	defer gov.mu.RUnlock()
+
+	return CPUStatus{
+		Limit:       gov.cpuLimit,
+		Load:        gov.cpuLoad,
+		BudgetSpent: gov.cpuBudgetSpent,
+	}
+}
+
 func (gov *Controller) controlProcessPriorities() {
 	if len(gov.processPriorities) == 0 {
 		return
@@ -118,6 +118,14 @@ func (d *DispatchListener) WorkspaceAdded(ctx context.Context, ws *dispatch.Work
 	return nil
 }
 
+// Governer returns the resource governer of a workspace container or nil if there is none
+func (d *DispatchListener) Governer(id container.ID) *Controller {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.governer[id]
+}
+
 // WorkspaceUpdated gets called when a workspace is updated
 func (d *DispatchListener) WorkspaceUpdated(ctx context.Context, ws *dispatch.Workspace) error {
 	d.mu.Lock()
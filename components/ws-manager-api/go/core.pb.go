@@ -82,6 +82,47 @@ func (AdmissionLevel) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f7e43720d1edc0fe, []int{1}
 }
 
+// PortProtocol is the application protocol a workspace port is served with. ws-proxy uses it to talk to the port.
+type PortProtocol int32
+
+const (
+	// HTTP (default) means the port serves HTTP/1.1
+	PortProtocol_PORT_PROTOCOL_HTTP PortProtocol = 0
+	// H2C means the port serves HTTP/2 without TLS (prior knowledge)
+	PortProtocol_PORT_PROTOCOL_H2C PortProtocol = 1
+	// TLS means the port serves HTTPS, typically with a self-signed certificate
+	PortProtocol_PORT_PROTOCOL_TLS PortProtocol = 2
+	// GRPC means the port serves gRPC, i.e. HTTP/2 without TLS
+	PortProtocol_PORT_PROTOCOL_GRPC PortProtocol = 3
+	// TCP means the port serves a protocol other than HTTP which cannot be proxied as such. There is no UDP counterpart:
+	// ws-proxy forwards TCP connections only.
+	PortProtocol_PORT_PROTOCOL_TCP PortProtocol = 4
+)
+
+var PortProtocol_name = map[int32]string{
+	0: "PORT_PROTOCOL_HTTP",
+	1: "PORT_PROTOCOL_H2C",
+	2: "PORT_PROTOCOL_TLS",
+	3: "PORT_PROTOCOL_GRPC",
+	4: "PORT_PROTOCOL_TCP",
+}
+
+var PortProtocol_value = map[string]int32{
+	"PORT_PROTOCOL_HTTP": 0,
+	"PORT_PROTOCOL_H2C":  1,
+	"PORT_PROTOCOL_TLS":  2,
+	"PORT_PROTOCOL_GRPC": 3,
+	"PORT_PROTOCOL_TCP":  4,
+}
+
+func (x PortProtocol) String() string {
+	return proto.EnumName(PortProtocol_name, int32(x))
+}
+
+func (PortProtocol) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{2}
+}
+
 // PortVisibility defines who may access a workspace port which is guarded by an authentication in the proxy
 type PortVisibility int32
 
@@ -108,7 +149,7 @@ func (x PortVisibility) String() string {
 }
 
 func (PortVisibility) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{2}
+	return fileDescriptor_f7e43720d1edc0fe, []int{3}
 }
 
 // WorkspaceConditionBool is a trinary bool: true/false/empty
@@ -137,7 +178,7 @@ func (x WorkspaceConditionBool) String() string {
 }
 
 func (WorkspaceConditionBool) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{3}
+	return fileDescriptor_f7e43720d1edc0fe, []int{4}
 }
 
 // WorkspacePhase is a simple, high-level summary of where the workspace is in its lifecycle.
@@ -200,7 +241,34 @@ func (x WorkspacePhase) String() string {
 }
 
 func (WorkspacePhase) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{4}
+	return fileDescriptor_f7e43720d1edc0fe, []int{5}
+}
+
+type AccessScope int32
+
+const (
+	// ACCESS_SCOPE_READ_ONLY permits requests which do not modify anything, i.e. GET, HEAD and OPTIONS without websockets
+	AccessScope_ACCESS_SCOPE_READ_ONLY AccessScope = 0
+	// ACCESS_SCOPE_FULL permits the same requests as the owner token
+	AccessScope_ACCESS_SCOPE_FULL AccessScope = 1
+)
+
+var AccessScope_name = map[int32]string{
+	0: "ACCESS_SCOPE_READ_ONLY",
+	1: "ACCESS_SCOPE_FULL",
+}
+
+var AccessScope_value = map[string]int32{
+	"ACCESS_SCOPE_READ_ONLY": 0,
+	"ACCESS_SCOPE_FULL":      1,
+}
+
+func (x AccessScope) String() string {
+	return proto.EnumName(AccessScope_name, int32(x))
+}
+
+func (AccessScope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{6}
 }
 
 // WorkspaceFeatureFlag enable non-standard behaviour in workspaces
@@ -254,7 +322,7 @@ func (x WorkspaceFeatureFlag) String() string {
 }
 
 func (WorkspaceFeatureFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{5}
+	return fileDescriptor_f7e43720d1edc0fe, []int{7}
 }
 
 // WorkspaceType specifies the purpose/use of a workspace. Different workspace types are handled differently by all parts of the system.
@@ -287,7 +355,7 @@ func (x WorkspaceType) String() string {
 }
 
 func (WorkspaceType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{6}
+	return fileDescriptor_f7e43720d1edc0fe, []int{8}
 }
 
 // GetWorkspacesRequest requests a list of running workspaces
@@ -1194,6 +1262,454 @@ func (m *ControlAdmissionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ControlAdmissionResponse proto.InternalMessageInfo
 
+// GetPrebuildReportRequest requests the report of a finished prebuild
+type GetPrebuildReportRequest struct {
+	// ID is the unique identifier of the prebuild workspace
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the ID of the user who owns the prebuild workspace
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPrebuildReportRequest) Reset()         { *m = GetPrebuildReportRequest{} }
+func (m *GetPrebuildReportRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPrebuildReportRequest) ProtoMessage()    {}
+func (*GetPrebuildReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{20}
+}
+
+func (m *GetPrebuildReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPrebuildReportRequest.Unmarshal(m, b)
+}
+func (m *GetPrebuildReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPrebuildReportRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPrebuildReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPrebuildReportRequest.Merge(m, src)
+}
+func (m *GetPrebuildReportRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPrebuildReportRequest.Size(m)
+}
+func (m *GetPrebuildReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPrebuildReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPrebuildReportRequest proto.InternalMessageInfo
+
+func (m *GetPrebuildReportRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GetPrebuildReportRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// GetPrebuildReportResponse is the report a prebuild uploaded next to its snapshot
+type GetPrebuildReportResponse struct {
+	// snapshot is the snapshot the prebuild produced
+	Snapshot string `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// tasks are the prebuild tasks in the order they were configured in
+	Tasks                []*PrebuildTaskReport `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetPrebuildReportResponse) Reset()         { *m = GetPrebuildReportResponse{} }
+func (m *GetPrebuildReportResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPrebuildReportResponse) ProtoMessage()    {}
+func (*GetPrebuildReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{21}
+}
+
+func (m *GetPrebuildReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPrebuildReportResponse.Unmarshal(m, b)
+}
+func (m *GetPrebuildReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPrebuildReportResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPrebuildReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPrebuildReportResponse.Merge(m, src)
+}
+func (m *GetPrebuildReportResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPrebuildReportResponse.Size(m)
+}
+func (m *GetPrebuildReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPrebuildReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPrebuildReportResponse proto.InternalMessageInfo
+
+func (m *GetPrebuildReportResponse) GetSnapshot() string {
+	if m != nil {
+		return m.Snapshot
+	}
+	return ""
+}
+
+func (m *GetPrebuildReportResponse) GetTasks() []*PrebuildTaskReport {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+// PrebuildTaskReport describes how a single prebuild task went
+type PrebuildTaskReport struct {
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// failed is true if one of the task's phases or one of its dependencies failed
+	Failed bool `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	// failure describes why the task failed if it did not fail with an exit code
+	Failure string `protobuf:"bytes,4,opt,name=failure,proto3" json:"failure,omitempty"`
+	// exit_code is the exit code of the failed phase
+	ExitCode int32 `protobuf:"varint,5,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	// phases lists the phases the task ran
+	Phases []*PrebuildTaskPhase `protobuf:"bytes,6,rep,name=phases,proto3" json:"phases,omitempty"`
+	// log_url is a presigned URL to download the task's output from. It's empty if the task has no log.
+	LogUrl               string   `protobuf:"bytes,7,opt,name=log_url,json=logUrl,proto3" json:"log_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrebuildTaskReport) Reset()         { *m = PrebuildTaskReport{} }
+func (m *PrebuildTaskReport) String() string { return proto.CompactTextString(m) }
+func (*PrebuildTaskReport) ProtoMessage()    {}
+func (*PrebuildTaskReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{22}
+}
+
+func (m *PrebuildTaskReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrebuildTaskReport.Unmarshal(m, b)
+}
+func (m *PrebuildTaskReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrebuildTaskReport.Marshal(b, m, deterministic)
+}
+func (m *PrebuildTaskReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrebuildTaskReport.Merge(m, src)
+}
+func (m *PrebuildTaskReport) XXX_Size() int {
+	return xxx_messageInfo_PrebuildTaskReport.Size(m)
+}
+func (m *PrebuildTaskReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrebuildTaskReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrebuildTaskReport proto.InternalMessageInfo
+
+func (m *PrebuildTaskReport) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PrebuildTaskReport) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PrebuildTaskReport) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+func (m *PrebuildTaskReport) GetFailure() string {
+	if m != nil {
+		return m.Failure
+	}
+	return ""
+}
+
+func (m *PrebuildTaskReport) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *PrebuildTaskReport) GetPhases() []*PrebuildTaskPhase {
+	if m != nil {
+		return m.Phases
+	}
+	return nil
+}
+
+func (m *PrebuildTaskReport) GetLogUrl() string {
+	if m != nil {
+		return m.LogUrl
+	}
+	return ""
+}
+
+// PrebuildTaskPhase describes how a single phase of a prebuild task went
+type PrebuildTaskPhase struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExitCode             int32    `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	DurationMs           int64    `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrebuildTaskPhase) Reset()         { *m = PrebuildTaskPhase{} }
+func (m *PrebuildTaskPhase) String() string { return proto.CompactTextString(m) }
+func (*PrebuildTaskPhase) ProtoMessage()    {}
+func (*PrebuildTaskPhase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{23}
+}
+
+func (m *PrebuildTaskPhase) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrebuildTaskPhase.Unmarshal(m, b)
+}
+func (m *PrebuildTaskPhase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrebuildTaskPhase.Marshal(b, m, deterministic)
+}
+func (m *PrebuildTaskPhase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrebuildTaskPhase.Merge(m, src)
+}
+func (m *PrebuildTaskPhase) XXX_Size() int {
+	return xxx_messageInfo_PrebuildTaskPhase.Size(m)
+}
+func (m *PrebuildTaskPhase) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrebuildTaskPhase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrebuildTaskPhase proto.InternalMessageInfo
+
+func (m *PrebuildTaskPhase) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PrebuildTaskPhase) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *PrebuildTaskPhase) GetDurationMs() int64 {
+	if m != nil {
+		return m.DurationMs
+	}
+	return 0
+}
+
+// GrantAccessRequest grants the holder of a share token access to a workspace
+type GrantAccessRequest struct {
+	// ID is the unique identifier of the workspace to grant access to
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// user_id names the user the token is meant for. It's informational only: ws-proxy does not verify it and anyone who holds the token can use it.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// scope determines what the grantee can do in the workspace
+	Scope AccessScope `protobuf:"varint,3,opt,name=scope,proto3,enum=wsman.AccessScope" json:"scope,omitempty"`
+	// duration is the time after which the grant expires (e.g. "30m"). It's required because every grant is a bearer token.
+	Duration             string   `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GrantAccessRequest) Reset()         { *m = GrantAccessRequest{} }
+func (m *GrantAccessRequest) String() string { return proto.CompactTextString(m) }
+func (*GrantAccessRequest) ProtoMessage()    {}
+func (*GrantAccessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{24}
+}
+
+func (m *GrantAccessRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantAccessRequest.Unmarshal(m, b)
+}
+func (m *GrantAccessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantAccessRequest.Marshal(b, m, deterministic)
+}
+func (m *GrantAccessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantAccessRequest.Merge(m, src)
+}
+func (m *GrantAccessRequest) XXX_Size() int {
+	return xxx_messageInfo_GrantAccessRequest.Size(m)
+}
+func (m *GrantAccessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantAccessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantAccessRequest proto.InternalMessageInfo
+
+func (m *GrantAccessRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GrantAccessRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *GrantAccessRequest) GetScope() AccessScope {
+	if m != nil {
+		return m.Scope
+	}
+	return AccessScope_ACCESS_SCOPE_READ_ONLY
+}
+
+func (m *GrantAccessRequest) GetDuration() string {
+	if m != nil {
+		return m.Duration
+	}
+	return ""
+}
+
+type GrantAccessResponse struct {
+	// grant_id identifies the grant, e.g. for revoking it
+	GrantId string `protobuf:"bytes,1,opt,name=grant_id,json=grantId,proto3" json:"grant_id,omitempty"`
+	// token is the token the grantee presents to ws-proxy, either as access cookie or in the x-gitpod-access-token header.
+	// Only its hash is stored, hence this is the only time the token is available.
+	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GrantAccessResponse) Reset()         { *m = GrantAccessResponse{} }
+func (m *GrantAccessResponse) String() string { return proto.CompactTextString(m) }
+func (*GrantAccessResponse) ProtoMessage()    {}
+func (*GrantAccessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{25}
+}
+
+func (m *GrantAccessResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantAccessResponse.Unmarshal(m, b)
+}
+func (m *GrantAccessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantAccessResponse.Marshal(b, m, deterministic)
+}
+func (m *GrantAccessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantAccessResponse.Merge(m, src)
+}
+func (m *GrantAccessResponse) XXX_Size() int {
+	return xxx_messageInfo_GrantAccessResponse.Size(m)
+}
+func (m *GrantAccessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantAccessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantAccessResponse proto.InternalMessageInfo
+
+func (m *GrantAccessResponse) GetGrantId() string {
+	if m != nil {
+		return m.GrantId
+	}
+	return ""
+}
+
+func (m *GrantAccessResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// RevokeAccessRequest revokes an access grant
+type RevokeAccessRequest struct {
+	// ID is the unique identifier of the workspace whose grant to revoke
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// grant_id is the grant to revoke
+	GrantId              string   `protobuf:"bytes,2,opt,name=grant_id,json=grantId,proto3" json:"grant_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAccessRequest) Reset()         { *m = RevokeAccessRequest{} }
+func (m *RevokeAccessRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeAccessRequest) ProtoMessage()    {}
+func (*RevokeAccessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{26}
+}
+
+func (m *RevokeAccessRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAccessRequest.Unmarshal(m, b)
+}
+func (m *RevokeAccessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAccessRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeAccessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAccessRequest.Merge(m, src)
+}
+func (m *RevokeAccessRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeAccessRequest.Size(m)
+}
+func (m *RevokeAccessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAccessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAccessRequest proto.InternalMessageInfo
+
+func (m *RevokeAccessRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RevokeAccessRequest) GetGrantId() string {
+	if m != nil {
+		return m.GrantId
+	}
+	return ""
+}
+
+type RevokeAccessResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAccessResponse) Reset()         { *m = RevokeAccessResponse{} }
+func (m *RevokeAccessResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeAccessResponse) ProtoMessage()    {}
+func (*RevokeAccessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{27}
+}
+
+func (m *RevokeAccessResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAccessResponse.Unmarshal(m, b)
+}
+func (m *RevokeAccessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAccessResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeAccessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAccessResponse.Merge(m, src)
+}
+func (m *RevokeAccessResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeAccessResponse.Size(m)
+}
+func (m *RevokeAccessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAccessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAccessResponse proto.InternalMessageInfo
+
 // WorkspaceStatus describes a workspace status
 type WorkspaceStatus struct {
 	// ID is the unique identifier of the workspace
@@ -1225,7 +1741,7 @@ func (m *WorkspaceStatus) Reset()         { *m = WorkspaceStatus{} }
 func (m *WorkspaceStatus) String() string { return proto.CompactTextString(m) }
 func (*WorkspaceStatus) ProtoMessage()    {}
 func (*WorkspaceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{20}
+	return fileDescriptor_f7e43720d1edc0fe, []int{28}
 }
 
 func (m *WorkspaceStatus) XXX_Unmarshal(b []byte) error {
@@ -1334,7 +1850,7 @@ func (m *WorkspaceSpec) Reset()         { *m = WorkspaceSpec{} }
 func (m *WorkspaceSpec) String() string { return proto.CompactTextString(m) }
 func (*WorkspaceSpec) ProtoMessage()    {}
 func (*WorkspaceSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{21}
+	return fileDescriptor_f7e43720d1edc0fe, []int{29}
 }
 
 func (m *WorkspaceSpec) XXX_Unmarshal(b []byte) error {
@@ -1413,17 +1929,21 @@ type PortSpec struct {
 	// visibility defines the visibility of the port
 	Visibility PortVisibility `protobuf:"varint,3,opt,name=visibility,proto3,enum=wsman.PortVisibility" json:"visibility,omitempty"`
 	// url is the public-facing URL this port is available at
-	Url                  string   `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Url string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	// protocol is the application protocol the port is served with
+	Protocol PortProtocol `protobuf:"varint,5,opt,name=protocol,proto3,enum=wsman.PortProtocol" json:"protocol,omitempty"`
+	// rate_limit overrides the rate limit ws-proxy applies to requests to this port if the port is public
+	RateLimit            *RateLimit `protobuf:"bytes,6,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *PortSpec) Reset()         { *m = PortSpec{} }
 func (m *PortSpec) String() string { return proto.CompactTextString(m) }
 func (*PortSpec) ProtoMessage()    {}
 func (*PortSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{22}
+	return fileDescriptor_f7e43720d1edc0fe, []int{30}
 }
 
 func (m *PortSpec) XXX_Unmarshal(b []byte) error {
@@ -1472,6 +1992,70 @@ func (m *PortSpec) GetUrl() string {
 	return ""
 }
 
+func (m *PortSpec) GetProtocol() PortProtocol {
+	if m != nil {
+		return m.Protocol
+	}
+	return PortProtocol_PORT_PROTOCOL_HTTP
+}
+
+func (m *PortSpec) GetRateLimit() *RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return nil
+}
+
+// RateLimit limits the rate of requests using a token bucket
+type RateLimit struct {
+	// requests_per_second is the rate at which the bucket refills. Zero means there's no limit.
+	RequestsPerSecond uint32 `protobuf:"varint,1,opt,name=requests_per_second,json=requestsPerSecond,proto3" json:"requests_per_second,omitempty"`
+	// burst is the size of the bucket, i.e. the number of requests which can be made at once
+	Burst                uint32   `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{31}
+}
+
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateLimit.Unmarshal(m, b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return xxx_messageInfo_RateLimit.Size(m)
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetRequestsPerSecond() uint32 {
+	if m != nil {
+		return m.RequestsPerSecond
+	}
+	return 0
+}
+
+func (m *RateLimit) GetBurst() uint32 {
+	if m != nil {
+		return m.Burst
+	}
+	return 0
+}
+
 // WorkspaceCondition gives more detailed information as to the state of the workspace. Which condition actually
 // has a value depends on the phase the workspace is in.
 type WorkspaceConditions struct {
@@ -1493,17 +2077,23 @@ type WorkspaceConditions struct {
 	// network_not_ready indicates if a workspace container is currently experiencing a network problem.
 	NetworkNotReady WorkspaceConditionBool `protobuf:"varint,8,opt,name=network_not_ready,json=networkNotReady,proto3,enum=wsman.WorkspaceConditionBool" json:"network_not_ready,omitempty"`
 	// first_user_activity is the time when MarkActive was first called on the workspace
-	FirstUserActivity    *timestamp.Timestamp `protobuf:"bytes,9,opt,name=first_user_activity,json=firstUserActivity,proto3" json:"first_user_activity,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	FirstUserActivity *timestamp.Timestamp `protobuf:"bytes,9,opt,name=first_user_activity,json=firstUserActivity,proto3" json:"first_user_activity,omitempty"`
+	// last_backup is the time of the last successful backup of the workspace content while it was running.
+	// If the workspace content has not been backed up during its runtime, this field is nil.
+	LastBackup *timestamp.Timestamp `protobuf:"bytes,10,opt,name=last_backup,json=lastBackup,proto3" json:"last_backup,omitempty"`
+	// content_progress describes the work ws-daemon currently does on the workspace content, i.e. the initialization
+	// while the workspace is INITIALIZING or the final backup while it is STOPPING. If no such work is underway, this field is nil.
+	ContentProgress      *ContentProgress `protobuf:"bytes,11,opt,name=content_progress,json=contentProgress,proto3" json:"content_progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *WorkspaceConditions) Reset()         { *m = WorkspaceConditions{} }
 func (m *WorkspaceConditions) String() string { return proto.CompactTextString(m) }
 func (*WorkspaceConditions) ProtoMessage()    {}
 func (*WorkspaceConditions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{23}
+	return fileDescriptor_f7e43720d1edc0fe, []int{32}
 }
 
 func (m *WorkspaceConditions) XXX_Unmarshal(b []byte) error {
@@ -1587,6 +2177,97 @@ func (m *WorkspaceConditions) GetFirstUserActivity() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *WorkspaceConditions) GetLastBackup() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastBackup
+	}
+	return nil
+}
+
+func (m *WorkspaceConditions) GetContentProgress() *ContentProgress {
+	if m != nil {
+		return m.ContentProgress
+	}
+	return nil
+}
+
+// ContentProgress describes the progress of work on a workspace's content
+type ContentProgress struct {
+	// operation is the work underway, i.e. "initialize" or "backup"
+	Operation string `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	// phase is the step of the operation currently underway, e.g. "cloning" or "uploading"
+	Phase string `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
+	// percent is the completion of the current phase in percent. If the progress is unknown, this field is -1.
+	Percent int32 `protobuf:"varint,3,opt,name=percent,proto3" json:"percent,omitempty"`
+	// attempt counts the attempts of the current phase, starting at one. Zero if the operation is not retried.
+	Attempt int32 `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// error is the last error that occured during the operation. If the error was not final, the operation is retried.
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContentProgress) Reset()         { *m = ContentProgress{} }
+func (m *ContentProgress) String() string { return proto.CompactTextString(m) }
+func (*ContentProgress) ProtoMessage()    {}
+func (*ContentProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{33}
+}
+
+func (m *ContentProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContentProgress.Unmarshal(m, b)
+}
+func (m *ContentProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContentProgress.Marshal(b, m, deterministic)
+}
+func (m *ContentProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContentProgress.Merge(m, src)
+}
+func (m *ContentProgress) XXX_Size() int {
+	return xxx_messageInfo_ContentProgress.Size(m)
+}
+func (m *ContentProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContentProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContentProgress proto.InternalMessageInfo
+
+func (m *ContentProgress) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *ContentProgress) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *ContentProgress) GetPercent() int32 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
+}
+
+func (m *ContentProgress) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *ContentProgress) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // WorkspaceMetadata is data associated with a workspace that's required for other parts of the system to function
 type WorkspaceMetadata struct {
 	// owner is the ID of the Gitpod user to whom we'll bill this workspace and who we consider responsible for its content
@@ -1604,7 +2285,7 @@ func (m *WorkspaceMetadata) Reset()         { *m = WorkspaceMetadata{} }
 func (m *WorkspaceMetadata) String() string { return proto.CompactTextString(m) }
 func (*WorkspaceMetadata) ProtoMessage()    {}
 func (*WorkspaceMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{24}
+	return fileDescriptor_f7e43720d1edc0fe, []int{34}
 }
 
 func (m *WorkspaceMetadata) XXX_Unmarshal(b []byte) error {
@@ -1661,7 +2342,7 @@ func (m *WorkspaceRuntimeInfo) Reset()         { *m = WorkspaceRuntimeInfo{} }
 func (m *WorkspaceRuntimeInfo) String() string { return proto.CompactTextString(m) }
 func (*WorkspaceRuntimeInfo) ProtoMessage()    {}
 func (*WorkspaceRuntimeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{25}
+	return fileDescriptor_f7e43720d1edc0fe, []int{35}
 }
 
 func (m *WorkspaceRuntimeInfo) XXX_Unmarshal(b []byte) error {
@@ -1695,17 +2376,19 @@ type WorkspaceAuthentication struct {
 	// Admission describes who can access the workspace and its ports.
 	Admission AdmissionLevel `protobuf:"varint,1,opt,name=admission,proto3,enum=wsman.AdmissionLevel" json:"admission,omitempty"`
 	// Owner token is the token one needs to access the workspace. Its presence is checked by ws-proxy.
-	OwnerToken           string   `protobuf:"bytes,2,opt,name=owner_token,json=ownerToken,proto3" json:"owner_token,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	OwnerToken string `protobuf:"bytes,2,opt,name=owner_token,json=ownerToken,proto3" json:"owner_token,omitempty"`
+	// grants give specific users or holders of a share token access to the workspace in addition to the owner
+	Grants               []*AccessGrant `protobuf:"bytes,3,rep,name=grants,proto3" json:"grants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *WorkspaceAuthentication) Reset()         { *m = WorkspaceAuthentication{} }
 func (m *WorkspaceAuthentication) String() string { return proto.CompactTextString(m) }
 func (*WorkspaceAuthentication) ProtoMessage()    {}
 func (*WorkspaceAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{26}
+	return fileDescriptor_f7e43720d1edc0fe, []int{36}
 }
 
 func (m *WorkspaceAuthentication) XXX_Unmarshal(b []byte) error {
@@ -1740,6 +2423,89 @@ func (m *WorkspaceAuthentication) GetOwnerToken() string {
 	return ""
 }
 
+func (m *WorkspaceAuthentication) GetGrants() []*AccessGrant {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+// AccessGrant gives someone other than the owner access to a workspace
+type AccessGrant struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// user_id names the user the token was meant for, if any. ws-proxy does not verify it.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// scope determines what the grantee can do in the workspace
+	Scope AccessScope `protobuf:"varint,3,opt,name=scope,proto3,enum=wsman.AccessScope" json:"scope,omitempty"`
+	// expires_at is the time after which the grant is no longer valid. It's mandatory: ws-proxy rejects grants without expiry.
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// token_hash is the hex-encoded SHA-256 hash of the token the grantee presents to ws-proxy
+	TokenHash            string   `protobuf:"bytes,5,opt,name=token_hash,json=tokenHash,proto3" json:"token_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccessGrant) Reset()         { *m = AccessGrant{} }
+func (m *AccessGrant) String() string { return proto.CompactTextString(m) }
+func (*AccessGrant) ProtoMessage()    {}
+func (*AccessGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{37}
+}
+
+func (m *AccessGrant) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessGrant.Unmarshal(m, b)
+}
+func (m *AccessGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessGrant.Marshal(b, m, deterministic)
+}
+func (m *AccessGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessGrant.Merge(m, src)
+}
+func (m *AccessGrant) XXX_Size() int {
+	return xxx_messageInfo_AccessGrant.Size(m)
+}
+func (m *AccessGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessGrant proto.InternalMessageInfo
+
+func (m *AccessGrant) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AccessGrant) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *AccessGrant) GetScope() AccessScope {
+	if m != nil {
+		return m.Scope
+	}
+	return AccessScope_ACCESS_SCOPE_READ_ONLY
+}
+
+func (m *AccessGrant) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *AccessGrant) GetTokenHash() string {
+	if m != nil {
+		return m.TokenHash
+	}
+	return ""
+}
+
 // StartWorkspaceSpec specifies the configuration of a workspace for a workspace start
 type StartWorkspaceSpec struct {
 	// workspace_image is the Docker image name of the workspace container
@@ -1773,7 +2539,7 @@ func (m *StartWorkspaceSpec) Reset()         { *m = StartWorkspaceSpec{} }
 func (m *StartWorkspaceSpec) String() string { return proto.CompactTextString(m) }
 func (*StartWorkspaceSpec) ProtoMessage()    {}
 func (*StartWorkspaceSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{27}
+	return fileDescriptor_f7e43720d1edc0fe, []int{38}
 }
 
 func (m *StartWorkspaceSpec) XXX_Unmarshal(b []byte) error {
@@ -1886,7 +2652,7 @@ func (m *GitSpec) Reset()         { *m = GitSpec{} }
 func (m *GitSpec) String() string { return proto.CompactTextString(m) }
 func (*GitSpec) ProtoMessage()    {}
 func (*GitSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{28}
+	return fileDescriptor_f7e43720d1edc0fe, []int{39}
 }
 
 func (m *GitSpec) XXX_Unmarshal(b []byte) error {
@@ -1934,7 +2700,7 @@ func (m *EnvironmentVariable) Reset()         { *m = EnvironmentVariable{} }
 func (m *EnvironmentVariable) String() string { return proto.CompactTextString(m) }
 func (*EnvironmentVariable) ProtoMessage()    {}
 func (*EnvironmentVariable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{29}
+	return fileDescriptor_f7e43720d1edc0fe, []int{40}
 }
 
 func (m *EnvironmentVariable) XXX_Unmarshal(b []byte) error {
@@ -1986,7 +2752,7 @@ func (m *WorkspaceLogMessage) Reset()         { *m = WorkspaceLogMessage{} }
 func (m *WorkspaceLogMessage) String() string { return proto.CompactTextString(m) }
 func (*WorkspaceLogMessage) ProtoMessage()    {}
 func (*WorkspaceLogMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{30}
+	return fileDescriptor_f7e43720d1edc0fe, []int{41}
 }
 
 func (m *WorkspaceLogMessage) XXX_Unmarshal(b []byte) error {
@@ -2031,9 +2797,11 @@ func (m *WorkspaceLogMessage) GetMessage() string {
 func init() {
 	proto.RegisterEnum("wsman.StopWorkspacePolicy", StopWorkspacePolicy_name, StopWorkspacePolicy_value)
 	proto.RegisterEnum("wsman.AdmissionLevel", AdmissionLevel_name, AdmissionLevel_value)
+	proto.RegisterEnum("wsman.PortProtocol", PortProtocol_name, PortProtocol_value)
 	proto.RegisterEnum("wsman.PortVisibility", PortVisibility_name, PortVisibility_value)
 	proto.RegisterEnum("wsman.WorkspaceConditionBool", WorkspaceConditionBool_name, WorkspaceConditionBool_value)
 	proto.RegisterEnum("wsman.WorkspacePhase", WorkspacePhase_name, WorkspacePhase_value)
+	proto.RegisterEnum("wsman.AccessScope", AccessScope_name, AccessScope_value)
 	proto.RegisterEnum("wsman.WorkspaceFeatureFlag", WorkspaceFeatureFlag_name, WorkspaceFeatureFlag_value)
 	proto.RegisterEnum("wsman.WorkspaceType", WorkspaceType_name, WorkspaceType_value)
 	proto.RegisterType((*GetWorkspacesRequest)(nil), "wsman.GetWorkspacesRequest")
@@ -2057,13 +2825,24 @@ func init() {
 	proto.RegisterType((*TakeSnapshotResponse)(nil), "wsman.TakeSnapshotResponse")
 	proto.RegisterType((*ControlAdmissionRequest)(nil), "wsman.ControlAdmissionRequest")
 	proto.RegisterType((*ControlAdmissionResponse)(nil), "wsman.ControlAdmissionResponse")
+	proto.RegisterType((*GetPrebuildReportRequest)(nil), "wsman.GetPrebuildReportRequest")
+	proto.RegisterType((*GetPrebuildReportResponse)(nil), "wsman.GetPrebuildReportResponse")
+	proto.RegisterType((*PrebuildTaskReport)(nil), "wsman.PrebuildTaskReport")
+	proto.RegisterType((*PrebuildTaskPhase)(nil), "wsman.PrebuildTaskPhase")
+	proto.RegisterType((*GrantAccessRequest)(nil), "wsman.GrantAccessRequest")
+	proto.RegisterType((*GrantAccessResponse)(nil), "wsman.GrantAccessResponse")
+	proto.RegisterType((*RevokeAccessRequest)(nil), "wsman.RevokeAccessRequest")
+	proto.RegisterType((*RevokeAccessResponse)(nil), "wsman.RevokeAccessResponse")
 	proto.RegisterType((*WorkspaceStatus)(nil), "wsman.WorkspaceStatus")
 	proto.RegisterType((*WorkspaceSpec)(nil), "wsman.WorkspaceSpec")
 	proto.RegisterType((*PortSpec)(nil), "wsman.PortSpec")
+	proto.RegisterType((*RateLimit)(nil), "wsman.RateLimit")
 	proto.RegisterType((*WorkspaceConditions)(nil), "wsman.WorkspaceConditions")
+	proto.RegisterType((*ContentProgress)(nil), "wsman.ContentProgress")
 	proto.RegisterType((*WorkspaceMetadata)(nil), "wsman.WorkspaceMetadata")
 	proto.RegisterType((*WorkspaceRuntimeInfo)(nil), "wsman.WorkspaceRuntimeInfo")
 	proto.RegisterType((*WorkspaceAuthentication)(nil), "wsman.WorkspaceAuthentication")
+	proto.RegisterType((*AccessGrant)(nil), "wsman.AccessGrant")
 	proto.RegisterType((*StartWorkspaceSpec)(nil), "wsman.StartWorkspaceSpec")
 	proto.RegisterType((*GitSpec)(nil), "wsman.GitSpec")
 	proto.RegisterType((*EnvironmentVariable)(nil), "wsman.EnvironmentVariable")
@@ -2075,136 +2854,176 @@ func init() {
 }
 
 var fileDescriptor_f7e43720d1edc0fe = []byte{
-	// 2064 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xdd, 0x6f, 0xdb, 0xc8,
-	0x11, 0xb7, 0x3e, 0x2c, 0x4b, 0x63, 0x5b, 0xa6, 0xd7, 0x5f, 0x8a, 0x72, 0x77, 0x31, 0xd8, 0x0b,
-	0x6a, 0xf8, 0x6a, 0xfb, 0xe0, 0x24, 0xc0, 0x25, 0x57, 0xe0, 0x2a, 0x4b, 0xb4, 0xc3, 0x8b, 0x2c,
-	0xa9, 0x2b, 0xc9, 0x39, 0xe7, 0x85, 0x58, 0x8b, 0x6b, 0x99, 0x30, 0x45, 0xb2, 0xe4, 0xca, 0x89,
-	0x0a, 0x14, 0x7d, 0xe8, 0x7b, 0x8b, 0x02, 0x7d, 0xee, 0x3f, 0xd7, 0xbf, 0xa2, 0x0f, 0x05, 0x8a,
-	0x5d, 0x2e, 0x29, 0xea, 0x2b, 0xce, 0xc3, 0xbd, 0x71, 0x76, 0x7e, 0x33, 0xbb, 0x3b, 0x3b, 0xf3,
-	0xdb, 0x59, 0x02, 0xf4, 0x5c, 0x9f, 0x1e, 0x7b, 0xbe, 0xcb, 0x5c, 0xb4, 0xfc, 0x31, 0x18, 0x10,
-	0xa7, 0xfc, 0xbc, 0xe7, 0x3a, 0x8c, 0x3a, 0xec, 0x28, 0xa0, 0xfe, 0x83, 0xd5, 0xa3, 0x47, 0xc4,
-	0xb3, 0x4e, 0x2c, 0xc7, 0x62, 0x16, 0xb1, 0xad, 0x3f, 0x53, 0x3f, 0x44, 0x97, 0x9f, 0xf5, 0x5d,
-	0xb7, 0x6f, 0xd3, 0x13, 0x21, 0xdd, 0x0c, 0x6f, 0x4f, 0x98, 0x35, 0xa0, 0x01, 0x23, 0x03, 0x2f,
-	0x04, 0xa8, 0xbb, 0xb0, 0x7d, 0x41, 0xd9, 0x7b, 0xd7, 0xbf, 0x0f, 0x3c, 0xd2, 0xa3, 0x01, 0xa6,
-	0x7f, 0x1a, 0xd2, 0x80, 0xa9, 0x17, 0xb0, 0x33, 0x35, 0x1e, 0x78, 0xae, 0x13, 0x50, 0x74, 0x0c,
-	0xb9, 0x80, 0x11, 0x36, 0x0c, 0x4a, 0xa9, 0xfd, 0xcc, 0xc1, 0xea, 0xe9, 0xee, 0xb1, 0x58, 0xd0,
-	0x71, 0x0c, 0x6d, 0x0b, 0x2d, 0x96, 0x28, 0xf5, 0x3f, 0x29, 0xd8, 0x69, 0x33, 0xe2, 0x8f, 0x7d,
-	0xc9, 0x29, 0x50, 0x11, 0xd2, 0x96, 0x59, 0x4a, 0xed, 0xa7, 0x0e, 0x0a, 0x38, 0x6d, 0x99, 0xe8,
-	0x39, 0x14, 0xe5, 0x66, 0x0c, 0xcf, 0xa7, 0xb7, 0xd6, 0xa7, 0x52, 0x5a, 0xe8, 0xd6, 0xe5, 0x68,
-	0x4b, 0x0c, 0xa2, 0x97, 0x90, 0x1f, 0x50, 0x46, 0x4c, 0xc2, 0x48, 0x29, 0xb3, 0x9f, 0x3a, 0x58,
-	0x3d, 0x2d, 0x4d, 0x2f, 0xe1, 0x52, 0xea, 0x71, 0x8c, 0x44, 0x47, 0x90, 0x0d, 0x3c, 0xda, 0x2b,
-	0x65, 0x85, 0xc5, 0x13, 0x69, 0x31, 0xb9, 0xb0, 0xb6, 0x47, 0x7b, 0x58, 0xc0, 0xd0, 0x01, 0x64,
-	0xd9, 0xc8, 0xa3, 0xa5, 0xdc, 0x7e, 0xea, 0xa0, 0x78, 0xba, 0x3d, 0x3d, 0x41, 0x67, 0xe4, 0x51,
-	0x2c, 0x10, 0x3f, 0x67, 0xf3, 0xcb, 0x4a, 0x4e, 0x3d, 0x84, 0xdd, 0xe9, 0x4d, 0xca, 0x78, 0x29,
-	0x90, 0x19, 0xfa, 0xb6, 0xdc, 0x26, 0xff, 0x54, 0x3f, 0xc0, 0x76, 0x9b, 0xb9, 0xde, 0xa3, 0xf1,
-	0x38, 0x85, 0x9c, 0xe7, 0xda, 0x56, 0x6f, 0x24, 0xe2, 0x50, 0x3c, 0x2d, 0xc7, 0x8b, 0x4e, 0x18,
-	0xb7, 0x04, 0x02, 0x4b, 0xa4, 0xba, 0xc7, 0x83, 0x3d, 0xe1, 0x3b, 0x5c, 0x86, 0x7a, 0x08, 0xa5,
-	0x1a, 0x0d, 0x7a, 0xbe, 0x75, 0x43, 0x1f, 0x9b, 0x58, 0x75, 0xe1, 0xc9, 0x1c, 0xec, 0x9c, 0xf3,
-	0x4f, 0x3d, 0x7e, 0xfe, 0x48, 0x85, 0x35, 0x9b, 0x04, 0xac, 0xd2, 0x63, 0xd6, 0x83, 0xc5, 0x46,
-	0xf2, 0x4c, 0x27, 0xc6, 0x54, 0x04, 0x4a, 0x7b, 0x78, 0x13, 0xce, 0x18, 0x25, 0xe0, 0x7f, 0x53,
-	0xb0, 0x99, 0x18, 0x94, 0xb3, 0x7f, 0xff, 0x65, 0xb3, 0xbf, 0x5d, 0x8a, 0xe7, 0x3f, 0x86, 0x8c,
-	0xed, 0xf6, 0xc5, 0xb4, 0xab, 0x71, 0x08, 0x63, 0x78, 0xdd, 0xed, 0x5f, 0xd2, 0x20, 0x20, 0x7d,
-	0xfa, 0x76, 0x09, 0x73, 0x20, 0xfa, 0x3d, 0xe4, 0xee, 0x28, 0x31, 0xa9, 0x5f, 0xca, 0x88, 0xfc,
-	0xfe, 0x36, 0x8a, 0xfa, 0xf4, 0x5a, 0x8e, 0xdf, 0x0a, 0x98, 0xe6, 0x30, 0x7f, 0x84, 0xa5, 0x4d,
-	0xf9, 0x35, 0xac, 0x26, 0x86, 0xf9, 0xe1, 0xdf, 0xd3, 0x51, 0x74, 0xf8, 0xf7, 0x74, 0x84, 0xb6,
-	0x61, 0xf9, 0x81, 0xd8, 0x43, 0x2a, 0xe3, 0x10, 0x0a, 0x6f, 0xd2, 0x3f, 0xa4, 0xce, 0x0a, 0xb0,
-	0xe2, 0x91, 0x91, 0xed, 0x12, 0x53, 0xfd, 0x11, 0x36, 0x2f, 0x89, 0x7f, 0x2f, 0xe2, 0xb3, 0x30,
-	0x3d, 0x76, 0x21, 0xd7, 0xb3, 0xdd, 0x80, 0x9a, 0xc2, 0x55, 0x1e, 0x4b, 0x49, 0xdd, 0x06, 0x94,
-	0x34, 0x96, 0xe7, 0xff, 0x13, 0x6c, 0xb6, 0x29, 0xeb, 0x58, 0x03, 0xea, 0x0e, 0xd9, 0x22, 0x97,
-	0x65, 0xc8, 0x9b, 0x43, 0x9f, 0x30, 0xcb, 0x75, 0xe4, 0xfa, 0x62, 0x99, 0xbb, 0x4d, 0x3a, 0x90,
-	0x6e, 0x09, 0xa0, 0xaa, 0xeb, 0x30, 0xdf, 0xb5, 0x5b, 0xae, 0xcf, 0x3e, 0xb3, 0x54, 0xfa, 0xc9,
-	0x73, 0x03, 0x1a, 0x2d, 0x35, 0x94, 0xd0, 0x6f, 0x64, 0x51, 0x86, 0x65, 0xbc, 0x21, 0x23, 0xcd,
-	0x3d, 0x8d, 0x4b, 0x51, 0xdd, 0x81, 0xad, 0x89, 0x29, 0xe4, 0xcc, 0xcf, 0x61, 0xab, 0x43, 0xee,
-	0x69, 0xdb, 0x21, 0x5e, 0x70, 0xe7, 0x2e, 0x9a, 0x5a, 0x3d, 0x80, 0xed, 0x49, 0xd8, 0xc2, 0xb2,
-	0xbc, 0x82, 0x3d, 0x39, 0x4f, 0xc5, 0x1c, 0x58, 0x41, 0x60, 0xb9, 0xce, 0xa2, 0xfd, 0x7c, 0x07,
-	0xcb, 0x36, 0x7d, 0xa0, 0xb6, 0x2c, 0xcc, 0x1d, 0xb9, 0xf0, 0xd8, 0xae, 0xce, 0x95, 0x38, 0xc4,
-	0xa8, 0x65, 0x28, 0xcd, 0xfa, 0x95, 0x9b, 0xf8, 0x77, 0x06, 0x36, 0xa6, 0x52, 0x77, 0x66, 0xb2,
-	0x24, 0xdf, 0xa5, 0xbf, 0x98, 0xef, 0x0e, 0x26, 0x42, 0x3b, 0x43, 0x60, 0x09, 0xaa, 0xfb, 0x0e,
-	0x96, 0xbd, 0x3b, 0x12, 0x50, 0x41, 0x8d, 0xe3, 0xcd, 0x8c, 0x19, 0x86, 0x2b, 0x71, 0x88, 0x41,
-	0x6f, 0xf8, 0x5d, 0xe4, 0x98, 0x16, 0x4f, 0x89, 0xa0, 0xb4, 0x3c, 0xbf, 0xa8, 0xaa, 0x31, 0x02,
-	0x27, 0xd0, 0xa8, 0x04, 0x2b, 0x83, 0xb0, 0xd6, 0x04, 0xad, 0x16, 0x70, 0x24, 0x72, 0x72, 0xf6,
-	0xa9, 0xe7, 0x96, 0x56, 0x24, 0x39, 0xcb, 0xbb, 0x4d, 0xf2, 0xfe, 0xf1, 0x85, 0xc5, 0x24, 0xa9,
-	0x08, 0x18, 0x7a, 0x05, 0x2b, 0xfe, 0xd0, 0xe1, 0x37, 0x59, 0x29, 0x2f, 0x2c, 0x9e, 0x4e, 0xaf,
-	0x00, 0x87, 0x6a, 0xdd, 0xb9, 0x75, 0x71, 0x84, 0x45, 0xa7, 0x90, 0x25, 0x43, 0x76, 0x57, 0x2a,
-	0x08, 0x9b, 0x6f, 0xa6, 0x6d, 0x2a, 0x43, 0x76, 0x47, 0x1d, 0x66, 0xf5, 0x44, 0xbe, 0x63, 0x81,
-	0x55, 0xff, 0x97, 0x82, 0xf5, 0x89, 0xa0, 0xa1, 0xdf, 0xc2, 0xc6, 0xc7, 0x68, 0xc0, 0xb0, 0x06,
-	0x7c, 0x37, 0xe1, 0x59, 0x15, 0xe3, 0x61, 0x9d, 0x8f, 0xa2, 0xa7, 0x50, 0xb0, 0xcc, 0x08, 0x22,
-	0xab, 0xc9, 0x32, 0xa5, 0xb2, 0x0c, 0x79, 0xce, 0x18, 0x36, 0x0d, 0x02, 0x71, 0x44, 0x79, 0x1c,
-	0xcb, 0x51, 0x6a, 0x66, 0xe3, 0xd4, 0x44, 0x2f, 0x61, 0x3d, 0xac, 0x18, 0xd3, 0xf0, 0x5c, 0x9f,
-	0xf1, 0xc0, 0x67, 0xe6, 0x15, 0xcc, 0x9a, 0x44, 0xf1, 0x81, 0xe0, 0xcb, 0xef, 0x30, 0x7e, 0x32,
-	0x2c, 0x2c, 0x6c, 0x71, 0x04, 0x05, 0x1c, 0x89, 0xea, 0x5f, 0x21, 0x1f, 0x79, 0x47, 0x08, 0xb2,
-	0x7c, 0x76, 0xb1, 0xdd, 0x75, 0x2c, 0xbe, 0x79, 0x65, 0x33, 0xe2, 0xf7, 0x29, 0x13, 0x3b, 0x5c,
-	0xc7, 0x52, 0x42, 0xaf, 0x00, 0x1e, 0xac, 0xc0, 0xba, 0xb1, 0x6c, 0xce, 0xf9, 0x99, 0x89, 0xcc,
-	0xe2, 0x0e, 0xaf, 0x62, 0x25, 0x4e, 0x00, 0x67, 0xb7, 0xae, 0xfe, 0x2b, 0x0b, 0x5b, 0x73, 0x12,
-	0x8b, 0x4f, 0x7c, 0x4b, 0x2c, 0x9b, 0x46, 0x95, 0x22, 0xa5, 0xe4, 0x56, 0xd2, 0x13, 0x5b, 0x41,
-	0x35, 0x28, 0x7a, 0x43, 0xdb, 0xb6, 0x9c, 0x7e, 0x78, 0x26, 0x81, 0x5c, 0xd6, 0xd7, 0x0b, 0xd3,
-	0xf7, 0xcc, 0x75, 0x6d, 0xbc, 0x2e, 0x8d, 0xc4, 0xb9, 0x05, 0xdc, 0x4b, 0xd4, 0xa4, 0xd0, 0x4f,
-	0x56, 0xc0, 0x02, 0x59, 0x36, 0x8f, 0x79, 0x91, 0x46, 0x9a, 0xb0, 0xe1, 0xc7, 0x1f, 0x48, 0x46,
-	0x12, 0x45, 0x54, 0xc0, 0xb1, 0x8c, 0xfe, 0x08, 0x3b, 0xb7, 0x96, 0x43, 0x6c, 0xe3, 0x86, 0xf4,
-	0xee, 0x87, 0x9e, 0xd1, 0x73, 0x07, 0x9e, 0x4d, 0x59, 0x74, 0x8e, 0x8f, 0x4c, 0xb4, 0x25, 0x6c,
-	0xcf, 0x84, 0x69, 0x55, 0x5a, 0xa2, 0xd7, 0x90, 0x37, 0xa9, 0x67, 0xbb, 0x23, 0x6a, 0x8a, 0x03,
-	0x7e, 0xd4, 0x4b, 0x0c, 0x47, 0x3a, 0x6c, 0x3a, 0x94, 0xf1, 0xd4, 0x36, 0x1c, 0x97, 0x19, 0x3e,
-	0x25, 0xe6, 0x48, 0x54, 0xdd, 0xa3, 0x3e, 0x36, 0xa4, 0x5d, 0x83, 0xb3, 0x2e, 0x31, 0x47, 0xe8,
-	0x67, 0xd8, 0xba, 0xb5, 0xfc, 0x80, 0x19, 0xc3, 0x80, 0xfa, 0x06, 0x89, 0x1a, 0x82, 0x82, 0x24,
-	0x91, 0xb0, 0x53, 0x3d, 0x8e, 0x3a, 0xd5, 0xe3, 0x4e, 0xd4, 0xa9, 0xe2, 0x4d, 0x61, 0xd6, 0x0d,
-	0xa8, 0x1f, 0x77, 0x0c, 0x7f, 0x81, 0xcd, 0x19, 0xf6, 0xe3, 0x77, 0xab, 0xfb, 0xd1, 0xa1, 0xbe,
-	0x4c, 0x89, 0x50, 0x40, 0x7b, 0x9c, 0x76, 0x18, 0x31, 0x2c, 0x53, 0x66, 0x44, 0x8e, 0x8b, 0xba,
-	0x89, 0x5e, 0x03, 0x04, 0xbc, 0x67, 0xa3, 0xa6, 0x41, 0x98, 0x24, 0xca, 0xcf, 0x2d, 0xa3, 0x20,
-	0xd1, 0x15, 0xa6, 0xbe, 0x80, 0xed, 0x79, 0x5c, 0xc3, 0x6b, 0xde, 0x71, 0x4d, 0x6a, 0x38, 0x64,
-	0x10, 0xd1, 0x42, 0x9e, 0x0f, 0x34, 0xc8, 0x80, 0xaa, 0x2e, 0xec, 0x2d, 0x20, 0x1b, 0xf4, 0x02,
-	0x0a, 0x24, 0xba, 0x1c, 0x84, 0xdd, 0xc2, 0x4b, 0x65, 0x8c, 0x43, 0xcf, 0x60, 0x55, 0xec, 0xd0,
-	0x60, 0xee, 0x3d, 0x8d, 0x2e, 0x6c, 0x10, 0x43, 0x1d, 0x3e, 0xa2, 0xfe, 0x3d, 0x0b, 0x68, 0xb6,
-	0xc3, 0xfd, 0x95, 0x18, 0xec, 0x0f, 0xb0, 0x7e, 0x4b, 0x09, 0x1b, 0xfa, 0xd4, 0xb8, 0xb5, 0x49,
-	0x3f, 0x10, 0xed, 0x52, 0x71, 0x96, 0x8a, 0xcf, 0x43, 0xd0, 0xb9, 0x4d, 0xfa, 0x78, 0xed, 0x76,
-	0x2c, 0x04, 0xe8, 0x1c, 0x56, 0x13, 0x0f, 0x16, 0xd9, 0x99, 0x7f, 0x3b, 0x4d, 0xfe, 0xb1, 0x23,
-	0x7d, 0x8c, 0xc5, 0x49, 0x43, 0xf4, 0x1c, 0x96, 0x3f, 0xcb, 0x8a, 0xa1, 0x16, 0xbd, 0x84, 0x15,
-	0xea, 0x3c, 0x3c, 0x10, 0x3f, 0x28, 0xe5, 0x04, 0x30, 0xba, 0xb7, 0x34, 0xe7, 0xc1, 0xf2, 0x5d,
-	0x67, 0x40, 0x1d, 0x76, 0x45, 0x7c, 0x8b, 0xdc, 0xd8, 0x14, 0x47, 0x50, 0xf4, 0x1d, 0x6c, 0xf6,
-	0xee, 0x68, 0xef, 0xde, 0x1d, 0x32, 0xc3, 0x76, 0xc3, 0xe3, 0x92, 0x24, 0xa9, 0x44, 0x8a, 0xba,
-	0x1c, 0x47, 0x47, 0x80, 0xc6, 0x91, 0x8d, 0xd1, 0x79, 0x81, 0xde, 0xfc, 0x38, 0xee, 0x39, 0x25,
-	0x7c, 0x1f, 0x32, 0x7d, 0x8b, 0xc9, 0x02, 0x28, 0xca, 0xd5, 0xf0, 0xcb, 0x8e, 0xaf, 0x9a, 0xab,
-	0x92, 0x6c, 0x06, 0x93, 0x6c, 0x36, 0x91, 0x31, 0xab, 0x5f, 0x96, 0x31, 0xea, 0x8f, 0xb0, 0x22,
-	0xdd, 0x73, 0x06, 0xe2, 0x65, 0x98, 0x4c, 0xd4, 0x48, 0xe6, 0x75, 0x44, 0x07, 0xc4, 0xb2, 0xa3,
-	0x1e, 0x55, 0x08, 0xea, 0x4f, 0xb0, 0x35, 0x27, 0x52, 0xfc, 0x56, 0x48, 0x38, 0xc9, 0x46, 0x0e,
-	0x66, 0x9b, 0x5c, 0x75, 0x98, 0x60, 0xf2, 0x71, 0xdf, 0xfd, 0x2b, 0xf5, 0x3b, 0x89, 0xe6, 0x22,
-	0x3b, 0xd1, 0x5c, 0x1c, 0xbe, 0x84, 0xad, 0x39, 0x2f, 0x26, 0xb4, 0x06, 0xf9, 0x46, 0x13, 0x5f,
-	0x56, 0xea, 0xf5, 0x6b, 0x65, 0x09, 0x6d, 0xc0, 0xaa, 0x7e, 0x79, 0xa9, 0xd5, 0xf4, 0x4a, 0x47,
-	0xab, 0x5f, 0x2b, 0xa9, 0xc3, 0x37, 0x50, 0x9c, 0x8c, 0x23, 0xda, 0x06, 0xa5, 0x52, 0xbb, 0xd4,
-	0x3b, 0x46, 0xf3, 0x7d, 0x43, 0xc3, 0x46, 0xb3, 0x21, 0x0c, 0x11, 0x14, 0xc3, 0x51, 0xed, 0x4a,
-	0xc3, 0xd7, 0xcd, 0x86, 0xa6, 0xa4, 0x0e, 0x75, 0x28, 0x4e, 0xde, 0x71, 0xe8, 0x29, 0xec, 0xb5,
-	0x9a, 0xb8, 0x63, 0x5c, 0xe9, 0x6d, 0xfd, 0x4c, 0xaf, 0xeb, 0x9d, 0x6b, 0xa3, 0x85, 0xf5, 0xab,
-	0x4a, 0x47, 0x53, 0x96, 0x50, 0x19, 0x76, 0x67, 0x94, 0xdd, 0xb3, 0xba, 0x5e, 0x55, 0x52, 0x87,
-	0x3f, 0xc0, 0xee, 0x7c, 0x7a, 0x45, 0x05, 0x58, 0x3e, 0xaf, 0xd4, 0xdb, 0xdc, 0x41, 0x1e, 0xb2,
-	0x1d, 0xdc, 0xd5, 0x94, 0x14, 0x1f, 0xd4, 0x2e, 0x5b, 0x9d, 0x6b, 0x25, 0x7d, 0xf8, 0xb7, 0x14,
-	0x14, 0x27, 0x7b, 0x38, 0xb4, 0x0a, 0x2b, 0xdd, 0xc6, 0xbb, 0x46, 0xf3, 0x7d, 0x43, 0x59, 0xe2,
-	0x42, 0x4b, 0x6b, 0xd4, 0xf4, 0xc6, 0x85, 0x92, 0xe2, 0xc1, 0xa8, 0x62, 0xad, 0xd2, 0xe1, 0x52,
-	0x1a, 0x29, 0xb0, 0xa6, 0x37, 0xf4, 0x8e, 0x5e, 0xa9, 0xeb, 0x1f, 0xf8, 0x48, 0x86, 0x83, 0x71,
-	0xb7, 0xd1, 0xe0, 0x42, 0x56, 0xc4, 0xaa, 0xd1, 0xd1, 0x30, 0xee, 0xb6, 0x3a, 0x5a, 0x4d, 0x59,
-	0xe1, 0xd6, 0xed, 0x4e, 0xb3, 0xd5, 0xe2, 0xea, 0x65, 0x8e, 0x15, 0x92, 0x56, 0x53, 0x72, 0x87,
-	0xff, 0x48, 0x25, 0x98, 0x32, 0x41, 0x05, 0x7c, 0xcd, 0x8d, 0x66, 0xb3, 0xa5, 0x2c, 0xa1, 0x22,
-	0x00, 0x8f, 0x85, 0x5e, 0xd7, 0x2e, 0xb4, 0x9a, 0x92, 0x42, 0x5b, 0xb0, 0x81, 0xb5, 0x0b, 0xbd,
-	0xdd, 0xc1, 0xd7, 0xc6, 0x79, 0xa5, 0x5a, 0xa9, 0x69, 0x4a, 0x06, 0x3d, 0x81, 0x9d, 0xf3, 0x6e,
-	0xbd, 0x6e, 0xbc, 0x6f, 0xe2, 0x77, 0xed, 0x56, 0xa5, 0xaa, 0x19, 0x67, 0x95, 0xea, 0xbb, 0x6e,
-	0x4b, 0xc9, 0x72, 0xfc, 0xb9, 0xfe, 0x8b, 0x56, 0x33, 0xb0, 0xd6, 0x6e, 0x76, 0x71, 0x55, 0x6b,
-	0x2b, 0xcb, 0xfc, 0x58, 0xba, 0x6d, 0x0d, 0x1b, 0x8d, 0xca, 0xa5, 0x26, 0xf0, 0x4a, 0x4e, 0xcd,
-	0xe6, 0xd3, 0x4a, 0xfa, 0xf0, 0x55, 0xa2, 0xa1, 0xe3, 0x2d, 0x90, 0xd8, 0x9b, 0x76, 0xd1, 0xad,
-	0x57, 0xb0, 0xb2, 0xc4, 0xb7, 0xd2, 0xc2, 0xda, 0x59, 0x57, 0xaf, 0xd7, 0xc2, 0x70, 0xb6, 0x70,
-	0xf3, 0x4c, 0x53, 0xd2, 0xa7, 0xff, 0xcc, 0x81, 0x32, 0xce, 0x3f, 0xe2, 0x90, 0x3e, 0xf5, 0x51,
-	0x1d, 0xd6, 0x27, 0x7e, 0x92, 0xa0, 0x88, 0xfd, 0xe6, 0xfd, 0x52, 0x29, 0x7f, 0x35, 0x5f, 0x29,
-	0x9f, 0x02, 0x4b, 0xa8, 0x09, 0xc5, 0x49, 0xb6, 0x46, 0x5f, 0xcd, 0xfd, 0x4d, 0x11, 0xf9, 0xfb,
-	0x7a, 0x81, 0x36, 0x76, 0x58, 0x87, 0xf5, 0x89, 0xcc, 0x8f, 0x97, 0x37, 0xef, 0xf7, 0x43, 0xf9,
-	0xab, 0xf9, 0xca, 0xd8, 0xdb, 0x2f, 0xb0, 0x39, 0xf3, 0x57, 0x00, 0x3d, 0x93, 0x46, 0x8b, 0xfe,
-	0x2d, 0x94, 0xf7, 0x17, 0x03, 0x62, 0xcf, 0x67, 0x50, 0x88, 0x5f, 0xd7, 0x68, 0x6f, 0xf6, 0xbd,
-	0x1d, 0x7a, 0x2a, 0x2d, 0x7a, 0x88, 0xab, 0x4b, 0xdf, 0xa7, 0x50, 0x15, 0x60, 0xfc, 0xea, 0x45,
-	0x11, 0x76, 0xe6, 0x15, 0x5d, 0x7e, 0x32, 0x47, 0x13, 0x2f, 0xa4, 0x0a, 0x30, 0x7e, 0xe3, 0xc6,
-	0x4e, 0x66, 0xde, 0xcd, 0xb1, 0x93, 0x39, 0x0f, 0xe2, 0x25, 0x7e, 0xad, 0x25, 0xde, 0xab, 0x28,
-	0xc2, 0xce, 0x3e, 0x93, 0xcb, 0xe5, 0x79, 0xaa, 0xd8, 0x8f, 0x0e, 0x6b, 0xc9, 0x97, 0x2b, 0x8a,
-	0xd0, 0x73, 0x5e, 0xbd, 0xe5, 0xa7, 0x73, 0x75, 0xb1, 0xab, 0x2e, 0x28, 0xd3, 0x4f, 0x50, 0xf4,
-	0xcd, 0xe4, 0xe4, 0xd3, 0x6f, 0xde, 0xf2, 0xb3, 0x85, 0xfa, 0xc8, 0xed, 0xd9, 0xef, 0x3e, 0x1c,
-	0xf6, 0x2d, 0x76, 0x37, 0xbc, 0x39, 0xee, 0xb9, 0x83, 0x93, 0xbe, 0xc5, 0x3c, 0xd7, 0x3c, 0xb2,
-	0x5c, 0xf9, 0x75, 0xf2, 0x31, 0x38, 0x1a, 0x84, 0x85, 0x72, 0x42, 0x3c, 0xeb, 0x26, 0x27, 0x5a,
-	0xaa, 0x17, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x26, 0x02, 0x2a, 0x9d, 0xcd, 0x14, 0x00, 0x00,
+	// 2699 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5f, 0x6f, 0xe3, 0xc6,
+	0x11, 0x37, 0xf5, 0xc7, 0x96, 0x46, 0xb6, 0x4c, 0xaf, 0xff, 0xc9, 0xba, 0x4b, 0xce, 0x60, 0x73,
+	0xa8, 0xe1, 0xf4, 0xec, 0xc0, 0xb9, 0x00, 0xb9, 0xa4, 0x40, 0x4e, 0x96, 0x69, 0x9f, 0x12, 0x59,
+	0x52, 0x56, 0xd2, 0x5d, 0xee, 0x5e, 0x08, 0x5a, 0x5a, 0xcb, 0x84, 0x29, 0x92, 0xe5, 0xae, 0x7c,
+	0xe7, 0x02, 0x7d, 0xca, 0x7b, 0xf3, 0xd2, 0xb7, 0x02, 0xfd, 0x20, 0xfd, 0x18, 0xfd, 0x06, 0x45,
+	0x3f, 0x45, 0x1f, 0x5a, 0x14, 0xbb, 0x5c, 0xd2, 0xa4, 0x44, 0x9d, 0xfd, 0x90, 0xbe, 0x71, 0x66,
+	0x7e, 0x33, 0xbb, 0x3b, 0x3b, 0x3b, 0x33, 0x1c, 0x80, 0x81, 0xeb, 0x93, 0x03, 0xcf, 0x77, 0x99,
+	0x8b, 0xf2, 0xef, 0xe9, 0xd8, 0x74, 0xaa, 0x4f, 0x07, 0xae, 0xc3, 0x88, 0xc3, 0x9e, 0x51, 0xe2,
+	0xdf, 0x58, 0x03, 0xf2, 0xcc, 0xf4, 0xac, 0x43, 0xcb, 0xb1, 0x98, 0x65, 0xda, 0xd6, 0x1f, 0x89,
+	0x1f, 0xa0, 0xab, 0x4f, 0x46, 0xae, 0x3b, 0xb2, 0xc9, 0xa1, 0xa0, 0x2e, 0x26, 0x97, 0x87, 0xcc,
+	0x1a, 0x13, 0xca, 0xcc, 0xb1, 0x17, 0x00, 0xb4, 0x2d, 0xd8, 0x38, 0x23, 0xec, 0x8d, 0xeb, 0x5f,
+	0x53, 0xcf, 0x1c, 0x10, 0x8a, 0xc9, 0x1f, 0x26, 0x84, 0x32, 0xed, 0x0c, 0x36, 0xa7, 0xf8, 0xd4,
+	0x73, 0x1d, 0x4a, 0xd0, 0x01, 0x2c, 0x52, 0x66, 0xb2, 0x09, 0xad, 0x28, 0xbb, 0xd9, 0xbd, 0xd2,
+	0xd1, 0xd6, 0x81, 0xd8, 0xd0, 0x41, 0x04, 0xed, 0x0a, 0x29, 0x96, 0x28, 0xed, 0x5f, 0x0a, 0x6c,
+	0x76, 0x99, 0xe9, 0xdf, 0xd9, 0x92, 0x4b, 0xa0, 0x32, 0x64, 0xac, 0x61, 0x45, 0xd9, 0x55, 0xf6,
+	0x8a, 0x38, 0x63, 0x0d, 0xd1, 0x53, 0x28, 0xcb, 0xc3, 0x18, 0x9e, 0x4f, 0x2e, 0xad, 0x0f, 0x95,
+	0x8c, 0x90, 0xad, 0x48, 0x6e, 0x47, 0x30, 0xd1, 0x73, 0x28, 0x8c, 0x09, 0x33, 0x87, 0x26, 0x33,
+	0x2b, 0xd9, 0x5d, 0x65, 0xaf, 0x74, 0x54, 0x99, 0xde, 0xc2, 0xb9, 0x94, 0xe3, 0x08, 0x89, 0x9e,
+	0x41, 0x8e, 0x7a, 0x64, 0x50, 0xc9, 0x09, 0x8d, 0x1d, 0xa9, 0x91, 0xdc, 0x58, 0xd7, 0x23, 0x03,
+	0x2c, 0x60, 0x68, 0x0f, 0x72, 0xec, 0xd6, 0x23, 0x95, 0xc5, 0x5d, 0x65, 0xaf, 0x7c, 0xb4, 0x31,
+	0xbd, 0x40, 0xef, 0xd6, 0x23, 0x58, 0x20, 0xbe, 0xcf, 0x15, 0xf2, 0xea, 0xa2, 0xb6, 0x0f, 0x5b,
+	0xd3, 0x87, 0x94, 0xfe, 0x52, 0x21, 0x3b, 0xf1, 0x6d, 0x79, 0x4c, 0xfe, 0xa9, 0xbd, 0x83, 0x8d,
+	0x2e, 0x73, 0xbd, 0x7b, 0xfd, 0x71, 0x04, 0x8b, 0x9e, 0x6b, 0x5b, 0x83, 0x5b, 0xe1, 0x87, 0xf2,
+	0x51, 0x35, 0xda, 0x74, 0x4c, 0xb9, 0x23, 0x10, 0x58, 0x22, 0xb5, 0x6d, 0xd8, 0x4c, 0x88, 0xc3,
+	0x6d, 0x68, 0xfb, 0x50, 0x39, 0x21, 0x74, 0xe0, 0x5b, 0x17, 0xe4, 0xbe, 0x85, 0x35, 0x17, 0x76,
+	0x52, 0xb0, 0x29, 0xf7, 0xaf, 0xdc, 0x7f, 0xff, 0x48, 0x83, 0x65, 0xdb, 0xa4, 0xac, 0x36, 0x60,
+	0xd6, 0x8d, 0xc5, 0x6e, 0xe5, 0x9d, 0x26, 0x78, 0x1a, 0x02, 0xb5, 0x3b, 0xb9, 0x08, 0x56, 0x0c,
+	0x03, 0xf0, 0xdf, 0x0a, 0xac, 0xc5, 0x98, 0x72, 0xf5, 0x2f, 0x1e, 0xb6, 0xfa, 0xab, 0x85, 0x68,
+	0xfd, 0x03, 0xc8, 0xda, 0xee, 0x48, 0x2c, 0x5b, 0x3a, 0xaa, 0x4e, 0xc3, 0x9b, 0xee, 0xe8, 0x9c,
+	0x50, 0x6a, 0x8e, 0xc8, 0xab, 0x05, 0xcc, 0x81, 0xe8, 0xf7, 0xb0, 0x78, 0x45, 0xcc, 0x21, 0xf1,
+	0x2b, 0x59, 0x11, 0xdf, 0x9f, 0x85, 0x5e, 0x9f, 0xde, 0xcb, 0xc1, 0x2b, 0x01, 0xd3, 0x1d, 0xe6,
+	0xdf, 0x62, 0xa9, 0x53, 0x7d, 0x01, 0xa5, 0x18, 0x9b, 0x5f, 0xfe, 0x35, 0xb9, 0x0d, 0x2f, 0xff,
+	0x9a, 0xdc, 0xa2, 0x0d, 0xc8, 0xdf, 0x98, 0xf6, 0x84, 0x48, 0x3f, 0x04, 0xc4, 0x37, 0x99, 0xaf,
+	0x95, 0xe3, 0x22, 0x2c, 0x79, 0xe6, 0xad, 0xed, 0x9a, 0x43, 0xed, 0x5b, 0x58, 0x3b, 0x37, 0xfd,
+	0x6b, 0xe1, 0x9f, 0xb9, 0xe1, 0xb1, 0x05, 0x8b, 0x03, 0xdb, 0xa5, 0x64, 0x28, 0x4c, 0x15, 0xb0,
+	0xa4, 0xb4, 0x0d, 0x40, 0x71, 0x65, 0x79, 0xff, 0xdf, 0xc1, 0x5a, 0x97, 0xb0, 0x9e, 0x35, 0x26,
+	0xee, 0x84, 0xcd, 0x33, 0x59, 0x85, 0xc2, 0x70, 0xe2, 0x9b, 0xcc, 0x72, 0x1d, 0xb9, 0xbf, 0x88,
+	0xe6, 0x66, 0xe3, 0x06, 0xa4, 0x59, 0x13, 0x50, 0xdd, 0x75, 0x98, 0xef, 0xda, 0x1d, 0xd7, 0x67,
+	0x1f, 0xd9, 0x2a, 0xf9, 0xe0, 0xb9, 0x94, 0x84, 0x5b, 0x0d, 0x28, 0xf4, 0x1b, 0xf9, 0x28, 0x83,
+	0x67, 0xbc, 0x2a, 0x3d, 0xcd, 0x2d, 0xdd, 0x3d, 0x45, 0x6d, 0x13, 0xd6, 0x13, 0x4b, 0xc8, 0x95,
+	0x9f, 0xc2, 0x7a, 0xcf, 0xbc, 0x26, 0x5d, 0xc7, 0xf4, 0xe8, 0x95, 0x3b, 0x6f, 0x69, 0x6d, 0x0f,
+	0x36, 0x92, 0xb0, 0xb9, 0xcf, 0xf2, 0x35, 0x6c, 0xcb, 0x75, 0x6a, 0xc3, 0xb1, 0x45, 0xa9, 0xe5,
+	0x3a, 0xf3, 0xce, 0xf3, 0x39, 0xe4, 0x6d, 0x72, 0x43, 0x6c, 0xf9, 0x30, 0x37, 0xe5, 0xc6, 0x23,
+	0xbd, 0x26, 0x17, 0xe2, 0x00, 0xa3, 0x55, 0xa1, 0x32, 0x6b, 0x57, 0x1e, 0xe2, 0x25, 0x54, 0xce,
+	0x08, 0xeb, 0xf8, 0xe4, 0x62, 0x62, 0xd9, 0x43, 0x4c, 0xbc, 0x8f, 0x38, 0x71, 0x03, 0xf2, 0xee,
+	0x7b, 0x87, 0xf8, 0x61, 0xe4, 0x08, 0x42, 0xbb, 0x82, 0x9d, 0x14, 0x0b, 0xf2, 0x90, 0x55, 0x28,
+	0x50, 0x79, 0x70, 0x69, 0x28, 0xa2, 0xd1, 0x21, 0xe4, 0x99, 0x49, 0xaf, 0x69, 0x25, 0xb3, 0x9b,
+	0x8d, 0x65, 0xc4, 0xd0, 0x52, 0xcf, 0xa4, 0xd7, 0xd2, 0x5a, 0x80, 0xd3, 0xfe, 0xa1, 0x00, 0x9a,
+	0x95, 0xce, 0x6c, 0x13, 0x41, 0xce, 0x31, 0xc7, 0x61, 0x7c, 0x8b, 0x6f, 0x7e, 0xff, 0x97, 0xa6,
+	0x65, 0x93, 0xa1, 0xb8, 0xe9, 0x02, 0x96, 0x14, 0xaa, 0xc0, 0x12, 0xff, 0x9a, 0xf8, 0x44, 0xe4,
+	0xe5, 0x22, 0x0e, 0x49, 0xf4, 0x08, 0x8a, 0xe4, 0x83, 0xc5, 0x8c, 0x81, 0x3b, 0x24, 0x95, 0xfc,
+	0xae, 0xb2, 0x97, 0xc7, 0x05, 0xce, 0xa8, 0xbb, 0x43, 0x91, 0x04, 0xbc, 0x2b, 0x93, 0x12, 0x5a,
+	0x59, 0xdc, 0xcd, 0xc6, 0xf2, 0x7f, 0x7c, 0x77, 0x1d, 0x0e, 0xc0, 0x12, 0x87, 0xb6, 0x61, 0xc9,
+	0x76, 0x47, 0x06, 0xbf, 0xf1, 0x25, 0xb1, 0xd0, 0xa2, 0xed, 0x8e, 0xfa, 0xbe, 0xad, 0x11, 0x58,
+	0x9b, 0xd1, 0x8a, 0x8e, 0xa0, 0xc4, 0x8e, 0x90, 0xd8, 0x50, 0x66, 0x6a, 0x43, 0x4f, 0xa0, 0x14,
+	0xbe, 0x13, 0x63, 0x4c, 0xc5, 0x21, 0xb3, 0x18, 0x42, 0xd6, 0x39, 0xd5, 0x7e, 0x56, 0x00, 0x9d,
+	0xf9, 0xa6, 0xc3, 0x6a, 0x83, 0x01, 0xa1, 0x74, 0xde, 0x15, 0x6f, 0xc3, 0xd2, 0x84, 0x12, 0xdf,
+	0xb0, 0x86, 0xd2, 0x7d, 0x8b, 0x9c, 0x6c, 0x0c, 0xd1, 0x1e, 0xe4, 0xe9, 0xc0, 0xf5, 0x88, 0x30,
+	0x5d, 0x3e, 0x42, 0x61, 0xc0, 0x09, 0x6b, 0x5d, 0x2e, 0xc1, 0x01, 0x20, 0xf1, 0x84, 0x73, 0x53,
+	0x4f, 0xf8, 0x14, 0xd6, 0x13, 0x9b, 0x90, 0x51, 0xb2, 0x03, 0x85, 0x11, 0x67, 0x1b, 0xd1, 0x5e,
+	0x96, 0x04, 0xdd, 0x10, 0x31, 0xc7, 0xdc, 0x6b, 0x12, 0x66, 0x83, 0x80, 0xd0, 0x5e, 0xc2, 0x3a,
+	0x26, 0x37, 0xee, 0x35, 0xf9, 0xf8, 0x69, 0xe2, 0x76, 0x33, 0x09, 0xbb, 0xbc, 0xeb, 0x48, 0x5a,
+	0x90, 0xef, 0xe1, 0x6f, 0x59, 0x58, 0x9d, 0x4a, 0xe5, 0x33, 0x66, 0xe3, 0xf5, 0x3f, 0xf3, 0xe0,
+	0xfa, 0xbf, 0x97, 0x48, 0x35, 0x33, 0x05, 0x3d, 0x56, 0xfa, 0x3f, 0x87, 0xbc, 0x88, 0x9a, 0x4a,
+	0x2e, 0xf1, 0xb8, 0xef, 0x2a, 0x2e, 0x17, 0xe2, 0x00, 0x83, 0xbe, 0xe1, 0xbd, 0x99, 0x33, 0xb4,
+	0xb8, 0x7f, 0x69, 0x25, 0x9f, 0x5e, 0x64, 0xea, 0x11, 0x02, 0xc7, 0xd0, 0x3c, 0xfa, 0xc7, 0x41,
+	0xed, 0x11, 0x6d, 0x46, 0x11, 0x87, 0x24, 0x6f, 0x56, 0x7c, 0xe2, 0xb9, 0x22, 0x56, 0xf9, 0xd3,
+	0x94, 0xbd, 0x9e, 0xec, 0x83, 0x0e, 0xce, 0x2c, 0x26, 0x8b, 0xac, 0x80, 0xa1, 0xaf, 0x60, 0xc9,
+	0x9f, 0x38, 0xbc, 0xb3, 0xab, 0x14, 0x84, 0xc6, 0xa3, 0xe9, 0x1d, 0xe0, 0x40, 0xdc, 0x70, 0x2e,
+	0x5d, 0x1c, 0x62, 0xd1, 0x11, 0xe4, 0xcc, 0x09, 0xbb, 0xaa, 0x14, 0x85, 0xce, 0xa7, 0xd3, 0x3a,
+	0xb5, 0x09, 0xbb, 0x22, 0x0e, 0xb3, 0x06, 0x22, 0x78, 0xb0, 0xc0, 0x6a, 0xff, 0x51, 0x60, 0x25,
+	0xe1, 0x34, 0xf4, 0x5b, 0x58, 0x7d, 0x1f, 0x32, 0x0c, 0x6b, 0xcc, 0x4f, 0x13, 0xdc, 0x55, 0x39,
+	0x62, 0x37, 0x38, 0x97, 0xbf, 0x20, 0x6b, 0x18, 0x42, 0x64, 0x75, 0xb1, 0x86, 0x52, 0x58, 0x85,
+	0x02, 0xaf, 0xa0, 0x36, 0xa1, 0x54, 0xe6, 0x88, 0x88, 0x0e, 0x53, 0x75, 0x2e, 0x4a, 0xd5, 0xe8,
+	0x39, 0xac, 0x04, 0x15, 0x64, 0x68, 0xf0, 0x1c, 0xc4, 0x1d, 0x9f, 0x4d, 0x2b, 0x20, 0xcb, 0x12,
+	0xc5, 0x19, 0xf4, 0xe1, 0x3d, 0x1d, 0xbf, 0x19, 0x16, 0x14, 0x3a, 0x99, 0x2e, 0x42, 0x52, 0xfb,
+	0xa7, 0x02, 0x85, 0xd0, 0x3c, 0xcf, 0x13, 0x7c, 0x79, 0x71, 0xde, 0x15, 0x2c, 0xbe, 0x79, 0xaa,
+	0x63, 0xa6, 0x3f, 0x22, 0x4c, 0x1c, 0x71, 0x05, 0x4b, 0x0a, 0x7d, 0x05, 0x70, 0x63, 0x51, 0xeb,
+	0xc2, 0xb2, 0x79, 0x13, 0x94, 0x4d, 0x84, 0x16, 0x37, 0xf8, 0x3a, 0x12, 0xe2, 0x18, 0x30, 0xe5,
+	0xec, 0x87, 0x50, 0x10, 0x9d, 0xfb, 0xc0, 0xb5, 0x45, 0xbc, 0x95, 0x8f, 0xd6, 0x63, 0x66, 0x3a,
+	0x52, 0x84, 0x23, 0x10, 0x3a, 0x04, 0xf0, 0x4d, 0x46, 0x0c, 0xdb, 0x1a, 0x5b, 0x4c, 0x1c, 0xbe,
+	0x74, 0xa4, 0x4a, 0x15, 0x6c, 0x32, 0xd2, 0xe4, 0x7c, 0x5c, 0xf4, 0xc3, 0x4f, 0xed, 0x47, 0x28,
+	0x46, 0x7c, 0x74, 0x00, 0xeb, 0x7e, 0xf0, 0xbe, 0xa9, 0xe1, 0x11, 0xdf, 0xa0, 0x84, 0x47, 0xb0,
+	0x3c, 0xf2, 0x5a, 0x28, 0xea, 0x10, 0xbf, 0x2b, 0x04, 0x3c, 0x63, 0x5c, 0x4c, 0x7c, 0x1a, 0x1e,
+	0x3f, 0x20, 0xb4, 0xbf, 0xe4, 0x61, 0x3d, 0xe5, 0x39, 0xc4, 0x0a, 0x43, 0x10, 0x33, 0xb1, 0xc2,
+	0x10, 0x5e, 0x40, 0x26, 0x71, 0x01, 0xe8, 0x04, 0xca, 0xde, 0xc4, 0xb6, 0x2d, 0x67, 0x14, 0x44,
+	0x12, 0x95, 0xbe, 0xfc, 0x64, 0xee, 0xa3, 0x3b, 0x76, 0x5d, 0x1b, 0xaf, 0x48, 0x25, 0x11, 0x6d,
+	0x94, 0x5b, 0x09, 0x7f, 0x35, 0xc8, 0x07, 0x8b, 0x32, 0x5a, 0xc9, 0x3d, 0xc8, 0x8a, 0x54, 0xd2,
+	0x85, 0x4e, 0xa2, 0xbc, 0xe6, 0xa7, 0xca, 0xeb, 0x8f, 0xb0, 0x79, 0x69, 0x39, 0xa6, 0x6d, 0x5c,
+	0x98, 0x83, 0xeb, 0x89, 0x67, 0x0c, 0xdc, 0xb1, 0x67, 0x13, 0x16, 0x46, 0xdf, 0x3d, 0x0b, 0xad,
+	0x0b, 0xdd, 0x63, 0xa1, 0x5a, 0x97, 0x9a, 0xe8, 0x05, 0x14, 0x86, 0xc4, 0xb3, 0xdd, 0x5b, 0x32,
+	0xac, 0x2c, 0x3d, 0xc4, 0x4a, 0x04, 0x47, 0x0d, 0x58, 0x73, 0x08, 0xe3, 0x0f, 0xd2, 0x70, 0x5c,
+	0x66, 0xf8, 0xc4, 0x1c, 0xde, 0x56, 0x0a, 0x0f, 0xb1, 0xb1, 0x2a, 0xf5, 0x5a, 0x2e, 0xc3, 0x5c,
+	0x0b, 0x7d, 0x0f, 0xeb, 0x97, 0x96, 0x4f, 0x99, 0x21, 0x2a, 0x95, 0x19, 0xb6, 0xf5, 0x45, 0x99,
+	0xfa, 0x82, 0xff, 0xcd, 0x83, 0xf0, 0x7f, 0xf3, 0xa0, 0x17, 0xfe, 0x6f, 0xe2, 0x35, 0xa1, 0xd6,
+	0xa7, 0xc4, 0x0f, 0xfb, 0x7e, 0xf4, 0x2d, 0x94, 0xf8, 0x7f, 0x80, 0xf4, 0x51, 0x05, 0xee, 0xb5,
+	0x01, 0x1c, 0x1e, 0xb8, 0x05, 0xd5, 0x40, 0x95, 0x79, 0xd1, 0xf0, 0x7c, 0x77, 0xe4, 0xf3, 0xd4,
+	0x51, 0x4a, 0xfc, 0x14, 0xd4, 0x03, 0x71, 0x47, 0x4a, 0xf1, 0xea, 0x20, 0xc9, 0xd0, 0x7e, 0x51,
+	0x60, 0x75, 0x0a, 0x84, 0x1e, 0x43, 0xd1, 0xf5, 0x88, 0xac, 0xa0, 0x41, 0x54, 0xde, 0x31, 0x78,
+	0x78, 0x07, 0xc5, 0x41, 0x16, 0x44, 0x41, 0xf0, 0x70, 0xf5, 0x88, 0x3f, 0x20, 0x0e, 0x13, 0xd1,
+	0x98, 0xc7, 0x21, 0xc9, 0x25, 0x26, 0x63, 0x64, 0xec, 0x31, 0x11, 0x61, 0x79, 0x1c, 0x92, 0xdc,
+	0x12, 0xf1, 0x7d, 0xd7, 0x97, 0x91, 0x13, 0x10, 0xda, 0x9f, 0x60, 0x6d, 0xa6, 0x8a, 0xdd, 0x75,
+	0x7e, 0x4a, 0xac, 0xf3, 0xe3, 0xcd, 0x02, 0xaf, 0x6e, 0xb1, 0x66, 0x81, 0x93, 0x8d, 0x21, 0x7a,
+	0x01, 0x40, 0x99, 0xe9, 0x33, 0x32, 0x34, 0x4c, 0x56, 0xc9, 0xde, 0xeb, 0xd4, 0xa2, 0x44, 0xd7,
+	0x98, 0xf6, 0x25, 0x6c, 0xa4, 0xd5, 0x0c, 0x9e, 0xbb, 0x1d, 0x77, 0x48, 0x8c, 0x58, 0x5b, 0x54,
+	0xe0, 0x8c, 0x96, 0x39, 0x26, 0xda, 0x5f, 0x15, 0xd8, 0x9e, 0x53, 0x35, 0xd0, 0x97, 0x50, 0x34,
+	0xc3, 0xae, 0xb7, 0xa2, 0x24, 0xb2, 0xde, 0x54, 0xb7, 0x7c, 0x87, 0xe3, 0xed, 0x94, 0x38, 0xa2,
+	0x11, 0xef, 0x3d, 0x40, 0xb0, 0x7a, 0x9c, 0x83, 0xf6, 0x61, 0x51, 0x74, 0x12, 0x54, 0xfe, 0xa3,
+	0x25, 0xfb, 0x21, 0xd1, 0xe3, 0x60, 0x89, 0xd0, 0xfe, 0xae, 0x40, 0x29, 0xc6, 0xff, 0x7f, 0xf4,
+	0x5c, 0x2f, 0x00, 0xc8, 0x07, 0xcf, 0xf2, 0x09, 0xe5, 0x0e, 0xcf, 0xdd, 0xef, 0x70, 0x89, 0xae,
+	0x31, 0xf4, 0x09, 0x80, 0x38, 0xa4, 0x71, 0x65, 0xd2, 0x2b, 0x19, 0x0a, 0x45, 0xc1, 0x79, 0x65,
+	0xd2, 0x2b, 0xed, 0xcf, 0x39, 0x40, 0xb3, 0x33, 0x8a, 0x5f, 0xa9, 0xe6, 0xbe, 0x84, 0x95, 0x4b,
+	0x62, 0xb2, 0x89, 0x4f, 0x8c, 0x4b, 0xdb, 0x1c, 0x05, 0xce, 0x2c, 0xcf, 0x36, 0x0f, 0xa7, 0x01,
+	0xe8, 0xd4, 0x36, 0x47, 0x78, 0xf9, 0xf2, 0x8e, 0xa0, 0xe8, 0x14, 0x4a, 0xb1, 0x91, 0x93, 0x3c,
+	0xf9, 0x67, 0xd3, 0xed, 0x4a, 0x64, 0xa8, 0x71, 0x87, 0xc5, 0x71, 0x45, 0xf4, 0x14, 0xf2, 0x1f,
+	0xad, 0xe3, 0x81, 0x14, 0x3d, 0x87, 0x25, 0xe2, 0xdc, 0xdc, 0x98, 0x7e, 0xd8, 0xf8, 0x87, 0x9d,
+	0x96, 0xee, 0xdc, 0x58, 0xbe, 0xeb, 0x8c, 0x89, 0xc3, 0x5e, 0x9b, 0xbe, 0x65, 0x5e, 0xd8, 0x04,
+	0x87, 0x50, 0xf4, 0x39, 0xac, 0x0d, 0xae, 0xc8, 0xe0, 0xda, 0x9d, 0x30, 0xc3, 0x76, 0x83, 0xb8,
+	0x94, 0x65, 0x5d, 0x0d, 0x05, 0x4d, 0xc9, 0x47, 0xcf, 0x00, 0xdd, 0x79, 0x36, 0x42, 0x17, 0x04,
+	0x7a, 0xed, 0xfd, 0xdd, 0xd4, 0x40, 0xc2, 0x77, 0x21, 0x3b, 0xb2, 0x98, 0x4c, 0x7e, 0x65, 0xb9,
+	0x9b, 0x33, 0x2b, 0xd8, 0x35, 0x17, 0xc5, 0x2b, 0x19, 0x24, 0x2b, 0x59, 0xe2, 0x69, 0x94, 0x1e,
+	0xf6, 0x34, 0xb4, 0x6f, 0x61, 0x49, 0x9a, 0xe7, 0xd5, 0x87, 0x47, 0x6a, 0xfc, 0x49, 0x86, 0xb4,
+	0x48, 0x2e, 0x63, 0xd3, 0xb2, 0xc3, 0x34, 0x25, 0x08, 0xed, 0x3b, 0x58, 0x4f, 0xf1, 0x54, 0xea,
+	0xef, 0x4e, 0xea, 0x98, 0x42, 0x9b, 0xc0, 0x7a, 0xca, 0xe4, 0xe4, 0x57, 0xea, 0xd0, 0x63, 0xed,
+	0x70, 0x2e, 0xd1, 0x0e, 0xef, 0x3f, 0x87, 0xf5, 0x94, 0x99, 0x17, 0x5a, 0x86, 0x42, 0xab, 0x8d,
+	0xcf, 0x6b, 0xcd, 0xe6, 0x5b, 0x75, 0x01, 0xad, 0x42, 0xa9, 0x71, 0x7e, 0xae, 0x9f, 0x34, 0x6a,
+	0x3d, 0xbd, 0xf9, 0x56, 0x55, 0xf6, 0xbf, 0x81, 0x72, 0xd2, 0x8f, 0x68, 0x03, 0xd4, 0xda, 0xc9,
+	0x79, 0xa3, 0x67, 0xb4, 0xdf, 0xb4, 0x74, 0x6c, 0xb4, 0x5b, 0x42, 0x11, 0x41, 0x39, 0xe0, 0xea,
+	0xaf, 0x75, 0xfc, 0xb6, 0xdd, 0xd2, 0x55, 0x65, 0xff, 0x67, 0x05, 0x96, 0xe3, 0xed, 0x14, 0xda,
+	0x02, 0xd4, 0x69, 0xe3, 0x9e, 0xd1, 0xc1, 0xed, 0x5e, 0xbb, 0xde, 0x6e, 0x1a, 0xaf, 0x7a, 0xbd,
+	0x8e, 0xba, 0x80, 0x36, 0x61, 0x6d, 0x8a, 0x7f, 0x54, 0x57, 0x95, 0x59, 0x76, 0xaf, 0xd9, 0x55,
+	0x33, 0xb3, 0x56, 0xce, 0x70, 0xa7, 0xae, 0x66, 0x53, 0xe0, 0xf5, 0x8e, 0x9a, 0xdb, 0x6f, 0x40,
+	0x39, 0xd9, 0x1a, 0xa2, 0x47, 0xb0, 0x2d, 0x80, 0xaf, 0x1b, 0xdd, 0xc6, 0x71, 0xa3, 0xd9, 0xe8,
+	0xbd, 0x35, 0x3a, 0xb8, 0xf1, 0xba, 0xd6, 0xd3, 0xd5, 0x05, 0x54, 0x85, 0xad, 0x19, 0x61, 0xff,
+	0xb8, 0xd9, 0xa8, 0xab, 0xca, 0xfe, 0xd7, 0xb0, 0x95, 0x5e, 0xe0, 0x51, 0x11, 0xf2, 0xa7, 0xb5,
+	0x66, 0x97, 0x1b, 0x28, 0x40, 0xae, 0x87, 0xfb, 0xba, 0xaa, 0x70, 0xa6, 0x7e, 0xde, 0xe9, 0xbd,
+	0x55, 0x33, 0xdc, 0x15, 0xe5, 0xe4, 0xbf, 0x0f, 0x2a, 0xc1, 0x52, 0xbf, 0xf5, 0x43, 0xab, 0xfd,
+	0xa6, 0xa5, 0x2e, 0x70, 0xa2, 0xa3, 0xb7, 0x4e, 0x1a, 0xad, 0x33, 0x55, 0xe1, 0x57, 0x52, 0xc7,
+	0x7a, 0xad, 0xc7, 0xa9, 0x0c, 0x52, 0x61, 0xb9, 0xd1, 0x6a, 0xf4, 0x1a, 0xb5, 0x66, 0xe3, 0x1d,
+	0xe7, 0x64, 0x39, 0x18, 0xf7, 0x5b, 0x2d, 0x4e, 0xe4, 0xc4, 0x8d, 0xb5, 0x7a, 0x3a, 0xc6, 0xfd,
+	0x4e, 0x4f, 0x3f, 0x51, 0x97, 0xb8, 0x76, 0xb7, 0xd7, 0xee, 0x74, 0xb8, 0x38, 0xcf, 0xb1, 0x82,
+	0xd2, 0x4f, 0xd4, 0xc5, 0xfd, 0x97, 0x50, 0x8a, 0x25, 0x5e, 0x7e, 0xd4, 0x5a, 0xbd, 0xae, 0x77,
+	0xbb, 0x46, 0xb7, 0xde, 0xee, 0xe8, 0x06, 0xd6, 0x6b, 0x27, 0xe1, 0x7d, 0x6e, 0xc2, 0x5a, 0x42,
+	0x76, 0xda, 0x6f, 0x36, 0x55, 0x65, 0xff, 0x17, 0x05, 0x36, 0xd2, 0x52, 0x1a, 0x3f, 0x75, 0xab,
+	0xdd, 0xe6, 0x97, 0x59, 0x06, 0xe0, 0xde, 0x6c, 0x34, 0xf5, 0x33, 0xfd, 0x44, 0x55, 0xd0, 0x3a,
+	0xac, 0x62, 0xfd, 0xac, 0xd1, 0xed, 0xe1, 0xb7, 0xc6, 0x69, 0xad, 0x5e, 0x3b, 0xd1, 0xd5, 0x2c,
+	0xda, 0x81, 0x4d, 0x6e, 0xd1, 0x78, 0xd3, 0xc6, 0x3f, 0x74, 0x3b, 0xb5, 0xba, 0x6e, 0x1c, 0xd7,
+	0xea, 0x3f, 0xf4, 0x3b, 0x6a, 0x8e, 0xe3, 0x4f, 0x1b, 0x3f, 0xe9, 0x27, 0x06, 0xd6, 0xbb, 0xed,
+	0x3e, 0xae, 0xeb, 0x5d, 0x35, 0xcf, 0xc3, 0xab, 0xdf, 0xd5, 0xb1, 0xd1, 0xaa, 0x9d, 0xeb, 0x02,
+	0xaf, 0x2e, 0x6a, 0xb9, 0x42, 0x46, 0xcd, 0xec, 0x7f, 0x05, 0x2b, 0x89, 0x9f, 0x0f, 0xe1, 0x1d,
+	0xfd, 0xac, 0xdf, 0xac, 0x61, 0x75, 0x81, 0x3b, 0xa3, 0x83, 0xf5, 0xe3, 0x7e, 0xa3, 0x79, 0x12,
+	0x5c, 0x48, 0x07, 0xb7, 0x8f, 0x75, 0x35, 0x73, 0xf4, 0xdf, 0x25, 0x50, 0xef, 0xde, 0x91, 0xe9,
+	0x98, 0x23, 0xe2, 0xa3, 0x26, 0xac, 0x24, 0xc6, 0xf5, 0x28, 0xcc, 0xe2, 0x69, 0xc3, 0xfd, 0xea,
+	0xe3, 0x74, 0xa1, 0xfc, 0x09, 0x5f, 0x40, 0x6d, 0x28, 0x27, 0xab, 0x0e, 0x7a, 0x9c, 0x3a, 0x30,
+	0x0f, 0xed, 0x7d, 0x32, 0x47, 0x1a, 0x19, 0x6c, 0xc2, 0x4a, 0xe2, 0x05, 0x47, 0xdb, 0x4b, 0x1b,
+	0x84, 0x57, 0x1f, 0xa7, 0x0b, 0x23, 0x6b, 0x3f, 0xc1, 0xda, 0xcc, 0x7c, 0x1a, 0x3d, 0x91, 0x4a,
+	0xf3, 0xa6, 0xdc, 0xd5, 0xdd, 0xf9, 0x80, 0xc8, 0xf2, 0x31, 0x14, 0xa3, 0x39, 0x2f, 0xda, 0x9e,
+	0x9d, 0xfc, 0x06, 0x96, 0x2a, 0xf3, 0x46, 0xc2, 0xda, 0xc2, 0x17, 0x0a, 0xaa, 0x03, 0xdc, 0xcd,
+	0x5f, 0x51, 0x88, 0x9d, 0x99, 0xe7, 0x56, 0x77, 0x52, 0x24, 0xd1, 0x46, 0xea, 0x00, 0x77, 0xd3,
+	0xd6, 0xc8, 0xc8, 0xcc, 0x04, 0xb7, 0xba, 0x93, 0x22, 0x89, 0x8c, 0x9c, 0x42, 0x29, 0x36, 0x39,
+	0x45, 0x3b, 0xb1, 0xb6, 0x38, 0x39, 0xb0, 0xad, 0x56, 0xd3, 0x44, 0x91, 0x9d, 0x06, 0x2c, 0xc7,
+	0x67, 0xa8, 0x28, 0x44, 0xa7, 0xcc, 0x5f, 0xab, 0x8f, 0x52, 0x65, 0x91, 0xa9, 0x3e, 0xa8, 0xd3,
+	0xc3, 0x50, 0xf4, 0x69, 0x72, 0xf1, 0xe9, 0xe9, 0x6b, 0xf5, 0xc9, 0x5c, 0x79, 0x3c, 0x22, 0x66,
+	0xa6, 0xa0, 0x51, 0x44, 0xcc, 0x9b, 0xb0, 0x56, 0x77, 0xe7, 0x03, 0xe2, 0x3e, 0x8c, 0xcd, 0xcc,
+	0x22, 0x1f, 0xce, 0x0e, 0xf3, 0xaa, 0xd5, 0x34, 0x51, 0xdc, 0x87, 0xf1, 0x89, 0x57, 0xe4, 0xc3,
+	0x94, 0x41, 0x5a, 0xf5, 0x51, 0xaa, 0x2c, 0x34, 0x75, 0xfc, 0xbb, 0x77, 0xfb, 0x23, 0x8b, 0x5d,
+	0x4d, 0x2e, 0x0e, 0x06, 0xee, 0xf8, 0x70, 0x64, 0x31, 0xcf, 0x1d, 0x3e, 0xb3, 0x5c, 0xf9, 0x75,
+	0xf8, 0x9e, 0x3e, 0x1b, 0x07, 0x59, 0xe1, 0xd0, 0xf4, 0xac, 0x8b, 0x45, 0xd1, 0x80, 0x7e, 0xf9,
+	0xbf, 0x01, 0x00, 0x22, 0xa7, 0xff, 0x15, 0x44, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2239,6 +3058,12 @@ type WorkspaceManagerClient interface {
 	TakeSnapshot(ctx context.Context, in *TakeSnapshotRequest, opts ...grpc.CallOption) (*TakeSnapshotResponse, error)
 	// controlAdmission makes a workspace accessible for everyone or for the owner only
 	ControlAdmission(ctx context.Context, in *ControlAdmissionRequest, opts ...grpc.CallOption) (*ControlAdmissionResponse, error)
+	// getPrebuildReport provides the report and task logs a prebuild uploaded next to its snapshot
+	GetPrebuildReport(ctx context.Context, in *GetPrebuildReportRequest, opts ...grpc.CallOption) (*GetPrebuildReportResponse, error)
+	// grantAccess gives a specific user or the holder of a share token access to a workspace
+	GrantAccess(ctx context.Context, in *GrantAccessRequest, opts ...grpc.CallOption) (*GrantAccessResponse, error)
+	// revokeAccess withdraws an access grant. ws-proxy learns about the revocation through the status update.
+	RevokeAccess(ctx context.Context, in *RevokeAccessRequest, opts ...grpc.CallOption) (*RevokeAccessResponse, error)
 }
 
 type workspaceManagerClient struct {
@@ -2362,6 +3187,33 @@ func (c *workspaceManagerClient) ControlAdmission(ctx context.Context, in *Contr
 	return out, nil
 }
 
+func (c *workspaceManagerClient) GetPrebuildReport(ctx context.Context, in *GetPrebuildReportRequest, opts ...grpc.CallOption) (*GetPrebuildReportResponse, error) {
+	out := new(GetPrebuildReportResponse)
+	err := c.cc.Invoke(ctx, "/wsman.WorkspaceManager/GetPrebuildReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceManagerClient) GrantAccess(ctx context.Context, in *GrantAccessRequest, opts ...grpc.CallOption) (*GrantAccessResponse, error) {
+	out := new(GrantAccessResponse)
+	err := c.cc.Invoke(ctx, "/wsman.WorkspaceManager/GrantAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceManagerClient) RevokeAccess(ctx context.Context, in *RevokeAccessRequest, opts ...grpc.CallOption) (*RevokeAccessResponse, error) {
+	out := new(RevokeAccessResponse)
+	err := c.cc.Invoke(ctx, "/wsman.WorkspaceManager/RevokeAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkspaceManagerServer is the server API for WorkspaceManager service.
 type WorkspaceManagerServer interface {
 	// getWorkspaces produces a list of running workspaces and their status
@@ -2384,6 +3236,12 @@ type WorkspaceManagerServer interface {
 	TakeSnapshot(context.Context, *TakeSnapshotRequest) (*TakeSnapshotResponse, error)
 	// controlAdmission makes a workspace accessible for everyone or for the owner only
 	ControlAdmission(context.Context, *ControlAdmissionRequest) (*ControlAdmissionResponse, error)
+	// getPrebuildReport provides the report and task logs a prebuild uploaded next to its snapshot
+	GetPrebuildReport(context.Context, *GetPrebuildReportRequest) (*GetPrebuildReportResponse, error)
+	// grantAccess gives a specific user or the holder of a share token access to a workspace
+	GrantAccess(context.Context, *GrantAccessRequest) (*GrantAccessResponse, error)
+	// revokeAccess withdraws an access grant. ws-proxy learns about the revocation through the status update.
+	RevokeAccess(context.Context, *RevokeAccessRequest) (*RevokeAccessResponse, error)
 }
 
 // UnimplementedWorkspaceManagerServer can be embedded to have forward compatible implementations.
@@ -2420,6 +3278,15 @@ func (*UnimplementedWorkspaceManagerServer) TakeSnapshot(ctx context.Context, re
 func (*UnimplementedWorkspaceManagerServer) ControlAdmission(ctx context.Context, req *ControlAdmissionRequest) (*ControlAdmissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ControlAdmission not implemented")
 }
+func (*UnimplementedWorkspaceManagerServer) GetPrebuildReport(ctx context.Context, req *GetPrebuildReportRequest) (*GetPrebuildReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrebuildReport not implemented")
+}
+func (*UnimplementedWorkspaceManagerServer) GrantAccess(ctx context.Context, req *GrantAccessRequest) (*GrantAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantAccess not implemented")
+}
+func (*UnimplementedWorkspaceManagerServer) RevokeAccess(ctx context.Context, req *RevokeAccessRequest) (*RevokeAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccess not implemented")
+}
 
 func RegisterWorkspaceManagerServer(s *grpc.Server, srv WorkspaceManagerServer) {
 	s.RegisterService(&_WorkspaceManager_serviceDesc, srv)
@@ -2608,6 +3475,60 @@ func _WorkspaceManager_ControlAdmission_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceManager_GetPrebuildReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrebuildReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceManagerServer).GetPrebuildReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsman.WorkspaceManager/GetPrebuildReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceManagerServer).GetPrebuildReport(ctx, req.(*GetPrebuildReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceManager_GrantAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceManagerServer).GrantAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsman.WorkspaceManager/GrantAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceManagerServer).GrantAccess(ctx, req.(*GrantAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceManager_RevokeAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceManagerServer).RevokeAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsman.WorkspaceManager/RevokeAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceManagerServer).RevokeAccess(ctx, req.(*RevokeAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WorkspaceManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "wsman.WorkspaceManager",
 	HandlerType: (*WorkspaceManagerServer)(nil),
@@ -2648,6 +3569,18 @@ var _WorkspaceManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ControlAdmission",
 			Handler:    _WorkspaceManager_ControlAdmission_Handler,
 		},
+		{
+			MethodName: "GetPrebuildReport",
+			Handler:    _WorkspaceManager_GetPrebuildReport_Handler,
+		},
+		{
+			MethodName: "GrantAccess",
+			Handler:    _WorkspaceManager_GrantAccess_Handler,
+		},
+		{
+			MethodName: "RevokeAccess",
+			Handler:    _WorkspaceManager_RevokeAccess_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -100,6 +100,26 @@ func (mr *MockWorkspaceManagerClientMockRecorder) DescribeWorkspace(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkspace", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).DescribeWorkspace), varargs...)
 }
 
+// GetPrebuildReport mocks base method
+func (m *MockWorkspaceManagerClient) GetPrebuildReport(arg0 context.Context, arg1 *api.GetPrebuildReportRequest, arg2 ...grpc.CallOption) (*api.GetPrebuildReportResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPrebuildReport", varargs...)
+	ret0, _ := ret[0].(*api.GetPrebuildReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrebuildReport indicates an expected call of GetPrebuildReport
+func (mr *MockWorkspaceManagerClientMockRecorder) GetPrebuildReport(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrebuildReport", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).GetPrebuildReport), varargs...)
+}
+
 // GetWorkspaces mocks base method
 func (m *MockWorkspaceManagerClient) GetWorkspaces(arg0 context.Context, arg1 *api.GetWorkspacesRequest, arg2 ...grpc.CallOption) (*api.GetWorkspacesResponse, error) {
 	m.ctrl.T.Helper()
@@ -120,6 +140,26 @@ func (mr *MockWorkspaceManagerClientMockRecorder) GetWorkspaces(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaces", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).GetWorkspaces), varargs...)
 }
 
+// GrantAccess mocks base method
+func (m *MockWorkspaceManagerClient) GrantAccess(arg0 context.Context, arg1 *api.GrantAccessRequest, arg2 ...grpc.CallOption) (*api.GrantAccessResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GrantAccess", varargs...)
+	ret0, _ := ret[0].(*api.GrantAccessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GrantAccess indicates an expected call of GrantAccess
+func (mr *MockWorkspaceManagerClientMockRecorder) GrantAccess(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantAccess", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).GrantAccess), varargs...)
+}
+
 // MarkActive mocks base method
 func (m *MockWorkspaceManagerClient) MarkActive(arg0 context.Context, arg1 *api.MarkActiveRequest, arg2 ...grpc.CallOption) (*api.MarkActiveResponse, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +180,26 @@ func (mr *MockWorkspaceManagerClientMockRecorder) MarkActive(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkActive", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).MarkActive), varargs...)
 }
 
+// RevokeAccess mocks base method
+func (m *MockWorkspaceManagerClient) RevokeAccess(arg0 context.Context, arg1 *api.RevokeAccessRequest, arg2 ...grpc.CallOption) (*api.RevokeAccessResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeAccess", varargs...)
+	ret0, _ := ret[0].(*api.RevokeAccessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAccess indicates an expected call of RevokeAccess
+func (mr *MockWorkspaceManagerClientMockRecorder) RevokeAccess(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccess", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).RevokeAccess), varargs...)
+}
+
 // SetTimeout mocks base method
 func (m *MockWorkspaceManagerClient) SetTimeout(arg0 context.Context, arg1 *api.SetTimeoutRequest, arg2 ...grpc.CallOption) (*api.SetTimeoutResponse, error) {
 	m.ctrl.T.Helper()
@@ -25,6 +25,9 @@ interface IWorkspaceManagerService extends grpc.ServiceDefinition<grpc.UntypedSe
     controlPort: IWorkspaceManagerService_IControlPort;
     takeSnapshot: IWorkspaceManagerService_ITakeSnapshot;
     controlAdmission: IWorkspaceManagerService_IControlAdmission;
+    getPrebuildReport: IWorkspaceManagerService_IGetPrebuildReport;
+    grantAccess: IWorkspaceManagerService_IGrantAccess;
+    revokeAccess: IWorkspaceManagerService_IRevokeAccess;
 }
 
 interface IWorkspaceManagerService_IGetWorkspaces extends grpc.MethodDefinition<core_pb.GetWorkspacesRequest, core_pb.GetWorkspacesResponse> {
@@ -117,6 +120,33 @@ interface IWorkspaceManagerService_IControlAdmission extends grpc.MethodDefiniti
     responseSerialize: grpc.serialize<core_pb.ControlAdmissionResponse>;
     responseDeserialize: grpc.deserialize<core_pb.ControlAdmissionResponse>;
 }
+interface IWorkspaceManagerService_IGetPrebuildReport extends grpc.MethodDefinition<core_pb.GetPrebuildReportRequest, core_pb.GetPrebuildReportResponse> {
+    path: string; // "/wsman.WorkspaceManager/GetPrebuildReport"
+    requestStream: boolean; // false
+    responseStream: boolean; // false
+    requestSerialize: grpc.serialize<core_pb.GetPrebuildReportRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.GetPrebuildReportRequest>;
+    responseSerialize: grpc.serialize<core_pb.GetPrebuildReportResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.GetPrebuildReportResponse>;
+}
+interface IWorkspaceManagerService_IGrantAccess extends grpc.MethodDefinition<core_pb.GrantAccessRequest, core_pb.GrantAccessResponse> {
+    path: string; // "/wsman.WorkspaceManager/GrantAccess"
+    requestStream: boolean; // false
+    responseStream: boolean; // false
+    requestSerialize: grpc.serialize<core_pb.GrantAccessRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.GrantAccessRequest>;
+    responseSerialize: grpc.serialize<core_pb.GrantAccessResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.GrantAccessResponse>;
+}
+interface IWorkspaceManagerService_IRevokeAccess extends grpc.MethodDefinition<core_pb.RevokeAccessRequest, core_pb.RevokeAccessResponse> {
+    path: string; // "/wsman.WorkspaceManager/RevokeAccess"
+    requestStream: boolean; // false
+    responseStream: boolean; // false
+    requestSerialize: grpc.serialize<core_pb.RevokeAccessRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.RevokeAccessRequest>;
+    responseSerialize: grpc.serialize<core_pb.RevokeAccessResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.RevokeAccessResponse>;
+}
 
 export const WorkspaceManagerService: IWorkspaceManagerService;
 
@@ -131,6 +161,9 @@ export interface IWorkspaceManagerServer {
     controlPort: grpc.handleUnaryCall<core_pb.ControlPortRequest, core_pb.ControlPortResponse>;
     takeSnapshot: grpc.handleUnaryCall<core_pb.TakeSnapshotRequest, core_pb.TakeSnapshotResponse>;
     controlAdmission: grpc.handleUnaryCall<core_pb.ControlAdmissionRequest, core_pb.ControlAdmissionResponse>;
+    getPrebuildReport: grpc.handleUnaryCall<core_pb.GetPrebuildReportRequest, core_pb.GetPrebuildReportResponse>;
+    grantAccess: grpc.handleUnaryCall<core_pb.GrantAccessRequest, core_pb.GrantAccessResponse>;
+    revokeAccess: grpc.handleUnaryCall<core_pb.RevokeAccessRequest, core_pb.RevokeAccessResponse>;
 }
 
 export interface IWorkspaceManagerClient {
@@ -163,6 +196,15 @@ export interface IWorkspaceManagerClient {
     controlAdmission(request: core_pb.ControlAdmissionRequest, callback: (error: grpc.ServiceError | null, response: core_pb.ControlAdmissionResponse) => void): grpc.ClientUnaryCall;
     controlAdmission(request: core_pb.ControlAdmissionRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.ControlAdmissionResponse) => void): grpc.ClientUnaryCall;
     controlAdmission(request: core_pb.ControlAdmissionRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.ControlAdmissionResponse) => void): grpc.ClientUnaryCall;
+    getPrebuildReport(request: core_pb.GetPrebuildReportRequest, callback: (error: grpc.ServiceError | null, response: core_pb.GetPrebuildReportResponse) => void): grpc.ClientUnaryCall;
+    getPrebuildReport(request: core_pb.GetPrebuildReportRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.GetPrebuildReportResponse) => void): grpc.ClientUnaryCall;
+    getPrebuildReport(request: core_pb.GetPrebuildReportRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.GetPrebuildReportResponse) => void): grpc.ClientUnaryCall;
+    grantAccess(request: core_pb.GrantAccessRequest, callback: (error: grpc.ServiceError | null, response: core_pb.GrantAccessResponse) => void): grpc.ClientUnaryCall;
+    grantAccess(request: core_pb.GrantAccessRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.GrantAccessResponse) => void): grpc.ClientUnaryCall;
+    grantAccess(request: core_pb.GrantAccessRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.GrantAccessResponse) => void): grpc.ClientUnaryCall;
+    revokeAccess(request: core_pb.RevokeAccessRequest, callback: (error: grpc.ServiceError | null, response: core_pb.RevokeAccessResponse) => void): grpc.ClientUnaryCall;
+    revokeAccess(request: core_pb.RevokeAccessRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.RevokeAccessResponse) => void): grpc.ClientUnaryCall;
+    revokeAccess(request: core_pb.RevokeAccessRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.RevokeAccessResponse) => void): grpc.ClientUnaryCall;
 }
 
 export class WorkspaceManagerClient extends grpc.Client implements IWorkspaceManagerClient {
@@ -196,4 +238,13 @@ export class WorkspaceManagerClient extends grpc.Client implements IWorkspaceMan
     public controlAdmission(request: core_pb.ControlAdmissionRequest, callback: (error: grpc.ServiceError | null, response: core_pb.ControlAdmissionResponse) => void): grpc.ClientUnaryCall;
     public controlAdmission(request: core_pb.ControlAdmissionRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.ControlAdmissionResponse) => void): grpc.ClientUnaryCall;
     public controlAdmission(request: core_pb.ControlAdmissionRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.ControlAdmissionResponse) => void): grpc.ClientUnaryCall;
+    public getPrebuildReport(request: core_pb.GetPrebuildReportRequest, callback: (error: grpc.ServiceError | null, response: core_pb.GetPrebuildReportResponse) => void): grpc.ClientUnaryCall;
+    public getPrebuildReport(request: core_pb.GetPrebuildReportRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.GetPrebuildReportResponse) => void): grpc.ClientUnaryCall;
+    public getPrebuildReport(request: core_pb.GetPrebuildReportRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.GetPrebuildReportResponse) => void): grpc.ClientUnaryCall;
+    public grantAccess(request: core_pb.GrantAccessRequest, callback: (error: grpc.ServiceError | null, response: core_pb.GrantAccessResponse) => void): grpc.ClientUnaryCall;
+    public grantAccess(request: core_pb.GrantAccessRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.GrantAccessResponse) => void): grpc.ClientUnaryCall;
+    public grantAccess(request: core_pb.GrantAccessRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.GrantAccessResponse) => void): grpc.ClientUnaryCall;
+    public revokeAccess(request: core_pb.RevokeAccessRequest, callback: (error: grpc.ServiceError | null, response: core_pb.RevokeAccessResponse) => void): grpc.ClientUnaryCall;
+    public revokeAccess(request: core_pb.RevokeAccessRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.RevokeAccessResponse) => void): grpc.ClientUnaryCall;
+    public revokeAccess(request: core_pb.RevokeAccessRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.RevokeAccessResponse) => void): grpc.ClientUnaryCall;
 }
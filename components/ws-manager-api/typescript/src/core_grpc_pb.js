@@ -78,6 +78,28 @@ function deserialize_wsman_DescribeWorkspaceResponse(buffer_arg) {
   return core_pb.DescribeWorkspaceResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_wsman_GetPrebuildReportRequest(arg) {
+  if (!(arg instanceof core_pb.GetPrebuildReportRequest)) {
+    throw new Error('Expected argument of type wsman.GetPrebuildReportRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_GetPrebuildReportRequest(buffer_arg) {
+  return core_pb.GetPrebuildReportRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_GetPrebuildReportResponse(arg) {
+  if (!(arg instanceof core_pb.GetPrebuildReportResponse)) {
+    throw new Error('Expected argument of type wsman.GetPrebuildReportResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_GetPrebuildReportResponse(buffer_arg) {
+  return core_pb.GetPrebuildReportResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_wsman_GetWorkspacesRequest(arg) {
   if (!(arg instanceof core_pb.GetWorkspacesRequest)) {
     throw new Error('Expected argument of type wsman.GetWorkspacesRequest');
@@ -100,6 +122,28 @@ function deserialize_wsman_GetWorkspacesResponse(buffer_arg) {
   return core_pb.GetWorkspacesResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_wsman_GrantAccessRequest(arg) {
+  if (!(arg instanceof core_pb.GrantAccessRequest)) {
+    throw new Error('Expected argument of type wsman.GrantAccessRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_GrantAccessRequest(buffer_arg) {
+  return core_pb.GrantAccessRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_GrantAccessResponse(arg) {
+  if (!(arg instanceof core_pb.GrantAccessResponse)) {
+    throw new Error('Expected argument of type wsman.GrantAccessResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_GrantAccessResponse(buffer_arg) {
+  return core_pb.GrantAccessResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_wsman_MarkActiveRequest(arg) {
   if (!(arg instanceof core_pb.MarkActiveRequest)) {
     throw new Error('Expected argument of type wsman.MarkActiveRequest');
@@ -122,6 +166,28 @@ function deserialize_wsman_MarkActiveResponse(buffer_arg) {
   return core_pb.MarkActiveResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_wsman_RevokeAccessRequest(arg) {
+  if (!(arg instanceof core_pb.RevokeAccessRequest)) {
+    throw new Error('Expected argument of type wsman.RevokeAccessRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_RevokeAccessRequest(buffer_arg) {
+  return core_pb.RevokeAccessRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_RevokeAccessResponse(arg) {
+  if (!(arg instanceof core_pb.RevokeAccessResponse)) {
+    throw new Error('Expected argument of type wsman.RevokeAccessResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_RevokeAccessResponse(buffer_arg) {
+  return core_pb.RevokeAccessResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_wsman_SetTimeoutRequest(arg) {
   if (!(arg instanceof core_pb.SetTimeoutRequest)) {
     throw new Error('Expected argument of type wsman.SetTimeoutRequest');
@@ -354,6 +420,42 @@ controlAdmission: {
     responseSerialize: serialize_wsman_ControlAdmissionResponse,
     responseDeserialize: deserialize_wsman_ControlAdmissionResponse,
   },
+  // getPrebuildReport provides the report and task logs a prebuild uploaded next to its snapshot
+getPrebuildReport: {
+    path: '/wsman.WorkspaceManager/GetPrebuildReport',
+    requestStream: false,
+    responseStream: false,
+    requestType: core_pb.GetPrebuildReportRequest,
+    responseType: core_pb.GetPrebuildReportResponse,
+    requestSerialize: serialize_wsman_GetPrebuildReportRequest,
+    requestDeserialize: deserialize_wsman_GetPrebuildReportRequest,
+    responseSerialize: serialize_wsman_GetPrebuildReportResponse,
+    responseDeserialize: deserialize_wsman_GetPrebuildReportResponse,
+  },
+  // grantAccess gives a specific user or the holder of a share token access to a workspace
+grantAccess: {
+    path: '/wsman.WorkspaceManager/GrantAccess',
+    requestStream: false,
+    responseStream: false,
+    requestType: core_pb.GrantAccessRequest,
+    responseType: core_pb.GrantAccessResponse,
+    requestSerialize: serialize_wsman_GrantAccessRequest,
+    requestDeserialize: deserialize_wsman_GrantAccessRequest,
+    responseSerialize: serialize_wsman_GrantAccessResponse,
+    responseDeserialize: deserialize_wsman_GrantAccessResponse,
+  },
+  // revokeAccess withdraws an access grant. ws-proxy learns about the revocation through the status update.
+revokeAccess: {
+    path: '/wsman.WorkspaceManager/RevokeAccess',
+    requestStream: false,
+    responseStream: false,
+    requestType: core_pb.RevokeAccessRequest,
+    responseType: core_pb.RevokeAccessResponse,
+    requestSerialize: serialize_wsman_RevokeAccessRequest,
+    requestDeserialize: deserialize_wsman_RevokeAccessRequest,
+    responseSerialize: serialize_wsman_RevokeAccessResponse,
+    responseDeserialize: deserialize_wsman_RevokeAccessResponse,
+  },
 };
 
 exports.WorkspaceManagerClient = grpc.makeGenericClientConstructor(WorkspaceManagerService);
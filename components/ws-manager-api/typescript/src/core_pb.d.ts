@@ -491,6 +491,234 @@ export namespace ControlAdmissionResponse {
     }
 }
 
+export class GetPrebuildReportRequest extends jspb.Message { 
+    getId(): string;
+    setId(value: string): void;
+
+    getOwner(): string;
+    setOwner(value: string): void;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GetPrebuildReportRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: GetPrebuildReportRequest): GetPrebuildReportRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GetPrebuildReportRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GetPrebuildReportRequest;
+    static deserializeBinaryFromReader(message: GetPrebuildReportRequest, reader: jspb.BinaryReader): GetPrebuildReportRequest;
+}
+
+export namespace GetPrebuildReportRequest {
+    export type AsObject = {
+        id: string,
+        owner: string,
+    }
+}
+
+export class GetPrebuildReportResponse extends jspb.Message { 
+    getSnapshot(): string;
+    setSnapshot(value: string): void;
+
+    clearTasksList(): void;
+    getTasksList(): Array<PrebuildTaskReport>;
+    setTasksList(value: Array<PrebuildTaskReport>): void;
+    addTasks(value?: PrebuildTaskReport, index?: number): PrebuildTaskReport;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GetPrebuildReportResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: GetPrebuildReportResponse): GetPrebuildReportResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GetPrebuildReportResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GetPrebuildReportResponse;
+    static deserializeBinaryFromReader(message: GetPrebuildReportResponse, reader: jspb.BinaryReader): GetPrebuildReportResponse;
+}
+
+export namespace GetPrebuildReportResponse {
+    export type AsObject = {
+        snapshot: string,
+        tasksList: Array<PrebuildTaskReport.AsObject>,
+    }
+}
+
+export class PrebuildTaskReport extends jspb.Message { 
+    getId(): string;
+    setId(value: string): void;
+
+    getName(): string;
+    setName(value: string): void;
+
+    getFailed(): boolean;
+    setFailed(value: boolean): void;
+
+    getFailure(): string;
+    setFailure(value: string): void;
+
+    getExitCode(): number;
+    setExitCode(value: number): void;
+
+    clearPhasesList(): void;
+    getPhasesList(): Array<PrebuildTaskPhase>;
+    setPhasesList(value: Array<PrebuildTaskPhase>): void;
+    addPhases(value?: PrebuildTaskPhase, index?: number): PrebuildTaskPhase;
+
+    getLogUrl(): string;
+    setLogUrl(value: string): void;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): PrebuildTaskReport.AsObject;
+    static toObject(includeInstance: boolean, msg: PrebuildTaskReport): PrebuildTaskReport.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: PrebuildTaskReport, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): PrebuildTaskReport;
+    static deserializeBinaryFromReader(message: PrebuildTaskReport, reader: jspb.BinaryReader): PrebuildTaskReport;
+}
+
+export namespace PrebuildTaskReport {
+    export type AsObject = {
+        id: string,
+        name: string,
+        failed: boolean,
+        failure: string,
+        exitCode: number,
+        phasesList: Array<PrebuildTaskPhase.AsObject>,
+        logUrl: string,
+    }
+}
+
+export class PrebuildTaskPhase extends jspb.Message { 
+    getName(): string;
+    setName(value: string): void;
+
+    getExitCode(): number;
+    setExitCode(value: number): void;
+
+    getDurationMs(): number;
+    setDurationMs(value: number): void;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): PrebuildTaskPhase.AsObject;
+    static toObject(includeInstance: boolean, msg: PrebuildTaskPhase): PrebuildTaskPhase.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: PrebuildTaskPhase, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): PrebuildTaskPhase;
+    static deserializeBinaryFromReader(message: PrebuildTaskPhase, reader: jspb.BinaryReader): PrebuildTaskPhase;
+}
+
+export namespace PrebuildTaskPhase {
+    export type AsObject = {
+        name: string,
+        exitCode: number,
+        durationMs: number,
+    }
+}
+
+export class GrantAccessRequest extends jspb.Message { 
+    getId(): string;
+    setId(value: string): void;
+
+    getUserId(): string;
+    setUserId(value: string): void;
+
+    getScope(): AccessScope;
+    setScope(value: AccessScope): void;
+
+    getDuration(): string;
+    setDuration(value: string): void;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GrantAccessRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: GrantAccessRequest): GrantAccessRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GrantAccessRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GrantAccessRequest;
+    static deserializeBinaryFromReader(message: GrantAccessRequest, reader: jspb.BinaryReader): GrantAccessRequest;
+}
+
+export namespace GrantAccessRequest {
+    export type AsObject = {
+        id: string,
+        userId: string,
+        scope: AccessScope,
+        duration: string,
+    }
+}
+
+export class GrantAccessResponse extends jspb.Message { 
+    getGrantId(): string;
+    setGrantId(value: string): void;
+
+    getToken(): string;
+    setToken(value: string): void;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GrantAccessResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: GrantAccessResponse): GrantAccessResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GrantAccessResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GrantAccessResponse;
+    static deserializeBinaryFromReader(message: GrantAccessResponse, reader: jspb.BinaryReader): GrantAccessResponse;
+}
+
+export namespace GrantAccessResponse {
+    export type AsObject = {
+        grantId: string,
+        token: string,
+    }
+}
+
+export class RevokeAccessRequest extends jspb.Message { 
+    getId(): string;
+    setId(value: string): void;
+
+    getGrantId(): string;
+    setGrantId(value: string): void;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RevokeAccessRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: RevokeAccessRequest): RevokeAccessRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RevokeAccessRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RevokeAccessRequest;
+    static deserializeBinaryFromReader(message: RevokeAccessRequest, reader: jspb.BinaryReader): RevokeAccessRequest;
+}
+
+export namespace RevokeAccessRequest {
+    export type AsObject = {
+        id: string,
+        grantId: string,
+    }
+}
+
+export class RevokeAccessResponse extends jspb.Message { 
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RevokeAccessResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: RevokeAccessResponse): RevokeAccessResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RevokeAccessResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RevokeAccessResponse;
+    static deserializeBinaryFromReader(message: RevokeAccessResponse, reader: jspb.BinaryReader): RevokeAccessResponse;
+}
+
+export namespace RevokeAccessResponse {
+    export type AsObject = {
+    }
+}
+
 export class WorkspaceStatus extends jspb.Message { 
     getId(): string;
     setId(value: string): void;
@@ -622,6 +850,15 @@ export class PortSpec extends jspb.Message {
     getUrl(): string;
     setUrl(value: string): void;
 
+    getProtocol(): PortProtocol;
+    setProtocol(value: PortProtocol): void;
+
+
+    hasRateLimit(): boolean;
+    clearRateLimit(): void;
+    getRateLimit(): RateLimit | undefined;
+    setRateLimit(value?: RateLimit): void;
+
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): PortSpec.AsObject;
@@ -639,6 +876,33 @@ export namespace PortSpec {
         target: number,
         visibility: PortVisibility,
         url: string,
+        protocol: PortProtocol,
+        rateLimit?: RateLimit.AsObject,
+    }
+}
+
+export class RateLimit extends jspb.Message { 
+    getRequestsPerSecond(): number;
+    setRequestsPerSecond(value: number): void;
+
+    getBurst(): number;
+    setBurst(value: number): void;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RateLimit.AsObject;
+    static toObject(includeInstance: boolean, msg: RateLimit): RateLimit.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RateLimit, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RateLimit;
+    static deserializeBinaryFromReader(message: RateLimit, reader: jspb.BinaryReader): RateLimit;
+}
+
+export namespace RateLimit {
+    export type AsObject = {
+        requestsPerSecond: number,
+        burst: number,
     }
 }
 
@@ -674,6 +938,18 @@ export class WorkspaceConditions extends jspb.Message {
     setFirstUserActivity(value?: google_protobuf_timestamp_pb.Timestamp): void;
 
 
+    hasLastBackup(): boolean;
+    clearLastBackup(): void;
+    getLastBackup(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setLastBackup(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+
+    hasContentProgress(): boolean;
+    clearContentProgress(): void;
+    getContentProgress(): ContentProgress | undefined;
+    setContentProgress(value?: ContentProgress): void;
+
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): WorkspaceConditions.AsObject;
     static toObject(includeInstance: boolean, msg: WorkspaceConditions): WorkspaceConditions.AsObject;
@@ -695,6 +971,45 @@ export namespace WorkspaceConditions {
         deployed: WorkspaceConditionBool,
         networkNotReady: WorkspaceConditionBool,
         firstUserActivity?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+        lastBackup?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+        contentProgress?: ContentProgress.AsObject,
+    }
+}
+
+export class ContentProgress extends jspb.Message { 
+    getOperation(): string;
+    setOperation(value: string): void;
+
+    getPhase(): string;
+    setPhase(value: string): void;
+
+    getPercent(): number;
+    setPercent(value: number): void;
+
+    getAttempt(): number;
+    setAttempt(value: number): void;
+
+    getError(): string;
+    setError(value: string): void;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ContentProgress.AsObject;
+    static toObject(includeInstance: boolean, msg: ContentProgress): ContentProgress.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ContentProgress, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ContentProgress;
+    static deserializeBinaryFromReader(message: ContentProgress, reader: jspb.BinaryReader): ContentProgress;
+}
+
+export namespace ContentProgress {
+    export type AsObject = {
+        operation: string,
+        phase: string,
+        percent: number,
+        attempt: number,
+        error: string,
     }
 }
 
@@ -758,6 +1073,11 @@ export class WorkspaceAuthentication extends jspb.Message {
     getOwnerToken(): string;
     setOwnerToken(value: string): void;
 
+    clearGrantsList(): void;
+    getGrantsList(): Array<AccessGrant>;
+    setGrantsList(value: Array<AccessGrant>): void;
+    addGrants(value?: AccessGrant, index?: number): AccessGrant;
+
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): WorkspaceAuthentication.AsObject;
@@ -773,6 +1093,47 @@ export namespace WorkspaceAuthentication {
     export type AsObject = {
         admission: AdmissionLevel,
         ownerToken: string,
+        grantsList: Array<AccessGrant.AsObject>,
+    }
+}
+
+export class AccessGrant extends jspb.Message { 
+    getId(): string;
+    setId(value: string): void;
+
+    getUserId(): string;
+    setUserId(value: string): void;
+
+    getScope(): AccessScope;
+    setScope(value: AccessScope): void;
+
+
+    hasExpiresAt(): boolean;
+    clearExpiresAt(): void;
+    getExpiresAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setExpiresAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+    getTokenHash(): string;
+    setTokenHash(value: string): void;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): AccessGrant.AsObject;
+    static toObject(includeInstance: boolean, msg: AccessGrant): AccessGrant.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: AccessGrant, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): AccessGrant;
+    static deserializeBinaryFromReader(message: AccessGrant, reader: jspb.BinaryReader): AccessGrant;
+}
+
+export namespace AccessGrant {
+    export type AsObject = {
+        id: string,
+        userId: string,
+        scope: AccessScope,
+        expiresAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+        tokenHash: string,
     }
 }
 
@@ -941,6 +1302,14 @@ export enum AdmissionLevel {
     ADMIT_EVERYONE = 1,
 }
 
+export enum PortProtocol {
+    PORT_PROTOCOL_HTTP = 0,
+    PORT_PROTOCOL_H2C = 1,
+    PORT_PROTOCOL_TLS = 2,
+    PORT_PROTOCOL_GRPC = 3,
+    PORT_PROTOCOL_TCP = 4,
+}
+
 export enum PortVisibility {
     PORT_VISIBILITY_PRIVATE = 0,
     PORT_VISIBILITY_PUBLIC = 1,
@@ -963,6 +1332,11 @@ export enum WorkspacePhase {
     STOPPED = 6,
 }
 
+export enum AccessScope {
+    ACCESS_SCOPE_READ_ONLY = 0,
+    ACCESS_SCOPE_FULL = 1,
+}
+
 export enum WorkspaceFeatureFlag {
     NOOP = 0,
     PRIVILEGED = 1,
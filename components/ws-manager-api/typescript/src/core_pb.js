@@ -21,7 +21,10 @@ var content$service$api_initializer_pb = require('@gitpod/content-service/lib');
 goog.object.extend(proto, content$service$api_initializer_pb);
 var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
 goog.object.extend(proto, google_protobuf_timestamp_pb);
+goog.exportSymbol('proto.wsman.AccessGrant', null, global);
+goog.exportSymbol('proto.wsman.AccessScope', null, global);
 goog.exportSymbol('proto.wsman.AdmissionLevel', null, global);
+goog.exportSymbol('proto.wsman.ContentProgress', null, global);
 goog.exportSymbol('proto.wsman.ControlAdmissionRequest', null, global);
 goog.exportSymbol('proto.wsman.ControlAdmissionResponse', null, global);
 goog.exportSymbol('proto.wsman.ControlPortRequest', null, global);
@@ -29,13 +32,23 @@ goog.exportSymbol('proto.wsman.ControlPortResponse', null, global);
 goog.exportSymbol('proto.wsman.DescribeWorkspaceRequest', null, global);
 goog.exportSymbol('proto.wsman.DescribeWorkspaceResponse', null, global);
 goog.exportSymbol('proto.wsman.EnvironmentVariable', null, global);
+goog.exportSymbol('proto.wsman.GetPrebuildReportRequest', null, global);
+goog.exportSymbol('proto.wsman.GetPrebuildReportResponse', null, global);
 goog.exportSymbol('proto.wsman.GetWorkspacesRequest', null, global);
 goog.exportSymbol('proto.wsman.GetWorkspacesResponse', null, global);
 goog.exportSymbol('proto.wsman.GitSpec', null, global);
+goog.exportSymbol('proto.wsman.GrantAccessRequest', null, global);
+goog.exportSymbol('proto.wsman.GrantAccessResponse', null, global);
 goog.exportSymbol('proto.wsman.MarkActiveRequest', null, global);
 goog.exportSymbol('proto.wsman.MarkActiveResponse', null, global);
+goog.exportSymbol('proto.wsman.PortProtocol', null, global);
 goog.exportSymbol('proto.wsman.PortSpec', null, global);
 goog.exportSymbol('proto.wsman.PortVisibility', null, global);
+goog.exportSymbol('proto.wsman.PrebuildTaskPhase', null, global);
+goog.exportSymbol('proto.wsman.PrebuildTaskReport', null, global);
+goog.exportSymbol('proto.wsman.RateLimit', null, global);
+goog.exportSymbol('proto.wsman.RevokeAccessRequest', null, global);
+goog.exportSymbol('proto.wsman.RevokeAccessResponse', null, global);
 goog.exportSymbol('proto.wsman.SetTimeoutRequest', null, global);
 goog.exportSymbol('proto.wsman.SetTimeoutResponse', null, global);
 goog.exportSymbol('proto.wsman.StartWorkspaceRequest', null, global);
@@ -479,6 +492,174 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.wsman.ControlAdmissionResponse.displayName = 'proto.wsman.ControlAdmissionResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.GetPrebuildReportRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.GetPrebuildReportRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.GetPrebuildReportRequest.displayName = 'proto.wsman.GetPrebuildReportRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.GetPrebuildReportResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.GetPrebuildReportResponse.repeatedFields_, null);
+};
+goog.inherits(proto.wsman.GetPrebuildReportResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.GetPrebuildReportResponse.displayName = 'proto.wsman.GetPrebuildReportResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.PrebuildTaskReport = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.PrebuildTaskReport.repeatedFields_, null);
+};
+goog.inherits(proto.wsman.PrebuildTaskReport, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.PrebuildTaskReport.displayName = 'proto.wsman.PrebuildTaskReport';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.PrebuildTaskPhase = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.PrebuildTaskPhase, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.PrebuildTaskPhase.displayName = 'proto.wsman.PrebuildTaskPhase';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.GrantAccessRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.GrantAccessRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.GrantAccessRequest.displayName = 'proto.wsman.GrantAccessRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.GrantAccessResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.GrantAccessResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.GrantAccessResponse.displayName = 'proto.wsman.GrantAccessResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.RevokeAccessRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.RevokeAccessRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.RevokeAccessRequest.displayName = 'proto.wsman.RevokeAccessRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.RevokeAccessResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.RevokeAccessResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.RevokeAccessResponse.displayName = 'proto.wsman.RevokeAccessResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -542,6 +723,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.wsman.PortSpec.displayName = 'proto.wsman.PortSpec';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.RateLimit = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.RateLimit, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.RateLimit.displayName = 'proto.wsman.RateLimit';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -563,6 +765,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.wsman.WorkspaceConditions.displayName = 'proto.wsman.WorkspaceConditions';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.ContentProgress = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.ContentProgress, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.ContentProgress.displayName = 'proto.wsman.ContentProgress';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -616,7 +839,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.wsman.WorkspaceAuthentication = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.WorkspaceAuthentication.repeatedFields_, null);
 };
 goog.inherits(proto.wsman.WorkspaceAuthentication, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -626,6 +849,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.wsman.WorkspaceAuthentication.displayName = 'proto.wsman.WorkspaceAuthentication';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.AccessGrant = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.AccessGrant, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.AccessGrant.displayName = 'proto.wsman.AccessGrant';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -3577,8 +3821,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.WorkspaceStatus.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.WorkspaceStatus.toObject(opt_includeInstance, this);
+proto.wsman.GetPrebuildReportRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.GetPrebuildReportRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3587,21 +3831,14 @@ proto.wsman.WorkspaceStatus.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.WorkspaceStatus} msg The msg instance to transform.
+ * @param {!proto.wsman.GetPrebuildReportRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceStatus.toObject = function(includeInstance, msg) {
+proto.wsman.GetPrebuildReportRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    metadata: (f = msg.getMetadata()) && proto.wsman.WorkspaceMetadata.toObject(includeInstance, f),
-    spec: (f = msg.getSpec()) && proto.wsman.WorkspaceSpec.toObject(includeInstance, f),
-    phase: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    conditions: (f = msg.getConditions()) && proto.wsman.WorkspaceConditions.toObject(includeInstance, f),
-    message: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    repo: (f = msg.getRepo()) && content$service$api_initializer_pb.GitStatus.toObject(includeInstance, f),
-    runtime: (f = msg.getRuntime()) && proto.wsman.WorkspaceRuntimeInfo.toObject(includeInstance, f),
-    auth: (f = msg.getAuth()) && proto.wsman.WorkspaceAuthentication.toObject(includeInstance, f)
+    owner: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -3615,23 +3852,23 @@ proto.wsman.WorkspaceStatus.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.WorkspaceStatus}
+ * @return {!proto.wsman.GetPrebuildReportRequest}
  */
-proto.wsman.WorkspaceStatus.deserializeBinary = function(bytes) {
+proto.wsman.GetPrebuildReportRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.WorkspaceStatus;
-  return proto.wsman.WorkspaceStatus.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.GetPrebuildReportRequest;
+  return proto.wsman.GetPrebuildReportRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.WorkspaceStatus} msg The message object to deserialize into.
+ * @param {!proto.wsman.GetPrebuildReportRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.WorkspaceStatus}
+ * @return {!proto.wsman.GetPrebuildReportRequest}
  */
-proto.wsman.WorkspaceStatus.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.GetPrebuildReportRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3643,42 +3880,8 @@ proto.wsman.WorkspaceStatus.deserializeBinaryFromReader = function(msg, reader)
       msg.setId(value);
       break;
     case 2:
-      var value = new proto.wsman.WorkspaceMetadata;
-      reader.readMessage(value,proto.wsman.WorkspaceMetadata.deserializeBinaryFromReader);
-      msg.setMetadata(value);
-      break;
-    case 3:
-      var value = new proto.wsman.WorkspaceSpec;
-      reader.readMessage(value,proto.wsman.WorkspaceSpec.deserializeBinaryFromReader);
-      msg.setSpec(value);
-      break;
-    case 4:
-      var value = /** @type {!proto.wsman.WorkspacePhase} */ (reader.readEnum());
-      msg.setPhase(value);
-      break;
-    case 5:
-      var value = new proto.wsman.WorkspaceConditions;
-      reader.readMessage(value,proto.wsman.WorkspaceConditions.deserializeBinaryFromReader);
-      msg.setConditions(value);
-      break;
-    case 6:
       var value = /** @type {string} */ (reader.readString());
-      msg.setMessage(value);
-      break;
-    case 7:
-      var value = new content$service$api_initializer_pb.GitStatus;
-      reader.readMessage(value,content$service$api_initializer_pb.GitStatus.deserializeBinaryFromReader);
-      msg.setRepo(value);
-      break;
-    case 8:
-      var value = new proto.wsman.WorkspaceRuntimeInfo;
-      reader.readMessage(value,proto.wsman.WorkspaceRuntimeInfo.deserializeBinaryFromReader);
-      msg.setRuntime(value);
-      break;
-    case 9:
-      var value = new proto.wsman.WorkspaceAuthentication;
-      reader.readMessage(value,proto.wsman.WorkspaceAuthentication.deserializeBinaryFromReader);
-      msg.setAuth(value);
+      msg.setOwner(value);
       break;
     default:
       reader.skipField();
@@ -3693,9 +3896,9 @@ proto.wsman.WorkspaceStatus.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.WorkspaceStatus.prototype.serializeBinary = function() {
+proto.wsman.GetPrebuildReportRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.WorkspaceStatus.serializeBinaryToWriter(this, writer);
+  proto.wsman.GetPrebuildReportRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3703,11 +3906,1491 @@ proto.wsman.WorkspaceStatus.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.WorkspaceStatus} message
+ * @param {!proto.wsman.GetPrebuildReportRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceStatus.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.GetPrebuildReportRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getOwner();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.GetPrebuildReportRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.GetPrebuildReportRequest.prototype.setId = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string owner = 2;
+ * @return {string}
+ */
+proto.wsman.GetPrebuildReportRequest.prototype.getOwner = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.GetPrebuildReportRequest.prototype.setOwner = function(value) {
+  jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.GetPrebuildReportResponse.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.GetPrebuildReportResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.GetPrebuildReportResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.GetPrebuildReportResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.GetPrebuildReportResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    snapshot: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    tasksList: jspb.Message.toObjectList(msg.getTasksList(),
+    proto.wsman.PrebuildTaskReport.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.GetPrebuildReportResponse}
+ */
+proto.wsman.GetPrebuildReportResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.GetPrebuildReportResponse;
+  return proto.wsman.GetPrebuildReportResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.GetPrebuildReportResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.GetPrebuildReportResponse}
+ */
+proto.wsman.GetPrebuildReportResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSnapshot(value);
+      break;
+    case 2:
+      var value = new proto.wsman.PrebuildTaskReport;
+      reader.readMessage(value,proto.wsman.PrebuildTaskReport.deserializeBinaryFromReader);
+      msg.addTasks(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.GetPrebuildReportResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.GetPrebuildReportResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.GetPrebuildReportResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.GetPrebuildReportResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSnapshot();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTasksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.wsman.PrebuildTaskReport.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string snapshot = 1;
+ * @return {string}
+ */
+proto.wsman.GetPrebuildReportResponse.prototype.getSnapshot = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.GetPrebuildReportResponse.prototype.setSnapshot = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated PrebuildTaskReport tasks = 2;
+ * @return {!Array<!proto.wsman.PrebuildTaskReport>}
+ */
+proto.wsman.GetPrebuildReportResponse.prototype.getTasksList = function() {
+  return /** @type{!Array<!proto.wsman.PrebuildTaskReport>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.PrebuildTaskReport, 2));
+};
+
+
+/** @param {!Array<!proto.wsman.PrebuildTaskReport>} value */
+proto.wsman.GetPrebuildReportResponse.prototype.setTasksList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.wsman.PrebuildTaskReport=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.PrebuildTaskReport}
+ */
+proto.wsman.GetPrebuildReportResponse.prototype.addTasks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.wsman.PrebuildTaskReport, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ */
+proto.wsman.GetPrebuildReportResponse.prototype.clearTasksList = function() {
+  this.setTasksList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.PrebuildTaskReport.repeatedFields_ = [6];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.PrebuildTaskReport.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.PrebuildTaskReport.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.PrebuildTaskReport} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.PrebuildTaskReport.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    failed: jspb.Message.getFieldWithDefault(msg, 3, false),
+    failure: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    exitCode: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    phasesList: jspb.Message.toObjectList(msg.getPhasesList(),
+    proto.wsman.PrebuildTaskPhase.toObject, includeInstance),
+    logUrl: jspb.Message.getFieldWithDefault(msg, 7, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.PrebuildTaskReport}
+ */
+proto.wsman.PrebuildTaskReport.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.PrebuildTaskReport;
+  return proto.wsman.PrebuildTaskReport.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.PrebuildTaskReport} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.PrebuildTaskReport}
+ */
+proto.wsman.PrebuildTaskReport.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setFailed(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFailure(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setExitCode(value);
+      break;
+    case 6:
+      var value = new proto.wsman.PrebuildTaskPhase;
+      reader.readMessage(value,proto.wsman.PrebuildTaskPhase.deserializeBinaryFromReader);
+      msg.addPhases(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLogUrl(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.PrebuildTaskReport.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.PrebuildTaskReport.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.PrebuildTaskReport} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.PrebuildTaskReport.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getFailed();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getFailure();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getExitCode();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getPhasesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      6,
+      f,
+      proto.wsman.PrebuildTaskPhase.serializeBinaryToWriter
+    );
+  }
+  f = message.getLogUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.PrebuildTaskReport.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.PrebuildTaskReport.prototype.setId = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.wsman.PrebuildTaskReport.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.PrebuildTaskReport.prototype.setName = function(value) {
+  jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional bool failed = 3;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.wsman.PrebuildTaskReport.prototype.getFailed = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 3, false));
+};
+
+
+/** @param {boolean} value */
+proto.wsman.PrebuildTaskReport.prototype.setFailed = function(value) {
+  jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional string failure = 4;
+ * @return {string}
+ */
+proto.wsman.PrebuildTaskReport.prototype.getFailure = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.PrebuildTaskReport.prototype.setFailure = function(value) {
+  jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional int32 exit_code = 5;
+ * @return {number}
+ */
+proto.wsman.PrebuildTaskReport.prototype.getExitCode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/** @param {number} value */
+proto.wsman.PrebuildTaskReport.prototype.setExitCode = function(value) {
+  jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * repeated PrebuildTaskPhase phases = 6;
+ * @return {!Array<!proto.wsman.PrebuildTaskPhase>}
+ */
+proto.wsman.PrebuildTaskReport.prototype.getPhasesList = function() {
+  return /** @type{!Array<!proto.wsman.PrebuildTaskPhase>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.PrebuildTaskPhase, 6));
+};
+
+
+/** @param {!Array<!proto.wsman.PrebuildTaskPhase>} value */
+proto.wsman.PrebuildTaskReport.prototype.setPhasesList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 6, value);
+};
+
+
+/**
+ * @param {!proto.wsman.PrebuildTaskPhase=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.PrebuildTaskPhase}
+ */
+proto.wsman.PrebuildTaskReport.prototype.addPhases = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.wsman.PrebuildTaskPhase, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ */
+proto.wsman.PrebuildTaskReport.prototype.clearPhasesList = function() {
+  this.setPhasesList([]);
+};
+
+
+/**
+ * optional string log_url = 7;
+ * @return {string}
+ */
+proto.wsman.PrebuildTaskReport.prototype.getLogUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.PrebuildTaskReport.prototype.setLogUrl = function(value) {
+  jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.PrebuildTaskPhase.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.PrebuildTaskPhase.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.PrebuildTaskPhase} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.PrebuildTaskPhase.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    exitCode: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    durationMs: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.PrebuildTaskPhase}
+ */
+proto.wsman.PrebuildTaskPhase.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.PrebuildTaskPhase;
+  return proto.wsman.PrebuildTaskPhase.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.PrebuildTaskPhase} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.PrebuildTaskPhase}
+ */
+proto.wsman.PrebuildTaskPhase.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setExitCode(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setDurationMs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.PrebuildTaskPhase.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.PrebuildTaskPhase.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.PrebuildTaskPhase} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.PrebuildTaskPhase.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getExitCode();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getDurationMs();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.wsman.PrebuildTaskPhase.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.PrebuildTaskPhase.prototype.setName = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 exit_code = 2;
+ * @return {number}
+ */
+proto.wsman.PrebuildTaskPhase.prototype.getExitCode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/** @param {number} value */
+proto.wsman.PrebuildTaskPhase.prototype.setExitCode = function(value) {
+  jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int64 duration_ms = 3;
+ * @return {number}
+ */
+proto.wsman.PrebuildTaskPhase.prototype.getDurationMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/** @param {number} value */
+proto.wsman.PrebuildTaskPhase.prototype.setDurationMs = function(value) {
+  jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.GrantAccessRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.GrantAccessRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.GrantAccessRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.GrantAccessRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    userId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    scope: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    duration: jspb.Message.getFieldWithDefault(msg, 4, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.GrantAccessRequest}
+ */
+proto.wsman.GrantAccessRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.GrantAccessRequest;
+  return proto.wsman.GrantAccessRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.GrantAccessRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.GrantAccessRequest}
+ */
+proto.wsman.GrantAccessRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUserId(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.wsman.AccessScope} */ (reader.readEnum());
+      msg.setScope(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDuration(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.GrantAccessRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.GrantAccessRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.GrantAccessRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.GrantAccessRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUserId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getScope();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+  f = message.getDuration();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.GrantAccessRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.GrantAccessRequest.prototype.setId = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string user_id = 2;
+ * @return {string}
+ */
+proto.wsman.GrantAccessRequest.prototype.getUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.GrantAccessRequest.prototype.setUserId = function(value) {
+  jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional AccessScope scope = 3;
+ * @return {!proto.wsman.AccessScope}
+ */
+proto.wsman.GrantAccessRequest.prototype.getScope = function() {
+  return /** @type {!proto.wsman.AccessScope} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/** @param {!proto.wsman.AccessScope} value */
+proto.wsman.GrantAccessRequest.prototype.setScope = function(value) {
+  jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+/**
+ * optional string duration = 4;
+ * @return {string}
+ */
+proto.wsman.GrantAccessRequest.prototype.getDuration = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.GrantAccessRequest.prototype.setDuration = function(value) {
+  jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.GrantAccessResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.GrantAccessResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.GrantAccessResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.GrantAccessResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    grantId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    token: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.GrantAccessResponse}
+ */
+proto.wsman.GrantAccessResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.GrantAccessResponse;
+  return proto.wsman.GrantAccessResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.GrantAccessResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.GrantAccessResponse}
+ */
+proto.wsman.GrantAccessResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setGrantId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setToken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.GrantAccessResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.GrantAccessResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.GrantAccessResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.GrantAccessResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getGrantId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getToken();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string grant_id = 1;
+ * @return {string}
+ */
+proto.wsman.GrantAccessResponse.prototype.getGrantId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.GrantAccessResponse.prototype.setGrantId = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string token = 2;
+ * @return {string}
+ */
+proto.wsman.GrantAccessResponse.prototype.getToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.GrantAccessResponse.prototype.setToken = function(value) {
+  jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.RevokeAccessRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.RevokeAccessRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.RevokeAccessRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.RevokeAccessRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    grantId: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.RevokeAccessRequest}
+ */
+proto.wsman.RevokeAccessRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.RevokeAccessRequest;
+  return proto.wsman.RevokeAccessRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.RevokeAccessRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.RevokeAccessRequest}
+ */
+proto.wsman.RevokeAccessRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setGrantId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.RevokeAccessRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.RevokeAccessRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.RevokeAccessRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.RevokeAccessRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getGrantId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.RevokeAccessRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.RevokeAccessRequest.prototype.setId = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string grant_id = 2;
+ * @return {string}
+ */
+proto.wsman.RevokeAccessRequest.prototype.getGrantId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.RevokeAccessRequest.prototype.setGrantId = function(value) {
+  jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.RevokeAccessResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.RevokeAccessResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.RevokeAccessResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.RevokeAccessResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.RevokeAccessResponse}
+ */
+proto.wsman.RevokeAccessResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.RevokeAccessResponse;
+  return proto.wsman.RevokeAccessResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.RevokeAccessResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.RevokeAccessResponse}
+ */
+proto.wsman.RevokeAccessResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.RevokeAccessResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.RevokeAccessResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.RevokeAccessResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.RevokeAccessResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.WorkspaceStatus.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceStatus.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.WorkspaceStatus} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.WorkspaceStatus.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    metadata: (f = msg.getMetadata()) && proto.wsman.WorkspaceMetadata.toObject(includeInstance, f),
+    spec: (f = msg.getSpec()) && proto.wsman.WorkspaceSpec.toObject(includeInstance, f),
+    phase: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    conditions: (f = msg.getConditions()) && proto.wsman.WorkspaceConditions.toObject(includeInstance, f),
+    message: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    repo: (f = msg.getRepo()) && content$service$api_initializer_pb.GitStatus.toObject(includeInstance, f),
+    runtime: (f = msg.getRuntime()) && proto.wsman.WorkspaceRuntimeInfo.toObject(includeInstance, f),
+    auth: (f = msg.getAuth()) && proto.wsman.WorkspaceAuthentication.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.WorkspaceStatus}
+ */
+proto.wsman.WorkspaceStatus.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.WorkspaceStatus;
+  return proto.wsman.WorkspaceStatus.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.WorkspaceStatus} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.WorkspaceStatus}
+ */
+proto.wsman.WorkspaceStatus.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = new proto.wsman.WorkspaceMetadata;
+      reader.readMessage(value,proto.wsman.WorkspaceMetadata.deserializeBinaryFromReader);
+      msg.setMetadata(value);
+      break;
+    case 3:
+      var value = new proto.wsman.WorkspaceSpec;
+      reader.readMessage(value,proto.wsman.WorkspaceSpec.deserializeBinaryFromReader);
+      msg.setSpec(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.wsman.WorkspacePhase} */ (reader.readEnum());
+      msg.setPhase(value);
+      break;
+    case 5:
+      var value = new proto.wsman.WorkspaceConditions;
+      reader.readMessage(value,proto.wsman.WorkspaceConditions.deserializeBinaryFromReader);
+      msg.setConditions(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
+    case 7:
+      var value = new content$service$api_initializer_pb.GitStatus;
+      reader.readMessage(value,content$service$api_initializer_pb.GitStatus.deserializeBinaryFromReader);
+      msg.setRepo(value);
+      break;
+    case 8:
+      var value = new proto.wsman.WorkspaceRuntimeInfo;
+      reader.readMessage(value,proto.wsman.WorkspaceRuntimeInfo.deserializeBinaryFromReader);
+      msg.setRuntime(value);
+      break;
+    case 9:
+      var value = new proto.wsman.WorkspaceAuthentication;
+      reader.readMessage(value,proto.wsman.WorkspaceAuthentication.deserializeBinaryFromReader);
+      msg.setAuth(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.WorkspaceStatus.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.WorkspaceStatus.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.WorkspaceStatus} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.WorkspaceStatus.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -4375,7 +6058,9 @@ proto.wsman.PortSpec.toObject = function(includeInstance, msg) {
     port: jspb.Message.getFieldWithDefault(msg, 1, 0),
     target: jspb.Message.getFieldWithDefault(msg, 2, 0),
     visibility: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    url: jspb.Message.getFieldWithDefault(msg, 4, "")
+    url: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    protocol: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    rateLimit: (f = msg.getRateLimit()) && proto.wsman.RateLimit.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4428,6 +6113,15 @@ proto.wsman.PortSpec.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {string} */ (reader.readString());
       msg.setUrl(value);
       break;
+    case 5:
+      var value = /** @type {!proto.wsman.PortProtocol} */ (reader.readEnum());
+      msg.setProtocol(value);
+      break;
+    case 6:
+      var value = new proto.wsman.RateLimit;
+      reader.readMessage(value,proto.wsman.RateLimit.deserializeBinaryFromReader);
+      msg.setRateLimit(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -4485,6 +6179,21 @@ proto.wsman.PortSpec.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getProtocol();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getRateLimit();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.wsman.RateLimit.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -4542,9 +6251,209 @@ proto.wsman.PortSpec.prototype.getUrl = function() {
 };
 
 
-/** @param {string} value */
-proto.wsman.PortSpec.prototype.setUrl = function(value) {
-  jspb.Message.setProto3StringField(this, 4, value);
+/** @param {string} value */
+proto.wsman.PortSpec.prototype.setUrl = function(value) {
+  jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional PortProtocol protocol = 5;
+ * @return {!proto.wsman.PortProtocol}
+ */
+proto.wsman.PortSpec.prototype.getProtocol = function() {
+  return /** @type {!proto.wsman.PortProtocol} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/** @param {!proto.wsman.PortProtocol} value */
+proto.wsman.PortSpec.prototype.setProtocol = function(value) {
+  jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+/**
+ * optional RateLimit rate_limit = 6;
+ * @return {?proto.wsman.RateLimit}
+ */
+proto.wsman.PortSpec.prototype.getRateLimit = function() {
+  return /** @type{?proto.wsman.RateLimit} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.RateLimit, 6));
+};
+
+
+/** @param {?proto.wsman.RateLimit|undefined} value */
+proto.wsman.PortSpec.prototype.setRateLimit = function(value) {
+  jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ */
+proto.wsman.PortSpec.prototype.clearRateLimit = function() {
+  this.setRateLimit(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.PortSpec.prototype.hasRateLimit = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.RateLimit.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.RateLimit.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.RateLimit} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.RateLimit.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    requestsPerSecond: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    burst: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.RateLimit}
+ */
+proto.wsman.RateLimit.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.RateLimit;
+  return proto.wsman.RateLimit.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.RateLimit} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.RateLimit}
+ */
+proto.wsman.RateLimit.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRequestsPerSecond(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setBurst(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.RateLimit.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.RateLimit.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.RateLimit} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.RateLimit.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRequestsPerSecond();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getBurst();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint32 requests_per_second = 1;
+ * @return {number}
+ */
+proto.wsman.RateLimit.prototype.getRequestsPerSecond = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/** @param {number} value */
+proto.wsman.RateLimit.prototype.setRequestsPerSecond = function(value) {
+  jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint32 burst = 2;
+ * @return {number}
+ */
+proto.wsman.RateLimit.prototype.getBurst = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/** @param {number} value */
+proto.wsman.RateLimit.prototype.setBurst = function(value) {
+  jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
@@ -4586,7 +6495,9 @@ proto.wsman.WorkspaceConditions.toObject = function(includeInstance, msg) {
     finalBackupComplete: jspb.Message.getFieldWithDefault(msg, 6, 0),
     deployed: jspb.Message.getFieldWithDefault(msg, 7, 0),
     networkNotReady: jspb.Message.getFieldWithDefault(msg, 8, 0),
-    firstUserActivity: (f = msg.getFirstUserActivity()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+    firstUserActivity: (f = msg.getFirstUserActivity()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    lastBackup: (f = msg.getLastBackup()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    contentProgress: (f = msg.getContentProgress()) && proto.wsman.ContentProgress.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4660,6 +6571,16 @@ proto.wsman.WorkspaceConditions.deserializeBinaryFromReader = function(msg, read
       reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
       msg.setFirstUserActivity(value);
       break;
+    case 10:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setLastBackup(value);
+      break;
+    case 11:
+      var value = new proto.wsman.ContentProgress;
+      reader.readMessage(value,proto.wsman.ContentProgress.deserializeBinaryFromReader);
+      msg.setContentProgress(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -4753,6 +6674,22 @@ proto.wsman.WorkspaceConditions.serializeBinaryToWriter = function(message, writ
       google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
+  f = message.getLastBackup();
+  if (f != null) {
+    writer.writeMessage(
+      10,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getContentProgress();
+  if (f != null) {
+    writer.writeMessage(
+      11,
+      f,
+      proto.wsman.ContentProgress.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -4900,12 +6837,311 @@ proto.wsman.WorkspaceConditions.prototype.clearFirstUserActivity = function() {
 };
 
 
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.wsman.WorkspaceConditions.prototype.hasFirstUserActivity = function() {
-  return jspb.Message.getField(this, 9) != null;
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceConditions.prototype.hasFirstUserActivity = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp last_backup = 10;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.wsman.WorkspaceConditions.prototype.getLastBackup = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 10));
+};
+
+
+/** @param {?proto.google.protobuf.Timestamp|undefined} value */
+proto.wsman.WorkspaceConditions.prototype.setLastBackup = function(value) {
+  jspb.Message.setWrapperField(this, 10, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ */
+proto.wsman.WorkspaceConditions.prototype.clearLastBackup = function() {
+  this.setLastBackup(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceConditions.prototype.hasLastBackup = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+/**
+ * optional ContentProgress content_progress = 11;
+ * @return {?proto.wsman.ContentProgress}
+ */
+proto.wsman.WorkspaceConditions.prototype.getContentProgress = function() {
+  return /** @type{?proto.wsman.ContentProgress} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.ContentProgress, 11));
+};
+
+
+/** @param {?proto.wsman.ContentProgress|undefined} value */
+proto.wsman.WorkspaceConditions.prototype.setContentProgress = function(value) {
+  jspb.Message.setWrapperField(this, 11, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ */
+proto.wsman.WorkspaceConditions.prototype.clearContentProgress = function() {
+  this.setContentProgress(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceConditions.prototype.hasContentProgress = function() {
+  return jspb.Message.getField(this, 11) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.ContentProgress.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ContentProgress.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.ContentProgress} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ContentProgress.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    operation: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    phase: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    percent: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    attempt: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    error: jspb.Message.getFieldWithDefault(msg, 5, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.ContentProgress}
+ */
+proto.wsman.ContentProgress.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.ContentProgress;
+  return proto.wsman.ContentProgress.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.ContentProgress} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.ContentProgress}
+ */
+proto.wsman.ContentProgress.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOperation(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPhase(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPercent(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setAttempt(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setError(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.ContentProgress.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.ContentProgress.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.ContentProgress} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ContentProgress.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getOperation();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPhase();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPercent();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getAttempt();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getError();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string operation = 1;
+ * @return {string}
+ */
+proto.wsman.ContentProgress.prototype.getOperation = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.ContentProgress.prototype.setOperation = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string phase = 2;
+ * @return {string}
+ */
+proto.wsman.ContentProgress.prototype.getPhase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.ContentProgress.prototype.setPhase = function(value) {
+  jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int32 percent = 3;
+ * @return {number}
+ */
+proto.wsman.ContentProgress.prototype.getPercent = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/** @param {number} value */
+proto.wsman.ContentProgress.prototype.setPercent = function(value) {
+  jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int32 attempt = 4;
+ * @return {number}
+ */
+proto.wsman.ContentProgress.prototype.getAttempt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/** @param {number} value */
+proto.wsman.ContentProgress.prototype.setAttempt = function(value) {
+  jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional string error = 5;
+ * @return {string}
+ */
+proto.wsman.ContentProgress.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.ContentProgress.prototype.setError = function(value) {
+  jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
@@ -5234,6 +7470,13 @@ proto.wsman.WorkspaceRuntimeInfo.prototype.setNodeName = function(value) {
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.WorkspaceAuthentication.repeatedFields_ = [3];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -5264,7 +7507,9 @@ proto.wsman.WorkspaceAuthentication.prototype.toObject = function(opt_includeIns
 proto.wsman.WorkspaceAuthentication.toObject = function(includeInstance, msg) {
   var f, obj = {
     admission: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    ownerToken: jspb.Message.getFieldWithDefault(msg, 2, "")
+    ownerToken: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    grantsList: jspb.Message.toObjectList(msg.getGrantsList(),
+    proto.wsman.AccessGrant.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -5309,6 +7554,11 @@ proto.wsman.WorkspaceAuthentication.deserializeBinaryFromReader = function(msg,
       var value = /** @type {string} */ (reader.readString());
       msg.setOwnerToken(value);
       break;
+    case 3:
+      var value = new proto.wsman.AccessGrant;
+      reader.readMessage(value,proto.wsman.AccessGrant.deserializeBinaryFromReader);
+      msg.addGrants(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -5352,6 +7602,14 @@ proto.wsman.WorkspaceAuthentication.serializeBinaryToWriter = function(message,
       f
     );
   }
+  f = message.getGrantsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.wsman.AccessGrant.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -5385,6 +7643,293 @@ proto.wsman.WorkspaceAuthentication.prototype.setOwnerToken = function(value) {
 };
 
 
+/**
+ * repeated AccessGrant grants = 3;
+ * @return {!Array<!proto.wsman.AccessGrant>}
+ */
+proto.wsman.WorkspaceAuthentication.prototype.getGrantsList = function() {
+  return /** @type{!Array<!proto.wsman.AccessGrant>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.AccessGrant, 3));
+};
+
+
+/** @param {!Array<!proto.wsman.AccessGrant>} value */
+proto.wsman.WorkspaceAuthentication.prototype.setGrantsList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.wsman.AccessGrant=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.AccessGrant}
+ */
+proto.wsman.WorkspaceAuthentication.prototype.addGrants = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.wsman.AccessGrant, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ */
+proto.wsman.WorkspaceAuthentication.prototype.clearGrantsList = function() {
+  this.setGrantsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.AccessGrant.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.AccessGrant.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.AccessGrant} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.AccessGrant.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    userId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    scope: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    expiresAt: (f = msg.getExpiresAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    tokenHash: jspb.Message.getFieldWithDefault(msg, 5, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.AccessGrant}
+ */
+proto.wsman.AccessGrant.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.AccessGrant;
+  return proto.wsman.AccessGrant.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.AccessGrant} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.AccessGrant}
+ */
+proto.wsman.AccessGrant.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUserId(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.wsman.AccessScope} */ (reader.readEnum());
+      msg.setScope(value);
+      break;
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setExpiresAt(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTokenHash(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.AccessGrant.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.AccessGrant.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.AccessGrant} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.AccessGrant.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUserId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getScope();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+  f = message.getExpiresAt();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getTokenHash();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.AccessGrant.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.AccessGrant.prototype.setId = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string user_id = 2;
+ * @return {string}
+ */
+proto.wsman.AccessGrant.prototype.getUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.AccessGrant.prototype.setUserId = function(value) {
+  jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional AccessScope scope = 3;
+ * @return {!proto.wsman.AccessScope}
+ */
+proto.wsman.AccessGrant.prototype.getScope = function() {
+  return /** @type {!proto.wsman.AccessScope} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/** @param {!proto.wsman.AccessScope} value */
+proto.wsman.AccessGrant.prototype.setScope = function(value) {
+  jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp expires_at = 4;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.wsman.AccessGrant.prototype.getExpiresAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+};
+
+
+/** @param {?proto.google.protobuf.Timestamp|undefined} value */
+proto.wsman.AccessGrant.prototype.setExpiresAt = function(value) {
+  jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ */
+proto.wsman.AccessGrant.prototype.clearExpiresAt = function() {
+  this.setExpiresAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.AccessGrant.prototype.hasExpiresAt = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional string token_hash = 5;
+ * @return {string}
+ */
+proto.wsman.AccessGrant.prototype.getTokenHash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/** @param {string} value */
+proto.wsman.AccessGrant.prototype.setTokenHash = function(value) {
+  jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
 
 /**
  * List of repeated fields within this message type.
@@ -6407,6 +8952,17 @@ proto.wsman.AdmissionLevel = {
   ADMIT_EVERYONE: 1
 };
 
+/**
+ * @enum {number}
+ */
+proto.wsman.PortProtocol = {
+  PORT_PROTOCOL_HTTP: 0,
+  PORT_PROTOCOL_H2C: 1,
+  PORT_PROTOCOL_TLS: 2,
+  PORT_PROTOCOL_GRPC: 3,
+  PORT_PROTOCOL_TCP: 4
+};
+
 /**
  * @enum {number}
  */
@@ -6438,6 +8994,14 @@ proto.wsman.WorkspacePhase = {
   STOPPED: 6
 };
 
+/**
+ * @enum {number}
+ */
+proto.wsman.AccessScope = {
+  ACCESS_SCOPE_READ_ONLY: 0,
+  ACCESS_SCOPE_FULL: 1
+};
+
 /**
  * @enum {number}
  */
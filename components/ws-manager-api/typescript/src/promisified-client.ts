@@ -6,7 +6,7 @@
 
 
 import { WorkspaceManagerClient } from "./core_grpc_pb";
-import { ControlPortRequest, ControlPortResponse, DescribeWorkspaceRequest, DescribeWorkspaceResponse, MarkActiveRequest, MarkActiveResponse, StartWorkspaceRequest, StartWorkspaceResponse, StopWorkspaceRequest, StopWorkspaceResponse, GetWorkspacesRequest, GetWorkspacesResponse, TakeSnapshotRequest, SetTimeoutRequest, SetTimeoutResponse, SubscribeRequest, SubscribeResponse, ControlAdmissionRequest, ControlAdmissionResponse, TakeSnapshotResponse } from "./core_pb";
+import { ControlPortRequest, ControlPortResponse, DescribeWorkspaceRequest, DescribeWorkspaceResponse, MarkActiveRequest, MarkActiveResponse, StartWorkspaceRequest, StartWorkspaceResponse, StopWorkspaceRequest, StopWorkspaceResponse, GetWorkspacesRequest, GetWorkspacesResponse, TakeSnapshotRequest, SetTimeoutRequest, SetTimeoutResponse, SubscribeRequest, SubscribeResponse, ControlAdmissionRequest, ControlAdmissionResponse, TakeSnapshotResponse, GrantAccessRequest, GrantAccessResponse, RevokeAccessRequest, RevokeAccessResponse } from "./core_pb";
 import { TraceContext } from '@gitpod/gitpod-protocol/lib/util/tracing';
 import * as opentracing from 'opentracing';
 import * as grpc from "grpc";
@@ -199,6 +199,36 @@ export class PromisifiedWorkspaceManagerClient {
         }));
     }
 
+    public grantAccess(ctx: TraceContext, request: GrantAccessRequest): Promise<GrantAccessResponse> {
+        return this.retryIfUnavailable((attempt: number) => new Promise<GrantAccessResponse>((resolve, reject) => {
+            const span = TraceContext.startSpan(`/ws-manager/grantAccess`, ctx);
+            span.log({attempt});
+            this.client.grantAccess(request, withTracing({span}), this.getDefaultUnaryOptions(), (err, resp) => {
+                span.finish();
+                if (err) {
+                    reject(err);
+                } else {
+                    resolve(resp);
+                }
+            });
+        }));
+    }
+
+    public revokeAccess(ctx: TraceContext, request: RevokeAccessRequest): Promise<RevokeAccessResponse> {
+        return this.retryIfUnavailable((attempt: number) => new Promise<RevokeAccessResponse>((resolve, reject) => {
+            const span = TraceContext.startSpan(`/ws-manager/revokeAccess`, ctx);
+            span.log({attempt});
+            this.client.revokeAccess(request, withTracing({span}), this.getDefaultUnaryOptions(), (err, resp) => {
+                span.finish();
+                if (err) {
+                    reject(err);
+                } else {
+                    resolve(resp);
+                }
+            });
+        }));
+    }
+
     public subscribe(ctx: TraceContext, request: SubscribeRequest): Promise<grpc.ClientReadableStream<SubscribeResponse>> {
         return new Promise<grpc.ClientReadableStream<SubscribeResponse>>((resolve, reject) => {
             const span = TraceContext.startSpan(`/ws-manager/subscribe`, ctx);
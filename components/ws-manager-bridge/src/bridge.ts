@@ -6,8 +6,8 @@
 
 import { inject, injectable } from "inversify";
 import { MessageBusIntegration } from "./messagebus-integration";
-import { Disposable, WorkspaceInstance, Queue, WorkspaceInstancePort, PortVisibility, RunningWorkspaceInfo } from "@gitpod/gitpod-protocol";
-import { WorkspaceManagerClient, WorkspaceStatus, WorkspacePhase, GetWorkspacesRequest, GetWorkspacesResponse, WorkspaceConditionBool, WorkspaceLogMessage, PortVisibility as WsManPortVisibility } from "@gitpod/ws-manager/lib";
+import { Disposable, WorkspaceInstance, Queue, WorkspaceInstancePort, PortVisibility, PortProtocol, RunningWorkspaceInfo } from "@gitpod/gitpod-protocol";
+import { WorkspaceManagerClient, WorkspaceStatus, WorkspacePhase, GetWorkspacesRequest, GetWorkspacesResponse, WorkspaceConditionBool, WorkspaceLogMessage, PortVisibility as WsManPortVisibility, PortProtocol as WsManPortProtocol } from "@gitpod/ws-manager/lib";
 import { WorkspaceDB } from "@gitpod/gitpod-db/lib/workspace-db";
 import { UserDB } from "@gitpod/gitpod-db/lib/user-db";
 import { log } from '@gitpod/gitpod-protocol/lib/util/logging';
@@ -99,6 +99,7 @@ export class WorkspaceManagerBridge implements Disposable {
                         targetPort: !!p.target ? p.target : undefined,
                         visibility: mapPortVisibility(p.visibility),
                         url: p.url,
+                        protocol: mapPortProtocol(p.protocol),
                     };
                 });
             }
@@ -302,6 +303,22 @@ const mapPortVisibility = (visibility: WsManPortVisibility | undefined): PortVis
     }
 };
 
+const mapPortProtocol = (protocol: WsManPortProtocol | undefined): PortProtocol | undefined => {
+    switch (protocol) {
+        case undefined:
+        case WsManPortProtocol.PORT_PROTOCOL_HTTP:
+            return undefined;
+        case WsManPortProtocol.PORT_PROTOCOL_H2C:
+            return "h2c";
+        case WsManPortProtocol.PORT_PROTOCOL_TLS:
+            return "tls";
+        case WsManPortProtocol.PORT_PROTOCOL_GRPC:
+            return "grpc";
+        case WsManPortProtocol.PORT_PROTOCOL_TCP:
+            return "tcp";
+    }
+};
+
 const durationLongerThanSeconds = (time: number, durationSeconds: number, now: number = Date.now()) => {
     return (now - time) / 1000 > durationSeconds;
 };
\ No newline at end of file
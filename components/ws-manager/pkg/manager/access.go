@@ -0,0 +1,145 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package manager
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/xerrors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+)
+
+// maxAccessGrants is the number of unexpired access grants a workspace can have at most.
+// All grants live in a single pod annotation which we must not grow without bound.
+const maxAccessGrants = 32
+
+// accessGrant is the form in which we store access grants in the workspaceAccessGrantsAnnotation
+type accessGrant struct {
+	ID        string     `json:"id"`
+	UserID    string     `json:"userId,omitempty"`
+	Scope     string     `json:"scope"`
+	TokenHash string     `json:"tokenHash"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// errAccessGrantWithoutExpiry is returned when someone attempts to store a grant which never expires.
+// ws-proxy does not honour such grants, hence we must not hand them out in the first place.
+var errAccessGrantWithoutExpiry = xerrors.Errorf("access grant has no expiry")
+
+// expired returns true if the grant is no longer valid. Grants without expiry are invalid, too.
+func (g accessGrant) expired(now time.Time) bool {
+	return g.ExpiresAt == nil || !now.Before(*g.ExpiresAt)
+}
+
+// hashAccessToken produces the hash of an access token which we store in place of the token itself
+func hashAccessToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// getAccessGrants parses the access grants stored on a workspace pod
+func getAccessGrants(pod *corev1.Pod) ([]accessGrant, error) {
+	v, ok := pod.Annotations[workspaceAccessGrantsAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	var grants []accessGrant
+	err := json.Unmarshal([]byte(v), &grants)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse access grants: %w", err)
+	}
+	return grants, nil
+}
+
+// accessGrantsToAPI converts the stored access grants to their API form, leaving out those which have expired
+func accessGrantsToAPI(grants []accessGrant, now time.Time) ([]*api.AccessGrant, error) {
+	var res []*api.AccessGrant
+	for _, g := range grants {
+		if g.expired(now) {
+			continue
+		}
+
+		ag := &api.AccessGrant{
+			Id:        g.ID,
+			UserId:    g.UserID,
+			Scope:     api.AccessScope(api.AccessScope_value[strings.ToUpper(g.Scope)]),
+			TokenHash: g.TokenHash,
+		}
+		ts, err := ptypes.TimestampProto(*g.ExpiresAt)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid expiry of access grant %s: %w", g.ID, err)
+		}
+		ag.ExpiresAt = ts
+		res = append(res, ag)
+	}
+	return res, nil
+}
+
+// patchAccessGrants modifies the access grants of a workspace. Grants which have expired are dropped before patch is called.
+// All grants patch returns must expire, otherwise patchAccessGrants fails with errAccessGrantWithoutExpiry.
+// Contrary to markWorkspace, patch sees the grants of the pod it's applied to, s.t. concurrent modifications don't get lost.
+func (m *Manager) patchAccessGrants(workspaceID string, patch func(grants []accessGrant) ([]accessGrant, error)) error {
+	client := m.Clientset.CoreV1().Pods(m.Config.Namespace)
+
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		pod, err := m.findWorkspacePod(workspaceID)
+		if err != nil {
+			return xerrors.Errorf("cannot find workspace %s: %w", workspaceID, err)
+		}
+		if pod == nil {
+			return xerrors.Errorf("workspace %s does not exist", workspaceID)
+		}
+
+		grants, err := getAccessGrants(pod)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		current := make([]accessGrant, 0, len(grants))
+		for _, g := range grants {
+			if g.expired(now) {
+				continue
+			}
+			current = append(current, g)
+		}
+
+		grants, err = patch(current)
+		if err != nil {
+			return err
+		}
+		for _, g := range grants {
+			if g.ExpiresAt == nil {
+				return xerrors.Errorf("grant %s: %w", g.ID, errAccessGrantWithoutExpiry)
+			}
+		}
+
+		if len(grants) == 0 {
+			delete(pod.Annotations, workspaceAccessGrantsAnnotation)
+		} else {
+			v, err := json.Marshal(grants)
+			if err != nil {
+				return xerrors.Errorf("cannot serialize access grants: %w", err)
+			}
+			pod.Annotations[workspaceAccessGrantsAnnotation] = string(v)
+		}
+
+		_, err = client.Update(pod)
+		return err
+	})
+	if err != nil {
+		return xerrors.Errorf("cannot patch access grants of workspace %s: %w", workspaceID, err)
+	}
+
+	return nil
+}
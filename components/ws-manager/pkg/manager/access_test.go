@@ -0,0 +1,125 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/xerrors"
+	fakek8s "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPatchAccessGrants(t *testing.T) {
+	var (
+		past   = time.Date(2020, 2, 28, 12, 0, 0, 0, time.UTC)
+		future = time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+		userGrant    = accessGrant{ID: "user", UserID: "foo", Scope: "access_scope_full", TokenHash: hashAccessToken("user-token"), ExpiresAt: &future}
+		eternalGrant = accessGrant{ID: "eternal", Scope: "access_scope_full", TokenHash: hashAccessToken("eternal-token")}
+		shareGrant   = accessGrant{ID: "share", Scope: "access_scope_read_only", TokenHash: hashAccessToken("share-token"), ExpiresAt: &future}
+		oldGrant     = accessGrant{ID: "old", Scope: "access_scope_full", TokenHash: hashAccessToken("old-token"), ExpiresAt: &past}
+		errPatch     = xerrors.Errorf("patch failed")
+	)
+
+	tests := []struct {
+		Description    string
+		InitialState   []accessGrant
+		Patch          func(grants []accessGrant) ([]accessGrant, error)
+		ExpectedGrants []accessGrant
+		ExpectedErr    error
+	}{
+		{
+			Description: "add grant",
+			Patch: func(grants []accessGrant) ([]accessGrant, error) {
+				return append(grants, userGrant), nil
+			},
+			ExpectedGrants: []accessGrant{userGrant},
+		},
+		{
+			Description:  "expired grants are dropped",
+			InitialState: []accessGrant{oldGrant, userGrant},
+			Patch: func(grants []accessGrant) ([]accessGrant, error) {
+				return append(grants, shareGrant), nil
+			},
+			ExpectedGrants: []accessGrant{userGrant, shareGrant},
+		},
+		{
+			Description:  "grants without expiry are rejected",
+			InitialState: []accessGrant{userGrant},
+			Patch: func(grants []accessGrant) ([]accessGrant, error) {
+				return append(grants, eternalGrant), nil
+			},
+			ExpectedGrants: []accessGrant{userGrant},
+			ExpectedErr:    errAccessGrantWithoutExpiry,
+		},
+		{
+			Description:  "remove last grant",
+			InitialState: []accessGrant{userGrant},
+			Patch: func(grants []accessGrant) ([]accessGrant, error) {
+				return nil, nil
+			},
+		},
+		{
+			Description:  "failed patch",
+			InitialState: []accessGrant{userGrant},
+			Patch: func(grants []accessGrant) ([]accessGrant, error) {
+				return nil, errPatch
+			},
+			ExpectedGrants: []accessGrant{userGrant},
+			ExpectedErr:    errPatch,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			manager := forTestingOnlyGetManager(t)
+			manager.Config.Namespace = ""
+			startCtx, err := forTestingOnlyCreateStartWorkspaceContext(manager, "foo", api.WorkspaceType_REGULAR)
+			if err != nil {
+				t.Errorf("cannot create test pod start context; this is a bug in the unit test itself: %v", err)
+				return
+			}
+
+			pod, err := manager.createDefiniteWorkspacePod(startCtx)
+			if err != nil {
+				t.Errorf("cannot create test pod; this is a bug in the unit test itself: %v", err)
+				return
+			}
+			manager.Clientset = fakek8s.NewSimpleClientset(pod)
+			if test.InitialState != nil {
+				err = manager.patchAccessGrants(startCtx.Request.Id, func([]accessGrant) ([]accessGrant, error) { return test.InitialState, nil })
+				if err != nil {
+					t.Errorf("cannot set initial access grants; this is a bug in the unit test itself: %v", err)
+					return
+				}
+			}
+
+			err = manager.patchAccessGrants(startCtx.Request.Id, test.Patch)
+			if !xerrors.Is(err, test.ExpectedErr) {
+				t.Errorf("unexpected error: expected %v, actual %v", test.ExpectedErr, err)
+				return
+			}
+
+			pod, _ = manager.findWorkspacePod(startCtx.Request.Id)
+			if len(test.ExpectedGrants) == 0 {
+				if v, ok := pod.Annotations[workspaceAccessGrantsAnnotation]; ok {
+					t.Errorf("access grants annotation was not removed: %s", v)
+				}
+				return
+			}
+			grants, err := getAccessGrants(pod)
+			if err != nil {
+				t.Errorf("cannot get access grants: %v", err)
+				return
+			}
+			if diff := cmp.Diff(test.ExpectedGrants, grants); diff != "" {
+				t.Errorf("unexpected access grants (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
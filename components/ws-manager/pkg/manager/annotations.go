@@ -76,12 +76,21 @@ const (
 	// workspaceAdmissionAnnotation determines the user admission to a workspace, i.e. if it can be accessed by everyone without token
 	workspaceAdmissionAnnotation = "gitpod/admission"
 
+	// workspaceAccessGrantsAnnotation holds the JSON serialized access grants of a workspace, i.e. who other than the owner can access it
+	workspaceAccessGrantsAnnotation = "gitpod/accessGrants"
+
 	// ingressPortsAnnotation holds the mapping workspace port -> allocated ingress port on kubernetes services
 	ingressPortsAnnotation = "gitpod/ingressPorts"
 
 	// withUsernamespaceAnnotation is set on workspaces which are wrapped in a user namespace (or have some form of user namespace support)
 	// Beware: this annotation is duplicated/copied in ws-daemon
 	withUsernamespaceAnnotation = "gitpod/withUsernamespace"
+
+	// contentProgressAnnotation holds the JSON serialized progress of the work ws-daemon currently does on the workspace content
+	contentProgressAnnotation = "gitpod/contentProgress"
+
+	// gitStatusAnnotation holds the JSON serialized Git status the workspace reported last
+	gitStatusAnnotation = "gitpod/gitStatus"
 )
 
 // markWorkspaceAsReady adds annotations to a workspace pod
@@ -0,0 +1,158 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	wsdaemon "github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"google.golang.org/grpc/codes"
+	grpc_status "google.golang.org/grpc/status"
+)
+
+const (
+	// contentProgressInterval is the minimum time between two updates of the content progress of a workspace,
+	// unless the operation or phase changes.
+	contentProgressInterval = 2 * time.Second
+)
+
+// watchContentProgress folds the lifecycle events ws-daemon reports for a workspace's content into the workspace pod,
+// s.t. they become part of the workspace status. This function returns once ctx is canceled or ws-daemon ends the stream.
+func (m *Monitor) watchContentProgress(ctx context.Context, snc wsdaemon.WorkspaceContentServiceClient, workspaceID string) {
+	log := log.WithFields(log.OWI("", "", workspaceID))
+
+	evts, err := snc.WatchWorkspaceEvents(ctx, &wsdaemon.WatchWorkspaceEventsRequest{Id: workspaceID})
+	if err != nil {
+		log.WithError(err).Debug("cannot watch workspace content events")
+		return
+	}
+
+	var (
+		progress   *api.ContentProgress
+		lastUpdate time.Time
+	)
+	for {
+		evt, err := evts.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return
+		}
+		if st, ok := grpc_status.FromError(err); ok && st.Code() == codes.Unimplemented {
+			// this ws-daemon does not report content events (yet)
+			return
+		}
+		if err != nil {
+			log.WithError(err).Debug("cannot receive workspace content event")
+			return
+		}
+
+		next, significant := foldContentEvent(progress, evt)
+		if next == progress {
+			continue
+		}
+		progress = next
+		if !significant && time.Since(lastUpdate) < contentProgressInterval {
+			continue
+		}
+
+		var mark *annotation
+		if progress == nil {
+			mark = deleteMark(contentProgressAnnotation)
+		} else {
+			fc, err := json.Marshal(progress)
+			if err != nil {
+				log.WithError(err).Warn("cannot marshal workspace content progress")
+				continue
+			}
+			mark = addMark(contentProgressAnnotation, string(fc))
+		}
+		err = m.manager.markWorkspace(workspaceID, mark)
+		if err != nil {
+			log.WithError(err).Debug("cannot update workspace content progress")
+			continue
+		}
+		lastUpdate = time.Now()
+	}
+}
+
+// foldContentEvent computes the content progress after an event. Significant changes, e.g. a new phase
+// or an error, should be reported right away. If the event does not change the progress, cur is returned.
+func foldContentEvent(cur *api.ContentProgress, evt *wsdaemon.WorkspaceLifecycleEvent) (next *api.ContentProgress, significant bool) {
+	switch p := evt.Payload.(type) {
+	case *wsdaemon.WorkspaceLifecycleEvent_Initializer:
+		ip := p.Initializer
+		if ip.Done {
+			return nil, true
+		}
+
+		next = &api.ContentProgress{
+			Operation: "initialize",
+			Phase:     ip.Phase,
+			Percent:   percentOf(ip.BytesDownloaded, ip.BytesTotal),
+		}
+	case *wsdaemon.WorkspaceLifecycleEvent_Backup:
+		bp := p.Backup
+		if !bp.Final {
+			// backups of running workspaces are of no interest for the workspace status
+			return cur, false
+		}
+		if bp.Done {
+			return nil, true
+		}
+
+		next = &api.ContentProgress{
+			Operation: "backup",
+			Phase:     bp.Phase,
+			Percent:   percentOf(bp.BytesUploaded, bp.BytesTotal),
+			Attempt:   bp.Attempt,
+		}
+	case *wsdaemon.WorkspaceLifecycleEvent_Error:
+		if p.Error.Operation == "backup" && (cur == nil || cur.Operation != "backup") {
+			// final backups report their progress before they can fail - this error stems from a backup of a running workspace
+			return cur, false
+		}
+		if cur == nil {
+			return &api.ContentProgress{
+				Operation: p.Error.Operation,
+				Percent:   -1,
+				Error:     p.Error.Message,
+			}, true
+		}
+
+		return &api.ContentProgress{
+			Operation: cur.Operation,
+			Phase:     cur.Phase,
+			Percent:   cur.Percent,
+			Attempt:   cur.Attempt,
+			Error:     p.Error.Message,
+		}, true
+	default:
+		return cur, false
+	}
+
+	significant = cur == nil || cur.Operation != next.Operation || cur.Phase != next.Phase || cur.Attempt != next.Attempt
+	if !significant && cur.Percent == next.Percent {
+		return cur, false
+	}
+	if !significant {
+		// we're still in the same phase - keep the error around until something significant happens
+		next.Error = cur.Error
+	}
+	return next, significant
+}
+
+func percentOf(n, total int64) int32 {
+	if total <= 0 {
+		return -1
+	}
+	if n >= total {
+		return 100
+	}
+	return int32(n * 100 / total)
+}
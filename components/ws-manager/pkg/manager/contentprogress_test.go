@@ -0,0 +1,129 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package manager
+
+import (
+	"testing"
+
+	wsdaemon "github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/golang/protobuf/proto"
+)
+
+func TestFoldContentEvent(t *testing.T) {
+	initEvt := func(phase string, n, total int64, done bool) *wsdaemon.WorkspaceLifecycleEvent {
+		return &wsdaemon.WorkspaceLifecycleEvent{Payload: &wsdaemon.WorkspaceLifecycleEvent_Initializer{Initializer: &wsdaemon.InitializerProgress{
+			Phase:           phase,
+			BytesDownloaded: n,
+			BytesTotal:      total,
+			Done:            done,
+		}}}
+	}
+	backupEvt := func(phase string, attempt int32, final, done bool) *wsdaemon.WorkspaceLifecycleEvent {
+		return &wsdaemon.WorkspaceLifecycleEvent{Payload: &wsdaemon.WorkspaceLifecycleEvent_Backup{Backup: &wsdaemon.BackupProgress{
+			Phase:         phase,
+			BytesUploaded: 50,
+			BytesTotal:    100,
+			Attempt:       attempt,
+			Final:         final,
+			Done:          done,
+		}}}
+	}
+	errEvt := func(operation, msg string) *wsdaemon.WorkspaceLifecycleEvent {
+		return &wsdaemon.WorkspaceLifecycleEvent{Payload: &wsdaemon.WorkspaceLifecycleEvent_Error{Error: &wsdaemon.ContentError{
+			Operation: operation,
+			Message:   msg,
+			WillRetry: true,
+		}}}
+	}
+
+	tests := []struct {
+		Description string
+		Current     *api.ContentProgress
+		Event       *wsdaemon.WorkspaceLifecycleEvent
+		Expectation *api.ContentProgress
+		Significant bool
+		Unchanged   bool
+	}{
+		{
+			Description: "first initializer event",
+			Event:       initEvt("cloning", 0, 0, false),
+			Expectation: &api.ContentProgress{Operation: "initialize", Phase: "cloning", Percent: -1},
+			Significant: true,
+		},
+		{
+			Description: "download progress",
+			Current:     &api.ContentProgress{Operation: "initialize", Phase: "downloading snapshot", Percent: 10},
+			Event:       initEvt("downloading snapshot", 50, 200, false),
+			Expectation: &api.ContentProgress{Operation: "initialize", Phase: "downloading snapshot", Percent: 25},
+		},
+		{
+			Description: "same percentage",
+			Current:     &api.ContentProgress{Operation: "initialize", Phase: "downloading snapshot", Percent: 25},
+			Event:       initEvt("downloading snapshot", 51, 200, false),
+			Unchanged:   true,
+		},
+		{
+			Description: "initializer done",
+			Current:     &api.ContentProgress{Operation: "initialize", Phase: "cloning", Percent: -1},
+			Event:       initEvt("", 0, 0, true),
+			Significant: true,
+		},
+		{
+			Description: "regular backup",
+			Event:       backupEvt("uploading", 1, false, false),
+			Unchanged:   true,
+		},
+		{
+			Description: "final backup retry",
+			Current:     &api.ContentProgress{Operation: "backup", Phase: "uploading", Percent: 90, Attempt: 1, Error: "timeout"},
+			Event:       backupEvt("uploading", 2, true, false),
+			Expectation: &api.ContentProgress{Operation: "backup", Phase: "uploading", Percent: 50, Attempt: 2},
+			Significant: true,
+		},
+		{
+			Description: "error keeps progress",
+			Current:     &api.ContentProgress{Operation: "backup", Phase: "uploading", Percent: 90, Attempt: 1},
+			Event:       errEvt("backup", "timeout"),
+			Expectation: &api.ContentProgress{Operation: "backup", Phase: "uploading", Percent: 90, Attempt: 1, Error: "timeout"},
+			Significant: true,
+		},
+		{
+			Description: "error without progress",
+			Event:       errEvt("initialize", "timeout"),
+			Expectation: &api.ContentProgress{Operation: "initialize", Percent: -1, Error: "timeout"},
+			Significant: true,
+		},
+		{
+			Description: "regular backup error",
+			Event:       errEvt("backup", "timeout"),
+			Unchanged:   true,
+		},
+		{
+			Description: "regular backup error while initializing",
+			Current:     &api.ContentProgress{Operation: "initialize", Phase: "cloning", Percent: -1},
+			Event:       errEvt("backup", "timeout"),
+			Unchanged:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			next, significant := foldContentEvent(test.Current, test.Event)
+			if test.Unchanged {
+				if next != test.Current {
+					t.Errorf("expected progress to remain unchanged, got %v", next)
+				}
+				return
+			}
+			if significant != test.Significant {
+				t.Errorf("unexpected significance: expected %v, got %v", test.Significant, significant)
+			}
+			if !proto.Equal(next, test.Expectation) {
+				t.Errorf("unexpected progress: expected %v, got %v", test.Expectation, next)
+			}
+		})
+	}
+}
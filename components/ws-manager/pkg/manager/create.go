@@ -216,7 +216,7 @@ func (m *Manager) createDefiniteWorkspacePod(startContext *startWorkspaceContext
 	if err != nil {
 		return nil, xerrors.Errorf("cannot create workspace container: %w", err)
 	}
-	theiaVolume, workspaceVolume, err := m.createWorkspaceVolumes(startContext)
+	theiaVolume, workspaceVolume, daemonVolume, statusVolume, err := m.createWorkspaceVolumes(startContext)
 	if err != nil {
 		return nil, xerrors.Errorf("cannot create workspace volumes: %w", err)
 	}
@@ -336,6 +336,8 @@ func (m *Manager) createDefiniteWorkspacePod(startContext *startWorkspaceContext
 			Volumes: []corev1.Volume{
 				theiaVolume,
 				workspaceVolume,
+				daemonVolume,
+				statusVolume,
 			},
 			Tolerations: []corev1.Toleration{
 				{
@@ -387,10 +389,7 @@ func (m *Manager) createDefiniteWorkspacePod(startContext *startWorkspaceContext
 			//   - the TAP driver documentation says so (see https://www.kernel.org/doc/Documentation/networking/tuntap.txt)
 			//   - systemd's nspawn does the same thing (if it's good enough for them, it's good enough for us)
 			var (
-				devType          = corev1.HostPathFile
-				hostPathOrCreate = corev1.HostPathDirectoryOrCreate
-				daemonVolumeName = "daemon-mount"
-				mountPropagation = corev1.MountPropagationHostToContainer
+				devType = corev1.HostPathFile
 			)
 			pod.Spec.Volumes = append(pod.Spec.Volumes,
 				corev1.Volume{
@@ -402,15 +401,6 @@ func (m *Manager) createDefiniteWorkspacePod(startContext *startWorkspaceContext
 						},
 					},
 				},
-				corev1.Volume{
-					Name: daemonVolumeName,
-					VolumeSource: corev1.VolumeSource{
-						HostPath: &corev1.HostPathVolumeSource{
-							Path: filepath.Join(m.Config.WorkspaceHostPath, startContext.Request.Id+"-daemon"),
-							Type: &hostPathOrCreate,
-						},
-					},
-				},
 			)
 			for i, c := range pod.Spec.Containers {
 				if c.Name != "workspace" {
@@ -422,11 +412,6 @@ func (m *Manager) createDefiniteWorkspacePod(startContext *startWorkspaceContext
 						MountPath: "/dev/net/tun",
 						Name:      "dev-net-tun",
 					},
-					corev1.VolumeMount{
-						MountPath:        "/.workspace",
-						Name:             daemonVolumeName,
-						MountPropagation: &mountPropagation,
-					},
 				)
 				pod.Spec.Containers[i].Command = []string{pod.Spec.Containers[i].Command[0], "ring0"}
 				break
@@ -478,6 +463,13 @@ func (m *Manager) createDefiniteWorkspacePod(startContext *startWorkspaceContext
 		}
 	}
 
+	_, userns := ffidx[api.WorkspaceFeatureFlag_USER_NAMESPACE]
+	_, fwb := ffidx[api.WorkspaceFeatureFlag_FULL_WORKSPACE_BACKUP]
+	if !userns && !fwb {
+		// ws-daemon serves its in-workspace services to user-namespaced and full workspace backup workspaces only
+		removeVolume(&pod, daemonVolumeName)
+	}
+
 	return &pod, nil
 }
 
@@ -546,6 +538,15 @@ func (m *Manager) createWorkspaceContainer(startContext *startWorkspaceContext)
 				MountPath: theiaDir,
 				ReadOnly:  true,
 			},
+			{
+				Name:             daemonVolumeName,
+				MountPath:        daemonDir,
+				MountPropagation: &mountPropagation,
+			},
+			{
+				Name:      statusVolumeName,
+				MountPath: statusDir,
+			},
 		},
 		ReadinessProbe: &corev1.Probe{
 			Handler: corev1.Handler{
@@ -588,6 +589,7 @@ func (m *Manager) createWorkspaceEnvironment(startContext *startWorkspaceContext
 	result = append(result, corev1.EnvVar{Name: "GITPOD_WORKSPACE_URL", Value: startContext.WorkspaceURL})
 	result = append(result, corev1.EnvVar{Name: "THEIA_SUPERVISOR_TOKEN", Value: m.Config.TheiaSupervisorToken})
 	result = append(result, corev1.EnvVar{Name: "THEIA_SUPERVISOR_ENDPOINT", Value: fmt.Sprintf(":%d", startContext.SupervisorPort)})
+	result = append(result, corev1.EnvVar{Name: "THEIA_SUPERVISOR_OWNER_TOKEN", Value: startContext.OwnerToken})
 	result = append(result, corev1.EnvVar{Name: "THEIA_WEBVIEW_EXTERNAL_ENDPOINT", Value: "webview-{{hostname}}"})
 
 	// We don't require that Git be configured for workspaces
@@ -599,7 +601,7 @@ func (m *Manager) createWorkspaceEnvironment(startContext *startWorkspaceContext
 	// User-defined env vars (i.e. those coming from the request)
 	if spec.Envvars != nil {
 		for _, e := range spec.Envvars {
-			if e.Name == "GITPOD_TASKS" || e.Name == "GITPOD_RESOLVED_EXTENSIONS" {
+			if e.Name == "GITPOD_TASKS" || e.Name == "GITPOD_SERVICES" || e.Name == "GITPOD_RESOLVED_EXTENSIONS" {
 				result = append(result, corev1.EnvVar{Name: e.Name, Value: e.Value})
 				continue
 			} else if strings.HasPrefix(e.Name, "GITPOD_") {
@@ -638,7 +640,7 @@ func (m *Manager) createWorkspaceEnvironment(startContext *startWorkspaceContext
 	return cleanResult, nil
 }
 
-func (m *Manager) createWorkspaceVolumes(startContext *startWorkspaceContext) (theia corev1.Volume, workspace corev1.Volume, err error) {
+func (m *Manager) createWorkspaceVolumes(startContext *startWorkspaceContext) (theia corev1.Volume, workspace corev1.Volume, daemon corev1.Volume, status corev1.Volume, err error) {
 	// silly protobuf structure design - this needs to be a reference to a string,
 	// so we have to assign it to a variable first to take the address
 	hostPathOrCreate := corev1.HostPathDirectoryOrCreate
@@ -662,6 +664,26 @@ func (m *Manager) createWorkspaceVolumes(startContext *startWorkspaceContext) (t
 			},
 		},
 	}
+	// ws-daemon serves the in-workspace services on a socket in this volume. Only some workspaces get it, see createDefiniteWorkspacePod.
+	daemon = corev1.Volume{
+		Name: daemonVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{
+				Path: filepath.Join(m.Config.WorkspaceHostPath, startContext.Request.Id+"-daemon"),
+				Type: &hostPathOrCreate,
+			},
+		},
+	}
+	// ws-daemon serves the in-workspace status services on a socket in this volume. Every workspace gets it.
+	status = corev1.Volume{
+		Name: statusVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{
+				Path: filepath.Join(m.Config.WorkspaceHostPath, startContext.Request.Id+"-status"),
+				Type: &hostPathOrCreate,
+			},
+		},
+	}
 
 	err = nil
 	return
@@ -745,6 +767,8 @@ func (m *Manager) createPortsService(workspaceID string, metaID string, serviceP
 			return nil, xerrors.Errorf("cannot render public URL for %d: %w", p.Port, err)
 		}
 		annotations[fmt.Sprintf("gitpod/port-url-%d", p.Port)] = url
+		setPortProtocolAnnotation(annotations, p)
+		setPortRateLimitAnnotation(annotations, p)
 	}
 
 	return &corev1.Service{
@@ -813,6 +837,7 @@ func (m *Manager) newStartWorkspaceContext(ctx context.Context, req *api.StartWo
 		Request:        req,
 		IDEPort:        23000,
 		SupervisorPort: 22999,
+		SSHPort:        23001,
 		WorkspaceURL:   workspaceURL,
 		TraceID:        traceID,
 		Headless:       headless,
@@ -70,12 +70,12 @@ func TestCreateDefiniteWorkspacePod(t *testing.T) {
 
 				b, err := yaml.Marshal(f.ctnt)
 				if err != nil {
-					t.Errorf("cannot re-marshal %s template: %w", f.tplfn, err)
+					t.Errorf("cannot re-marshal %s template: %v", f.tplfn, err)
 					return nil
 				}
 				err = afero.WriteFile(fs, f.tplfn, b, 0755)
 				if err != nil {
-					t.Errorf("cannot write %s template: %w", f.tplfn, err)
+					t.Errorf("cannot write %s template: %v", f.tplfn, err)
 					return nil
 				}
 				f.setter(f.tplfn)
@@ -0,0 +1,117 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	wsdaemon "github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	grpc_status "google.golang.org/grpc/status"
+)
+
+// watchGitStatus folds the Git status a running workspace reports through ws-daemon into the workspace pod,
+// s.t. the workspace status reflects the repo while the workspace is running. Calling this function for a workspace
+// that's already watched does nothing. The watch ends once stopWatchingGitStatus is called or ws-daemon ends the stream.
+func (m *Monitor) watchGitStatus(wso workspaceObjects, workspaceID string) {
+	m.gitStatusWatchersLock.Lock()
+	defer m.gitStatusWatchersLock.Unlock()
+	if _, watching := m.gitStatusWatchers[workspaceID]; watching {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m.gitStatusWatchers[workspaceID] = cancel
+
+	go func() {
+		defer func() {
+			m.gitStatusWatchersLock.Lock()
+			delete(m.gitStatusWatchers, workspaceID)
+			m.gitStatusWatchersLock.Unlock()
+			cancel()
+		}()
+
+		snc, err := m.manager.connectToWorkspaceDaemon(ctx, wso)
+		if err != nil {
+			log.WithFields(wso.GetOWI()).WithError(err).Debug("cannot connect to ws-daemon - not watching Git status")
+			return
+		}
+		m.foldGitStatus(ctx, snc, workspaceID, wso.Pod.Annotations[gitStatusAnnotation])
+	}()
+}
+
+// stopWatchingGitStatus ends the Git status watch of a workspace if there is one
+func (m *Monitor) stopWatchingGitStatus(workspaceID string) {
+	m.gitStatusWatchersLock.Lock()
+	defer m.gitStatusWatchersLock.Unlock()
+	if cancel, watching := m.gitStatusWatchers[workspaceID]; watching {
+		cancel()
+		delete(m.gitStatusWatchers, workspaceID)
+	}
+}
+
+func (m *Monitor) foldGitStatus(ctx context.Context, snc wsdaemon.WorkspaceContentServiceClient, workspaceID string, current string) {
+	log := log.WithFields(log.OWI("", "", workspaceID))
+
+	evts, err := snc.WatchWorkspaceEvents(ctx, &wsdaemon.WatchWorkspaceEventsRequest{Id: workspaceID})
+	if err != nil {
+		log.WithError(err).Debug("cannot watch workspace Git status")
+		return
+	}
+
+	var last *csapi.GitStatus
+	if current != "" {
+		last = &csapi.GitStatus{}
+		err = json.Unmarshal([]byte(current), last)
+		if err != nil {
+			last = nil
+		}
+	}
+	for {
+		evt, err := evts.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return
+		}
+		if st, ok := grpc_status.FromError(err); ok && st.Code() == codes.Unimplemented {
+			// this ws-daemon does not report content events (yet)
+			return
+		}
+		if err != nil {
+			log.WithError(err).Debug("cannot receive workspace Git status")
+			return
+		}
+
+		gs, ok := evt.Payload.(*wsdaemon.WorkspaceLifecycleEvent_GitStatus)
+		if !ok {
+			continue
+		}
+		repo := gs.GitStatus
+		if proto.Equal(last, repo) {
+			continue
+		}
+
+		var mark *annotation
+		if repo == nil {
+			mark = deleteMark(gitStatusAnnotation)
+		} else {
+			fc, err := json.Marshal(repo)
+			if err != nil {
+				log.WithError(err).Warn("cannot marshal workspace Git status")
+				continue
+			}
+			mark = addMark(gitStatusAnnotation, string(fc))
+		}
+		err = m.manager.markWorkspace(workspaceID, mark)
+		if err != nil {
+			log.WithError(err).Debug("cannot update workspace Git status")
+			continue
+		}
+		last = repo
+	}
+}
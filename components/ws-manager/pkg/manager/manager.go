@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -71,6 +72,7 @@ type startWorkspaceContext struct {
 	OwnerToken     string                     `json:"ownerToken"`
 	IDEPort        int32                      `json:"idePort"`
 	SupervisorPort int32                      `json:"supervisorPort"`
+	SSHPort        int32                      `json:"sshPort"`
 	WorkspaceURL   string                     `json:"workspaceURL"`
 	TraceID        string                     `json:"traceID"`
 	Headless       bool                       `json:"headless"`
@@ -81,8 +83,16 @@ const (
 	theiaVolumeName = "vol-this-theia"
 	// workspaceVolume is the name of the workspace volume
 	workspaceVolumeName = "vol-this-workspace"
+	// daemonVolumeName is the name of the volume through which ws-daemon offers its in-workspace services
+	daemonVolumeName = "daemon-mount"
+	// statusVolumeName is the name of the volume through which ws-daemon offers its in-workspace status services
+	statusVolumeName = "daemon-status"
 	// workspaceDir is the path within all containers where workspaceVolume is mounted to
 	workspaceDir = "/workspace"
+	// daemonDir is the path within the workspace container where the daemonVolume is mounted to
+	daemonDir = "/.workspace"
+	// statusDir is the path within the workspace container where the statusVolume is mounted to
+	statusDir = "/.workspace-status"
 	// theiaDir is the path within all containers where theiaVolume is mounted to
 	theiaDir = "/theia"
 	// MarkerLabel is the label by which we identify pods which belong to ws-manager
@@ -238,6 +248,10 @@ func (m *Manager) StartWorkspace(ctx context.Context, req *api.StartWorkspaceReq
 					Name: "supervisor",
 					Port: startContext.SupervisorPort,
 				},
+				{
+					Name: "ssh",
+					Port: startContext.SSHPort,
+				},
 			},
 			Selector: startContext.Labels,
 		},
@@ -716,6 +730,13 @@ func (m *Manager) ControlPort(ctx context.Context, req *api.ControlPortRequest)
 			service.Annotations = make(map[string]string)
 		}
 		service.Annotations[ingressPortsAnnotation] = string(serializedPorts)
+		if req.Expose {
+			setPortProtocolAnnotation(service.Annotations, req.Spec)
+			setPortRateLimitAnnotation(service.Annotations, req.Spec)
+		} else {
+			delete(service.Annotations, portProtocolAnnotation(req.Spec.Port))
+			delete(service.Annotations, portRateLimitAnnotation(req.Spec.Port))
+		}
 
 		for _, p := range service.Spec.Ports {
 			ingressPort, _ := alloc.AllocatedPort(int(p.Port))
@@ -770,6 +791,74 @@ func portNameToVisibility(s string) api.PortVisibility {
 	return api.PortVisibility(i32Value)
 }
 
+// portProtocolAnnotation returns the ports service annotation which stores the protocol of a port
+func portProtocolAnnotation(port uint32) string {
+	return fmt.Sprintf("gitpod/port-protocol-%d", port)
+}
+
+// setPortProtocolAnnotation stores the protocol of the port in the annotations. HTTP is the default and isn't stored.
+func setPortProtocolAnnotation(annotations map[string]string, spec *api.PortSpec) {
+	key := portProtocolAnnotation(spec.Port)
+	if spec.Protocol == api.PortProtocol_PORT_PROTOCOL_HTTP {
+		delete(annotations, key)
+		return
+	}
+	annotations[key] = strings.ToLower(strings.TrimPrefix(spec.Protocol.String(), "PORT_PROTOCOL_"))
+}
+
+// portProtocolFromAnnotations parses the protocol stored using setPortProtocolAnnotation (or HTTP if there is none)
+func portProtocolFromAnnotations(annotations map[string]string, port uint32) api.PortProtocol {
+	protocol, ok := annotations[portProtocolAnnotation(port)]
+	if !ok {
+		return api.PortProtocol_PORT_PROTOCOL_HTTP
+	}
+	i32Value, present := api.PortProtocol_value[fmt.Sprintf("PORT_PROTOCOL_%s", strings.ToUpper(protocol))]
+	if !present {
+		return api.PortProtocol_PORT_PROTOCOL_HTTP
+	}
+	return api.PortProtocol(i32Value)
+}
+
+// portRateLimitAnnotation returns the ports service annotation which stores the rate limit override of a port
+func portRateLimitAnnotation(port uint32) string {
+	return fmt.Sprintf("gitpod/port-rate-limit-%d", port)
+}
+
+// setPortRateLimitAnnotation stores the rate limit override of the port in the annotations as <rps>/<burst>.
+// Ports without override aren't stored.
+func setPortRateLimitAnnotation(annotations map[string]string, spec *api.PortSpec) {
+	key := portRateLimitAnnotation(spec.Port)
+	if spec.RateLimit == nil || spec.RateLimit.RequestsPerSecond == 0 {
+		delete(annotations, key)
+		return
+	}
+	annotations[key] = fmt.Sprintf("%d/%d", spec.RateLimit.RequestsPerSecond, spec.RateLimit.Burst)
+}
+
+// portRateLimitFromAnnotations parses the rate limit stored using setPortRateLimitAnnotation (or nil if there is none)
+func portRateLimitFromAnnotations(annotations map[string]string, port uint32) *api.RateLimit {
+	val, ok := annotations[portRateLimitAnnotation(port)]
+	if !ok {
+		return nil
+	}
+	segs := strings.Split(val, "/")
+	if len(segs) != 2 {
+		return nil
+	}
+	rps, err := strconv.ParseUint(segs[0], 10, 32)
+	if err != nil {
+		return nil
+	}
+	burst, err := strconv.ParseUint(segs[1], 10, 32)
+	if err != nil {
+		return nil
+	}
+	return &api.RateLimit{
+		RequestsPerSecond: uint32(rps),
+		Burst:             uint32(burst),
+	}
+}
+
 // DescribeWorkspace investigates a workspace and returns its status, and configuration
 func (m *Manager) DescribeWorkspace(ctx context.Context, req *api.DescribeWorkspaceRequest) (res *api.DescribeWorkspaceResponse, err error) {
 	span, ctx := tracing.FromContext(ctx, "DescribeWorkspace")
@@ -19,6 +19,7 @@ import (
 	wsdaemon "github.com/gitpod-io/gitpod/ws-daemon/api"
 	"github.com/gitpod-io/gitpod/ws-manager/api"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -113,6 +114,111 @@ func (m *Manager) ControlAdmission(ctx context.Context, req *api.ControlAdmissio
 	return &api.ControlAdmissionResponse{}, nil
 }
 
+var (
+	errTooManyAccessGrants = xerrors.Errorf("workspace has too many access grants")
+	errAccessGrantNotFound = xerrors.Errorf("access grant does not exist")
+)
+
+// GrantAccess gives the holder of a share token access to a workspace until the grant expires
+func (m *Manager) GrantAccess(ctx context.Context, req *api.GrantAccessRequest) (res *api.GrantAccessResponse, err error) {
+	span, ctx := tracing.FromContext(ctx, "GrantAccess")
+	tracing.ApplyOWI(span, log.OWI("", "", req.Id))
+	tracing.LogRequestSafe(span, req)
+	defer tracing.FinishSpan(span, &err)
+
+	scope, ok := api.AccessScope_name[int32(req.Scope)]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid access scope")
+	}
+	// ws-proxy cannot tell who presents a token, hence every grant is a bearer token which must expire
+	if req.Duration == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "access grants must have a duration")
+	}
+	d, err := time.ParseDuration(req.Duration)
+	if err != nil || d <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid duration \"%s\"", req.Duration)
+	}
+	expiresAt := time.Now().Add(d).UTC()
+
+	pod, err := m.findWorkspacePod(req.Id)
+	if isKubernetesObjNotFoundError(err) {
+		return nil, status.Errorf(codes.NotFound, "workspace %s does not exist", req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get workspace status: %q", err)
+	}
+	tracing.ApplyOWI(span, wsk8s.GetOWIFromObject(&pod.ObjectMeta))
+	if pod.DeletionTimestamp != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot grant access to stopping workspaces")
+	}
+
+	token, err := getRandomString(32)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot create access token: %q", err)
+	}
+	grant := accessGrant{
+		ID:     uuid.New().String(),
+		UserID: req.UserId,
+		// lowercase is just for vanity's sake
+		Scope:     strings.ToLower(scope),
+		TokenHash: hashAccessToken(token),
+		ExpiresAt: &expiresAt,
+	}
+
+	err = m.patchAccessGrants(req.Id, func(grants []accessGrant) ([]accessGrant, error) {
+		if len(grants) >= maxAccessGrants {
+			return nil, errTooManyAccessGrants
+		}
+		return append(grants, grant), nil
+	})
+	if xerrors.Is(err, errTooManyAccessGrants) {
+		return nil, status.Errorf(codes.ResourceExhausted, "workspace has %d access grants already - revoke some first", maxAccessGrants)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot grant access: %q", err)
+	}
+	tracing.LogKV(span, "grantID", grant.ID)
+
+	return &api.GrantAccessResponse{
+		GrantId: grant.ID,
+		Token:   token,
+	}, nil
+}
+
+// RevokeAccess withdraws an access grant. ws-proxy learns about the revocation through the status update.
+func (m *Manager) RevokeAccess(ctx context.Context, req *api.RevokeAccessRequest) (res *api.RevokeAccessResponse, err error) {
+	span, ctx := tracing.FromContext(ctx, "RevokeAccess")
+	tracing.ApplyOWI(span, log.OWI("", "", req.Id))
+	tracing.LogRequestSafe(span, req)
+	defer tracing.FinishSpan(span, &err)
+
+	pod, err := m.findWorkspacePod(req.Id)
+	if isKubernetesObjNotFoundError(err) {
+		return nil, status.Errorf(codes.NotFound, "workspace %s does not exist", req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get workspace status: %q", err)
+	}
+	tracing.ApplyOWI(span, wsk8s.GetOWIFromObject(&pod.ObjectMeta))
+
+	err = m.patchAccessGrants(req.Id, func(grants []accessGrant) ([]accessGrant, error) {
+		for i, g := range grants {
+			if g.ID == req.GrantId {
+				return append(grants[:i], grants[i+1:]...), nil
+			}
+		}
+		return nil, errAccessGrantNotFound
+	})
+	if xerrors.Is(err, errAccessGrantNotFound) {
+		return nil, status.Errorf(codes.NotFound, "access grant %s does not exist", req.GrantId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot revoke access: %q", err)
+	}
+
+	return &api.RevokeAccessResponse{}, nil
+}
+
 // SetTimeout changes the default timeout for a running workspace
 func (m *Manager) SetTimeout(ctx context.Context, req *api.SetTimeoutRequest) (res *api.SetTimeoutResponse, err error) {
 	span, ctx := tracing.FromContext(ctx, "SetTimeout")
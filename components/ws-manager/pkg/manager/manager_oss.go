@@ -26,6 +26,16 @@ func (m *Manager) ControlAdmission(ctx context.Context, req *api.ControlAdmissio
 	return nil, errEnterpriseFeature
 }
 
+// GrantAccess gives the holder of a share token access to a workspace until the grant expires
+func (m *Manager) GrantAccess(ctx context.Context, req *api.GrantAccessRequest) (res *api.GrantAccessResponse, err error) {
+	return nil, errEnterpriseFeature
+}
+
+// RevokeAccess withdraws an access grant
+func (m *Manager) RevokeAccess(ctx context.Context, req *api.RevokeAccessRequest) (res *api.RevokeAccessResponse, err error) {
+	return nil, errEnterpriseFeature
+}
+
 // SetTimeout changes the default timeout for a running workspace
 func (m *Manager) SetTimeout(ctx context.Context, req *api.SetTimeoutRequest) (res *api.SetTimeoutResponse, err error) {
 	return nil, errEnterpriseFeature
@@ -10,6 +10,7 @@ import (
 
 	ctesting "github.com/gitpod-io/gitpod/common-go/testing"
 	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -235,3 +236,31 @@ func TestFindWorkspacePod(t *testing.T) {
 		})
 	}
 }
+
+func TestPortRateLimitAnnotation(t *testing.T) {
+	tests := []struct {
+		Description string
+		Spec        *api.PortSpec
+		Annotation  string
+		Expectation *api.RateLimit
+	}{
+		{"no rate limit", &api.PortSpec{Port: 8080}, "", nil},
+		{"zero rate limit", &api.PortSpec{Port: 8080, RateLimit: &api.RateLimit{Burst: 10}}, "", nil},
+		{"rate limit", &api.PortSpec{Port: 8080, RateLimit: &api.RateLimit{RequestsPerSecond: 5, Burst: 10}}, "5/10", &api.RateLimit{RequestsPerSecond: 5, Burst: 10}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Description, func(t *testing.T) {
+			annotations := map[string]string{portRateLimitAnnotation(test.Spec.Port): "1/1"}
+			setPortRateLimitAnnotation(annotations, test.Spec)
+
+			if act := annotations[portRateLimitAnnotation(test.Spec.Port)]; act != test.Annotation {
+				t.Errorf("unexpected annotation: expected %q, actual %q", test.Annotation, act)
+			}
+			act := portRateLimitFromAnnotations(annotations, test.Spec.Port)
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected rate limit (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -89,6 +89,9 @@ type Monitor struct {
 	finalizerMap     map[string]context.CancelFunc
 	finalizerMapLock sync.Mutex
 
+	gitStatusWatchers     map[string]context.CancelFunc
+	gitStatusWatchersLock sync.Mutex
+
 	headlessListener *HeadlessListener
 
 	OnError func(error)
@@ -112,6 +115,8 @@ func (m *Manager) CreateMonitor() (*Monitor, error) {
 		didShutdown:      make(chan bool, 1),
 		headlessListener: NewHeadlessListener(m.Clientset, m.Config.Namespace),
 
+		gitStatusWatchers: make(map[string]context.CancelFunc),
+
 		OnError: func(err error) {
 			log.WithError(err).Error("workspace monitor error")
 		},
@@ -494,10 +499,16 @@ func (m *Monitor) actOnPodEvent(ctx context.Context, status *api.WorkspaceStatus
 			if err != nil {
 				log.WithError(err).Warn("was unable to remove traceID annotation from workspace")
 			}
+
+			// keep the Git status up to date while the user works in the workspace
+			m.watchGitStatus(*wso, workspaceID)
 		}
 	}
 
 	if status.Phase == api.WorkspacePhase_STOPPING {
+		// the final Git status is reported when the workspace content is disposed of
+		m.stopWatchingGitStatus(workspaceID)
+
 		// This may be the last pod-based status we'll ever see for this workspace, so we must store it in the
 		// plis config map which in turn will trigger the status update mechanism. Because we serialize events
 		// for each workspace, the cfgmap event won't be handled before this function finishes.
@@ -597,7 +608,7 @@ func (m *Monitor) actOnHeadlessDone(pod *corev1.Pod, failed bool) (err error) {
 			tracing.LogError(span, err)
 			return handleFailure(fmt.Sprintf("cannot take snapshot: %v", err))
 		}
-		res, err := snc.TakeSnapshot(ctx, &wsdaemon.TakeSnapshotRequest{Id: id})
+		res, err := snc.TakeSnapshot(ctx, &wsdaemon.TakeSnapshotRequest{Id: id, Prebuild: true})
 		if err != nil {
 			tracing.LogError(span, err)
 			return handleFailure(fmt.Sprintf("cannot take snapshot: %v", err))
@@ -936,7 +947,7 @@ func (m *Monitor) waitForWorkspaceReady(ctx context.Context, pod *corev1.Pod) (e
 	tracing.LogEvent(span, "contentInitDone")
 
 	// workspace is ready - mark it as such
-	err = m.manager.markWorkspace(workspaceID, deleteMark(workspaceNeverReadyAnnotation))
+	err = m.manager.markWorkspace(workspaceID, deleteMark(workspaceNeverReadyAnnotation), deleteMark(contentProgressAnnotation))
 	if err != nil {
 		return xerrors.Errorf("cannot workspace: %w", err)
 	}
@@ -1089,6 +1100,11 @@ func (m *Monitor) initializeWorkspaceContent(ctx context.Context, pod *corev1.Po
 		return nil
 	}
 
+	// report the initialization progress while ws-daemon initializes the workspace
+	watchCtx, stopWatching := context.WithCancel(ctx)
+	defer stopWatching()
+	go m.watchContentProgress(watchCtx, snc, workspaceID)
+
 	err = retryIfUnavailable(ctx, func(ctx context.Context) error {
 		_, err = snc.InitWorkspace(ctx, &wsdaemon.InitWorkspaceRequest{
 			Id: workspaceID,
@@ -1206,12 +1222,17 @@ func (m *Monitor) finalizeWorkspaceContent(ctx context.Context, wso *workspaceOb
 		m.finalizerMap[workspaceID] = cancelReq
 		m.finalizerMapLock.Unlock()
 
+		// report the backup progress while ws-daemon disposes of the workspace
+		watchCtx, stopWatching := context.WithCancel(ctx)
+		go m.watchContentProgress(watchCtx, snc, workspaceID)
+
 		// DiposeWorkspace will "degenerate" to a simple wait if the finalization/disposal process is already running.
 		// This is unlike the initialization process where we wait for things to finish in a later phase.
 		resp, err := snc.DisposeWorkspace(ctx, &wsdaemon.DisposeWorkspaceRequest{
 			Id:     workspaceID,
 			Backup: doBackup,
 		})
+		stopWatching()
 		if resp != nil {
 			gitStatus = resp.GitStatus
 		}
@@ -1277,7 +1298,8 @@ func (m *Monitor) finalizeWorkspaceContent(ctx context.Context, wso *workspaceOb
 		plis.FinalBackupComplete = true
 		needsUpdate = true
 
-		if plis.LastPodStatus != nil {
+		// if we could not dispose of the content, the Git status last reported by the workspace is the best we have
+		if plis.LastPodStatus != nil && (gitStatus != nil || backupError == nil) {
 			plis.LastPodStatus.Repo = gitStatus
 			needsUpdate = true
 		}
@@ -0,0 +1,113 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxPrebuildReportSize is the size up to which we download a prebuild report
+const maxPrebuildReportSize = 1 << 20
+
+// GetPrebuildReport provides the report and task logs a prebuild uploaded next to its snapshot
+func (m *Manager) GetPrebuildReport(ctx context.Context, req *api.GetPrebuildReportRequest) (res *api.GetPrebuildReportResponse, err error) {
+	span, ctx := tracing.FromContext(ctx, "GetPrebuildReport")
+	tracing.ApplyOWI(span, log.OWI(req.Owner, req.Id, ""))
+	defer tracing.FinishSpan(span, &err)
+
+	if req.Id == "" || req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "id and owner are required")
+	}
+	if m.Content == nil || m.Content.Storage == nil {
+		return nil, status.Error(codes.Unavailable, "no content storage configured")
+	}
+
+	var (
+		rs  = m.Content.Storage
+		bkt = rs.Bucket(req.Owner)
+	)
+	info, err := rs.SignDownload(ctx, bkt, storage.WorkspaceObject(req.Id, storage.PrebuildReport))
+	if err == storage.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "workspace %s has no prebuild report", req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get prebuild report: %q", err)
+	}
+	report, err := m.downloadPrebuildReport(ctx, info.URL)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot download prebuild report: %q", err)
+	}
+
+	res = &api.GetPrebuildReportResponse{
+		Snapshot: report.Snapshot,
+		Tasks:    make([]*api.PrebuildTaskReport, 0, len(report.Tasks)),
+	}
+	for _, t := range report.Tasks {
+		tr := &api.PrebuildTaskReport{
+			Id:       t.ID,
+			Name:     t.Name,
+			Failed:   t.Failed,
+			Failure:  t.Failure,
+			ExitCode: t.ExitCode,
+		}
+		for _, p := range t.Phases {
+			tr.Phases = append(tr.Phases, &api.PrebuildTaskPhase{
+				Name:       p.Name,
+				ExitCode:   p.ExitCode,
+				DurationMs: p.DurationMS,
+			})
+		}
+		if t.LogFile != "" {
+			loginfo, err := rs.SignDownload(ctx, bkt, storage.WorkspaceObject(req.Id, storage.PrebuildLog(t.ID)))
+			if err != nil && err != storage.ErrNotFound {
+				log.WithError(err).WithFields(log.OWI(req.Owner, req.Id, "")).WithField("task", t.ID).Warn("cannot sign prebuild task log download")
+			}
+			if err == nil {
+				tr.LogUrl = loginfo.URL
+			}
+		}
+		res.Tasks = append(res.Tasks, tr)
+	}
+
+	return res, nil
+}
+
+func (m *Manager) downloadPrebuildReport(ctx context.Context, url string) (*csapi.PrebuildReport, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	client := m.Content.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, xerrors.Errorf("cannot get %s: status %d", url, resp.StatusCode)
+	}
+
+	var report csapi.PrebuildReport
+	err = json.NewDecoder(io.LimitReader(resp.Body, maxPrebuildReportSize)).Decode(&report)
+	if err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
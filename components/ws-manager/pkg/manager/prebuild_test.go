@@ -0,0 +1,99 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package manager
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gitpod-io/gitpod/content-service/pkg/layer"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestGetPrebuildReport(t *testing.T) {
+	objects := map[string]string{
+		"gitpod-user-owner/workspaces/prebuild/prebuild-report.json": `{
+			"snapshot": "workspaces/prebuild/snapshot-1.tar@gitpod-user-owner",
+			"tasks": [
+				{"id": "0", "name": "build", "failed": true, "exitCode": 2, "logFile": "prebuild-log-0", "phases": [{"name": "before", "durationMs": 10}, {"name": "init", "exitCode": 2, "durationMs": 2000}]},
+				{"id": "1", "name": "lint", "failed": true, "failure": "depends on failed task \"build\"", "phases": []},
+				{"id": "2", "name": "docs", "logFile": "prebuild-log-2", "phases": []}
+			]
+		}`,
+		"gitpod-user-owner/workspaces/prebuild/prebuild-log-0.txt": "make: *** [all] Error 2",
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, ok := objects[strings.TrimPrefix(r.URL.Path, "/")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(content))
+	}))
+	defer srv.Close()
+
+	manager := forTestingOnlyGetManager(t)
+	manager.Content = &layer.Provider{
+		Storage: &testPresignedStorage{URL: srv.URL, Objects: objects},
+		Client:  srv.Client(),
+	}
+
+	_, err := manager.GetPrebuildReport(context.Background(), &api.GetPrebuildReportRequest{Id: "regular", Owner: "owner"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound for a workspace without prebuild report, got %v", err)
+	}
+
+	act, err := manager.GetPrebuildReport(context.Background(), &api.GetPrebuildReportRequest{Id: "prebuild", Owner: "owner"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := &api.GetPrebuildReportResponse{
+		Snapshot: "workspaces/prebuild/snapshot-1.tar@gitpod-user-owner",
+		Tasks: []*api.PrebuildTaskReport{
+			{
+				Id:       "0",
+				Name:     "build",
+				Failed:   true,
+				ExitCode: 2,
+				Phases: []*api.PrebuildTaskPhase{
+					{Name: "before", DurationMs: 10},
+					{Name: "init", ExitCode: 2, DurationMs: 2000},
+				},
+				LogUrl: srv.URL + "/gitpod-user-owner/workspaces/prebuild/prebuild-log-0.txt",
+			},
+			{Id: "1", Name: "lint", Failed: true, Failure: "depends on failed task \"build\""},
+			// the log of task 2 never made it to the storage
+			{Id: "2", Name: "docs"},
+		},
+	}
+	if diff := cmp.Diff(exp, act, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected GetPrebuildReport() (-want +got):\n%s", diff)
+	}
+}
+
+type testPresignedStorage struct {
+	URL     string
+	Objects map[string]string
+}
+
+func (s *testPresignedStorage) Bucket(owner string) string {
+	return "gitpod-user-" + owner
+}
+
+func (s *testPresignedStorage) SignDownload(ctx context.Context, bucket, obj string) (*storage.DownloadInfo, error) {
+	name := bucket + "/" + obj
+	if _, ok := s.Objects[name]; !ok {
+		return nil, storage.ErrNotFound
+	}
+	return &storage.DownloadInfo{URL: s.URL + "/" + name}, nil
+}
@@ -14,6 +14,7 @@ import (
 	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/common-go/util"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	regapi "github.com/gitpod-io/gitpod/registry-facade/api"
 	"github.com/gitpod-io/gitpod/ws-manager/api"
 	"github.com/golang/protobuf/ptypes"
@@ -342,6 +343,14 @@ func (m *Manager) getWorkspaceStatus(wso workspaceObjects) (*api.WorkspaceStatus
 		if av, ok := api.AdmissionLevel_value[strings.ToUpper(wso.Pod.Annotations[workspaceAdmissionAnnotation])]; ok {
 			admission = api.AdmissionLevel(av)
 		}
+		grants, err := getAccessGrants(wso.Pod)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot get workspace status: %w", err)
+		}
+		apiGrants, err := accessGrantsToAPI(grants, time.Now())
+		if err != nil {
+			return nil, xerrors.Errorf("cannot get workspace status: %w", err)
+		}
 
 		status = &api.WorkspaceStatus{
 			Id:       id,
@@ -363,6 +372,7 @@ func (m *Manager) getWorkspaceStatus(wso workspaceObjects) (*api.WorkspaceStatus
 			Auth: &api.WorkspaceAuthentication{
 				Admission:  admission,
 				OwnerToken: ownerToken,
+				Grants:     apiGrants,
 			},
 		}
 
@@ -395,6 +405,8 @@ func (m *Manager) getWorkspaceStatus(wso workspaceObjects) (*api.WorkspaceStatus
 				Target:     uint32(p.TargetPort.IntValue()),
 				Visibility: portNameToVisibility(p.Name),
 				Url:        service.Annotations[fmt.Sprintf("gitpod/port-url-%d", p.Port)],
+				Protocol:   portProtocolFromAnnotations(service.Annotations, uint32(p.Port)),
+				RateLimit:  portRateLimitFromAnnotations(service.Annotations, uint32(p.Port)),
 			}
 
 			// enforce the cannonical form where target defaults to port
@@ -446,6 +458,36 @@ func getWorkspaceMetadata(pod *corev1.Pod) *api.WorkspaceMetadata {
 func (m *Manager) extractStatusFromPod(result *api.WorkspaceStatus, wso workspaceObjects) error {
 	pod := wso.Pod
 
+	if lastBackup, ok := pod.Annotations[wsk8s.LastBackupAnnotation]; ok {
+		t, err := time.Parse(time.RFC3339Nano, lastBackup)
+		if err != nil {
+			return xerrors.Errorf("cannot parse lastBackup: %w", err)
+		}
+		pt, err := ptypes.TimestampProto(t)
+		if err != nil {
+			return xerrors.Errorf("cannot convert lastBackup: %w", err)
+		}
+		result.Conditions.LastBackup = pt
+	}
+
+	if progress, ok := pod.Annotations[contentProgressAnnotation]; ok {
+		var cp api.ContentProgress
+		err := json.Unmarshal([]byte(progress), &cp)
+		if err != nil {
+			return xerrors.Errorf("cannot parse contentProgress: %w", err)
+		}
+		result.Conditions.ContentProgress = &cp
+	}
+
+	if repo, ok := pod.Annotations[gitStatusAnnotation]; ok {
+		var gs csapi.GitStatus
+		err := json.Unmarshal([]byte(repo), &gs)
+		if err != nil {
+			return xerrors.Errorf("cannot parse gitStatus: %w", err)
+		}
+		result.Repo = &gs
+	}
+
 	// check failure states, i.e. determine value of result.Failed
 	failure, phase := extractFailure(wso)
 	result.Conditions.Failed = failure
@@ -74,6 +74,9 @@ func (c *Config) Validate() error {
 	if err := c.WorkspaceInfoProviderConfig.Validate(); err != nil {
 		return err
 	}
+	if len(c.WorkspaceInfoProviderConfig.Clusters) > 0 && c.Proxy.ClusterForwardingSecret == "" {
+		return xerrors.Errorf("proxy.clusterForwardingSecret is required when forwarding to other workspace clusters")
+	}
 
 	return nil
 }
@@ -96,6 +99,7 @@ type IngressConfig struct {
 	HostBasedIngress   *HostBasedInressConfig         `json:"host"`
 	PathAndHostIngress *PathAndHostIngressConfig      `json:"pathAndHost"`
 	PathAndPortIngress *PathAndPortBasedIngressConfig `json:"pathAndPort"`
+	TCPIngress         *TCPIngressConfig              `json:"tcp,omitempty"`
 }
 
 // Validate validates this config
@@ -114,9 +118,24 @@ func (c *IngressConfig) Validate() (err error) {
 		return err
 	}
 
+	if c.TCPIngress != nil && c.TCPIngress.PortRange && c.Kind != PathAndPortIngress {
+		return xerrors.Errorf("TCP ingress on the port range requires %s ingress", PathAndPortIngress)
+	}
+
 	return nil
 }
 
+// TCPIngressConfig configures ingress to workspace ports which serve protocols other than HTTP.
+// Only TCP is supported - ws-proxy does not forward UDP.
+type TCPIngressConfig struct {
+	// SNIAddress is the address of the listener which routes TLS connections by their server name
+	// without terminating TLS. If empty, there is no such listener.
+	SNIAddress string `json:"sniAddress,omitempty"`
+	// PortRange opts into splicing raw TCP connections on the port range of the pathAndPort ingress,
+	// i.e. on the ingress ports ws-manager allocates.
+	PortRange bool `json:"portRange,omitempty"`
+}
+
 // HostBasedInressConfig configures the host-based ingress
 type HostBasedInressConfig struct {
 	Address string `json:"address"`
@@ -52,32 +52,59 @@ var runCmd = &cobra.Command{
 		}
 		log.Infof("workspace info provider started")
 
+		// all proxies share the rate limiter and metrics s.t. limits and counts apply across all ingress ports
+		var rateLimitConfig proxy.RateLimitConfig
+		if cfg.Proxy.RateLimit != nil {
+			rateLimitConfig = *cfg.Proxy.RateLimit
+		}
+		var (
+			rateLimiter      = proxy.NewRateLimiter(rateLimitConfig)
+			bandwidthMetrics = proxy.NewBandwidthMetrics()
+		)
+		newWorkspaceProxy := func(addr string, router proxy.WorkspaceRouter) *proxy.WorkspaceProxy {
+			p := proxy.NewWorkspaceProxy(addr, cfg.Proxy, router, workspaceInfoProvider)
+			p.RateLimiter = rateLimiter
+			p.BandwidthMetrics = bandwidthMetrics
+			return p
+		}
+		tcpProxy := proxy.NewTCPProxy(cfg.Proxy, workspaceInfoProvider)
+		tcpProxy.RateLimiter = rateLimiter
+		tcpProxy.BandwidthMetrics = bandwidthMetrics
+
 		switch cfg.Ingress.Kind {
 		case HostBasedIngress:
 			addr := cfg.Ingress.HostBasedIngress.Address
-			go proxy.NewWorkspaceProxy(addr, cfg.Proxy, proxy.HostBasedRouter(cfg.Ingress.HostBasedIngress.Header, cfg.Proxy.GitpodInstallation.WorkspaceHostSuffix), workspaceInfoProvider).MustServe()
+			go newWorkspaceProxy(addr, proxy.HostBasedRouter(cfg.Ingress.HostBasedIngress.Header, cfg.Proxy.GitpodInstallation.WorkspaceHostSuffix)).MustServe()
 			log.WithField("ingress", cfg.Ingress.Kind).Infof("started proxying on %s", addr)
 		case PathAndHostIngress:
 			addr := cfg.Ingress.PathAndHostIngress.Address
-			go proxy.NewWorkspaceProxy(addr, cfg.Proxy, proxy.PathAndHostRouter(cfg.Ingress.PathAndHostIngress.TrimPrefix, cfg.Ingress.PathAndHostIngress.Header, cfg.Proxy.GitpodInstallation.WorkspaceHostSuffix), workspaceInfoProvider).MustServe()
+			go newWorkspaceProxy(addr, proxy.PathAndHostRouter(cfg.Ingress.PathAndHostIngress.TrimPrefix, cfg.Ingress.PathAndHostIngress.Header, cfg.Proxy.GitpodInstallation.WorkspaceHostSuffix)).MustServe()
 			log.WithField("ingress", cfg.Ingress.Kind).Infof("started proxying on %s", addr)
 		case PathAndPortIngress:
 			var (
 				addr   = cfg.Ingress.PathAndPortIngress.Address
 				router = proxy.PathAndPortRouter(cfg.Ingress.PathAndPortIngress.TrimPrefix)
 			)
-			go proxy.NewWorkspaceProxy(addr, cfg.Proxy, router, workspaceInfoProvider).MustServe()
+			go newWorkspaceProxy(addr, router).MustServe()
 			log.WithField("ingress", cfg.Ingress.Kind).Infof("started proxying on %s", addr)
 
+			tcpPortRange := cfg.Ingress.TCPIngress != nil && cfg.Ingress.TCPIngress.PortRange
 			for port := cfg.Ingress.PathAndPortIngress.Start; port <= cfg.Ingress.PathAndPortIngress.End; port++ {
-				go proxy.
-					NewWorkspaceProxy(fmt.Sprintf(":%d", port), cfg.Proxy, router, workspaceInfoProvider).
-					MustServe()
+				p := newWorkspaceProxy(fmt.Sprintf(":%d", port), router)
+				if tcpPortRange {
+					p.TCPProxy = tcpProxy
+				}
+				go p.MustServe()
 			}
 			log.WithField("ingress", cfg.Ingress.Kind).Infof("started proxying on port range :%d-:%d", cfg.Ingress.PathAndPortIngress.Start, cfg.Ingress.PathAndPortIngress.End)
 		default:
 			log.Fatalf("unknown ingress kind %s", cfg.Ingress.Kind)
 		}
+		if cfg.Ingress.TCPIngress != nil && cfg.Ingress.TCPIngress.SNIAddress != "" {
+			addr := cfg.Ingress.TCPIngress.SNIAddress
+			go tcpProxy.MustServeSNI(addr)
+			log.WithField("ingress", "tcp").Infof("started TLS passthrough on %s", addr)
+		}
 
 		if cfg.PProfAddr != "" {
 			go pprof.Serve(cfg.PProfAddr)
@@ -88,6 +115,10 @@ var runCmd = &cobra.Command{
 				prometheus.NewGoCollector(),
 				prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
 			)
+			err = bandwidthMetrics.Register(reg)
+			if err != nil {
+				log.WithError(err).Fatal("cannot register metrics")
+			}
 
 			handler := http.NewServeMux()
 			handler.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
@@ -5,13 +5,19 @@
 package proxy
 
 import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"net/url"
+	"path"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/gorilla/mux"
 )
 
@@ -77,6 +83,25 @@ func WorkspaceAuthHandler(domain string, info WorkspaceInfoProvider) mux.Middlew
 				// port seems to be private - subject it to the same access policy as the workspace itself
 			}
 
+			if tkn, present := getAccessToken(req, cookiePrefix, ws.InstanceID); present {
+				// whoever presents an access token wants to use an access grant - the owner cookie does not matter then
+				grant := findAccessGrant(ws.Auth, tkn, time.Now())
+				if grant == nil {
+					log.Warn("access token matches no valid access grant")
+					resp.WriteHeader(http.StatusForbidden)
+					return
+				}
+				if grant.Scope == api.AccessScope_ACCESS_SCOPE_READ_ONLY && !isReadOnlyRequest(req, port != "") {
+					log.WithField("grantID", grant.Id).WithField("method", req.Method).Debug("read-only access grant does not permit request")
+					resp.WriteHeader(http.StatusForbidden)
+					return
+				}
+
+				req.Header.Del(accessTokenHeader)
+				h.ServeHTTP(resp, req)
+				return
+			}
+
 			cn := fmt.Sprintf("%s%s_owner_", cookiePrefix, ws.InstanceID)
 			c, err := req.Cookie(cn)
 			if err != nil {
@@ -101,3 +126,94 @@ func WorkspaceAuthHandler(domain string, info WorkspaceInfoProvider) mux.Middlew
 		})
 	}
 }
+
+// isAccessibleByAnyone returns true if anyone may access a workspace port without authenticating, i.e. if the
+// workspace admits everyone or the port is public. port is nil for connections which are not made to a workspace port.
+func isAccessibleByAnyone(ws *WorkspaceInfo, port *PortInfo) bool {
+	if ws.Auth != nil && ws.Auth.Admission == api.AdmissionLevel_ADMIT_EVERYONE {
+		return true
+	}
+	return port != nil && port.Visibility == api.PortVisibility_PORT_VISIBILITY_PUBLIC
+}
+
+// accessTokenHeader carries the token of an access grant for clients which cannot use the access cookie
+const accessTokenHeader = "x-gitpod-access-token"
+
+// getAccessToken returns the access grant token of a request, which is either the access cookie or the access token header
+func getAccessToken(req *http.Request, cookiePrefix, instanceID string) (token string, present bool) {
+	if tkn := req.Header.Get(accessTokenHeader); tkn != "" {
+		return tkn, true
+	}
+
+	c, err := req.Cookie(fmt.Sprintf("%s%s_access_", cookiePrefix, instanceID))
+	if err != nil {
+		return "", false
+	}
+	tkn, err := url.QueryUnescape(c.Value)
+	if err != nil {
+		// a token we cannot decode matches no grant
+		return c.Value, true
+	}
+	return tkn, true
+}
+
+// findAccessGrant returns the unexpired access grant the token belongs to, or nil if there is none
+func findAccessGrant(auth *api.WorkspaceAuthentication, token string, now time.Time) *api.AccessGrant {
+	if auth == nil {
+		return nil
+	}
+
+	h := sha256.Sum256([]byte(token))
+	hash := hex.EncodeToString(h[:])
+	for _, g := range auth.Grants {
+		if subtle.ConstantTimeCompare([]byte(g.TokenHash), []byte(hash)) != 1 {
+			continue
+		}
+		// every grant is a bearer token and hence must expire - ws-manager does not create any other
+		if g.ExpiresAt == nil {
+			return nil
+		}
+		exp, err := ptypes.Timestamp(g.ExpiresAt)
+		if err != nil || !now.Before(exp) {
+			return nil
+		}
+		return g
+	}
+	return nil
+}
+
+// readOnlySupervisorPrefixes are the supervisor routes a read-only access grant permits. Supervisor's REST gateway
+// serves state-changing and secret-revealing calls (e.g. closing terminals or getting tokens) as GET, hence we cannot
+// go by the method there.
+var readOnlySupervisorPrefixes = []string{
+	"/_supervisor/frontend/",
+	"/_supervisor/v1/status/",
+}
+
+// isReadOnlyRequest returns true if the request cannot modify anything in the workspace as far as we can tell.
+// Requests to workspace ports never reach supervisor, hence its routes only matter if portRequest is false.
+func isReadOnlyRequest(req *http.Request, portRequest bool) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+	default:
+		return false
+	}
+	// websockets are bidirectional and hence can do anything
+	if strings.EqualFold(req.Header.Get("Upgrade"), "websocket") {
+		return false
+	}
+	if portRequest {
+		return true
+	}
+
+	p := path.Clean(req.URL.Path)
+	if p != "/_supervisor" && !strings.HasPrefix(p, "/_supervisor/") {
+		return true
+	}
+	for _, prefix := range readOnlySupervisorPrefixes {
+		if strings.HasPrefix(p, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -5,14 +5,18 @@
 package proxy
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/google/go-cmp/cmp"
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
@@ -31,6 +35,11 @@ func TestWorkspaceAuthHandler(t *testing.T) {
 		instanceID  = "instance-fce1-4ff6-9364-cf6dff0c4ecf"
 		ownerToken  = "owner-token"
 		testPort    = 8080
+
+		fullAccessToken     = "full-access-token"
+		readOnlyAccessToken = "read-only-access-token"
+		expiredAccessToken  = "expired-access-token"
+		eternalAccessToken  = "eternal-access-token"
 	)
 	var (
 		ownerOnlyInfos = map[string]*WorkspaceInfo{
@@ -55,6 +64,23 @@ func TestWorkspaceAuthHandler(t *testing.T) {
 				Ports: []PortInfo{{PortSpec: api.PortSpec{Port: testPort, Visibility: api.PortVisibility_PORT_VISIBILITY_PUBLIC}}},
 			},
 		}
+		grantInfos = map[string]*WorkspaceInfo{
+			workspaceID: {
+				WorkspaceID: workspaceID,
+				InstanceID:  instanceID,
+				Auth: &api.WorkspaceAuthentication{
+					Admission:  api.AdmissionLevel_ADMIT_OWNER_ONLY,
+					OwnerToken: ownerToken,
+					Grants: []*api.AccessGrant{
+						{Id: "full", UserId: "foo", Scope: api.AccessScope_ACCESS_SCOPE_FULL, TokenHash: hashToken(fullAccessToken), ExpiresAt: &timestamp.Timestamp{Seconds: time.Now().Add(time.Hour).Unix()}},
+						{Id: "read-only", Scope: api.AccessScope_ACCESS_SCOPE_READ_ONLY, TokenHash: hashToken(readOnlyAccessToken), ExpiresAt: &timestamp.Timestamp{Seconds: time.Now().Add(time.Hour).Unix()}},
+						{Id: "expired", Scope: api.AccessScope_ACCESS_SCOPE_FULL, TokenHash: hashToken(expiredAccessToken), ExpiresAt: &timestamp.Timestamp{Seconds: time.Now().Add(-time.Hour).Unix()}},
+						{Id: "eternal", UserId: "foo", Scope: api.AccessScope_ACCESS_SCOPE_FULL, TokenHash: hashToken(eternalAccessToken)},
+					},
+				},
+				Ports: []PortInfo{{PortSpec: api.PortSpec{Port: testPort, Visibility: api.PortVisibility_PORT_VISIBILITY_PRIVATE}}},
+			},
+		}
 		admitEveryoneInfos = map[string]*WorkspaceInfo{
 			workspaceID: {
 				WorkspaceID: workspaceID,
@@ -64,12 +90,17 @@ func TestWorkspaceAuthHandler(t *testing.T) {
 		}
 	)
 	tests := []struct {
-		Name        string
-		Infos       map[string]*WorkspaceInfo
-		OwnerCookie string
-		WorkspaceID string
-		Port        string
-		Expected    testResult
+		Name         string
+		Infos        map[string]*WorkspaceInfo
+		OwnerCookie  string
+		AccessCookie string
+		AccessHeader string
+		Method       string
+		Path         string
+		Upgrade      string
+		WorkspaceID  string
+		Port         string
+		Expected     testResult
 	}{
 		{
 			Name:        "workspace not found",
@@ -217,21 +248,214 @@ func TestWorkspaceAuthHandler(t *testing.T) {
 				StatusCode:    http.StatusUnauthorized,
 			},
 		},
+		{
+			Name:         "access grant cookie",
+			Infos:        grantInfos,
+			WorkspaceID:  workspaceID,
+			AccessCookie: fullAccessToken,
+			Method:       http.MethodPost,
+			Expected: testResult{
+				HandlerCalled: true,
+				StatusCode:    http.StatusOK,
+			},
+		},
+		{
+			Name:         "access grant header",
+			Infos:        grantInfos,
+			WorkspaceID:  workspaceID,
+			AccessHeader: fullAccessToken,
+			Port:         strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: true,
+				StatusCode:    http.StatusOK,
+			},
+		},
+		{
+			Name:         "unknown access token",
+			Infos:        grantInfos,
+			WorkspaceID:  workspaceID,
+			AccessCookie: "this is the wrong value",
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
+		{
+			Name:         "unknown access token with owner cookie",
+			Infos:        grantInfos,
+			WorkspaceID:  workspaceID,
+			OwnerCookie:  ownerToken,
+			AccessHeader: "this is the wrong value",
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
+		{
+			Name:         "expired access grant",
+			Infos:        grantInfos,
+			WorkspaceID:  workspaceID,
+			AccessCookie: expiredAccessToken,
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
+		{
+			Name:         "access grant without expiry",
+			Infos:        grantInfos,
+			WorkspaceID:  workspaceID,
+			AccessCookie: eternalAccessToken,
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
+		{
+			Name:         "revoked access grant",
+			Infos:        ownerOnlyInfos,
+			WorkspaceID:  workspaceID,
+			AccessCookie: fullAccessToken,
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
+		{
+			Name:         "read-only access grant GET",
+			Infos:        grantInfos,
+			WorkspaceID:  workspaceID,
+			AccessHeader: readOnlyAccessToken,
+			Expected: testResult{
+				HandlerCalled: true,
+				StatusCode:    http.StatusOK,
+			},
+		},
+		{
+			Name:         "read-only access grant POST",
+			Infos:        grantInfos,
+			WorkspaceID:  workspaceID,
+			AccessHeader: readOnlyAccessToken,
+			Method:       http.MethodPost,
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
+		{
+			Name:         "read-only access grant supervisor status",
+			Infos:        grantInfos,
+			WorkspaceID:  workspaceID,
+			AccessHeader: readOnlyAccessToken,
+			Path:         "/_supervisor/v1/status/ide",
+			Expected: testResult{
+				HandlerCalled: true,
+				StatusCode:    http.StatusOK,
+			},
+		},
+		{
+			Name:         "read-only access grant supervisor frontend",
+			Infos:        grantInfos,
+			WorkspaceID:  workspaceID,
+			AccessHeader: readOnlyAccessToken,
+			Path:         "/_supervisor/frontend/main.js",
+			Expected: testResult{
+				HandlerCalled: true,
+				StatusCode:    http.StatusOK,
+			},
+		},
+		{
+			Name:         "read-only access grant close terminal",
+			Infos:        grantInfos,
+			WorkspaceID:  workspaceID,
+			AccessHeader: readOnlyAccessToken,
+			Path:         "/_supervisor/v1/terminal/close/foo",
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
+		{
+			Name:         "read-only access grant get token",
+			Infos:        grantInfos,
+			WorkspaceID:  workspaceID,
+			AccessHeader: readOnlyAccessToken,
+			Path:         "/_supervisor/v1/token/git/github.com/repo",
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
+		{
+			Name:         "read-only access grant supervisor path traversal",
+			Infos:        grantInfos,
+			WorkspaceID:  workspaceID,
+			AccessHeader: readOnlyAccessToken,
+			Path:         "/_supervisor/v1/status/../token/git/github.com/repo",
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
+		{
+			Name:         "read-only access grant supervisor path on port",
+			Infos:        grantInfos,
+			WorkspaceID:  workspaceID,
+			AccessHeader: readOnlyAccessToken,
+			Port:         strconv.Itoa(testPort),
+			Path:         "/_supervisor/v1/terminal/close/foo",
+			Expected: testResult{
+				HandlerCalled: true,
+				StatusCode:    http.StatusOK,
+			},
+		},
+		{
+			Name:         "read-only access grant websocket",
+			Infos:        grantInfos,
+			WorkspaceID:  workspaceID,
+			AccessCookie: readOnlyAccessToken,
+			Upgrade:      "websocket",
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
 			var res testResult
 			handler := WorkspaceAuthHandler(domain, &fixedInfoProvider{Infos: test.Infos})(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+				if req.Header.Get(accessTokenHeader) != "" {
+					t.Error("access token header was passed on")
+				}
 				res.HandlerCalled = true
 				resp.WriteHeader(http.StatusOK)
 			}))
 
 			rr := httptest.NewRecorder()
-			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://%s/", domain), nil)
+			method := test.Method
+			if method == "" {
+				method = http.MethodGet
+			}
+			path := test.Path
+			if path == "" {
+				path = "/"
+			}
+			req := httptest.NewRequest(method, fmt.Sprintf("http://%s%s", domain, path), nil)
 			if test.OwnerCookie != "" {
 				setOwnerTokenCookie(req, instanceID, test.OwnerCookie)
 			}
+			if test.AccessCookie != "" {
+				req.AddCookie(&http.Cookie{Name: "_test_domain_com_ws_" + instanceID + "_access_", Value: test.AccessCookie})
+			}
+			if test.AccessHeader != "" {
+				req.Header.Set(accessTokenHeader, test.AccessHeader)
+			}
+			if test.Upgrade != "" {
+				req.Header.Set("Connection", "upgrade")
+				req.Header.Set("Upgrade", test.Upgrade)
+			}
 			vars := map[string]string{
 				workspaceIDIdentifier: test.WorkspaceID,
 			}
@@ -254,3 +478,8 @@ func setOwnerTokenCookie(r *http.Request, instanceID, token string) *http.Reques
 	r.AddCookie(&http.Cookie{Name: "_test_domain_com_ws_" + instanceID + "_owner_", Value: token})
 	return r
 }
+
+func hashToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
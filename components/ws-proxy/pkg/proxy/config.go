@@ -27,6 +27,11 @@ type Config struct {
 	TheiaServer        *TheiaServer        `json:"theiaServer"`
 	GitpodInstallation *GitpodInstallation `json:"gitpodInstallation"`
 	WorkspacePodConfig *WorkspacePodConfig `json:"workspacePodConfig"`
+	RateLimit          *RateLimitConfig    `json:"rateLimit,omitempty"`
+
+	// ClusterForwardingSecret authenticates requests the ws-proxies of different workspace clusters forward to each other.
+	// All ws-proxies behind the same domain must share this secret.
+	ClusterForwardingSecret string `json:"clusterForwardingSecret,omitempty"`
 
 	BuiltinPages BuiltinPagesConfig `json:"builtinPages"`
 }
@@ -42,6 +47,7 @@ func (c *Config) Validate() error {
 		c.BlobServer,
 		c.GitpodInstallation,
 		c.WorkspacePodConfig,
+		c.RateLimit,
 	} {
 		err := v.Validate()
 		if err != nil {
@@ -59,6 +65,7 @@ type WorkspacePodConfig struct {
 	TheiaPort           uint16 `json:"theiaPort"`
 	SupervisorPort      uint16 `json:"supervisorPort"`
 	SupervisorImage     string `json:"supervisorImage"`
+	SSHPort             uint16 `json:"sshPort"`
 }
 
 // Validate validates the configuration to catch issues during startup and not at runtime
@@ -161,6 +168,51 @@ func (c *TransportConfig) Validate() error {
 	)
 }
 
+// RateLimitConfig configures the rate at which clients can make requests to workspaces
+type RateLimitConfig struct {
+	// Workspace limits the requests to a workspace, i.e. to its IDE and all of its ports
+	Workspace RateLimit `json:"workspace"`
+	// PublicPort limits the requests to each public port of a workspace. Ports can override this limit in their PortSpec.
+	PublicPort RateLimit `json:"publicPort"`
+}
+
+// Validate validates the configuration to catch issues during startup and not at runtime
+func (c *RateLimitConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	err := c.Workspace.Validate()
+	if err != nil {
+		return xerrors.Errorf("invalid workspace rate limit: %w", err)
+	}
+	err = c.PublicPort.Validate()
+	if err != nil {
+		return xerrors.Errorf("invalid public port rate limit: %w", err)
+	}
+	return nil
+}
+
+// RateLimit configures a token bucket
+type RateLimit struct {
+	// RequestsPerSecond is the rate at which the bucket refills. Zero means there's no limit.
+	RequestsPerSecond float64 `json:"requestsPerSecond"`
+	// Burst is the size of the bucket, i.e. the number of requests which can be made at once
+	Burst int `json:"burst"`
+}
+
+// Validate validates the configuration to catch issues during startup and not at runtime
+func (c *RateLimit) Validate() error {
+	if c.RequestsPerSecond == 0 {
+		return nil
+	}
+
+	return validation.ValidateStruct(c,
+		validation.Field(&c.RequestsPerSecond, validation.Min(0.0)),
+		validation.Field(&c.Burst, validation.Required, validation.Min(1)),
+	)
+}
+
 // BuiltinPagesConfig configures pages served directly by ws-proxy
 type BuiltinPagesConfig struct {
 	Location string `json:"location"`
@@ -177,6 +229,7 @@ func (c *BuiltinPagesConfig) Validate() error {
 			validation.Required,
 			validation.By(validateFileExists("")),
 			validation.By(validateFileExists(builtinPagePortNotFound)),
+			validation.By(validateFileExists(builtinPageRateLimited)),
 		),
 	)
 }
@@ -8,6 +8,7 @@ import (
 	"context"
 	"io"
 	"net/url"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -43,6 +44,10 @@ type WorkspaceInfoProvider interface {
 type WorkspaceInfoProviderConfig struct {
 	WsManagerAddr     string        `json:"wsManagerAddr"`
 	ReconnectInterval util.Duration `json:"reconnectInterval"`
+
+	// Clusters are further workspace clusters behind the same domain. Requests for their workspaces
+	// are forwarded to the cluster's ws-proxy.
+	Clusters []WorkspaceClusterConfig `json:"clusters,omitempty"`
 }
 
 // Validate validates the configuration to catch issues during startup and not at runtime
@@ -53,8 +58,46 @@ func (c *WorkspaceInfoProviderConfig) Validate() error {
 
 	err := validation.ValidateStruct(c,
 		validation.Field(&c.WsManagerAddr, validation.Required),
+		validation.Field(&c.Clusters),
+	)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]struct{}, len(c.Clusters))
+	for _, cl := range c.Clusters {
+		if _, exists := names[cl.Name]; exists {
+			return xerrors.Errorf("workspace cluster %s is configured more than once", cl.Name)
+		}
+		names[cl.Name] = struct{}{}
+	}
+	return nil
+}
+
+// WorkspaceClusterConfig configures a workspace cluster other than the one ws-proxy runs in
+type WorkspaceClusterConfig struct {
+	Name          string `json:"name"`
+	WsManagerAddr string `json:"wsManagerAddr"`
+	// ProxyURL is the URL of the cluster's ws-proxy, e.g. https://ws-proxy.eu02.gitpod.io
+	ProxyURL string `json:"proxyURL"`
+}
+
+// Validate validates the configuration to catch issues during startup and not at runtime
+func (c WorkspaceClusterConfig) Validate() error {
+	return validation.ValidateStruct(&c,
+		validation.Field(&c.Name, validation.Required),
+		validation.Field(&c.WsManagerAddr, validation.Required),
+		validation.Field(&c.ProxyURL, validation.Required, validation.By(func(value interface{}) error {
+			u, err := url.Parse(value.(string))
+			if err != nil {
+				return err
+			}
+			if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+				return xerrors.Errorf("must be an absolute http(s) URL")
+			}
+			return nil
+		})),
 	)
-	return err
 }
 
 // WorkspaceInfo is all the infos ws-proxy needs to know about a workspace
@@ -62,6 +105,7 @@ type WorkspaceInfo struct {
 	WorkspaceID string
 	InstanceID  string
 	URL         string
+	Owner       string
 
 	IDEImage string
 
@@ -70,6 +114,11 @@ type WorkspaceInfo struct {
 
 	Ports []PortInfo
 	Auth  *wsapi.WorkspaceAuthentication
+
+	// Cluster is the name of the workspace cluster the workspace runs in. Empty for the cluster ws-proxy runs in.
+	Cluster string
+	// ClusterProxyURL is the ws-proxy of the workspace's cluster. Empty for the cluster ws-proxy runs in.
+	ClusterProxyURL string
 }
 
 // PortInfo contains all information ws-proxy needs to know about a workspace port
@@ -80,15 +129,27 @@ type PortInfo struct {
 	PublicPort string
 }
 
+// findPortInfo returns the info of the workspace port, or nil if the workspace does not expose that port
+func findPortInfo(ws *WorkspaceInfo, port string) *PortInfo {
+	for i := range ws.Ports {
+		if strconv.FormatUint(uint64(ws.Ports[i].Port), 10) == port {
+			return &ws.Ports[i]
+		}
+	}
+	return nil
+}
+
 // RemoteWorkspaceInfoProvider provides (cached) infos about running workspaces that it queries from ws-manager
 type RemoteWorkspaceInfoProvider struct {
 	Config WorkspaceInfoProviderConfig
 	Dialer WSManagerDialer
 
-	stop  chan struct{}
-	ready bool
-	mu    sync.Mutex
-	cache *workspaceInfoCache
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	ready  map[string]bool
+	mu     sync.Mutex
+	cache  *workspaceInfoCache
 }
 
 // WSManagerDialer dials out to a ws-manager instance
@@ -96,17 +157,21 @@ type WSManagerDialer func(target string) (io.Closer, wsapi.WorkspaceManagerClien
 
 // NewRemoteWorkspaceInfoProvider creates a fresh WorkspaceInfoProvider
 func NewRemoteWorkspaceInfoProvider(config WorkspaceInfoProviderConfig) *RemoteWorkspaceInfoProvider {
+	ctx, cancel := context.WithCancel(context.Background())
 	return &RemoteWorkspaceInfoProvider{
 		Config: config,
 		Dialer: defaultWsmanagerDialer,
 		cache:  newWorkspaceInfoCache(),
-		stop:   make(chan struct{}),
+		ctx:    ctx,
+		cancel: cancel,
+		ready:  make(map[string]bool),
 	}
 }
 
-// Close prevents the info provider from connecting
+// Close stops listening to and connecting to ws-manager. It returns once all connections are closed.
 func (p *RemoteWorkspaceInfoProvider) Close() {
-	close(p.stop)
+	p.cancel()
+	p.wg.Wait()
 }
 
 func defaultWsmanagerDialer(target string) (io.Closer, wsapi.WorkspaceManagerClient, error) {
@@ -126,8 +191,8 @@ func defaultWsmanagerDialer(target string) (io.Closer, wsapi.WorkspaceManagerCli
 // transforms the relevent pieces into WorkspaceInfos and stores them in the cache
 func (p *RemoteWorkspaceInfoProvider) Run() (err error) {
 	// create initial connection
-	target := p.Config.WsManagerAddr
-	conn, client, err := p.Dialer(target)
+	local := WorkspaceClusterConfig{WsManagerAddr: p.Config.WsManagerAddr}
+	conn, client, err := p.Dialer(local.WsManagerAddr)
 	if err != nil {
 		return xerrors.Errorf("error while connecting to ws-manager: %w", err)
 	}
@@ -135,67 +200,100 @@ func (p *RemoteWorkspaceInfoProvider) Run() (err error) {
 	// do the initial fetching synchronously
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	infos, err := p.fetchInitialWorkspaceInfo(ctx, client)
+	infos, err := p.fetchInitialWorkspaceInfo(ctx, local, client)
 	if err != nil {
 		return err
 	}
-	p.cache.Reinit(infos)
+	p.cache.Reinit(local.Name, infos)
+	p.setReady(local.Name, true)
 
 	// maintain connection and stream workspace statuus
-	go func(conn io.Closer, client wsapi.WorkspaceManagerClient) {
-		for {
-			p.mu.Lock()
-			p.ready = true
-			p.mu.Unlock()
-
-			err := p.listen(client)
-			if xerrors.Is(err, io.EOF) {
-				log.Warn("ws-manager closed the connection, reconnecting after timeout...")
-			} else if err != nil {
-				log.WithError(err).Warnf("error while listening for workspace status updates, reconnecting after timeout")
-			}
+	p.wg.Add(1 + len(p.Config.Clusters))
+	go p.maintainConnection(local, conn, client)
+
+	// other clusters being unavailable must not keep us from serving the workspaces of our own
+	for _, cluster := range p.Config.Clusters {
+		go p.maintainConnection(cluster, nil, nil)
+	}
+
+	return nil
+}
 
-			conn.Close()
-			p.mu.Lock()
-			p.ready = false
-			p.mu.Unlock()
+// maintainConnection streams the workspace status of a cluster and reconnects when the connection breaks.
+// If conn is nil, maintainConnection connects to the cluster's ws-manager first.
+func (p *RemoteWorkspaceInfoProvider) maintainConnection(cluster WorkspaceClusterConfig, conn io.Closer, client wsapi.WorkspaceManagerClient) {
+	defer p.wg.Done()
 
-			var stop bool
-			select {
-			case <-p.stop:
-				stop = true
-			default:
+	var (
+		target = cluster.WsManagerAddr
+		err    error
+	)
+	for {
+		for conn == nil {
+			if p.stopped() {
+				return
 			}
-			if stop {
-				break
+
+			conn, client, err = p.Dialer(target)
+			if err != nil {
+				log.WithError(err).WithField("cluster", cluster.Name).Warnf("error while connecting to ws-manager, reconnecting after timeout...")
+				conn = nil
+				p.waitForReconnect()
 			}
+		}
 
-			for {
-				time.Sleep(time.Duration(p.Config.ReconnectInterval))
+		p.setReady(cluster.Name, true)
 
-				conn, client, err = p.Dialer(target)
-				if err != nil {
-					log.WithError(err).Warnf("error while connecting to ws-manager, reconnecting after timeout...")
-					continue
-				}
-				break
-			}
+		err = p.listen(cluster, client)
+		if xerrors.Is(err, io.EOF) {
+			log.WithField("cluster", cluster.Name).Warn("ws-manager closed the connection, reconnecting after timeout...")
+		} else if err != nil {
+			log.WithError(err).WithField("cluster", cluster.Name).Warnf("error while listening for workspace status updates, reconnecting after timeout")
 		}
-	}(conn, client)
 
-	return nil
+		conn.Close()
+		conn = nil
+		p.setReady(cluster.Name, false)
+
+		if p.stopped() {
+			return
+		}
+		p.waitForReconnect()
+	}
+}
+
+func (p *RemoteWorkspaceInfoProvider) stopped() bool {
+	return p.ctx.Err() != nil
+}
+
+// waitForReconnect waits for the reconnect interval to pass or the provider to be closed
+func (p *RemoteWorkspaceInfoProvider) waitForReconnect() {
+	select {
+	case <-time.After(time.Duration(p.Config.ReconnectInterval)):
+	case <-p.ctx.Done():
+	}
 }
 
-// Ready returns true if the info provider is up and running
+// setReady marks a cluster as (un)available. While we're not connected to a cluster's ws-manager
+// we keep its workspaces, but prefer other clusters which know the same workspace.
+func (p *RemoteWorkspaceInfoProvider) setReady(cluster string, ready bool) {
+	p.mu.Lock()
+	p.ready[cluster] = ready
+	p.mu.Unlock()
+
+	p.cache.SetHealthy(cluster, ready)
+}
+
+// Ready returns true if the info provider is up and running, i.e. is connected to the ws-manager of its own cluster
 func (p *RemoteWorkspaceInfoProvider) Ready() bool {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	return p.ready
+	return p.ready[""]
 }
 
 // listen starts listening to WorkspaceStatus updates from ws-manager
-func (p *RemoteWorkspaceInfoProvider) listen(client wsapi.WorkspaceManagerClient) (err error) {
+func (p *RemoteWorkspaceInfoProvider) listen(cluster WorkspaceClusterConfig, client wsapi.WorkspaceManagerClient) (err error) {
 	defer func() {
 		if err != nil {
 			err = xerrors.Errorf("error while starting streaming status updates from ws-manager: %w", err)
@@ -203,12 +301,12 @@ func (p *RemoteWorkspaceInfoProvider) listen(client wsapi.WorkspaceManagerClient
 	}()
 
 	// rebuild entire cache on (re-)connect
-	ctx := context.Background()
-	infos, err := p.fetchInitialWorkspaceInfo(ctx, client)
+	ctx := p.ctx
+	infos, err := p.fetchInitialWorkspaceInfo(ctx, cluster, client)
 	if err != nil {
 		return err
 	}
-	p.cache.Reinit(infos)
+	p.cache.Reinit(cluster.Name, infos)
 
 	// start streaming status updates
 	stream, err := client.Subscribe(ctx, &wsapi.SubscribeRequest{})
@@ -228,16 +326,16 @@ func (p *RemoteWorkspaceInfoProvider) listen(client wsapi.WorkspaceManagerClient
 		}
 
 		if status.Phase == wsapi.WorkspacePhase_STOPPED {
-			p.cache.Delete(status.Metadata.MetaId)
+			p.cache.Delete(cluster.Name, status.Metadata.MetaId)
 		} else {
-			info := mapWorkspaceStatusToInfo(status)
+			info := mapWorkspaceStatusToInfo(cluster, status)
 			p.cache.Insert(info)
 		}
 	}
 }
 
 // fetchInitialWorkspaceInfo retrieves initial WorkspaceStatus' from ws-manager and maps them into WorkspaceInfos
-func (p *RemoteWorkspaceInfoProvider) fetchInitialWorkspaceInfo(ctx context.Context, client wsapi.WorkspaceManagerClient) ([]*WorkspaceInfo, error) {
+func (p *RemoteWorkspaceInfoProvider) fetchInitialWorkspaceInfo(ctx context.Context, cluster WorkspaceClusterConfig, client wsapi.WorkspaceManagerClient) ([]*WorkspaceInfo, error) {
 	initialResp, err := client.GetWorkspaces(ctx, &wsapi.GetWorkspacesRequest{})
 	if err != nil {
 		return nil, xerrors.Errorf("error while retrieving initial state from ws-manager: %w", err)
@@ -245,12 +343,12 @@ func (p *RemoteWorkspaceInfoProvider) fetchInitialWorkspaceInfo(ctx context.Cont
 
 	var infos []*WorkspaceInfo
 	for _, status := range initialResp.GetStatus() {
-		infos = append(infos, mapWorkspaceStatusToInfo(status))
+		infos = append(infos, mapWorkspaceStatusToInfo(cluster, status))
 	}
 	return infos, nil
 }
 
-func mapWorkspaceStatusToInfo(status *wsapi.WorkspaceStatus) *WorkspaceInfo {
+func mapWorkspaceStatusToInfo(cluster WorkspaceClusterConfig, status *wsapi.WorkspaceStatus) *WorkspaceInfo {
 	var portInfos []PortInfo
 	for _, spec := range status.Spec.ExposedPorts {
 		proxyPort := getPortStr(spec.Url)
@@ -265,13 +363,16 @@ func mapWorkspaceStatusToInfo(status *wsapi.WorkspaceStatus) *WorkspaceInfo {
 	}
 
 	return &WorkspaceInfo{
-		WorkspaceID:   status.Metadata.MetaId,
-		InstanceID:    status.Id,
-		URL:           status.Spec.Url,
-		IDEImage:      status.Spec.IdeImage,
-		IDEPublicPort: getPortStr(status.Spec.Url),
-		Ports:         portInfos,
-		Auth:          status.Auth,
+		WorkspaceID:     status.Metadata.MetaId,
+		InstanceID:      status.Id,
+		URL:             status.Spec.Url,
+		Owner:           status.Metadata.Owner,
+		IDEImage:        status.Spec.IdeImage,
+		IDEPublicPort:   getPortStr(status.Spec.Url),
+		Ports:           portInfos,
+		Auth:            status.Auth,
+		Cluster:         cluster.Name,
+		ClusterProxyURL: cluster.ProxyURL,
 	}
 }
 
@@ -313,10 +414,12 @@ func getPortStr(urlStr string) string {
 
 // workspaceInfoCache stores WorkspaceInfo in a manner which is easy to query for WorkspaceInfoProvider
 type workspaceInfoCache struct {
-	// WorkspaceInfos indexed by workspaceID
-	infos map[string]*WorkspaceInfo
-	// WorkspaceCoords indexed by public (proxy) port (string)
-	coordsByPublicPort map[string]*WorkspaceCoords
+	// WorkspaceInfos indexed by workspaceID and cluster
+	infos map[string]map[string]*WorkspaceInfo
+	// WorkspaceCoords indexed by public (proxy) port (string) and cluster
+	coordsByPublicPort map[string]map[string]*WorkspaceCoords
+	// unhealthy are the clusters whose ws-manager we're not connected to
+	unhealthy map[string]bool
 
 	// cond signals the arrival of new workspace info
 	cond *sync.Cond
@@ -327,19 +430,31 @@ type workspaceInfoCache struct {
 func newWorkspaceInfoCache() *workspaceInfoCache {
 	var mu sync.RWMutex
 	return &workspaceInfoCache{
-		infos:              make(map[string]*WorkspaceInfo),
-		coordsByPublicPort: make(map[string]*WorkspaceCoords),
+		infos:              make(map[string]map[string]*WorkspaceInfo),
+		coordsByPublicPort: make(map[string]map[string]*WorkspaceCoords),
+		unhealthy:          make(map[string]bool),
 		mu:                 &mu,
 		cond:               sync.NewCond(&mu),
 	}
 }
 
-func (c *workspaceInfoCache) Reinit(infos []*WorkspaceInfo) {
+// Reinit replaces all workspace info of a cluster
+func (c *workspaceInfoCache) Reinit(cluster string, infos []*WorkspaceInfo) {
 	c.cond.L.Lock()
 	defer c.cond.L.Unlock()
 
-	c.infos = make(map[string]*WorkspaceInfo, len(infos))
-	c.coordsByPublicPort = make(map[string]*WorkspaceCoords, len(c.coordsByPublicPort))
+	for id, clusters := range c.infos {
+		delete(clusters, cluster)
+		if len(clusters) == 0 {
+			delete(c.infos, id)
+		}
+	}
+	for port, clusters := range c.coordsByPublicPort {
+		delete(clusters, cluster)
+		if len(clusters) == 0 {
+			delete(c.coordsByPublicPort, port)
+		}
+	}
 
 	for _, info := range infos {
 		c.doInsert(info)
@@ -356,59 +471,132 @@ func (c *workspaceInfoCache) Insert(info *WorkspaceInfo) {
 }
 
 func (c *workspaceInfoCache) doInsert(info *WorkspaceInfo) {
-	c.infos[info.WorkspaceID] = info
-	c.coordsByPublicPort[info.IDEPublicPort] = &WorkspaceCoords{
-		ID: info.WorkspaceID,
+	clusters, ok := c.infos[info.WorkspaceID]
+	if !ok {
+		clusters = make(map[string]*WorkspaceInfo)
+		c.infos[info.WorkspaceID] = clusters
 	}
+	clusters[info.Cluster] = info
 
+	c.insertCoords(info.Cluster, info.IDEPublicPort, &WorkspaceCoords{
+		ID: info.WorkspaceID,
+	})
 	for _, p := range info.Ports {
-		c.coordsByPublicPort[p.PublicPort] = &WorkspaceCoords{
+		c.insertCoords(info.Cluster, p.PublicPort, &WorkspaceCoords{
 			ID:   info.WorkspaceID,
 			Port: strconv.Itoa(int(p.Port)),
-		}
+		})
+	}
+}
+
+func (c *workspaceInfoCache) insertCoords(cluster, publicPort string, coords *WorkspaceCoords) {
+	clusters, ok := c.coordsByPublicPort[publicPort]
+	if !ok {
+		clusters = make(map[string]*WorkspaceCoords)
+		c.coordsByPublicPort[publicPort] = clusters
 	}
+	clusters[cluster] = coords
 }
 
-func (c *workspaceInfoCache) Delete(workspaceID string) {
+func (c *workspaceInfoCache) Delete(cluster, workspaceID string) {
 	c.cond.L.Lock()
 	defer c.cond.L.Unlock()
 
-	info, present := c.infos[workspaceID]
+	info, present := c.infos[workspaceID][cluster]
 	if !present || info == nil {
 		return
 	}
-	delete(c.coordsByPublicPort, info.IDEPublicPort)
-	delete(c.infos, workspaceID)
+
+	ports := []string{info.IDEPublicPort}
+	for _, p := range info.Ports {
+		ports = append(ports, p.PublicPort)
+	}
+	for _, port := range ports {
+		clusters := c.coordsByPublicPort[port]
+		if coords, ok := clusters[cluster]; !ok || coords.ID != workspaceID {
+			continue
+		}
+		delete(clusters, cluster)
+		if len(clusters) == 0 {
+			delete(c.coordsByPublicPort, port)
+		}
+	}
+
+	delete(c.infos[workspaceID], cluster)
+	if len(c.infos[workspaceID]) == 0 {
+		delete(c.infos, workspaceID)
+	}
+}
+
+// SetHealthy marks a cluster as (un)healthy
+func (c *workspaceInfoCache) SetHealthy(cluster string, healthy bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if healthy {
+		delete(c.unhealthy, cluster)
+	} else {
+		c.unhealthy[cluster] = true
+	}
+}
+
+// preferredCluster picks the cluster to serve a workspace from which is known to several clusters.
+// Healthy clusters go before unhealthy ones, and our own cluster goes before others.
+// Callers must hold mu.
+func (c *workspaceInfoCache) preferredCluster(clusters []string) string {
+	sort.Slice(clusters, func(i, j int) bool {
+		hi, hj := !c.unhealthy[clusters[i]], !c.unhealthy[clusters[j]]
+		if hi != hj {
+			return hi
+		}
+		// our own cluster has the empty name and comes first
+		return clusters[i] < clusters[j]
+	})
+	return clusters[0]
+}
+
+// get returns the workspace info of the preferred cluster. Callers must hold mu.
+func (c *workspaceInfoCache) get(workspaceID string) (*WorkspaceInfo, bool) {
+	infos := c.infos[workspaceID]
+	if len(infos) == 0 {
+		return nil, false
+	}
+
+	clusters := make([]string, 0, len(infos))
+	for cluster := range infos {
+		clusters = append(clusters, cluster)
+	}
+	return infos[c.preferredCluster(clusters)], true
 }
 
 // WaitFor waits for workspace info until that info is available or the context is canceled.
 func (c *workspaceInfoCache) WaitFor(ctx context.Context, workspaceID string) (w *WorkspaceInfo, ok bool) {
 	c.mu.RLock()
-	w, ok = c.infos[workspaceID]
+	w, ok = c.get(workspaceID)
 	c.mu.RUnlock()
 	if ok {
 		return
 	}
 
-	inc := make(chan *WorkspaceInfo)
+	// inc is buffered s.t. we don't block while holding the lock if nobody's waiting anymore
+	inc := make(chan *WorkspaceInfo, 1)
 	go func() {
 		defer close(inc)
 
 		c.cond.L.Lock()
 		defer c.cond.L.Unlock()
 		for {
-			c.cond.Wait()
-			if ctx.Err() != nil {
+			// the info might have arrived between our first look and us acquiring the lock
+			info, ok := c.get(workspaceID)
+			if ok {
+				inc <- info
 				return
 			}
 
-			info, ok := c.infos[workspaceID]
-			if !ok {
-				continue
+			c.cond.Wait()
+			if ctx.Err() != nil {
+				return
 			}
-
-			inc <- info
-			return
 		}
 	}()
 
@@ -427,8 +615,16 @@ func (c *workspaceInfoCache) GetCoordsByPublicPort(wsProxyPort string) (*Workspa
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	coords, ok := c.coordsByPublicPort[wsProxyPort]
-	return coords, ok
+	coords := c.coordsByPublicPort[wsProxyPort]
+	if len(coords) == 0 {
+		return nil, false
+	}
+
+	clusters := make([]string, 0, len(coords))
+	for cluster := range coords {
+		clusters = append(clusters, cluster)
+	}
+	return coords[c.preferredCluster(clusters)], true
 }
 
 type fixedInfoProvider struct {
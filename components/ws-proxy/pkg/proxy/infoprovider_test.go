@@ -12,10 +12,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gitpod-io/gitpod/common-go/util"
 	wsapi "github.com/gitpod-io/gitpod/ws-manager/api"
 	wsmock "github.com/gitpod-io/gitpod/ws-manager/api/mock"
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
 )
 
 func TestRemoteInfoProvider(t *testing.T) {
@@ -120,17 +122,24 @@ func TestRemoteInfoProvider(t *testing.T) {
 			defer ctrl.Finish()
 
 			updates := make(chan *wsapi.SubscribeResponse)
-			srv := wsmock.NewMockWorkspaceManager_SubscribeClient(ctrl)
-			srv.EXPECT().Recv().DoAndReturn(func() (*wsapi.SubscribeResponse, error) {
-				u := <-updates
-				if u == nil {
-					return nil, io.EOF
-				}
+			cl := wsmock.NewMockWorkspaceManagerClient(ctrl)
+			cl.EXPECT().Subscribe(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, req *wsapi.SubscribeRequest, opts ...grpc.CallOption) (wsapi.WorkspaceManager_SubscribeClient, error) {
+				srv := wsmock.NewMockWorkspaceManager_SubscribeClient(ctrl)
+				srv.EXPECT().Recv().DoAndReturn(func() (*wsapi.SubscribeResponse, error) {
+					var u *wsapi.SubscribeResponse
+					select {
+					case u = <-updates:
+					case <-ctx.Done():
+						return nil, ctx.Err()
+					}
+					if u == nil {
+						return nil, io.EOF
+					}
 
-				return u, nil
+					return u, nil
+				}).AnyTimes()
+				return srv, nil
 			}).AnyTimes()
-			cl := wsmock.NewMockWorkspaceManagerClient(ctrl)
-			cl.EXPECT().Subscribe(gomock.Any(), gomock.Any()).Return(srv, nil).AnyTimes()
 			cl.EXPECT().GetWorkspaces(gomock.Any(), gomock.Any()).Return(&wsapi.GetWorkspacesResponse{}, nil).AnyTimes()
 
 			prov := NewRemoteWorkspaceInfoProvider(WorkspaceInfoProviderConfig{WsManagerAddr: "target"})
@@ -141,7 +150,8 @@ func TestRemoteInfoProvider(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
-			defer prov.Close()
+			// parallel steps run once this function has returned
+			t.Cleanup(prov.Close)
 
 			for i, step := range test.Steps {
 				// copy step because we capture the loop variable in the Run() function
@@ -216,3 +226,171 @@ var (
 		WorkspaceID: testWorkspaceStatus.Metadata.MetaId,
 	}
 )
+
+func TestRemoteInfoProviderClusters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newClient := func(status ...*wsapi.WorkspaceStatus) *wsmock.MockWorkspaceManagerClient {
+		cl := wsmock.NewMockWorkspaceManagerClient(ctrl)
+		cl.EXPECT().Subscribe(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, req *wsapi.SubscribeRequest, opts ...grpc.CallOption) (wsapi.WorkspaceManager_SubscribeClient, error) {
+			srv := wsmock.NewMockWorkspaceManager_SubscribeClient(ctrl)
+			srv.EXPECT().Recv().DoAndReturn(func() (*wsapi.SubscribeResponse, error) {
+				// block until the provider is closed
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}).AnyTimes()
+			return srv, nil
+		}).AnyTimes()
+		cl.EXPECT().GetWorkspaces(gomock.Any(), gomock.Any()).Return(&wsapi.GetWorkspacesResponse{Status: status}, nil).AnyTimes()
+		return cl
+	}
+	remoteStatus := *testWorkspaceStatus
+	remoteStatus.Metadata = &wsapi.WorkspaceMetadata{MetaId: "a5a5f2b0-4b3b-4b6a-9f4e-0e7c4c2f0000"}
+	clients := map[string]wsapi.WorkspaceManagerClient{
+		"local": newClient(testWorkspaceStatus),
+		"eu02":  newClient(&remoteStatus),
+	}
+
+	prov := NewRemoteWorkspaceInfoProvider(WorkspaceInfoProviderConfig{
+		WsManagerAddr: "local",
+		Clusters: []WorkspaceClusterConfig{
+			{Name: "eu02", WsManagerAddr: "eu02", ProxyURL: "https://ws-proxy.eu02.gitpod.io"},
+			{Name: "down", WsManagerAddr: "down", ProxyURL: "https://ws-proxy.down.gitpod.io"},
+		},
+		ReconnectInterval: util.Duration(10 * time.Millisecond),
+	})
+	prov.Dialer = func(target string) (io.Closer, wsapi.WorkspaceManagerClient, error) {
+		cl, ok := clients[target]
+		if !ok {
+			return nil, nil, fmt.Errorf("cannot connect to %s", target)
+		}
+		return ioutil.NopCloser(nil), cl, nil
+	}
+	err := prov.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer prov.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+	if diff := cmp.Diff(testWorkspaceInfo, prov.WorkspaceInfo(ctx, testWorkspaceStatus.Metadata.MetaId)); diff != "" {
+		t.Errorf("unexpected local workspace info (-want +got):\n%s", diff)
+	}
+	remote := prov.WorkspaceInfo(ctx, remoteStatus.Metadata.MetaId)
+	if remote == nil {
+		t.Fatal("remote workspace info not found")
+	}
+	if remote.Cluster != "eu02" || remote.ClusterProxyURL != "https://ws-proxy.eu02.gitpod.io" {
+		t.Errorf("unexpected cluster of remote workspace: %s (%s)", remote.Cluster, remote.ClusterProxyURL)
+	}
+	if !prov.Ready() {
+		t.Error("provider is not ready although the local ws-manager is connected")
+	}
+}
+
+func TestWorkspaceInfoCacheClusters(t *testing.T) {
+	var (
+		local  = *testWorkspaceInfo
+		remote = *testWorkspaceInfo
+	)
+	remote.Cluster = "eu02"
+	remote.ClusterProxyURL = "https://ws-proxy.eu02.gitpod.io"
+	id := local.WorkspaceID
+
+	type Expectation struct {
+		Cluster       string
+		PortAvailable bool
+	}
+	getExpectation := func(c *workspaceInfoCache) *Expectation {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		info, ok := c.WaitFor(ctx, id)
+		if !ok {
+			return nil
+		}
+		res := &Expectation{Cluster: info.Cluster}
+		_, res.PortAvailable = c.GetCoordsByPublicPort(local.IDEPublicPort)
+		return res
+	}
+
+	tests := []struct {
+		Name        string
+		Actions     func(c *workspaceInfoCache)
+		Expectation *Expectation
+	}{
+		{
+			Name:        "local only",
+			Actions:     func(c *workspaceInfoCache) { c.Insert(&local) },
+			Expectation: &Expectation{PortAvailable: true},
+		},
+		{
+			Name:        "remote only",
+			Actions:     func(c *workspaceInfoCache) { c.Insert(&remote) },
+			Expectation: &Expectation{Cluster: "eu02", PortAvailable: true},
+		},
+		{
+			Name: "local preferred",
+			Actions: func(c *workspaceInfoCache) {
+				c.Insert(&remote)
+				c.Insert(&local)
+			},
+			Expectation: &Expectation{PortAvailable: true},
+		},
+		{
+			Name: "failover to healthy cluster",
+			Actions: func(c *workspaceInfoCache) {
+				c.Insert(&remote)
+				c.Insert(&local)
+				c.SetHealthy("", false)
+			},
+			Expectation: &Expectation{Cluster: "eu02", PortAvailable: true},
+		},
+		{
+			Name: "unhealthy cluster as last resort",
+			Actions: func(c *workspaceInfoCache) {
+				c.Insert(&local)
+				c.SetHealthy("", false)
+			},
+			Expectation: &Expectation{PortAvailable: true},
+		},
+		{
+			Name: "delete in one cluster",
+			Actions: func(c *workspaceInfoCache) {
+				c.Insert(&remote)
+				c.Insert(&local)
+				c.Delete("", id)
+			},
+			Expectation: &Expectation{Cluster: "eu02", PortAvailable: true},
+		},
+		{
+			Name: "reinit keeps other clusters",
+			Actions: func(c *workspaceInfoCache) {
+				c.Insert(&remote)
+				c.Insert(&local)
+				c.Reinit("eu02", nil)
+			},
+			Expectation: &Expectation{PortAvailable: true},
+		},
+		{
+			Name: "reinit removes all",
+			Actions: func(c *workspaceInfoCache) {
+				c.Insert(&remote)
+				c.Reinit("eu02", nil)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			c := newWorkspaceInfoCache()
+			test.Actions(c)
+
+			act := getExpectation(c)
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected cache state (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
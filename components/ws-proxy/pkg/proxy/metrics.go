@@ -0,0 +1,245 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// bandwidthSeriesIdleTimeout is the time after which we drop the series of a workspace port which saw no traffic
+	bandwidthSeriesIdleTimeout = 1 * time.Hour
+	// bandwidthPruneInterval is the least time between two attempts to drop idle series
+	bandwidthPruneInterval = 5 * time.Minute
+
+	// idePortLabel is the port label value of requests made to the IDE rather than a workspace port
+	idePortLabel = "ide"
+)
+
+// BandwidthMetrics counts the bytes ws-proxy receives from (ingress) and sends to (egress) the clients of workspaces.
+// A single BandwidthMetrics instance must be shared by all proxies serving the same workspaces.
+type BandwidthMetrics struct {
+	ingressBytes *prometheus.CounterVec
+	egressBytes  *prometheus.CounterVec
+
+	mu        sync.Mutex
+	series    map[bandwidthLabels]*bandwidthSeries
+	lastPrune time.Time
+}
+
+type bandwidthLabels struct {
+	Workspace string
+	Owner     string
+	Port      string
+}
+
+type bandwidthSeries struct {
+	Active   int
+	LastUsed time.Time
+}
+
+// NewBandwidthMetrics creates new bandwidth metrics
+func NewBandwidthMetrics() *BandwidthMetrics {
+	labels := []string{"workspace", "owner", "port"}
+	return &BandwidthMetrics{
+		ingressBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "gitpod",
+			Subsystem: "ws_proxy",
+			Name:      "workspace_ingress_bytes_total",
+			Help:      "Bytes received from clients of a workspace",
+		}, labels),
+		egressBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "gitpod",
+			Subsystem: "ws_proxy",
+			Name:      "workspace_egress_bytes_total",
+			Help:      "Bytes sent to clients of a workspace",
+		}, labels),
+		series: make(map[bandwidthLabels]*bandwidthSeries),
+	}
+}
+
+// Register registers all metrics ws-proxy can export
+func (m *BandwidthMetrics) Register(reg prometheus.Registerer) error {
+	collectors := []prometheus.Collector{
+		m.ingressBytes,
+		m.egressBytes,
+	}
+	for _, c := range collectors {
+		err := reg.Register(c)
+		if err != nil {
+			return xerrors.Errorf("cannot register bandwidth metrics: %w", err)
+		}
+	}
+	return nil
+}
+
+// begin marks the start of a request and returns the counters it adds its bytes to
+func (m *BandwidthMetrics) begin(labels bandwidthLabels) (ingress, egress prometheus.Counter) {
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.prune(now)
+
+	s, exists := m.series[labels]
+	if !exists {
+		s = &bandwidthSeries{}
+		m.series[labels] = s
+	}
+	s.Active++
+	s.LastUsed = now
+
+	return m.ingressBytes.WithLabelValues(labels.Workspace, labels.Owner, labels.Port),
+		m.egressBytes.WithLabelValues(labels.Workspace, labels.Owner, labels.Port)
+}
+
+// end marks the end of a request started with begin
+func (m *BandwidthMetrics) end(labels bandwidthLabels) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, exists := m.series[labels]
+	if !exists {
+		return
+	}
+	s.Active--
+	s.LastUsed = time.Now()
+}
+
+// prune drops the series of workspace ports which saw no traffic for some time, e.g. because the workspace has stopped.
+// Callers must hold m.mu.
+func (m *BandwidthMetrics) prune(now time.Time) {
+	if now.Sub(m.lastPrune) < bandwidthPruneInterval {
+		return
+	}
+	m.lastPrune = now
+
+	for labels, s := range m.series {
+		if s.Active > 0 || now.Sub(s.LastUsed) < bandwidthSeriesIdleTimeout {
+			continue
+		}
+
+		m.ingressBytes.DeleteLabelValues(labels.Workspace, labels.Owner, labels.Port)
+		m.egressBytes.DeleteLabelValues(labels.Workspace, labels.Owner, labels.Port)
+		delete(m.series, labels)
+	}
+}
+
+// bandwidthHandler counts the bytes of requests to and responses from a workspace, including those of upgraded connections.
+// This handler expects the workspace info to be present in the request context.
+func bandwidthHandler(metrics *BandwidthMetrics) mux.MiddlewareFunc {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			info := getWorkspaceInfoFromContext(req.Context())
+			if info == nil {
+				h.ServeHTTP(resp, req)
+				return
+			}
+
+			labels := bandwidthLabels{
+				Workspace: info.WorkspaceID,
+				Owner:     info.Owner,
+				Port:      getWorkspaceCoords(req).Port,
+			}
+			if labels.Port == "" {
+				labels.Port = idePortLabel
+			}
+			ingress, egress := metrics.begin(labels)
+			defer metrics.end(labels)
+
+			if req.Body != nil && req.Body != http.NoBody {
+				req.Body = &countingReadCloser{ReadCloser: req.Body, Counter: ingress}
+			}
+			h.ServeHTTP(&countingResponseWriter{
+				ResponseWriter: resp,
+				Ingress:        ingress,
+				Egress:         egress,
+			}, req)
+		})
+	}
+}
+
+// countingReadCloser counts the bytes read from a request body
+type countingReadCloser struct {
+	io.ReadCloser
+	Counter prometheus.Counter
+}
+
+func (r *countingReadCloser) Read(p []byte) (n int, err error) {
+	n, err = r.ReadCloser.Read(p)
+	r.Counter.Add(float64(n))
+	return
+}
+
+// countingResponseWriter counts the bytes written to a response, and those which pass a hijacked connection
+type countingResponseWriter struct {
+	http.ResponseWriter
+	Ingress prometheus.Counter
+	Egress  prometheus.Counter
+}
+
+func (w *countingResponseWriter) Write(p []byte) (n int, err error) {
+	n, err = w.ResponseWriter.Write(p)
+	w.Egress.Add(float64(n))
+	return
+}
+
+// Flush implements http.Flusher
+func (w *countingResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker
+func (w *countingResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hj, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, xerrors.Errorf("response writer does not support hijacking")
+	}
+	conn, brw, err := hj.Hijack()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &countingConn{Conn: conn, Ingress: w.Ingress, Egress: w.Egress}, brw, nil
+}
+
+// Unwrap returns the original response writer, e.g. for use with http.ResponseController
+func (w *countingResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// countingConn counts the bytes which pass a hijacked connection
+type countingConn struct {
+	net.Conn
+	Ingress prometheus.Counter
+	Egress  prometheus.Counter
+}
+
+func (c *countingConn) Read(p []byte) (n int, err error) {
+	n, err = c.Conn.Read(p)
+	c.Ingress.Add(float64(n))
+	return
+}
+
+func (c *countingConn) Write(p []byte) (n int, err error) {
+	n, err = c.Conn.Write(p)
+	c.Egress.Add(float64(n))
+	return
+}
+
+func (c *countingConn) CloseWrite() error {
+	return closeWrite(c.Conn)
+}
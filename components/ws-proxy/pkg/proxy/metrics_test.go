@@ -0,0 +1,117 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/gorilla/websocket"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+)
+
+func TestBandwidthMetrics(t *testing.T) {
+	log.Log.Logger.SetLevel(logrus.PanicLevel)
+
+	ws := workspaces[0]
+	ws.Owner = "owner-id"
+	ws.Auth = &api.WorkspaceAuthentication{Admission: api.AdmissionLevel_ADMIT_EVERYONE}
+	port := ws.Ports[0]
+
+	metrics := NewBandwidthMetrics()
+	proxy := NewWorkspaceProxy(":8080", config, HostBasedRouter(hostBasedHeader, wsHostSuffix), &fakeWsInfoProvider{infos: []WorkspaceInfo{ws}})
+	proxy.BandwidthMetrics = metrics
+	handler, err := proxy.Handler()
+	if err != nil {
+		t.Fatalf("cannot create proxy handler: %q", err)
+	}
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	portTarget := startTestTarget(t, portServeHost, "port")
+	defer portTarget.Close()
+	portTarget.Target.Handler = func(w http.ResponseWriter, r *http.Request, requestCount uint8) {
+		if !isWebsocketRequest(r) {
+			io.Copy(w, r.Body)
+			return
+		}
+
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		tpe, msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		conn.WriteMessage(tpe, msg)
+	}
+	host := strings.TrimSuffix(strings.TrimPrefix(port.Url, "https://"), "/")
+
+	var (
+		ingress = func() float64 {
+			return testutil.ToFloat64(metrics.ingressBytes.WithLabelValues(ws.WorkspaceID, ws.Owner, fmt.Sprint(port.Port)))
+		}
+		egress = func() float64 {
+			return testutil.ToFloat64(metrics.egressBytes.WithLabelValues(ws.WorkspaceID, ws.Owner, fmt.Sprint(port.Port)))
+		}
+	)
+
+	t.Run("http", func(t *testing.T) {
+		const body = "hello world"
+		req, _ := http.NewRequest("POST", srv.URL, strings.NewReader(body))
+		req.Header.Set(hostBasedHeader, host)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if act := ingress(); act != float64(len(body)) {
+			t.Errorf("unexpected ingress bytes: expected %d, actual %v", len(body), act)
+		}
+		if act := egress(); act != float64(len(body)) {
+			t.Errorf("unexpected egress bytes: expected %d, actual %v", len(body), act)
+		}
+	})
+
+	t.Run("websocket", func(t *testing.T) {
+		ingressBefore, egressBefore := ingress(), egress()
+
+		conn, _, err := websocket.DefaultDialer.Dial(strings.Replace(srv.URL, "http://", "ws://", 1), http.Header{
+			hostBasedHeader: []string{host},
+		})
+		if err != nil {
+			t.Fatalf("cannot open websocket: %v", err)
+		}
+		err = conn.WriteMessage(websocket.BinaryMessage, []byte("ping"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _, err = conn.ReadMessage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		conn.Close()
+
+		// the connection is hijacked, i.e. we don't know when the proxy has copied all bytes
+		if act := ingress() - ingressBefore; act == 0 {
+			t.Errorf("websocket ingress bytes were not counted")
+		}
+		if act := egress() - egressBefore; act == 0 {
+			t.Errorf("websocket egress bytes were not counted")
+		}
+	})
+}
@@ -5,6 +5,8 @@
 package proxy
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
@@ -15,7 +17,9 @@ import (
 	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
+	wsapi "github.com/gitpod-io/gitpod/ws-manager/api"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/net/http2"
 	"golang.org/x/xerrors"
 )
 
@@ -188,6 +192,81 @@ func createDefaultTransport(config *TransportConfig) *http.Transport {
 	}
 }
 
+// createH2CTransport creates a transport which speaks HTTP/2 without TLS (prior knowledge) to the upstream
+func createH2CTransport(config *TransportConfig) *http2.Transport {
+	dialer := &net.Dialer{
+		Timeout:   time.Duration(config.ConnectTimeout),
+		KeepAlive: 30 * time.Second,
+	}
+	return &http2.Transport{
+		AllowHTTP: true,
+		DialTLS: func(network, addr string, cfg *tls.Config) (net.Conn, error) {
+			return dialer.Dial(network, addr)
+		},
+	}
+}
+
+// createTLSTransport creates a transport for upstreams which serve HTTPS
+func createTLSTransport(config *TransportConfig) *http.Transport {
+	res := createDefaultTransport(config)
+	// workspace ports serving HTTPS mostly use self-signed certificates which we cannot verify anyways
+	res.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	return res
+}
+
+// portProtocolLookupTimeout is the time we wait for workspace information when looking up a port's protocol
+const portProtocolLookupTimeout = 2 * time.Second
+
+// portProtocolTransport picks the transport matching the protocol of the workspace port a request is forwarded to
+type portProtocolTransport struct {
+	Config       *RouteHandlerConfig
+	InfoProvider WorkspaceInfoProvider
+}
+
+// RoundTrip forwards the request using the transport matching the port's protocol
+func (t *portProtocolTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch t.protocol(req) {
+	case wsapi.PortProtocol_PORT_PROTOCOL_H2C, wsapi.PortProtocol_PORT_PROTOCOL_GRPC:
+		if isWebsocketRequest(req) {
+			// websockets cannot be upgraded on HTTP/2 connections - most HTTP/2 servers accept HTTP/1.1 as well though
+			break
+		}
+		return t.Config.H2CTransport.RoundTrip(req)
+	case wsapi.PortProtocol_PORT_PROTOCOL_TLS:
+		outreq := req.Clone(req.Context())
+		outreq.URL.Scheme = "https"
+		return t.Config.TLSTransport.RoundTrip(outreq)
+	}
+	return t.Config.DefaultTransport.RoundTrip(req)
+}
+
+func (t *portProtocolTransport) protocol(req *http.Request) wsapi.PortProtocol {
+	coords := getWorkspaceCoords(req)
+
+	ctx, cancel := context.WithTimeout(req.Context(), portProtocolLookupTimeout)
+	defer cancel()
+	info := t.InfoProvider.WorkspaceInfo(ctx, coords.ID)
+	if info == nil {
+		return wsapi.PortProtocol_PORT_PROTOCOL_HTTP
+	}
+	for _, p := range info.Ports {
+		if fmt.Sprint(p.Port) == coords.Port {
+			return p.Protocol
+		}
+	}
+	return wsapi.PortProtocol_PORT_PROTOCOL_HTTP
+}
+
+// withPortProtocolTransport forwards requests using a transport which matches the protocol of the workspace port
+func withPortProtocolTransport(config *RouteHandlerConfig, ip WorkspaceInfoProvider) proxyPassOpt {
+	return func(cfg *proxyPassConfig) {
+		cfg.Transport = &portProtocolTransport{
+			Config:       config,
+			InfoProvider: ip,
+		}
+	}
+}
+
 // tell the browser to cache for 1 year and don't ask the server during this period
 func withLongTermCaching() proxyPassOpt {
 	return func(cfg *proxyPassConfig) {
@@ -5,6 +5,7 @@
 package proxy
 
 import (
+	"net"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -19,6 +20,14 @@ type WorkspaceProxy struct {
 	Config                Config
 	WorkspaceRouter       WorkspaceRouter
 	WorkspaceInfoProvider WorkspaceInfoProvider
+
+	// RateLimiter limits the rate of requests to workspaces. If nil, requests are not limited.
+	RateLimiter *RateLimiter
+	// BandwidthMetrics count the bytes clients exchange with workspaces. If nil, bytes are not counted.
+	BandwidthMetrics *BandwidthMetrics
+	// TCPProxy splices connections to Address which are meant for a TCP workspace port, provided Address is an ingress port.
+	// If nil, all connections are served as HTTP.
+	TCPProxy *TCPProxy
 }
 
 // NewWorkspaceProxy creates a new workspace proxy
@@ -40,6 +49,20 @@ func (p *WorkspaceProxy) MustServe() {
 	}
 	srv := &http.Server{Addr: p.Address, Handler: handler}
 
+	l, err := net.Listen("tcp", p.Address)
+	if err != nil {
+		log.WithError(err).Fatal("cannot start proxy")
+		return
+	}
+	if p.TCPProxy != nil {
+		_, publicPort, err := net.SplitHostPort(p.Address)
+		if err != nil {
+			log.WithError(err).Fatal("cannot start proxy")
+			return
+		}
+		l = p.TCPProxy.PortListener(l, publicPort)
+	}
+
 	if p.Config.HTTPS.Enabled {
 		var (
 			crt = p.Config.HTTPS.Certificate
@@ -49,9 +72,9 @@ func (p *WorkspaceProxy) MustServe() {
 			crt = filepath.Join(tproot, crt)
 			key = filepath.Join(tproot, key)
 		}
-		err = srv.ListenAndServeTLS(crt, key)
+		err = srv.ServeTLS(l, crt, key)
 	} else {
-		err = srv.ListenAndServe()
+		err = srv.Serve(l)
 	}
 
 	if err != nil {
@@ -65,13 +88,20 @@ func (p *WorkspaceProxy) Handler() (http.Handler, error) {
 	r := mux.NewRouter()
 
 	// install routes
-	handlerConfig, err := NewRouteHandlerConfig(&p.Config, WithDefaultAuth(p.WorkspaceInfoProvider))
+	opts := []RouteHandlerConfigOpt{WithDefaultAuth(p.WorkspaceInfoProvider)}
+	if p.RateLimiter != nil {
+		opts = append(opts, WithRateLimiter(p.RateLimiter))
+	}
+	if p.BandwidthMetrics != nil {
+		opts = append(opts, WithBandwidthMetrics(p.BandwidthMetrics))
+	}
+	handlerConfig, err := NewRouteHandlerConfig(&p.Config, opts...)
 	if err != nil {
 		return nil, err
 	}
 	theiaRouter, portRouter, blobserveRouter := p.WorkspaceRouter(r, p.WorkspaceInfoProvider)
 	installWorkspaceRoutes(theiaRouter, handlerConfig, p.WorkspaceInfoProvider)
-	err = installWorkspacePortRoutes(portRouter, handlerConfig)
+	err = installWorkspacePortRoutes(portRouter, handlerConfig, p.WorkspaceInfoProvider)
 	if err != nil {
 		return nil, err
 	}
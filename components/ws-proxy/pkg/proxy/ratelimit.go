@@ -0,0 +1,177 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/gorilla/mux"
+	"golang.org/x/time/rate"
+)
+
+// rateLimiterPruneInterval is the least time between two attempts to drop the token buckets nobody uses anymore
+const rateLimiterPruneInterval = 1 * time.Minute
+
+// RateLimiter limits the rate of requests to workspaces and their public ports using token buckets.
+// A single RateLimiter must be shared by all proxies serving the same workspaces.
+type RateLimiter struct {
+	Config RateLimitConfig
+
+	mu        sync.Mutex
+	buckets   map[rateLimitKey]*rateLimitBucket
+	lastPrune time.Time
+}
+
+type rateLimitKey struct {
+	WorkspaceID string
+	// Port is empty for the bucket of the workspace itself
+	Port string
+}
+
+type rateLimitBucket struct {
+	Limiter  *rate.Limiter
+	LastUsed time.Time
+}
+
+// full reports whether the bucket has refilled completely since it was last used, i.e. dropping it loses nothing
+func (b *rateLimitBucket) full(now time.Time) bool {
+	limit := b.Limiter.Limit()
+	if limit == rate.Inf {
+		return true
+	}
+	refill := time.Duration(float64(b.Limiter.Burst()) / float64(limit) * float64(time.Second))
+	return now.Sub(b.LastUsed) >= refill
+}
+
+// NewRateLimiter creates a new rate limiter
+func NewRateLimiter(cfg RateLimitConfig) *RateLimiter {
+	return &RateLimiter{
+		Config:  cfg,
+		buckets: make(map[rateLimitKey]*rateLimitBucket),
+	}
+}
+
+// Allow reports whether a request to a workspace may be made now. port is nil for requests which are not made to a workspace port.
+// If the request is not allowed, Allow returns the time after which the request would be allowed.
+func (l *RateLimiter) Allow(workspaceID string, port *PortInfo) (ok bool, retryAfter time.Duration) {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.prune(now)
+
+	var reservations []*rate.Reservation
+	defer func() {
+		if ok {
+			return
+		}
+		for _, r := range reservations {
+			r.CancelAt(now)
+		}
+	}()
+
+	if port != nil && port.Visibility == api.PortVisibility_PORT_VISIBILITY_PUBLIC {
+		cfg := l.Config.PublicPort
+		if rl := port.RateLimit; rl != nil && rl.RequestsPerSecond > 0 {
+			cfg = RateLimit{RequestsPerSecond: float64(rl.RequestsPerSecond), Burst: int(rl.Burst)}
+			if cfg.Burst == 0 {
+				cfg.Burst = int(rl.RequestsPerSecond)
+			}
+		}
+
+		r := l.reserve(rateLimitKey{WorkspaceID: workspaceID, Port: fmt.Sprint(port.Port)}, cfg, now)
+		if r != nil {
+			reservations = append(reservations, r)
+			if delay := r.DelayFrom(now); delay > 0 {
+				return false, delay
+			}
+		}
+	}
+
+	r := l.reserve(rateLimitKey{WorkspaceID: workspaceID}, l.Config.Workspace, now)
+	if r != nil {
+		reservations = append(reservations, r)
+		if delay := r.DelayFrom(now); delay > 0 {
+			return false, delay
+		}
+	}
+
+	return true, 0
+}
+
+// reserve takes a token from the bucket of key. Returns nil if there is no limit.
+func (l *RateLimiter) reserve(key rateLimitKey, cfg RateLimit, now time.Time) *rate.Reservation {
+	if cfg.RequestsPerSecond <= 0 {
+		delete(l.buckets, key)
+		return nil
+	}
+
+	limit := rate.Limit(cfg.RequestsPerSecond)
+	b, exists := l.buckets[key]
+	if !exists {
+		b = &rateLimitBucket{Limiter: rate.NewLimiter(limit, cfg.Burst)}
+		l.buckets[key] = b
+	}
+	if b.Limiter.Limit() != limit {
+		// the port's rate limit has changed since we last saw it
+		b.Limiter.SetLimitAt(now, limit)
+	}
+	if b.Limiter.Burst() != cfg.Burst {
+		b.Limiter.SetBurstAt(now, cfg.Burst)
+	}
+	b.LastUsed = now
+
+	return b.Limiter.ReserveN(now, 1)
+}
+
+// prune drops all token buckets which have refilled completely. Callers must hold l.mu.
+func (l *RateLimiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < rateLimiterPruneInterval {
+		return
+	}
+	l.lastPrune = now
+
+	for key, b := range l.buckets {
+		if b.full(now) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// rateLimitHandler rejects requests which exceed the rate limit of the workspace or workspace port they're made to.
+// This handler expects the workspace info to be present in the request context.
+func rateLimitHandler(limiter *RateLimiter, rateLimitedPage http.Handler) mux.MiddlewareFunc {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			info := getWorkspaceInfoFromContext(req.Context())
+			if info == nil {
+				h.ServeHTTP(resp, req)
+				return
+			}
+
+			var port *PortInfo
+			if p := getWorkspaceCoords(req).Port; p != "" {
+				port = findPortInfo(info, p)
+			}
+
+			ok, retryAfter := limiter.Allow(info.WorkspaceID, port)
+			if !ok {
+				getLog(req.Context()).WithField("retryAfter", retryAfter.String()).Debug("request exceeds rate limit")
+				resp.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+				rateLimitedPage.ServeHTTP(resp, req)
+				return
+			}
+
+			h.ServeHTTP(resp, req)
+		})
+	}
+}
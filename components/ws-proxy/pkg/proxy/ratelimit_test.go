@@ -0,0 +1,137 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/google/go-cmp/cmp"
+	"github.com/sirupsen/logrus"
+)
+
+func TestRateLimiter(t *testing.T) {
+	var (
+		publicPort   = &PortInfo{PortSpec: api.PortSpec{Port: 8080, Visibility: api.PortVisibility_PORT_VISIBILITY_PUBLIC}}
+		privatePort  = &PortInfo{PortSpec: api.PortSpec{Port: 8080, Visibility: api.PortVisibility_PORT_VISIBILITY_PRIVATE}}
+		overridePort = &PortInfo{PortSpec: api.PortSpec{Port: 8080, Visibility: api.PortVisibility_PORT_VISIBILITY_PUBLIC, RateLimit: &api.RateLimit{RequestsPerSecond: 1, Burst: 3}}}
+	)
+	type request struct {
+		WorkspaceID string
+		Port        *PortInfo
+	}
+	tests := []struct {
+		Desc        string
+		Config      RateLimitConfig
+		Requests    []request
+		Expectation []bool
+	}{
+		{
+			Desc:        "no limit",
+			Requests:    []request{{"ws1", nil}, {"ws1", publicPort}, {"ws1", nil}},
+			Expectation: []bool{true, true, true},
+		},
+		{
+			Desc:        "workspace limit",
+			Config:      RateLimitConfig{Workspace: RateLimit{RequestsPerSecond: 0.001, Burst: 2}},
+			Requests:    []request{{"ws1", nil}, {"ws1", publicPort}, {"ws1", nil}, {"ws2", nil}},
+			Expectation: []bool{true, true, false, true},
+		},
+		{
+			Desc:        "public port limit",
+			Config:      RateLimitConfig{PublicPort: RateLimit{RequestsPerSecond: 0.001, Burst: 1}},
+			Requests:    []request{{"ws1", publicPort}, {"ws1", publicPort}, {"ws1", nil}, {"ws2", publicPort}},
+			Expectation: []bool{true, false, true, true},
+		},
+		{
+			Desc:        "private ports have no port limit",
+			Config:      RateLimitConfig{PublicPort: RateLimit{RequestsPerSecond: 0.001, Burst: 1}},
+			Requests:    []request{{"ws1", privatePort}, {"ws1", privatePort}},
+			Expectation: []bool{true, true},
+		},
+		{
+			Desc:        "port override",
+			Config:      RateLimitConfig{PublicPort: RateLimit{RequestsPerSecond: 0.001, Burst: 1}},
+			Requests:    []request{{"ws1", overridePort}, {"ws1", overridePort}, {"ws1", overridePort}, {"ws1", overridePort}},
+			Expectation: []bool{true, true, true, false},
+		},
+		{
+			Desc: "denied port request does not count towards workspace limit",
+			Config: RateLimitConfig{
+				Workspace:  RateLimit{RequestsPerSecond: 0.001, Burst: 2},
+				PublicPort: RateLimit{RequestsPerSecond: 0.001, Burst: 1},
+			},
+			Requests:    []request{{"ws1", publicPort}, {"ws1", publicPort}, {"ws1", nil}, {"ws1", nil}},
+			Expectation: []bool{true, false, true, false},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			limiter := NewRateLimiter(test.Config)
+
+			var act []bool
+			for _, req := range test.Requests {
+				ok, _ := limiter.Allow(req.WorkspaceID, req.Port)
+				act = append(act, ok)
+			}
+
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRateLimitHandler(t *testing.T) {
+	log.Log.Logger.SetLevel(logrus.PanicLevel)
+
+	ws := workspaces[0]
+	ws.Auth = &api.WorkspaceAuthentication{Admission: api.AdmissionLevel_ADMIT_EVERYONE}
+	port := ws.Ports[0]
+
+	proxy := NewWorkspaceProxy(":8080", config, HostBasedRouter(hostBasedHeader, wsHostSuffix), &fakeWsInfoProvider{infos: []WorkspaceInfo{ws}})
+	proxy.RateLimiter = NewRateLimiter(RateLimitConfig{PublicPort: RateLimit{RequestsPerSecond: 0.5, Burst: 1}})
+	handler, err := proxy.Handler()
+	if err != nil {
+		t.Fatalf("cannot create proxy handler: %q", err)
+	}
+
+	portTarget := startTestTarget(t, portServeHost, "port")
+	defer portTarget.Close()
+
+	var statusCodes []int
+	for i := 0; i < 2; i++ {
+		req := modifyRequest(httptest.NewRequest("GET", port.Url, nil), addHostHeader)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		resp := rec.Result()
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		statusCodes = append(statusCodes, resp.StatusCode)
+		if resp.StatusCode != http.StatusTooManyRequests {
+			continue
+		}
+		if ra := resp.Header.Get("Retry-After"); ra != "2" {
+			t.Errorf("unexpected Retry-After header: %q", ra)
+		}
+		if !strings.Contains(string(body), "Too many requests") {
+			t.Errorf("rate limited page was not served: %s", string(body))
+		}
+	}
+
+	if diff := cmp.Diff([]int{http.StatusOK, http.StatusTooManyRequests}, statusCodes); diff != "" {
+		t.Errorf("unexpected status codes (-want +got):\n%s", diff)
+	}
+	if portTarget.RequestCount != 1 {
+		t.Errorf("expected one request to reach the port, got %d", portTarget.RequestCount)
+	}
+}
@@ -7,6 +7,7 @@ package proxy
 import (
 	"bytes"
 	"context"
+	"crypto/subtle"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -29,8 +30,12 @@ import (
 type RouteHandlerConfig struct {
 	Config               *Config
 	DefaultTransport     http.RoundTripper
+	H2CTransport         http.RoundTripper
+	TLSTransport         http.RoundTripper
 	CorsHandler          mux.MiddlewareFunc
 	WorkspaceAuthHandler mux.MiddlewareFunc
+	RateLimitHandler     mux.MiddlewareFunc
+	BandwidthHandler     mux.MiddlewareFunc
 }
 
 // RouteHandlerConfigOpt modifies the router handler config
@@ -43,6 +48,20 @@ func WithDefaultAuth(infoprov WorkspaceInfoProvider) RouteHandlerConfigOpt {
 	}
 }
 
+// WithRateLimiter limits the rate of requests to workspaces and their ports
+func WithRateLimiter(limiter *RateLimiter) RouteHandlerConfigOpt {
+	return func(config *Config, c *RouteHandlerConfig) {
+		c.RateLimitHandler = rateLimitHandler(limiter, serveRateLimitedPage(config))
+	}
+}
+
+// WithBandwidthMetrics counts the bytes clients exchange with workspaces
+func WithBandwidthMetrics(metrics *BandwidthMetrics) RouteHandlerConfigOpt {
+	return func(config *Config, c *RouteHandlerConfig) {
+		c.BandwidthHandler = bandwidthHandler(metrics)
+	}
+}
+
 // NewRouteHandlerConfig creates a new instance
 func NewRouteHandlerConfig(config *Config, opts ...RouteHandlerConfigOpt) (*RouteHandlerConfig, error) {
 	corsHandler, err := corsHandler(config.GitpodInstallation.Scheme, config.GitpodInstallation.HostName)
@@ -53,8 +72,12 @@ func NewRouteHandlerConfig(config *Config, opts ...RouteHandlerConfigOpt) (*Rout
 	cfg := &RouteHandlerConfig{
 		Config:               config,
 		DefaultTransport:     createDefaultTransport(config.TransportConfig),
+		H2CTransport:         createH2CTransport(config.TransportConfig),
+		TLSTransport:         createTLSTransport(config.TransportConfig),
 		CorsHandler:          corsHandler,
 		WorkspaceAuthHandler: func(h http.Handler) http.Handler { return h },
+		RateLimitHandler:     func(h http.Handler) http.Handler { return h },
+		BandwidthHandler:     func(h http.Handler) http.Handler { return h },
 	}
 	for _, o := range opts {
 		o(config, cfg)
@@ -68,6 +91,8 @@ type RouteHandler = func(r *mux.Router, config *RouteHandlerConfig)
 // installWorkspaceRoutes configures routing of workspace and IDE requests
 func installWorkspaceRoutes(r *mux.Router, config *RouteHandlerConfig, ip WorkspaceInfoProvider) {
 	r.Use(logHandler)
+	r.Use(clusterForwardingHandler(config, ip))
+	r.Use(config.BandwidthHandler)
 	r.Use(handlers.CompressHandler)
 
 	// Note: the order of routes defines their priority.
@@ -94,9 +119,15 @@ func installWorkspaceRoutes(r *mux.Router, config *RouteHandlerConfig, ip Worksp
 		routes.HandleDirectIDERoute(r.PathPrefix(pp))
 	}
 
+	if config.Config.WorkspacePodConfig.SSHPort != 0 {
+		routes.HandleSSHTunnelRoute(r.Path("/_ssh/tunnel"))
+	}
+
 	routes.HandleSupervisorFrontendRoute(r.PathPrefix("/_supervisor/frontend"))
 	routes.HandleDirectSupervisorRoute(r.PathPrefix("/_supervisor/v1/status/supervisor"), false)
 	routes.HandleDirectSupervisorRoute(r.PathPrefix("/_supervisor/v1/status/ide"), false)
+	// reverse tunnels are opened by clients outside of the browser - supervisor authenticates them itself
+	routes.HandleDirectSupervisorRoute(r.Path("/_supervisor/tunnel"), false)
 	routes.HandleDirectSupervisorRoute(r.PathPrefix("/_supervisor/v1"), true)
 	routes.HandleDirectSupervisorRoute(r.PathPrefix("/_supervisor"), true)
 
@@ -124,6 +155,7 @@ func (ir *ideRoutes) HandleDirectIDERoute(route *mux.Route) {
 	r.Use(ir.Config.CorsHandler)
 	r.Use(ir.Config.WorkspaceAuthHandler)
 	r.Use(ir.workspaceMustExistHandler)
+	r.Use(ir.Config.RateLimitHandler)
 
 	r.NewRoute().HandlerFunc(proxyPass(ir.Config, workspacePodResolver))
 }
@@ -135,11 +167,23 @@ func (ir *ideRoutes) HandleDirectSupervisorRoute(route *mux.Route, authenticated
 	r.Use(ir.workspaceMustExistHandler)
 	if authenticated {
 		r.Use(ir.Config.WorkspaceAuthHandler)
+		r.Use(ir.Config.RateLimitHandler)
 	}
 
 	r.NewRoute().HandlerFunc(proxyPass(ir.Config, workspacePodSupervisorResolver))
 }
 
+// HandleSSHTunnelRoute tunnels SSH connections to the workspace's SSH server over a WebSocket.
+// This route does not require the owner cookie: the SSH server authenticates its users itself,
+// using the owner token or the owner's public keys.
+func (ir *ideRoutes) HandleSSHTunnelRoute(route *mux.Route) {
+	r := route.Subrouter()
+	r.Use(logRouteHandlerHandler("HandleSSHTunnelRoute"))
+	r.Use(ir.workspaceMustExistHandler)
+
+	r.NewRoute().HandlerFunc(sshTunnel(ir.Config, workspacePodSSHResolver))
+}
+
 func (ir *ideRoutes) HandleSupervisorFrontendRoute(route *mux.Route) {
 	if ir.Config.Config.BlobServer == nil {
 		// if we don't have blobserve, we serve the supervisor frontend from supervisor directly
@@ -194,7 +238,9 @@ func (ir *ideRoutes) HandleRoot(route *mux.Route) {
 	r.Use(ir.workspaceMustExistHandler)
 
 	workspaceIDEPass := ir.Config.WorkspaceAuthHandler(
-		proxyPass(ir.Config, workspacePodResolver),
+		ir.Config.RateLimitHandler(
+			proxyPass(ir.Config, workspacePodResolver),
+		),
 	)
 	// always hit the blobserver to ensure that blob is downloaded
 	r.NewRoute().HandlerFunc(proxyPass(ir.Config, dynamicIDEResolver, func(h *proxyPassConfig) {
@@ -222,7 +268,9 @@ func (ir *ideRoutes) handleRootWithoutBlobserve(route *mux.Route) {
 	// We first try and service the request using the static IDE server or blobserve.
 	// If that fails, we proxy-pass to the workspace.
 	workspaceIDEPass := ir.Config.WorkspaceAuthHandler(
-		proxyPass(ir.Config, workspacePodResolver),
+		ir.Config.RateLimitHandler(
+			proxyPass(ir.Config, workspacePodResolver),
+		),
 	)
 	ideAssetPass := proxyPass(ir.Config, staticIDEResolver, withHTTPErrorHandler(workspaceIDEPass))
 	r.NewRoute().HandlerFunc(ideAssetPass)
@@ -258,14 +306,17 @@ func installBlobserveRoutes(r *mux.Router, config *RouteHandlerConfig) {
 }
 
 // installWorkspacePortRoutes configures routing for exposed ports
-func installWorkspacePortRoutes(r *mux.Router, config *RouteHandlerConfig) error {
+func installWorkspacePortRoutes(r *mux.Router, config *RouteHandlerConfig, ip WorkspaceInfoProvider) error {
 	showPortNotFoundPage, err := servePortNotFoundPage(config.Config)
 	if err != nil {
 		return err
 	}
 
 	r.Use(logHandler)
+	r.Use(clusterForwardingHandler(config, ip))
+	r.Use(config.BandwidthHandler)
 	r.Use(config.WorkspaceAuthHandler)
+	r.Use(config.RateLimitHandler)
 	// filter all session cookies
 	r.Use(sensitiveCookieHandler(config.Config.GitpodInstallation.HostName))
 
@@ -276,6 +327,7 @@ func installWorkspacePortRoutes(r *mux.Router, config *RouteHandlerConfig) error
 			workspacePodPortResolver,
 			withHTTPErrorHandler(showPortNotFoundPage),
 			withXFrameOptionsFilter(),
+			withPortProtocolTransport(config, ip),
 		),
 	)
 
@@ -300,6 +352,12 @@ func workspacePodSupervisorResolver(config *Config, req *http.Request) (url *url
 	return buildWorkspacePodURL(config.WorkspacePodConfig.ServiceTemplate, coords.ID, fmt.Sprint(config.WorkspacePodConfig.SupervisorPort))
 }
 
+// workspacePodSSHResolver resolves to the workspace pods SSH server from the given request
+func workspacePodSSHResolver(config *Config, req *http.Request) (url *url.URL, err error) {
+	coords := getWorkspaceCoords(req)
+	return buildWorkspacePodURL(config.WorkspacePodConfig.ServiceTemplate, coords.ID, fmt.Sprint(config.WorkspacePodConfig.SSHPort))
+}
+
 // staticIDEResolver resolves to static IDE server with the statically configured version
 func staticIDEResolver(config *Config, req *http.Request) (url *url.URL, err error) {
 	targetURL := *req.URL
@@ -468,7 +526,95 @@ func workspaceMustExistHandler(config *Config, infoProvider WorkspaceInfoProvide
 	}
 }
 
-// getWorkspaceInfoFromContext retrieves workspace information put there by the workspaceMustExistHandler
+const (
+	// forwardedClusterHeader marks requests ws-proxy forwarded to the ws-proxy of another workspace cluster.
+	// It carries the cluster forwarding secret s.t. clients cannot pose as another ws-proxy.
+	forwardedClusterHeader = "x-wsproxy-cluster"
+	// clusterLookupTimeout is the time we wait for workspace information when deciding which cluster serves a request
+	clusterLookupTimeout = 3 * time.Second
+)
+
+// clusterForwardingHandler forwards requests for workspaces which run in another cluster to that cluster's ws-proxy.
+// The other ws-proxy gets the request as we received it, and authenticates and routes it itself.
+func clusterForwardingHandler(config *RouteHandlerConfig, infoProvider WorkspaceInfoProvider) mux.MiddlewareFunc {
+	forward := proxyPass(config, clusterProxyResolver, withErrorHandler(func(w http.ResponseWriter, req *http.Request, err error) {
+		getLog(req.Context()).WithError(err).Warn("cannot forward request to workspace cluster")
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	secret := config.Config.ClusterForwardingSecret
+
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			// Only other ws-proxies know the secret - we ignore the header of anyone else. Either way the header
+			// must not make it to the workspace.
+			forwarded := isForwardedByClusterProxy(req, secret)
+			req.Header.Del(forwardedClusterHeader)
+
+			coords := getWorkspaceCoords(req)
+			ctx, cancel := context.WithTimeout(req.Context(), clusterLookupTimeout)
+			info := infoProvider.WorkspaceInfo(ctx, coords.ID)
+			cancel()
+			if info == nil {
+				h.ServeHTTP(resp, req)
+				return
+			}
+			if info.ClusterProxyURL == "" {
+				h.ServeHTTP(resp, req.WithContext(context.WithValue(req.Context(), infoContextValueKey, info)))
+				return
+			}
+			if forwarded {
+				// another ws-proxy forwarded this request to us already - we don't forward it any further to avoid loops
+				log.WithFields(log.OWI("", coords.ID, "")).WithField("cluster", info.Cluster).Warn("workspace clusters disagree where a workspace runs - not forwarding request again")
+				resp.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			if secret == "" {
+				log.WithFields(log.OWI("", coords.ID, "")).WithField("cluster", info.Cluster).Error("cannot forward request to workspace cluster without a cluster forwarding secret")
+				resp.WriteHeader(http.StatusBadGateway)
+				return
+			}
+
+			req.Header.Set(forwardedClusterHeader, secret)
+			forward(resp, req.WithContext(context.WithValue(req.Context(), infoContextValueKey, info)))
+		})
+	}
+}
+
+// isForwardedByClusterProxy returns true if the request comes from the ws-proxy of another workspace cluster
+func isForwardedByClusterProxy(req *http.Request, secret string) bool {
+	hdr := req.Header.Get(forwardedClusterHeader)
+	if hdr == "" || secret == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(hdr), []byte(secret)) == 1
+}
+
+// clusterProxyResolver resolves to the ws-proxy of the cluster the workspace runs in
+func clusterProxyResolver(config *Config, req *http.Request) (*url.URL, error) {
+	info := getWorkspaceInfoFromContext(req.Context())
+	if info == nil {
+		return nil, xerrors.Errorf("no workspace information available - cannot resolve workspace cluster")
+	}
+	dst, err := url.Parse(info.ClusterProxyURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// the workspace routers may have rewritten the request's path already
+	if req.RequestURI != "" {
+		orig, err := url.ParseRequestURI(req.RequestURI)
+		if err != nil {
+			return nil, err
+		}
+		req.URL.Path = orig.Path
+		req.URL.RawPath = orig.RawPath
+		req.URL.RawQuery = orig.RawQuery
+	}
+
+	return dst, nil
+}
+
+// getWorkspaceInfoFromContext retrieves workspace information put there by the clusterForwardingHandler or workspaceMustExistHandler
 func getWorkspaceInfoFromContext(ctx context.Context) *WorkspaceInfo {
 	r := ctx.Value(infoContextValueKey)
 	rl, ok := r.(*WorkspaceInfo)
@@ -501,6 +647,10 @@ func removeSensitiveCookies(cookies []*http.Cookie, domain string) []*http.Cooki
 			// skip owner token
 			continue
 		}
+		if strings.HasPrefix(c.Name, hostnamePrefix) && strings.HasSuffix(c.Name, "_access_") {
+			// skip access grant token
+			continue
+		}
 		log.WithField("hostnamePrefix", hostnamePrefix).WithField("name", c.Name).Debug("keeping cookie")
 		cookies[n] = c
 		n++
@@ -627,6 +777,7 @@ func (t *blobserveTransport) redirect(image string, req *http.Request) (*http.Re
 
 const (
 	builtinPagePortNotFound = "port-not-found.html"
+	builtinPageRateLimited  = "rate-limited.html"
 )
 
 func servePortNotFoundPage(config *Config) (http.Handler, error) {
@@ -645,3 +796,24 @@ func servePortNotFoundPage(config *Config) (http.Handler, error) {
 		w.Write(page)
 	}), nil
 }
+
+// serveRateLimitedPage serves the rate limited page. If that page cannot be read, we respond with the status code only.
+func serveRateLimitedPage(config *Config) http.Handler {
+	fn := filepath.Join(config.BuiltinPages.Location, builtinPageRateLimited)
+	if tp := os.Getenv("TELEPRESENCE_ROOT"); tp != "" {
+		fn = filepath.Join(tp, fn)
+	}
+	page, err := ioutil.ReadFile(fn)
+	if err != nil {
+		log.WithError(err).WithField("fn", fn).Warn("cannot read rate limited page")
+	}
+	page = bytes.ReplaceAll(page, []byte("https://gitpod.io"), []byte(fmt.Sprintf("%s://%s", config.GitpodInstallation.Scheme, config.GitpodInstallation.HostName)))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(page) > 0 {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		}
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write(page)
+	})
+}
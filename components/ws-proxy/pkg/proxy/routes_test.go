@@ -21,7 +21,10 @@ import (
 	"github.com/gitpod-io/gitpod/common-go/util"
 	"github.com/gitpod-io/gitpod/ws-manager/api"
 	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/websocket"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 )
 
 const (
@@ -387,6 +390,20 @@ func TestRoutes(t *testing.T) {
 				Body: "supervisor hit: /_supervisor/v1/status/ide\n",
 			},
 		},
+		{
+			Desc: "unauthenticated supervisor tunnel",
+			Request: modifyRequest(httptest.NewRequest("GET", workspaces[0].URL+"_supervisor/tunnel", nil),
+				addHostHeader,
+			),
+			Expectation: Expectation{
+				Status: http.StatusOK,
+				Header: http.Header{
+					"Content-Length": {"36"},
+					"Content-Type":   {"text/plain; charset=utf-8"},
+				},
+				Body: "supervisor hit: /_supervisor/tunnel\n",
+			},
+		},
 		{
 			Desc: "unauthenticated supervisor API (content status)",
 			Request: modifyRequest(httptest.NewRequest("GET", workspaces[0].URL+"_supervisor/v1/status/content", nil),
@@ -691,6 +708,215 @@ func TestRoutes(t *testing.T) {
 	}
 }
 
+func TestSSHTunnel(t *testing.T) {
+	log.Init("ws-proxy-test", "", false, true)
+	log.Log.Logger.SetLevel(logrus.ErrorLevel)
+
+	sshServer, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sshServer.Close()
+	go func() {
+		for {
+			conn, err := sshServer.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	cfg := config
+	podCfg := *config.WorkspacePodConfig
+	podCfg.SSHPort = uint16(sshServer.Addr().(*net.TCPAddr).Port)
+	cfg.WorkspacePodConfig = &podCfg
+
+	proxy := NewWorkspaceProxy(":8080", cfg, HostBasedRouter(hostBasedHeader, wsHostSuffix), &fakeWsInfoProvider{infos: workspaces})
+	handler, err := proxy.Handler()
+	if err != nil {
+		t.Fatalf("cannot create proxy handler: %q", err)
+	}
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	wsHost := strings.TrimSuffix(strings.TrimPrefix(workspaces[0].URL, "https://"), "/")
+	conn, _, err := websocket.DefaultDialer.Dial(strings.Replace(srv.URL, "http://", "ws://", 1)+"/_ssh/tunnel", http.Header{
+		hostBasedHeader: []string{wsHost},
+	})
+	if err != nil {
+		t.Fatalf("cannot open SSH tunnel: %v", err)
+	}
+	defer conn.Close()
+
+	msg := []byte("SSH-2.0-test")
+	err = conn.WriteMessage(websocket.BinaryMessage, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var received []byte
+	for len(received) < len(msg) {
+		_, b, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("cannot read from SSH tunnel: %v", err)
+		}
+		received = append(received, b...)
+	}
+	if string(received) != string(msg) {
+		t.Errorf("unexpected tunnel response: %q", string(received))
+	}
+}
+
+func TestPortProtocolTransport(t *testing.T) {
+	log.Init("ws-proxy-test", "", false, true)
+	log.Log.Logger.SetLevel(logrus.ErrorLevel)
+
+	// the upstreams tell us how they were talked to
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%s tls=%v", r.Proto, r.TLS != nil)
+	})
+	upstreams := map[api.PortProtocol]*httptest.Server{
+		api.PortProtocol_PORT_PROTOCOL_HTTP: httptest.NewServer(handler),
+		api.PortProtocol_PORT_PROTOCOL_H2C:  httptest.NewServer(h2c.NewHandler(handler, &http2.Server{})),
+		api.PortProtocol_PORT_PROTOCOL_TLS:  httptest.NewTLSServer(handler),
+	}
+	ws := workspaces[0]
+	ws.Ports = nil
+	for protocol, srv := range upstreams {
+		defer srv.Close()
+
+		port := srv.Listener.Addr().(*net.TCPAddr).Port
+		ws.Ports = append(ws.Ports, PortInfo{PortSpec: api.PortSpec{
+			Port:       uint32(port),
+			Url:        fmt.Sprintf("https://%d-%s.test-domain.com/", port, ws.WorkspaceID),
+			Visibility: api.PortVisibility_PORT_VISIBILITY_PUBLIC,
+			Protocol:   protocol,
+		}})
+	}
+
+	proxy := NewWorkspaceProxy(":8080", config, HostBasedRouter(hostBasedHeader, wsHostSuffix), &fakeWsInfoProvider{infos: []WorkspaceInfo{ws}})
+	handlerUnderTest, err := proxy.Handler()
+	if err != nil {
+		t.Fatalf("cannot create proxy handler: %q", err)
+	}
+
+	expectations := map[api.PortProtocol]string{
+		api.PortProtocol_PORT_PROTOCOL_HTTP: "HTTP/1.1 tls=false",
+		api.PortProtocol_PORT_PROTOCOL_H2C:  "HTTP/2.0 tls=false",
+		api.PortProtocol_PORT_PROTOCOL_TLS:  "HTTP/1.1 tls=true",
+	}
+	for _, port := range ws.Ports {
+		t.Run(port.Protocol.String(), func(t *testing.T) {
+			req := modifyRequest(httptest.NewRequest("GET", port.Url, nil), addHostHeader)
+			rec := httptest.NewRecorder()
+			handlerUnderTest.ServeHTTP(rec, req)
+
+			resp := rec.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("unexpected status %d: %s", resp.StatusCode, string(body))
+			}
+			if string(body) != expectations[port.Protocol] {
+				t.Errorf("expected %q, got %q", expectations[port.Protocol], string(body))
+			}
+		})
+	}
+}
+
+func TestClusterForwarding(t *testing.T) {
+	log.Init("ws-proxy-test", "", false, true)
+	log.Log.Logger.SetLevel(logrus.ErrorLevel)
+
+	// the other cluster's ws-proxy tells us what it received
+	remoteProxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%s %s cluster=%s", r.Host, r.URL.RequestURI(), r.Header.Get(forwardedClusterHeader))
+	}))
+	defer remoteProxy.Close()
+
+	ws := workspaces[0]
+	ws.Cluster = "eu02"
+	ws.ClusterProxyURL = remoteProxy.URL
+
+	const secret = "forwarding-secret"
+	cfg := config
+	cfg.ClusterForwardingSecret = secret
+
+	tests := []struct {
+		Desc       string
+		Router     WorkspaceRouter
+		Request    *http.Request
+		WantStatus int
+		WantBody   string
+	}{
+		{
+			Desc:       "host-based IDE",
+			Router:     HostBasedRouter(hostBasedHeader, wsHostSuffix),
+			Request:    modifyRequest(httptest.NewRequest("GET", ws.URL+"some/path?q=1", nil), addHostHeader),
+			WantStatus: http.StatusOK,
+			WantBody:   "c95fd41c-13d9-4d51-b282-e2be09de207f.test-domain.com /some/path?q=1 cluster=" + secret,
+		},
+		{
+			Desc:       "host-based port",
+			Router:     HostBasedRouter(hostBasedHeader, wsHostSuffix),
+			Request:    modifyRequest(httptest.NewRequest("GET", ws.Ports[0].Url+"api", nil), addHostHeader),
+			WantStatus: http.StatusOK,
+			WantBody:   "28080-c95fd41c-13d9-4d51-b282-e2be09de207f.test-domain.com /api cluster=" + secret,
+		},
+		{
+			Desc:       "path-based IDE keeps the workspace prefix",
+			Router:     PathAndHostRouter("/", hostBasedHeader, wsHostSuffix),
+			Request:    httptest.NewRequest("GET", "https://test-domain.com/"+ws.WorkspaceID+"/some/path", nil),
+			WantStatus: http.StatusOK,
+			WantBody:   "test-domain.com /" + ws.WorkspaceID + "/some/path cluster=" + secret,
+		},
+		{
+			Desc:   "already forwarded",
+			Router: HostBasedRouter(hostBasedHeader, wsHostSuffix),
+			Request: modifyRequest(httptest.NewRequest("GET", ws.URL, nil),
+				addHostHeader,
+				addHeader(forwardedClusterHeader, secret),
+			),
+			WantStatus: http.StatusBadGateway,
+		},
+		{
+			Desc:   "client poses as ws-proxy",
+			Router: HostBasedRouter(hostBasedHeader, wsHostSuffix),
+			Request: modifyRequest(httptest.NewRequest("GET", ws.URL, nil),
+				addHostHeader,
+				addHeader(forwardedClusterHeader, "eu01"),
+			),
+			WantStatus: http.StatusOK,
+			WantBody:   "c95fd41c-13d9-4d51-b282-e2be09de207f.test-domain.com / cluster=" + secret,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			proxy := NewWorkspaceProxy(":8080", cfg, test.Router, &fakeWsInfoProvider{infos: []WorkspaceInfo{ws}})
+			handler, err := proxy.Handler()
+			if err != nil {
+				t.Fatalf("cannot create proxy handler: %q", err)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, test.Request)
+
+			resp := rec.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			if resp.StatusCode != test.WantStatus {
+				t.Fatalf("unexpected status %d: %s", resp.StatusCode, string(body))
+			}
+			if string(body) != test.WantBody {
+				t.Errorf("expected %q, got %q", test.WantBody, string(body))
+			}
+		})
+	}
+}
+
 type fakeWsInfoProvider struct {
 	infos []WorkspaceInfo
 }
@@ -735,6 +961,7 @@ func TestRemoveSensitiveCookies(t *testing.T) {
 		sessionCookie  = &http.Cookie{Domain: domain, Name: "_test_domain_com_", Value: "fobar"}
 		portAuthCookie = &http.Cookie{Domain: domain, Name: "_test_domain_com_ws_77f6b236_3456_4b88_8284_81ca543a9d65_port_auth_", Value: "some-token"}
 		ownerCookie    = &http.Cookie{Domain: domain, Name: "_test_domain_com_ws_77f6b236_3456_4b88_8284_81ca543a9d65_owner_", Value: "some-other-token"}
+		accessCookie   = &http.Cookie{Domain: domain, Name: "_test_domain_com_ws_77f6b236_3456_4b88_8284_81ca543a9d65_access_", Value: "yet-another-token"}
 		miscCookie     = &http.Cookie{Domain: domain, Name: "some-other-cookie", Value: "I like cookies"}
 	)
 
@@ -747,6 +974,7 @@ func TestRemoveSensitiveCookies(t *testing.T) {
 		{"session cookie", []*http.Cookie{sessionCookie, miscCookie}, []*http.Cookie{miscCookie}},
 		{"portAuth cookie", []*http.Cookie{portAuthCookie, miscCookie}, []*http.Cookie{miscCookie}},
 		{"owner cookie", []*http.Cookie{ownerCookie, miscCookie}, []*http.Cookie{miscCookie}},
+		{"access cookie", []*http.Cookie{accessCookie, miscCookie}, []*http.Cookie{miscCookie}},
 		{"misc cookie", []*http.Cookie{miscCookie}, []*http.Cookie{miscCookie}},
 	}
 	for _, test := range tests {
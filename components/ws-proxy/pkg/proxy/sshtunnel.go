@@ -0,0 +1,102 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// sshTunnelDialTimeout is the time we give the workspace's SSH server to accept a connection
+	sshTunnelDialTimeout = 10 * time.Second
+	// sshTunnelBufferSize is the size of the buffers we use to move data through the tunnel
+	sshTunnelBufferSize = 32 << 10
+)
+
+var sshTunnelUpgrader = websocket.Upgrader{
+	ReadBufferSize:  sshTunnelBufferSize,
+	WriteBufferSize: sshTunnelBufferSize,
+	// SSH clients connecting through the tunnel are not browsers, and the tunnel carries no ambient
+	// credentials that a foreign origin could abuse. Hence there's no point in checking the origin.
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// sshTunnel upgrades requests to a WebSocket connection and pipes all binary messages to the SSH server
+// of the workspace. This way, SSH clients that can only speak HTTP(S) can connect to workspaces.
+func sshTunnel(config *RouteHandlerConfig, resolver targetResolver) http.HandlerFunc {
+	return func(resp http.ResponseWriter, req *http.Request) {
+		log := getLog(req.Context())
+
+		tgt, err := resolver(config.Config, req)
+		if err != nil {
+			log.WithError(err).Warn("cannot resolve SSH tunnel target")
+			resp.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		if !websocket.IsWebSocketUpgrade(req) {
+			resp.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		backend, err := net.DialTimeout("tcp", tgt.Host, sshTunnelDialTimeout)
+		if err != nil {
+			log.WithError(err).WithField("target", tgt.Host).Warn("cannot connect to workspace SSH server")
+			resp.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		defer backend.Close()
+
+		conn, err := sshTunnelUpgrader.Upgrade(resp, req, nil)
+		if err != nil {
+			// the upgrader has already responded to the client
+			log.WithError(err).Debug("cannot upgrade SSH tunnel connection")
+			return
+		}
+		defer conn.Close()
+
+		done := make(chan struct{}, 2)
+		go func() {
+			defer func() { done <- struct{}{} }()
+			for {
+				tpe, r, err := conn.NextReader()
+				if err != nil {
+					return
+				}
+				if tpe != websocket.BinaryMessage {
+					continue
+				}
+				_, err = io.Copy(backend, r)
+				if err != nil {
+					return
+				}
+			}
+		}()
+		go func() {
+			defer func() { done <- struct{}{} }()
+			buf := make([]byte, sshTunnelBufferSize)
+			for {
+				n, err := backend.Read(buf)
+				if n > 0 {
+					werr := conn.WriteMessage(websocket.BinaryMessage, buf[:n])
+					if werr != nil {
+						return
+					}
+				}
+				if err != nil {
+					_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+					return
+				}
+			}
+		}()
+
+		// once either side is done, the deferred closes take down the other side
+		<-done
+	}
+}
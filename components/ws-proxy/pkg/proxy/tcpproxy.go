@@ -0,0 +1,335 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// sniHandshakeTimeout is the time clients have to send their TLS ClientHello
+	sniHandshakeTimeout = 10 * time.Second
+)
+
+var errClientHelloPeeked = xerrors.Errorf("client hello peeked")
+
+// TCPProxy splices connections to workspace ports which serve protocols other than HTTP (PORT_PROTOCOL_TCP).
+// Such connections carry no cookies or tokens, hence only ports which anyone may access are reachable.
+//
+// TCPProxy does not forward UDP: there is neither a server name to route datagrams by, nor a connection whose
+// lifetime we could tie admission checks, rate limits and bandwidth metrics to. Ports serving UDP cannot be exposed.
+type TCPProxy struct {
+	Config                Config
+	WorkspaceInfoProvider WorkspaceInfoProvider
+
+	// RateLimiter limits the rate of connections to workspaces. If nil, connections are not limited.
+	RateLimiter *RateLimiter
+	// BandwidthMetrics count the bytes clients exchange with workspaces. If nil, bytes are not counted.
+	BandwidthMetrics *BandwidthMetrics
+}
+
+// NewTCPProxy creates a new TCP proxy
+func NewTCPProxy(config Config, workspaceInfoProvider WorkspaceInfoProvider) *TCPProxy {
+	return &TCPProxy{
+		Config:                config,
+		WorkspaceInfoProvider: workspaceInfoProvider,
+	}
+}
+
+// MustServeSNI listens on addr and routes TLS connections to workspace ports using the server name of their ClientHello.
+// The TLS session is passed through to the workspace port, i.e. the workspace terminates TLS. Ends the process if listening fails.
+func (p *TCPProxy) MustServeSNI(addr string) {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.WithError(err).Fatal("cannot start TLS passthrough proxy")
+		return
+	}
+
+	err = p.ServeSNI(l)
+	if err != nil {
+		log.WithError(err).Fatal("cannot serve TLS passthrough proxy")
+	}
+}
+
+// ServeSNI accepts TLS connections on l and routes them to workspace ports using the server name of their ClientHello
+func (p *TCPProxy) ServeSNI(l net.Listener) error {
+	hostRegex := workspacePortHostRegex(p.Config.GitpodInstallation.WorkspaceHostSuffix)
+
+	for {
+		conn, err := l.Accept()
+		if ne, ok := err.(net.Error); ok && ne.Temporary() {
+			log.WithError(err).Warn("cannot accept TLS passthrough connection")
+			time.Sleep(100 * time.Millisecond)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		go func() {
+			serverName, hello, err := peekServerName(conn)
+			if err != nil {
+				log.WithError(err).WithField("remoteAddr", conn.RemoteAddr().String()).Debug("cannot read TLS ClientHello")
+				conn.Close()
+				return
+			}
+
+			matches := hostRegex.FindStringSubmatch(serverName)
+			if len(matches) < 4 {
+				log.WithField("serverName", serverName).Debug("TLS server name matches no workspace port")
+				conn.Close()
+				return
+			}
+
+			p.splice(&prefixedConn{Conn: conn, prefix: hello}, WorkspaceCoords{ID: matches[3], Port: matches[2]})
+		}()
+	}
+}
+
+// PortListener wraps a listener of the port range ws-manager allocates ingress ports from. Connections to a public port which is allocated
+// to a TCP workspace port are spliced to that port, all other connections are returned from Accept to be served as HTTP.
+func (p *TCPProxy) PortListener(l net.Listener, publicPort string) net.Listener {
+	res := &tcpPortListener{
+		Listener: l,
+		accepted: make(chan acceptResult),
+		closed:   make(chan struct{}),
+	}
+	go res.accept(p, publicPort)
+	return res
+}
+
+// tcpPortListener demultiplexes the connections of an ingress port into those we splice and those we serve as HTTP
+type tcpPortListener struct {
+	net.Listener
+
+	accepted  chan acceptResult
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+type acceptResult struct {
+	Conn net.Conn
+	Err  error
+}
+
+func (l *tcpPortListener) accept(p *TCPProxy, publicPort string) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			select {
+			case l.accepted <- acceptResult{Err: err}:
+			case <-l.closed:
+				return
+			}
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				continue
+			}
+			return
+		}
+
+		go func() {
+			coords := p.WorkspaceInfoProvider.WorkspaceCoords(publicPort)
+			if coords != nil && coords.Port != "" && p.servesTCP(*coords) {
+				p.splice(conn, *coords)
+				return
+			}
+
+			select {
+			case l.accepted <- acceptResult{Conn: conn}:
+			case <-l.closed:
+				conn.Close()
+			}
+		}()
+	}
+}
+
+// Accept waits for and returns the next connection which is not spliced to a TCP workspace port
+func (l *tcpPortListener) Accept() (net.Conn, error) {
+	select {
+	case r := <-l.accepted:
+		return r.Conn, r.Err
+	case <-l.closed:
+		return nil, xerrors.Errorf("listener closed")
+	}
+}
+
+// Close closes the listener
+func (l *tcpPortListener) Close() error {
+	l.closeOnce.Do(func() { close(l.closed) })
+	return l.Listener.Close()
+}
+
+// servesTCP returns true if the workspace port serves a protocol other than HTTP
+func (p *TCPProxy) servesTCP(coords WorkspaceCoords) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), clusterLookupTimeout)
+	defer cancel()
+	ws := p.WorkspaceInfoProvider.WorkspaceInfo(ctx, coords.ID)
+	if ws == nil {
+		return false
+	}
+	port := findPortInfo(ws, coords.Port)
+	return port != nil && port.Protocol == api.PortProtocol_PORT_PROTOCOL_TCP
+}
+
+// splice checks if the connection may be made to the workspace port and if so, copies bytes between the connection and the workspace port
+// until either side closes its connection. splice closes conn.
+func (p *TCPProxy) splice(conn net.Conn, coords WorkspaceCoords) {
+	defer conn.Close()
+
+	owi := log.OWI("", coords.ID, "")
+	lg := log.WithFields(owi).WithField("port", coords.Port).WithField("remoteAddr", conn.RemoteAddr().String())
+
+	ctx, cancel := context.WithTimeout(context.Background(), clusterLookupTimeout)
+	ws := p.WorkspaceInfoProvider.WorkspaceInfo(ctx, coords.ID)
+	cancel()
+	if ws == nil {
+		lg.Debug("did not find workspace info")
+		return
+	}
+	if ws.ClusterProxyURL != "" {
+		lg.WithField("cluster", ws.Cluster).Debug("workspace runs in another cluster - cannot splice TCP connection")
+		return
+	}
+	port := findPortInfo(ws, coords.Port)
+	if port == nil || port.Protocol != api.PortProtocol_PORT_PROTOCOL_TCP {
+		lg.Debug("workspace port is not exposed as TCP port")
+		return
+	}
+	if !isAccessibleByAnyone(ws, port) {
+		// raw connections cannot carry the owner cookie or an access token
+		lg.Debug("workspace port is not public - refusing TCP connection")
+		return
+	}
+	if p.RateLimiter != nil {
+		if ok, _ := p.RateLimiter.Allow(ws.WorkspaceID, port); !ok {
+			lg.Debug("connection exceeds rate limit")
+			return
+		}
+	}
+
+	dst, err := buildWorkspacePodURL(p.Config.WorkspacePodConfig.PortServiceTemplate, coords.ID, coords.Port)
+	if err != nil {
+		lg.WithError(err).Error("cannot resolve workspace port")
+		return
+	}
+	upstream, err := net.DialTimeout("tcp", dst.Host, time.Duration(p.Config.TransportConfig.ConnectTimeout))
+	if err != nil {
+		lg.WithError(err).Debug("cannot connect to workspace port")
+		return
+	}
+	defer upstream.Close()
+
+	if p.BandwidthMetrics != nil {
+		labels := bandwidthLabels{Workspace: ws.WorkspaceID, Owner: ws.Owner, Port: coords.Port}
+		ingress, egress := p.BandwidthMetrics.begin(labels)
+		defer p.BandwidthMetrics.end(labels)
+		conn = &countingConn{Conn: conn, Ingress: ingress, Egress: egress}
+	}
+
+	lg.Debug("splicing TCP connection")
+	pipe(conn, upstream, lg)
+}
+
+// pipe copies bytes in both directions until both directions are done
+func pipe(a, b net.Conn, lg *logrus.Entry) {
+	var wg sync.WaitGroup
+	cp := func(dst, src net.Conn) {
+		defer wg.Done()
+		_, err := io.Copy(dst, src)
+		if err != nil {
+			lg.WithError(err).Debug("TCP connection ended")
+		}
+		// let the other side know we're done sending, but keep reading what it has to say
+		closeWrite(dst)
+	}
+
+	wg.Add(2)
+	go cp(a, b)
+	go cp(b, a)
+	wg.Wait()
+}
+
+// closeWrite shuts down the writing side of a connection. If the connection does not support that, closeWrite closes it altogether.
+func closeWrite(conn net.Conn) error {
+	if cw, ok := conn.(interface{ CloseWrite() error }); ok {
+		return cw.CloseWrite()
+	}
+	return conn.Close()
+}
+
+// peekServerName reads the TLS ClientHello from conn and returns its server name, alongside all bytes read from conn
+func peekServerName(conn net.Conn) (serverName string, hello []byte, err error) {
+	err = conn.SetReadDeadline(time.Now().Add(sniHandshakeTimeout))
+	if err != nil {
+		return "", nil, err
+	}
+
+	var (
+		buf      bytes.Buffer
+		received bool
+	)
+	err = tls.Server(readOnlyConn{Reader: io.TeeReader(conn, &buf)}, &tls.Config{
+		GetConfigForClient: func(h *tls.ClientHelloInfo) (*tls.Config, error) {
+			serverName = h.ServerName
+			received = true
+			return nil, errClientHelloPeeked
+		},
+	}).Handshake()
+	if !received {
+		return "", nil, xerrors.Errorf("no TLS ClientHello: %w", err)
+	}
+	if serverName == "" {
+		return "", nil, xerrors.Errorf("TLS ClientHello without server name")
+	}
+
+	err = conn.SetReadDeadline(time.Time{})
+	if err != nil {
+		return "", nil, err
+	}
+	return serverName, buf.Bytes(), nil
+}
+
+// readOnlyConn lets the TLS server read the ClientHello without being able to respond to it
+type readOnlyConn struct {
+	io.Reader
+}
+
+func (c readOnlyConn) Write(p []byte) (int, error)        { return 0, io.ErrClosedPipe }
+func (c readOnlyConn) Close() error                       { return nil }
+func (c readOnlyConn) LocalAddr() net.Addr                { return nil }
+func (c readOnlyConn) RemoteAddr() net.Addr               { return nil }
+func (c readOnlyConn) SetDeadline(t time.Time) error      { return nil }
+func (c readOnlyConn) SetReadDeadline(t time.Time) error  { return nil }
+func (c readOnlyConn) SetWriteDeadline(t time.Time) error { return nil }
+
+// prefixedConn returns prefix from Read before it reads from the connection itself
+type prefixedConn struct {
+	net.Conn
+	prefix []byte
+}
+
+func (c *prefixedConn) Read(p []byte) (int, error) {
+	if len(c.prefix) > 0 {
+		n := copy(p, c.prefix)
+		c.prefix = c.prefix[n:]
+		return n, nil
+	}
+	return c.Conn.Read(p)
+}
+
+func (c *prefixedConn) CloseWrite() error {
+	return closeWrite(c.Conn)
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/sirupsen/logrus"
+)
+
+func TestTCPProxySNI(t *testing.T) {
+	log.Log.Logger.SetLevel(logrus.PanicLevel)
+
+	upstream := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "upstream hit: %s", r.Host)
+	}))
+	defer upstream.Close()
+	upstreamPort := uint32(upstream.Listener.Addr().(*net.TCPAddr).Port)
+
+	tests := []struct {
+		Desc        string
+		Admission   api.AdmissionLevel
+		Port        api.PortSpec
+		ServerName  string
+		Expectation string
+	}{
+		{
+			Desc:        "public TCP port",
+			Port:        api.PortSpec{Port: upstreamPort, Visibility: api.PortVisibility_PORT_VISIBILITY_PUBLIC, Protocol: api.PortProtocol_PORT_PROTOCOL_TCP},
+			Expectation: "upstream hit",
+		},
+		{
+			Desc:       "private TCP port",
+			Port:       api.PortSpec{Port: upstreamPort, Visibility: api.PortVisibility_PORT_VISIBILITY_PRIVATE, Protocol: api.PortProtocol_PORT_PROTOCOL_TCP},
+			ServerName: "",
+		},
+		{
+			Desc:        "private TCP port of workspace which admits everyone",
+			Admission:   api.AdmissionLevel_ADMIT_EVERYONE,
+			Port:        api.PortSpec{Port: upstreamPort, Visibility: api.PortVisibility_PORT_VISIBILITY_PRIVATE, Protocol: api.PortProtocol_PORT_PROTOCOL_TCP},
+			Expectation: "upstream hit",
+		},
+		{
+			Desc: "HTTP port",
+			Port: api.PortSpec{Port: upstreamPort, Visibility: api.PortVisibility_PORT_VISIBILITY_PUBLIC, Protocol: api.PortProtocol_PORT_PROTOCOL_HTTP},
+		},
+		{
+			Desc:       "unknown workspace",
+			Port:       api.PortSpec{Port: upstreamPort, Visibility: api.PortVisibility_PORT_VISIBILITY_PUBLIC, Protocol: api.PortProtocol_PORT_PROTOCOL_TCP},
+			ServerName: fmt.Sprintf("%d-00000000-0000-0000-0000-000000000000.test-domain.com", upstreamPort),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			ws := workspaces[0]
+			ws.Auth = &api.WorkspaceAuthentication{Admission: test.Admission, OwnerToken: "owner-token"}
+			ws.Ports = []PortInfo{{PortSpec: test.Port}}
+
+			l, err := net.Listen("tcp", "localhost:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer l.Close()
+			go NewTCPProxy(config, &fakeWsInfoProvider{infos: []WorkspaceInfo{ws}}).ServeSNI(l)
+
+			serverName := test.ServerName
+			if serverName == "" {
+				serverName = fmt.Sprintf("%d-%s.test-domain.com", upstreamPort, ws.WorkspaceID)
+			}
+			client := &http.Client{
+				Timeout: 5 * time.Second,
+				Transport: &http.Transport{
+					TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+					DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+						return (&net.Dialer{}).DialContext(ctx, "tcp", l.Addr().String())
+					},
+				},
+			}
+			resp, err := client.Get("https://" + serverName + "/")
+			var act string
+			if err == nil {
+				body, _ := ioutil.ReadAll(resp.Body)
+				resp.Body.Close()
+				act = string(body)
+			}
+
+			if test.Expectation == "" {
+				if err == nil {
+					t.Errorf("expected connection to be refused, got %q", act)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if exp := test.Expectation + ": " + serverName; act != exp {
+				t.Errorf("expected %q, got %q", exp, act)
+			}
+		})
+	}
+}
+
+func TestTCPProxyPortListener(t *testing.T) {
+	log.Log.Logger.SetLevel(logrus.PanicLevel)
+
+	upstream, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer upstream.Close()
+	go func() {
+		for {
+			conn, err := upstream.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+	upstreamPort := uint32(upstream.Addr().(*net.TCPAddr).Port)
+
+	tests := []struct {
+		Desc     string
+		Protocol api.PortProtocol
+		Spliced  bool
+	}{
+		{"TCP port", api.PortProtocol_PORT_PROTOCOL_TCP, true},
+		{"HTTP port", api.PortProtocol_PORT_PROTOCOL_HTTP, false},
+	}
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			l, err := net.Listen("tcp", "localhost:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			publicPort := strconv.Itoa(l.Addr().(*net.TCPAddr).Port)
+
+			ws := workspaces[0]
+			ws.Ports = []PortInfo{{
+				PortSpec:   api.PortSpec{Port: upstreamPort, Visibility: api.PortVisibility_PORT_VISIBILITY_PUBLIC, Protocol: test.Protocol},
+				PublicPort: publicPort,
+			}}
+			pl := NewTCPProxy(config, &fakeWsInfoProvider{infos: []WorkspaceInfo{ws}}).PortListener(l, publicPort)
+			defer pl.Close()
+
+			accepted := make(chan net.Conn, 1)
+			go func() {
+				conn, err := pl.Accept()
+				if err != nil {
+					return
+				}
+				accepted <- conn
+			}()
+
+			conn, err := net.Dial("tcp", l.Addr().String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+			conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+			if !test.Spliced {
+				select {
+				case c := <-accepted:
+					c.Close()
+				case <-time.After(5 * time.Second):
+					t.Errorf("connection was not accepted to be served as HTTP")
+				}
+				return
+			}
+
+			_, err = conn.Write([]byte("hello"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			buf := make([]byte, 5)
+			_, err = io.ReadFull(conn, buf)
+			if err != nil {
+				t.Fatalf("cannot read from spliced connection: %v", err)
+			}
+			if string(buf) != "hello" {
+				t.Errorf("unexpected response: %q", string(buf))
+			}
+			select {
+			case <-accepted:
+				t.Errorf("spliced connection was accepted to be served as HTTP")
+			default:
+			}
+		})
+	}
+}
@@ -84,8 +84,13 @@ func matchWorkspaceHostHeader(wsHostSuffix string, headerProvider hostHeaderProv
 	}
 }
 
+// workspacePortHostRegex matches the host names of workspace ports, e.g. 8080-<workspaceID><wsHostSuffix>
+func workspacePortHostRegex(wsHostSuffix string) *regexp.Regexp {
+	return regexp.MustCompile("^(webview-)?" + workspacePortRegex + workspaceIDRegex + wsHostSuffix)
+}
+
 func matchWorkspacePortHostHeader(wsHostSuffix string, headerProvider hostHeaderProvider) mux.MatcherFunc {
-	r := regexp.MustCompile("^(webview-)?" + workspacePortRegex + workspaceIDRegex + wsHostSuffix)
+	r := workspacePortHostRegex(wsHostSuffix)
 	return func(req *http.Request, m *mux.RouteMatch) bool {
 		hostname := headerProvider(req)
 		if hostname == "" {
@@ -0,0 +1,64 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/spf13/cobra"
+)
+
+// nodesDescribeCmd represents the describe command
+var nodesDescribeCmd = &cobra.Command{
+	Use:   "describe <node>",
+	Short: "describes the workspaces on a node as seen by its ws-daemon",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		daemons, err := getDaemonClients(ctx, args[0])
+		if err != nil {
+			log.WithError(err).Fatal("cannot connect")
+		}
+
+		var (
+			resps = make([]*api.ListWorkspacesResponse, len(daemons))
+			wg    sync.WaitGroup
+		)
+		for i, d := range daemons {
+			wg.Add(1)
+			go func(i int, d daemonConnection) {
+				defer wg.Done()
+				defer d.Conn.Close()
+
+				resp, err := d.Client.ListWorkspaces(ctx, &api.ListWorkspacesRequest{})
+				if err != nil {
+					log.WithError(err).WithField("pod", d.Pod).Error("error during RPC call")
+					return
+				}
+				resps[i] = resp
+			}(i, d)
+		}
+		wg.Wait()
+
+		tpl := `INSTANCE	OWNER	STATE	CONTAINER	CPU LIMIT	CPU LOAD	DISK	LAST BACKUP	LIVE BACKUP
+{{- range . }}{{ if . }}{{ range .Workspaces }}
+{{ .Id }}	{{ .Metadata.Owner }}	{{ .State }}	{{ if .Container }}{{ .Container.Id | trunc 12 }}{{ end }}	{{ .Resources.CpuLimit }}	{{ .Resources.CpuLoad }}	{{ .Resources.DiskUsage }}	{{ if .Backup.LastBackup }}{{ .Backup.LastBackup.Seconds | date "2006-01-02T15:04:05Z07:00" }}{{ end }}	{{ .Backup.LiveBackupRunning -}}
+{{ end }}{{ end }}{{ end }}
+`
+		err = getOutputFormat(tpl, "{..id}").Print(resps)
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	nodesCmd.AddCommand(nodesDescribeCmd)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/gitpod-io/gitpod/gpctl/pkg/util"
+	"github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// nodesCmd represents the nodes command
+var nodesCmd = &cobra.Command{
+	Use:   "nodes",
+	Short: "Inspects workspace nodes and their ws-daemons",
+}
+
+// daemonTLSSecret is the secret which holds the certificates ws-daemon and its clients use
+const daemonTLSSecret = "ws-daemon-tls"
+
+func init() {
+	nodesCmd.PersistentFlags().String("tls-path", "", "Path to a directory containing ca.crt, tls.crt and tls.key. Defaults to the certificates in the "+daemonTLSSecret+" secret.")
+	nodesCmd.PersistentFlags().Bool("insecure", false, "Connect to ws-daemons which don't use TLS")
+
+	rootCmd.AddCommand(nodesCmd)
+}
+
+type daemonConnection struct {
+	Pod    string
+	Conn   *grpc.ClientConn
+	Client api.InventoryServiceClient
+}
+
+// getDaemonClients connects to all ws-daemon pods running on a node
+func getDaemonClients(ctx context.Context, node string) ([]daemonConnection, error) {
+	cfg, namespace, err := getKubeconfig()
+	if err != nil {
+		return nil, err
+	}
+	clientSet, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := clientSet.CoreV1().Pods(namespace).List(metav1.ListOptions{
+		LabelSelector: "component=ws-daemon",
+		FieldSelector: fmt.Sprintf("spec.nodeName=%s", node),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(pods.Items) == 0 {
+		return nil, xerrors.Errorf("no ws-daemon running on node %s", node)
+	}
+
+	secopt, err := getDaemonSecurityOption(clientSet, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]daemonConnection, 0, len(pods.Items))
+	for i, pod := range pods.Items {
+		localPort := 20300 + i
+		readychan, errchan := util.ForwardPort(ctx, cfg, namespace, pod.Name, fmt.Sprintf("%d:8080", localPort))
+		select {
+		case <-readychan:
+		case err := <-errchan:
+			return nil, err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", localPort), secopt)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, daemonConnection{
+			Pod:    pod.Name,
+			Conn:   conn,
+			Client: api.NewInventoryServiceClient(conn),
+		})
+	}
+	return res, nil
+}
+
+// getDaemonSecurityOption produces the transport credentials for connecting to ws-daemon. Unless we're told
+// otherwise we use the client certificate from the ws-daemon TLS secret, just like ws-manager does.
+func getDaemonSecurityOption(clientSet kubernetes.Interface, namespace string) (grpc.DialOption, error) {
+	if insecure, _ := nodesCmd.Flags().GetBool("insecure"); insecure {
+		return grpc.WithInsecure(), nil
+	}
+
+	var rootCA, cert, key []byte
+	if tlsPath, _ := nodesCmd.Flags().GetString("tls-path"); tlsPath != "" {
+		var err error
+		rootCA, err = ioutil.ReadFile(filepath.Join(tlsPath, "ca.crt"))
+		if err != nil {
+			return nil, xerrors.Errorf("could not read ca certificate: %w", err)
+		}
+		cert, err = ioutil.ReadFile(filepath.Join(tlsPath, "tls.crt"))
+		if err != nil {
+			return nil, xerrors.Errorf("could not read tls cert: %w", err)
+		}
+		key, err = ioutil.ReadFile(filepath.Join(tlsPath, "tls.key"))
+		if err != nil {
+			return nil, xerrors.Errorf("could not read tls key: %w", err)
+		}
+	} else {
+		secret, err := clientSet.CoreV1().Secrets(namespace).Get(daemonTLSSecret, metav1.GetOptions{})
+		if err != nil {
+			return nil, xerrors.Errorf("cannot get secret %s (use --insecure for ws-daemons without TLS): %w", daemonTLSSecret, err)
+		}
+		rootCA, cert, key = secret.Data["ca.crt"], secret.Data["tls.crt"], secret.Data["tls.key"]
+	}
+
+	creds, err := newDaemonCredentials(rootCA, cert, key)
+	if err != nil {
+		return nil, err
+	}
+	return grpc.WithTransportCredentials(creds), nil
+}
+
+func newDaemonCredentials(rootCA, cert, key []byte) (credentials.TransportCredentials, error) {
+	certPool := x509.NewCertPool()
+	if ok := certPool.AppendCertsFromPEM(rootCA); !ok {
+		return nil, xerrors.Errorf("failed to append ca certs")
+	}
+
+	certificate, err := tls.X509KeyPair(cert, key)
+	if err != nil {
+		return nil, xerrors.Errorf("could not load tls cert: %w", err)
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		ServerName:   "wsdaemon",
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      certPool,
+	}), nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/spf13/cobra"
+)
+
+// workspacesGrantAccessCmd grants someone other than the owner access to a workspace
+var workspacesGrantAccessCmd = &cobra.Command{
+	Use:   "grant-access <instanceID>",
+	Short: "gives the holder of a share token access to a workspace",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		userID, _ := cmd.Flags().GetString("user")
+		duration, _ := cmd.Flags().GetString("duration")
+		readOnly, _ := cmd.Flags().GetBool("read-only")
+		scope := api.AccessScope_ACCESS_SCOPE_FULL
+		if readOnly {
+			scope = api.AccessScope_ACCESS_SCOPE_READ_ONLY
+		}
+
+		conn, client, err := getWorkspacesClient(ctx)
+		if err != nil {
+			log.WithError(err).Fatal("cannot connect")
+		}
+		defer conn.Close()
+
+		instanceID := args[0]
+		if strings.ContainsAny(instanceID, ".") || strings.HasPrefix(instanceID, "http://") || strings.HasPrefix(instanceID, "https://") {
+			s, err := getStatusByURL(ctx, client, instanceID)
+			if err != nil {
+				log.Fatal(err)
+			}
+			instanceID = s.Id
+		}
+
+		resp, err := client.GrantAccess(ctx, &api.GrantAccessRequest{
+			Id:       instanceID,
+			UserId:   userID,
+			Scope:    scope,
+			Duration: duration,
+		})
+		if err != nil {
+			log.WithError(err).Fatal("error during RPC call")
+		}
+
+		err = getOutputFormat("grant: {{ .GrantId }}\ntoken: {{ .Token }}\n", "{.token}").Print(resp)
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	workspacesCmd.AddCommand(workspacesGrantAccessCmd)
+	workspacesGrantAccessCmd.Flags().String("user", "", "user the share token is meant for - informational only, anyone holding the token can use it")
+	workspacesGrantAccessCmd.Flags().String("duration", "", "time after which the grant expires, e.g. 30m")
+	_ = workspacesGrantAccessCmd.MarkFlagRequired("duration")
+	workspacesGrantAccessCmd.Flags().Bool("read-only", false, "permit only requests which do not modify anything")
+}
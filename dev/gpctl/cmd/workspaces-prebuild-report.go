@@ -0,0 +1,104 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/spf13/cobra"
+)
+
+var workspacesPrebuildReportOpts struct {
+	Owner string
+	Task  string
+}
+
+// workspacesPrebuildReportCmd shows the report of a finished prebuild
+var workspacesPrebuildReportCmd = &cobra.Command{
+	Use:   "prebuild-report <workspaceID>",
+	Short: "shows the report a prebuild uploaded next to its snapshot",
+	Long: `Shows the report a prebuild uploaded next to its snapshot.
+Use --task to print the output of a single prebuild task instead.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		conn, client, err := getWorkspacesClient(ctx)
+		if err != nil {
+			log.WithError(err).Fatal("cannot connect")
+		}
+		defer conn.Close()
+
+		resp, err := client.GetPrebuildReport(ctx, &api.GetPrebuildReportRequest{
+			Id:    args[0],
+			Owner: workspacesPrebuildReportOpts.Owner,
+		})
+		if err != nil {
+			log.WithError(err).Fatal("error during RPC call")
+		}
+
+		if task := workspacesPrebuildReportOpts.Task; task != "" {
+			for _, t := range resp.Tasks {
+				if t.Id != task && t.Name != task {
+					continue
+				}
+				if t.LogUrl == "" {
+					log.Fatalf("task %s has no log", task)
+				}
+				err = printPrebuildLog(ctx, t.LogUrl)
+				if err != nil {
+					log.WithError(err).Fatal("cannot download task log")
+				}
+				return
+			}
+			log.Fatalf("prebuild has no task %s", task)
+		}
+
+		tpl := `Snapshot:	{{ .Snapshot }}
+Tasks:
+{{- range .Tasks }}
+  {{ .Id }} {{ .Name }}:	{{ if .Failed }}failed{{ if .Failure }} ({{ .Failure }}){{ else }} with exit code {{ .ExitCode }}{{ end }}{{ else }}ok{{ end }}
+{{- range .Phases }}
+    {{ .Name }}:	exit code {{ .ExitCode }}, {{ .DurationMs }}ms
+{{- end }}
+{{- end }}
+`
+		err = getOutputFormat(tpl, "{.snapshot}").Print(resp)
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func printPrebuildLog(ctx context.Context, url string) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("cannot get log: status %d", resp.StatusCode)
+	}
+	_, err = io.Copy(os.Stdout, resp.Body)
+	return err
+}
+
+func init() {
+	workspacesCmd.AddCommand(workspacesPrebuildReportCmd)
+	workspacesPrebuildReportCmd.Flags().StringVar(&workspacesPrebuildReportOpts.Owner, "owner", "", "ID of the user who owns the prebuild workspace")
+	workspacesPrebuildReportCmd.Flags().StringVar(&workspacesPrebuildReportOpts.Task, "task", "", "ID or name of the task whose output to print")
+	_ = workspacesPrebuildReportCmd.MarkFlagRequired("owner")
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2020 TypeFox GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/spf13/cobra"
+)
+
+// workspacesRevokeAccessCmd withdraws an access grant of a workspace
+var workspacesRevokeAccessCmd = &cobra.Command{
+	Use:   "revoke-access <instanceID> <grantID>",
+	Short: "withdraws an access grant of a workspace",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		conn, client, err := getWorkspacesClient(ctx)
+		if err != nil {
+			log.WithError(err).Fatal("cannot connect")
+		}
+		defer conn.Close()
+
+		instanceID := args[0]
+		if strings.ContainsAny(instanceID, ".") || strings.HasPrefix(instanceID, "http://") || strings.HasPrefix(instanceID, "https://") {
+			s, err := getStatusByURL(ctx, client, instanceID)
+			if err != nil {
+				log.Fatal(err)
+			}
+			instanceID = s.Id
+		}
+
+		resp, err := client.RevokeAccess(ctx, &api.RevokeAccessRequest{
+			Id:      instanceID,
+			GrantId: args[1],
+		})
+		if err != nil {
+			log.WithError(err).Fatal("error during RPC call")
+		}
+
+		err = getOutputFormat("revoked\n", "").Print(resp)
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	workspacesCmd.AddCommand(workspacesRevokeAccessCmd)
+}